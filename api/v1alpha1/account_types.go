@@ -42,6 +42,12 @@ type AccountSpec struct {
 	ManualSTSMode         bool                  `json:"manualSTSMode,omitempty"`
 	AccountPool           string                `json:"accountPool,omitempty"`
 	RegionalServiceQuotas RegionalServiceQuotas `json:"regionalServiceQuotas,omitempty"`
+	// AccountName is the name of the AWS account, the name of the Account when empty
+	// +optional
+	AccountName string `json:"accountName,omitempty"`
+	// Email is the email address of the root user of the AWS account, derived from the account name when empty
+	// +optional
+	Email string `json:"email,omitempty"`
 }
 
 type RegionalServiceQuotas map[string]AccountServiceQuota
@@ -100,6 +106,70 @@ const (
 
 type SupportedOptInRegions string
 
+// AMICopies maps source AMI IDs to the progress of their copy into another account
+type AMICopies map[string]*AMICopyStatus
+
+// AMICopyStatus tracks the copy of a single AMI into another account
+type AMICopyStatus struct {
+	State AMICopyState `json:"state"`
+	// DestinationImageID is the ID of the copied AMI in the destination account
+	// +optional
+	DestinationImageID string `json:"destinationImageID,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type AMICopyState string
+
+const (
+	AMICopyShared    AMICopyState = "SHARED"
+	AMICopyCopying   AMICopyState = "COPYING"
+	AMICopyCompleted AMICopyState = "COMPLETED"
+	AMICopyFailed    AMICopyState = "FAILED"
+)
+
+// RegionStatuses maps regions to the outcome of their initialization
+type RegionStatuses map[string]*RegionStatus
+
+// RegionStatus is the outcome of the initialization of a region
+type RegionStatus struct {
+	State RegionState `json:"state"`
+	// RetryAfter is when a region skipped because of an outage is initialized again
+	// +optional
+	RetryAfter *metav1.Time `json:"retryAfter,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type RegionState string
+
+const (
+	RegionInitialized RegionState = "Initialized"
+	RegionInitFailed  RegionState = "Failed"
+	// RegionSkippedDueToOutage is set when the error rate of the region across accounts opened its circuit
+	RegionSkippedDueToOutage RegionState = "SkippedDueToOutage"
+)
+
+// AccountFailureReason classifies the root cause of an Account entering the Failed state
+// +kubebuilder:validation:Enum=CreationTimeout;OrgLimitExceeded;RegionInitFailed;VerificationTimeout;ClientError;QuotaDenied
+type AccountFailureReason string
+
+const (
+	// FailureReasonCreationTimeout is set when the account stayed in Creating for too long
+	FailureReasonCreationTimeout AccountFailureReason = "CreationTimeout"
+	// FailureReasonOrgLimitExceeded is set when AWS Organizations refused to create more accounts. An account that
+	// is refused at the account limit isn't failed, it waits with the WaitingOnAccountLimit condition instead
+	FailureReasonOrgLimitExceeded AccountFailureReason = "OrgLimitExceeded"
+	// FailureReasonRegionInitFailed is set when region initialization failed or timed out
+	FailureReasonRegionInitFailed AccountFailureReason = "RegionInitFailed"
+	// FailureReasonVerificationTimeout is set when the account was not verified by AWS in time
+	FailureReasonVerificationTimeout AccountFailureReason = "VerificationTimeout"
+	// FailureReasonClientError is set when an AWS or Kubernetes API call failed
+	FailureReasonClientError AccountFailureReason = "ClientError"
+	// FailureReasonQuotaDenied is set when AWS denied a service quota increase
+	FailureReasonQuotaDenied AccountFailureReason = "QuotaDenied"
+)
+
 // AccountStatus defines the observed state of Account
 // +k8s:openapi-gen=true
 type AccountStatus struct {
@@ -114,6 +184,51 @@ type AccountStatus struct {
 	Reused                   bool                  `json:"reused,omitempty"`
 	RegionalServiceQuotas    RegionalServiceQuotas `json:"regionalServiceQuotas,omitempty"`
 	OptInRegions             OptInRegions          `json:"optInRegions,omitempty"`
+	// RegionStatuses reports the outcome of the initialization of every region, including the regions skipped because
+	// of an AWS outage
+	// +optional
+	RegionStatuses RegionStatuses `json:"regionStatuses,omitempty"`
+	// AMICopies reports the progress of AMIs requested to be copied into another account
+	// +optional
+	AMICopies AMICopies `json:"amiCopies,omitempty"`
+	// FailureReason is the root cause class of the failure when the account is in the Failed state
+	// +optional
+	FailureReason AccountFailureReason `json:"failureReason,omitempty"`
+	// LastCleanup reports what the AWS cleanup of the last reuse of the account deleted
+	// +optional
+	LastCleanup *AccountCleanupReport `json:"lastCleanup,omitempty"`
+	// ObservedGeneration is the generation of the account last reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ReuseCount is how many times the account was released by a claim and made available again
+	// +optional
+	ReuseCount int `json:"reuseCount,omitempty"`
+}
+
+// AccountCleanupReport summarizes the AWS cleanup run when an account is reused
+type AccountCleanupReport struct {
+	// StartTime is when the cleanup started
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is when the last cleanup step finished
+	CompletionTime metav1.Time `json:"completionTime"`
+	// Succeeded is true when every cleanup step finished without an error
+	Succeeded bool `json:"succeeded"`
+	// Steps reports the outcome of every cleanup step that ran
+	// +optional
+	// +listType=atomic
+	Steps []AccountCleanupStep `json:"steps,omitempty"`
+}
+
+// AccountCleanupStep is the outcome of a single cleanup step, e.g. the deletion of the S3 buckets
+type AccountCleanupStep struct {
+	Name string `json:"name"`
+	// Deleted is the number of resources the step deleted
+	Deleted int `json:"deleted"`
+	// Duration is how long the step took
+	Duration metav1.Duration `json:"duration"`
+	// Error is the error the step failed with
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 // AccountCondition contains details for the current condition of a AWS account
@@ -154,6 +269,8 @@ const (
 	AccountPending AccountConditionType = "Pending"
 	// AccountPendingVerification is set when account creation is pending
 	AccountPendingVerification AccountConditionType = "PendingVerification"
+	// AccountPendingCreation is set when an Account is queued for the creation of its AWS account
+	AccountPendingCreation AccountConditionType = "PendingCreation"
 	// FIXME: Have to call this different than "AccountClaimed", as that clashes
 	// with the AccountClaimConditionType
 	AccountIsClaimed AccountConditionType = "Claimed"
@@ -176,6 +293,17 @@ const (
 	AccountOptingInRegions AccountConditionType = "OptingInRegions"
 	// AccountOptInRegionEnabled indicates that supported Opt-In regions have been enabled
 	AccountOptInRegionEnabled AccountConditionType = "OptInRegionsEnabled"
+	// AccountMaintenancePending indicates a disruptive operation waits for the maintenance window of the account's pool
+	AccountMaintenancePending AccountConditionType = "MaintenancePending"
+	// AccountWaitingOnAccountLimit indicates the creation of the AWS account waits for the organization to be under the
+	// account limit of the operator ConfigMap
+	AccountWaitingOnAccountLimit AccountConditionType = "WaitingOnAccountLimit"
+	// AccountRoleNameCollision indicates a role the operator creates exists in the AWS account without being managed
+	// by the operator
+	AccountRoleNameCollision AccountConditionType = "RoleNameCollision"
+	// AccountSkippedVerification indicates the account turned ready without Enterprise Support verification, skipped
+	// by the operator ConfigMap
+	AccountSkippedVerification AccountConditionType = "SkippedVerification"
 )
 
 // +genclient
@@ -231,6 +359,15 @@ func (a *Account) IsFailed() bool {
 	return false
 }
 
+// GetAccountName returns the name of the AWS account, spec.accountName or, for Accounts created before it was set,
+// the name of the Account
+func (a *Account) GetAccountName() string {
+	if a.Spec.AccountName != "" {
+		return a.Spec.AccountName
+	}
+	return a.Name
+}
+
 // HasState returns true if an account has a state set at all
 func (a *Account) HasState() bool {
 	return a.Status.State != ""
@@ -346,6 +483,11 @@ func (a *Account) IsReady() bool {
 	return a.Status.State == string(AccountReady)
 }
 
+// IsPendingCreation returns true if an account is queued for the creation of its AWS account
+func (a *Account) IsPendingCreation() bool {
+	return a.Status.State == string(AccountPendingCreation)
+}
+
 // IsCreating returns true if an account is creating
 func (a *Account) IsCreating() bool {
 	return a.Status.State == string(AccountCreating)
@@ -458,9 +600,11 @@ func (a *Account) IsEnablingOptInRegions() bool {
 	return a.Status.State == string(AccountOptingInRegions)
 }
 
-// IsProgressing returns true if the account state is Creating, Pending Verification, or InitializingRegions
+// IsProgressing returns true if the account state is PendingCreation, Creating, Pending Verification, or
+// InitializingRegions
 func (a *Account) IsProgressing() bool {
-	if a.Status.State == string(AccountCreating) ||
+	if a.Status.State == string(AccountPendingCreation) ||
+		a.Status.State == string(AccountCreating) ||
 		a.Status.State == string(AccountPendingVerification) ||
 		a.Status.State == string(AccountInitializingRegions) {
 		return true
@@ -2,6 +2,9 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestValidate(t *testing.T) {
@@ -80,6 +83,40 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "Testing STS session duration too long",
+			accountClaim: &AccountClaim{
+				Spec: AccountClaimSpec{
+					ManualSTSMode:      true,
+					STSRoleARN:         "arn:aws:whatever:something:role/whomever",
+					STSSessionDuration: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+			expectedErr: ErrSTSSessionDurationInvalid,
+		},
+		{
+			name: "Testing STS session policy not JSON",
+			accountClaim: &AccountClaim{
+				Spec: AccountClaimSpec{
+					ManualSTSMode:    true,
+					STSRoleARN:       "arn:aws:whatever:something:role/whomever",
+					STSSessionPolicy: "s3:*",
+				},
+			},
+			expectedErr: ErrSTSSessionPolicyInvalid,
+		},
+		{
+			name: "Testing STS session Valid",
+			accountClaim: &AccountClaim{
+				Spec: AccountClaimSpec{
+					ManualSTSMode:      true,
+					STSRoleARN:         "arn:aws:whatever:something:role/whomever",
+					STSSessionDuration: &metav1.Duration{Duration: 30 * time.Minute},
+					STSSessionPolicy:   `{"Version":"2012-10-17","Statement":[]}`,
+				},
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "Testing non-ccs Valid",
 			accountClaim: &AccountClaim{
@@ -1,7 +1,9 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"errors"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,6 +31,54 @@ type AccountClaimSpec struct {
 	KmsKeyId            string             `json:"kmsKeyId,omitempty"`
 	AccountPool         string             `json:"accountPool,omitempty"`
 	FleetManagerConfig  FleetManagerConfig `json:"fleetManagerConfig,omitempty"` // FleetmanagerConfig is exclusively designed for use by the fleet manager
+	// VpcEndpointServiceAllowedPrincipals lists the principal ARNs allowed to connect to the VPC endpoint services in the claimed account
+	VpcEndpointServiceAllowedPrincipals []string `json:"vpcEndpointServiceAllowedPrincipals,omitempty"`
+	// TemplateRef names an AccountClaimTemplate providing defaults for fields left empty on the claim
+	TemplateRef string `json:"templateRef,omitempty"`
+	// STSSessionDuration is the duration of the session the operator assumes STSRoleARN with. It defaults to one hour,
+	// which is also the most AWS allows for sessions assumed through the STS jump role.
+	STSSessionDuration *metav1.Duration `json:"stsSessionDuration,omitempty"`
+	// STSSessionPolicy is an optional IAM policy document further restricting the session assumed with STSRoleARN
+	STSSessionPolicy string `json:"stsSessionPolicy,omitempty"`
+	// RegionalCredentialSecrets delivers a copy of the credentials secret for each region in Aws.Regions, named after
+	// the credentials secret and the region, with the region and its STS regional endpoint set
+	RegionalCredentialSecrets bool `json:"regionalCredentialSecrets,omitempty"`
+	// CredentialProfile names the credential profile, defined in the operator ConfigMap, setting the IAM policies of
+	// the IAM user whose credentials are delivered. It defaults to admin.
+	CredentialProfile string `json:"credentialProfile,omitempty"`
+	// RequiredQuotas are service quotas that have to be approved in the claimed account before the claim is Ready
+	RequiredQuotas []RequiredQuota `json:"requiredQuotas,omitempty"`
+	// RequiredOU is the ID of an organizational unit the claimed account has to be in before the claim is Ready. The
+	// account is moved into it instead of the OU of the legal entity, and AccountOU is set once the move is verified.
+	// +kubebuilder:validation:Pattern=`^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$`
+	RequiredOU string `json:"requiredOU,omitempty"`
+	// TemporaryQuotas are service quotas raised in the claimed account for the duration of the claim. They're requested
+	// once the claim is Ready, without blocking it, and recorded in the account for rollback when the claim is deleted.
+	TemporaryQuotas []RequiredQuota `json:"temporaryQuotas,omitempty"`
+	// RegionReportConfigMap names a ConfigMap in the namespace of the claim the operator writes the region capability
+	// report of the claimed account to, once the claim is Ready
+	RegionReportConfigMap string `json:"regionReportConfigMap,omitempty"`
+	// CredentialSecretReader names a ServiceAccount in the namespace of the credentials secret the operator grants
+	// read access to the secrets delivered for the claim, and to those only, through a Role and RoleBinding it manages
+	CredentialSecretReader string `json:"credentialSecretReader,omitempty"`
+	// CLIConfig adds the AWS CLI config and credentials files of a profile named after the claim, defaulting to its
+	// first region, to the delivered credentials secrets under the config and credentials keys
+	CLIConfig bool `json:"cliConfig,omitempty"`
+	// Tags are tags the operator keeps on the AWS resources of the claim while it exists: the Organizations tags of the
+	// account and the tags of the IAM user or fleet manager role created for it. They are removed when the account is
+	// reset. Unlike CustomTags, which are only applied when the resources are created, they are reconciled.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// RequiredQuota is a service quota a claim needs approved in its account
+type RequiredQuota struct {
+	// QuotaCode is the code of the service quota, e.g. L-1216C47A for the running on-demand standard instances
+	QuotaCode SupportedServiceQuotas `json:"quotaCode"`
+	// Value is the lowest value of the quota the claim accepts
+	Value int `json:"value"`
+	// Region is the region the quota is needed in. It defaults to every region of the claim.
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // AccountClaimStatus defines the observed state of AccountClaim
@@ -39,6 +89,91 @@ type AccountClaimStatus struct {
 	Conditions []AccountClaimCondition `json:"conditions"`
 
 	State ClaimStatus `json:"state"`
+
+	// Phases are the phases of the claim completed so far, in the order of ClaimPhases
+	// +optional
+	Phases []AccountClaimPhase `json:"phases,omitempty"`
+	// Progress is the percentage of the phases of the claim completed
+	// +optional
+	Progress int `json:"progress,omitempty"`
+	// ObservedGeneration is the generation of the claim last reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// CredentialProfile is the credential profile applied to the delivered credentials
+	// +optional
+	CredentialProfile string `json:"credentialProfile,omitempty"`
+	// DeliveredSecrets are the secrets delivered for the claim, deleted with it as they may live in other namespaces
+	// +optional
+	DeliveredSecrets []SecretRef `json:"deliveredSecrets,omitempty"`
+	// IAMUserSweepCursor is where the sweep of the IAM users of a fleet manager account resumes on the next reconcile
+	// +optional
+	IAMUserSweepCursor string `json:"iamUserSweepCursor,omitempty"`
+	// AppliedSpec records the fields of the spec the claim was provisioned with, which can't be changed afterwards
+	// +optional
+	AppliedSpec *AppliedClaimSpec `json:"appliedSpec,omitempty"`
+	// TemporaryQuotas are the requests of the temporary quotas of the claim, by quota code and region
+	// +optional
+	TemporaryQuotas []TemporaryQuotaStatus `json:"temporaryQuotas,omitempty"`
+	// CleanupDryRun lists what the cleanup of the account of the deleted claim would delete, while the claim has the
+	// cleanup-dry-run annotation
+	// +optional
+	CleanupDryRun *CleanupDryRunReport `json:"cleanupDryRun,omitempty"`
+}
+
+// CleanupDryRunReport lists the resources the cleanup of the account of a deleted claim would delete
+type CleanupDryRunReport struct {
+	// Time is when the resources were listed
+	Time metav1.Time `json:"time"`
+	// Resources are the resources the cleanup would delete, by kind
+	// +optional
+	// +listType=atomic
+	Resources []CleanupDryRunResources `json:"resources,omitempty"`
+}
+
+// CleanupDryRunResources are the resources of a kind the cleanup would delete, e.g. the S3 buckets
+type CleanupDryRunResources struct {
+	Kind string `json:"kind"`
+	// Count is how many resources of the kind the cleanup would delete
+	Count int `json:"count"`
+	// Names are the names or IDs of the resources, only the first ones of kinds with many resources
+	// +optional
+	// +listType=atomic
+	Names []string `json:"names,omitempty"`
+}
+
+// TemporaryQuotaStatus is the request of a temporary quota of a claim in a region of its account
+type TemporaryQuotaStatus struct {
+	QuotaCode SupportedServiceQuotas `json:"quotaCode"`
+	Region    string                 `json:"region"`
+	// Value is the value requested for the claim
+	Value int `json:"value"`
+	// PreviousValue is the value of the quota in the account before the claim raised it
+	PreviousValue int `json:"previousValue"`
+	// Status is the status of the request
+	Status ServiceRequestStatus `json:"status"`
+}
+
+// AppliedClaimSpec is the part of the spec of a claim that can't be reconciled once the claim is Ready
+type AppliedClaimSpec struct {
+	// Regions are the names of the regions of the claim
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+	// +optional
+	BYOC bool `json:"byoc,omitempty"`
+	// +optional
+	BYOCAWSAccountID string `json:"byocAWSAccountID,omitempty"`
+	// +optional
+	ManualSTSMode bool `json:"manualSTSMode,omitempty"`
+	// +optional
+	LegalEntityID string `json:"legalEntityID,omitempty"`
+	// +optional
+	AccountPool string `json:"accountPool,omitempty"`
+}
+
+// AccountClaimPhase records when a phase of the claim was completed
+type AccountClaimPhase struct {
+	Name           ClaimPhase  `json:"name"`
+	CompletionTime metav1.Time `json:"completionTime"`
 }
 
 // AccountClaimCondition contains details for the current condition of a AWS account claim
@@ -81,6 +216,46 @@ const (
 	InvalidAccountClaim AccountClaimConditionType = "InvalidAccountClaim"
 	// InternalError is set when a serious internal issue arrises
 	InternalError AccountClaimConditionType = "InternalError"
+	// BlockedByFinalizers is set when a deleted AccountClaim can't be cleaned up because of other finalizers
+	BlockedByFinalizers AccountClaimConditionType = "BlockedByFinalizers"
+	// STSRoleTrustFailed is set when the operator can't assume the STSRoleARN of a manual STS mode claim
+	STSRoleTrustFailed AccountClaimConditionType = "STSRoleTrustFailed"
+	// LinkedAccountFailed is set when the Account linked to the claim failed, its reason is the Account's failure reason
+	LinkedAccountFailed AccountClaimConditionType = "AccountFailed"
+	// AccountLinkConflict is set when the Account linked to the claim is linked to another claim as well
+	AccountLinkConflict AccountClaimConditionType = "AccountLinkConflict"
+	// ApprovalPending is set while the claim waits to be approved before an account is handed out
+	ApprovalPending AccountClaimConditionType = "ApprovalPending"
+	// ApprovalDenied is set when the claim was denied or wasn't approved in time
+	ApprovalDenied AccountClaimConditionType = "ApprovalDenied"
+	// RegionsTrimmed is set when regions the account pool doesn't support were removed from the claim
+	RegionsTrimmed AccountClaimConditionType = "RegionsTrimmed"
+	// QuotasPending is set while the required quotas of the claim aren't approved in its account
+	QuotasPending AccountClaimConditionType = "QuotasPending"
+	// OUPending is set while the account of the claim isn't verified to be in the required OU of the claim
+	OUPending AccountClaimConditionType = "OUPending"
+	// SpecDriftUnsupported is set when fields of the spec of a Ready claim were changed that can't be reconciled
+	SpecDriftUnsupported AccountClaimConditionType = "SpecDriftUnsupported"
+	// BYOCAccountMismatch is set when the cleanup of a CCS claim is refused as its credentials or Account don't match
+	// the customer account of the claim
+	BYOCAccountMismatch AccountClaimConditionType = "BYOCAccountMismatch"
+	// Escalated is set when the claim wasn't Ready within its fulfillment deadline
+	Escalated AccountClaimConditionType = "Escalated"
+	// RoleNameCollision is set when a role the operator creates for the claim exists in the AWS account without being
+	// managed by the operator
+	RoleNameCollision AccountClaimConditionType = "RoleNameCollision"
+	// UnsupportedInPartition is set when the claim requests a capability the AWS partition of the operator doesn't have
+	UnsupportedInPartition AccountClaimConditionType = "UnsupportedInPartition"
+	// CleanupDryRun is set when the cleanup of the account of a deleted claim was only listed, as the claim has the
+	// cleanup-dry-run annotation
+	CleanupDryRun AccountClaimConditionType = "CleanupDryRun"
+)
+
+const (
+	// MinSTSSessionDuration is the shortest session AWS STS issues
+	MinSTSSessionDuration = 15 * time.Minute
+	// MaxSTSSessionDuration is the longest session AWS STS issues for a role chained through the STS jump role
+	MaxSTSSessionDuration = time.Hour
 )
 
 // ClaimStatus is a valid value from AccountClaim.Status
@@ -89,12 +264,31 @@ type ClaimStatus string
 const (
 	// ClaimStatusPending pending status for a claim
 	ClaimStatusPending ClaimStatus = "Pending"
+	// ClaimStatusPendingApproval status for a claim waiting to be approved
+	ClaimStatusPendingApproval ClaimStatus = "PendingApproval"
 	// ClaimStatusReady ready status for a claim
 	ClaimStatusReady ClaimStatus = "Ready"
 	// ClaimStatusError error status for a claim
 	ClaimStatusError ClaimStatus = "Error"
 )
 
+// ClaimPhase is a step of satisfying a claim
+type ClaimPhase string
+
+const (
+	// ClaimPhaseSelected an account was selected for the claim
+	ClaimPhaseSelected ClaimPhase = "Selected"
+	// ClaimPhaseCredentialsProvisioned the credentials of the account were delivered to the claim's namespace
+	ClaimPhaseCredentialsProvisioned ClaimPhase = "CredentialsProvisioned"
+	// ClaimPhaseRolesConfigured the roles of the account were set up and the account moved to its OU
+	ClaimPhaseRolesConfigured ClaimPhase = "RolesConfigured"
+	// ClaimPhaseReady the claim is Ready
+	ClaimPhaseReady ClaimPhase = "Ready"
+)
+
+// ClaimPhases are the phases of a claim, in the order they're listed in its status
+var ClaimPhases = []ClaimPhase{ClaimPhaseSelected, ClaimPhaseCredentialsProvisioned, ClaimPhaseRolesConfigured, ClaimPhaseReady}
+
 // +genclient
 // +kubebuilder:object:root=true
 
@@ -102,6 +296,7 @@ const (
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="Status the account claim"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.progress",description="Percentage of the phases of the account claim completed"
 // +kubebuilder:printcolumn:name="Account",type="string",JSONPath=".spec.accountLink",description="Account CR link for the account claim"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age since the account claim was created"
 // +kubebuilder:resource:path=accountclaims,scope=Namespaced
@@ -166,6 +361,12 @@ var ErrBYOCSecretRefMissing = errors.New("BYOCSecretRefMissing")
 // ErrSTSRoleARNMissing is an error for missing STS Role ARN definition in the AccountClaim
 var ErrSTSRoleARNMissing = errors.New("STSRoleARNMissing")
 
+// ErrSTSSessionDurationInvalid is an error for an STS session duration AWS doesn't accept
+var ErrSTSSessionDurationInvalid = errors.New("STSSessionDurationInvalid")
+
+// ErrSTSSessionPolicyInvalid is an error for an STS session policy that isn't a JSON document
+var ErrSTSSessionPolicyInvalid = errors.New("STSSessionPolicyInvalid")
+
 // Validates an AccountClaim object
 func (a *AccountClaim) Validate() error {
 	// Validate STS mode first since we only require the
@@ -189,6 +390,12 @@ func (a *AccountClaim) validateSTS() error {
 	if a.Spec.STSRoleARN == "" {
 		return ErrSTSRoleARNMissing
 	}
+	if d := a.Spec.STSSessionDuration; d != nil && (d.Duration < MinSTSSessionDuration || d.Duration > MaxSTSSessionDuration) {
+		return ErrSTSSessionDurationInvalid
+	}
+	if a.Spec.STSSessionPolicy != "" && !json.Valid([]byte(a.Spec.STSSessionPolicy)) {
+		return ErrSTSSessionPolicyInvalid
+	}
 	return nil
 }
 
@@ -0,0 +1,46 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccountClaimTemplateSpec defines the claim fields shared by every AccountClaim referencing the template.
+// Fields set on the AccountClaim itself always take precedence.
+// +k8s:openapi-gen=true
+type AccountClaimTemplateSpec struct {
+	// Regions are used by claims that don't list any regions themselves
+	Regions []AwsRegions `json:"regions,omitempty"`
+	// AccountPool is the pool claims are satisfied from
+	AccountPool string `json:"accountPool,omitempty"`
+	// AwsCredentialSecretName is the name of the credentials secret created in the claim's namespace
+	AwsCredentialSecretName string `json:"awsCredentialSecretName,omitempty"`
+	CustomTags              string `json:"customTags,omitempty"`
+	KmsKeyId                string `json:"kmsKeyId,omitempty"`
+	// RegionalServiceQuotas are requested for the Accounts created for BYOC claims
+	RegionalServiceQuotas RegionalServiceQuotas `json:"regionalServiceQuotas,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountClaimTemplate is the Schema for the accountclaimtemplates API
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path=accountclaimtemplates,scope=Cluster
+type AccountClaimTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AccountClaimTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountClaimTemplateList contains a list of AccountClaimTemplate
+type AccountClaimTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountClaimTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccountClaimTemplate{}, &AccountClaimTemplateList{})
+}
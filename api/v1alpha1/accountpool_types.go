@@ -11,6 +11,120 @@ import (
 // +k8s:openapi-gen=true
 type AccountPoolSpec struct {
 	PoolSize int `json:"poolSize"`
+	// Canary designates accounts of the pool new operator versions reconcile first, the rest of the pool waits until
+	// they pass validation
+	// +optional
+	Canary *AccountPoolCanary `json:"canary,omitempty"`
+	// MaxReuseCount is how many times an account of the pool can be reused, an account released once more is retired
+	// instead. Accounts are never retired for their reuse count when it's 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReuseCount int `json:"maxReuseCount,omitempty"`
+	// MaxAccountAge is how long after its creation an account of the pool is retired, once it isn't claimed. Accounts
+	// are never retired for their age when it's unset.
+	// +optional
+	MaxAccountAge *metav1.Duration `json:"maxAccountAge,omitempty"`
+	// Bootstrap creates a number of accounts for a new pool at a controlled pace, reporting its progress in the status
+	// of the pool. The pool doesn't create accounts for its PoolSize until the bootstrap completed.
+	// +optional
+	Bootstrap *AccountPoolBootstrap `json:"bootstrap,omitempty"`
+}
+
+// AccountPoolBootstrap is the bootstrap of the accounts of a new pool
+type AccountPoolBootstrap struct {
+	// Accounts is how many accounts the bootstrap creates
+	// +kubebuilder:validation:Minimum=1
+	Accounts int `json:"accounts"`
+	// Interval is the least time between the creation of two accounts, 1m by default
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// MaxFailures is how many of its accounts can fail before the bootstrap stops creating accounts, 3 by default
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFailures int `json:"maxFailures,omitempty"`
+}
+
+// BootstrapPhase is the phase of the bootstrap of a pool
+type BootstrapPhase string
+
+const (
+	// BootstrapPhaseInProgress is set while the bootstrap creates accounts or waits for them to be ready
+	BootstrapPhaseInProgress BootstrapPhase = "InProgress"
+	// BootstrapPhaseCompleted is set once all the accounts of the bootstrap are ready
+	BootstrapPhaseCompleted BootstrapPhase = "Completed"
+	// BootstrapPhaseStopped is set once MaxFailures accounts of the bootstrap failed, no more accounts are created
+	// until the bootstrap is removed from the pool
+	BootstrapPhaseStopped BootstrapPhase = "Stopped"
+
+	// AccountPoolBootstrapLabel is the ID of the bootstrap of the pool which created an Account
+	AccountPoolBootstrapLabel = "aws.managed.openshift.io/pool-bootstrap"
+)
+
+// AccountPoolBootstrapStatus is the progress of the bootstrap of a pool
+type AccountPoolBootstrapStatus struct {
+	// ID identifies the accounts created by the bootstrap in their pool-bootstrap label
+	ID string `json:"id"`
+	// Phase is the phase of the bootstrap: InProgress, Completed or Stopped
+	Phase BootstrapPhase `json:"phase"`
+	// Created is how many accounts the bootstrap created
+	Created int `json:"created"`
+	// Verifying is how many accounts of the bootstrap are still progressing towards ready
+	Verifying int `json:"verifying"`
+	// Ready is how many accounts of the bootstrap are ready or were claimed
+	Ready int `json:"ready"`
+	// Failed is how many accounts of the bootstrap failed
+	Failed int `json:"failed"`
+	// Message details the phase, e.g. why the bootstrap stopped
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is when the bootstrap started
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty"`
+	// LastCreationTime is when the bootstrap last created an account
+	// +optional
+	LastCreationTime *metav1.Time `json:"lastCreationTime,omitempty"`
+}
+
+// AccountPoolCanary designates the canary accounts of a pool
+type AccountPoolCanary struct {
+	// Accounts are the names of the canary Accounts of the pool
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Accounts []string `json:"accounts"`
+	// Halt stops new operator versions from reconciling the rest of the pool, whatever the state of the canaries
+	// +optional
+	Halt bool `json:"halt,omitempty"`
+}
+
+// CanaryPhase is the phase of the rollout of an operator version to a pool
+type CanaryPhase string
+
+const (
+	// CanaryPhaseValidating is set while the canary accounts are reconciled and validated by the operator version
+	CanaryPhaseValidating CanaryPhase = "Validating"
+	// CanaryPhasePromoted is set once the canary accounts passed validation, the rest of the pool is reconciled
+	CanaryPhasePromoted CanaryPhase = "Promoted"
+	// CanaryPhaseHalted is set when a canary account regressed, the rest of the pool isn't reconciled
+	CanaryPhaseHalted CanaryPhase = "Halted"
+
+	// ReconciledVersionAnnotation is the version of the operator that last reconciled an Account
+	ReconciledVersionAnnotation = "aws.managed.openshift.io/reconciled-version"
+	// CanaryValidatedVersionAnnotation is the version of the operator a canary Account last passed validation with
+	CanaryValidatedVersionAnnotation = "aws.managed.openshift.io/canary-validated-version"
+)
+
+// AccountPoolCanaryStatus is the state of the rollout of an operator version to a pool
+type AccountPoolCanaryStatus struct {
+	// Version is the operator version being rolled out
+	Version string `json:"version"`
+	// Phase is the phase of the rollout: Validating, Promoted or Halted
+	Phase CanaryPhase `json:"phase"`
+	// Message details the phase, e.g. the regression of a canary account
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the last time the phase changed
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // AccountPoolStatus defines the observed state of AccountPool
@@ -32,6 +146,26 @@ type AccountPoolStatus struct {
 
 	// AWSLimitDelta shows the approximate difference between the number of AWS accounts currently created and the limit. This should be the same across all hive shards in an environment
 	AWSLimitDelta int `json:"awsLimitDelta"`
+
+	// ObservedGeneration is the generation of the pool last reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Canary is the state of the rollout of the current operator version to the pool, when it has canary accounts
+	// +optional
+	Canary *AccountPoolCanaryStatus `json:"canary,omitempty"`
+
+	// HighestReuseCount is the highest reuse count of the accounts of the pool
+	// +optional
+	HighestReuseCount int `json:"highestReuseCount,omitempty"`
+
+	// OldestAccountAgeDays is the age in days of the oldest account of the pool
+	// +optional
+	OldestAccountAgeDays int `json:"oldestAccountAgeDays,omitempty"`
+
+	// Bootstrap is the progress of the bootstrap of the pool, while the pool has one
+	// +optional
+	Bootstrap *AccountPoolBootstrapStatus `json:"bootstrap,omitempty"`
 }
 
 // +genclient
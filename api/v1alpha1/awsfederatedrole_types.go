@@ -103,6 +103,8 @@ const (
 	AWSFederatedRoleValid AWSFederatedRoleConditionType = "Valid"
 	// AWSFederatedRoleInvalid is set when an awsfederated role is invalid
 	AWSFederatedRoleInvalid AWSFederatedRoleConditionType = "Invalid"
+	// AWSFederatedRoleFailed is set when reconciling an awsfederated role failed unexpectedly
+	AWSFederatedRoleFailed AWSFederatedRoleConditionType = "Failed"
 )
 
 // +kubebuilder:object:root=true
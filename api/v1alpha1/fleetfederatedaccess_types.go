@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FleetFederatedAccessLabel is set on the AWSFederatedAccountAccesses of a FleetFederatedAccess to its name
+const FleetFederatedAccessLabel = "fleetFederatedAccess"
+
+// FleetFederatedAccessSpec defines the desired state of FleetFederatedAccess
+// +k8s:openapi-gen=true
+type FleetFederatedAccessSpec struct {
+	// AccountSelector selects the Accounts the access is granted to. Accounts that stop matching lose the access.
+	AccountSelector metav1.LabelSelector `json:"accountSelector"`
+	// ExternalCustomerAWSIAMARN holds the external AWS IAM ARN
+	ExternalCustomerAWSIAMARN string `json:"externalCustomerAWSIAMARN"`
+	// AWSFederatedRole must reference a federatedrole cr that currently exists
+	AWSFederatedRole AWSFederatedRoleRef `json:"awsFederatedRole"`
+}
+
+// FleetFederatedAccessStatus defines the observed state of FleetFederatedAccess
+// +k8s:openapi-gen=true
+type FleetFederatedAccessStatus struct {
+	// State is Ready once the access is applied to every selected Account, Failed when it failed for any of them
+	State AWSFederatedAccountAccessState `json:"state,omitempty"`
+	// Accounts is the rollout status of every selected Account
+	// +listType=map
+	// +listMapKey=account
+	Accounts []FleetFederatedAccessAccountStatus `json:"accounts,omitempty"`
+	// Ready is the number of Accounts the access is applied to
+	Ready int `json:"ready"`
+	// InProgress is the number of Accounts the access is being applied to
+	InProgress int `json:"inProgress"`
+	// Failed is the number of Accounts the access failed to be applied to
+	Failed int `json:"failed"`
+}
+
+// FleetFederatedAccessAccountStatus is the rollout status of the access to an Account
+type FleetFederatedAccessAccountStatus struct {
+	// Account is the name of the Account CR
+	Account string `json:"account"`
+	// AccountAccess is the name of the AWSFederatedAccountAccess granting the access to the Account
+	AccountAccess string `json:"accountAccess,omitempty"`
+	// State is the state of the AWSFederatedAccountAccess
+	State AWSFederatedAccountAccessState `json:"state"`
+	// Message explains why the access isn't applied yet
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FleetFederatedAccess is the Schema for the fleetfederatedaccesses API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="State of the access rollout"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.ready",description="Number of Accounts the access is applied to"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failed",description="Number of Accounts the access failed to be applied to"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age since the fleet federated access was created"
+// +kubebuilder:resource:path=fleetfederatedaccesses,scope=Namespaced
+type FleetFederatedAccess struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FleetFederatedAccessSpec   `json:"spec,omitempty"`
+	Status FleetFederatedAccessStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FleetFederatedAccessList contains a list of FleetFederatedAccess
+type FleetFederatedAccessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FleetFederatedAccess `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FleetFederatedAccess{}, &FleetFederatedAccessList{})
+}
@@ -0,0 +1,87 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OperatorConfigName is the name of the OperatorConfig the operator reads, in the operator namespace
+const OperatorConfigName = "aws-account-operator"
+
+// OperatorConfigSpec is the typed configuration of the operator. Fields that are set replace the matching keys of the
+// operator ConfigMap, the keys of fields left unset keep the value set in the ConfigMap.
+// +k8s:openapi-gen=true
+type OperatorConfigSpec struct {
+	// FeatureFlags enable optional features, by name without the feature. prefix of their ConfigMap key, e.g.
+	// compliance_tags
+	FeatureFlags map[string]bool `json:"featureFlags,omitempty"`
+	// AccountLimit is the number of accounts the organization may hold
+	// +kubebuilder:validation:Minimum=0
+	AccountLimit *int `json:"accountLimit,omitempty"`
+	// MaxConcurrentReconciles is the number of concurrent reconciles, by controller name
+	MaxConcurrentReconciles map[string]int `json:"maxConcurrentReconciles,omitempty"`
+	// OrganizationalUnits are the OUs accounts are moved to
+	OrganizationalUnits *OperatorConfigOrganizationalUnits `json:"organizationalUnits,omitempty"`
+	// STSJumpRoles are the roles STS accounts are assumed through
+	STSJumpRoles []string `json:"stsJumpRoles,omitempty"`
+	// SupportJumpRole is the role support access is assumed through
+	SupportJumpRole string `json:"supportJumpRole,omitempty"`
+	// OptInRegions are the opt-in regions enabled in the accounts
+	OptInRegions []string `json:"optInRegions,omitempty"`
+	// Quotas are the desired service quotas of the accounts, by quota name, e.g. vcpu
+	Quotas map[string]int `json:"quotas,omitempty"`
+}
+
+// OperatorConfigOrganizationalUnits are the OUs of the organization the operator manages accounts in
+// +k8s:openapi-gen=true
+type OperatorConfigOrganizationalUnits struct {
+	// Root is the ID of the organization root
+	Root string `json:"root"`
+	// Base is the ID of the OU claimed accounts are moved under
+	Base string `json:"base"`
+}
+
+// OperatorConfigStatus defines the observed state of OperatorConfig
+// +k8s:openapi-gen=true
+type OperatorConfigStatus struct {
+	// ObservedGeneration is the generation last written to the operator ConfigMap
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastSyncTime is when the operator ConfigMap was last updated from the OperatorConfig
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// LegacyKeys are the keys of the operator ConfigMap the OperatorConfig doesn't set yet
+	LegacyKeys []string `json:"legacyKeys,omitempty"`
+	// Conditions report the state of the operator, e.g. OrganizationsAccessDenied
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// OperatorConfigOrganizationsAccessDenied is the condition of the OperatorConfig reporting the account state
+// transitions are frozen because the operator is denied Organizations access
+const OperatorConfigOrganizationsAccessDenied = "OrganizationsAccessDenied"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OperatorConfig is the Schema for the operatorconfigs API
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path=operatorconfigs,scope=Namespaced
+type OperatorConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OperatorConfigSpec   `json:"spec,omitempty"`
+	Status OperatorConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OperatorConfigList contains a list of OperatorConfig
+type OperatorConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OperatorConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OperatorConfig{}, &OperatorConfigList{})
+}
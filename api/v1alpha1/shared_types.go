@@ -98,7 +98,7 @@ var FederatedRoleNameLabel = "awsFederatedRoleName"
 
 var LastRoleUpdateAnnotation = "lastRoleUpdate"
 
-// AccountIDLabel is the string for the AWS Account ID label on AWS Federated Account Access CRs
+// AccountIDLabel is the string for the AWS Account ID label on AWS Federated Account Access and Account CRs
 var AccountIDLabel = "awsAccountID"
 
 // ClusterAccountNameTagKey is the AWS key name for cluster account name
@@ -113,6 +113,22 @@ var ClusterClaimLinkTagKey = "clusterClaimLink"
 // ClusterClaimLinkNamespaceTagKey is the AWS key name for cluster claim namespace
 var ClusterClaimLinkNamespaceTagKey = "clusterClaimLinkNamespace"
 
+// ManagedByTagKey is the AWS key name of the tag marking the IAM resources created by the operator
+var ManagedByTagKey = "managed-by"
+
+// ManagedByTagValue is the value of the ManagedByTagKey tag
+var ManagedByTagValue = "aws-account-operator"
+
+// IAMExpiryAnnotation sets the time, in RFC3339, after which the IAM resources created for the object can be swept
+var IAMExpiryAnnotation = "aws.managed.openshift.io/expiry"
+
+// ClaimTagsAnnotation records, on an Account, the tags of its claim applied to its AWS resources, as a JSON object
+var ClaimTagsAnnotation = "aws.managed.openshift.io/claim-tags"
+
+// QuotaRollbackAnnotation records, on an Account, the quotas raised by the temporary quotas of its former claims which
+// have to be lowered back, as a JSON array
+var QuotaRollbackAnnotation = "aws.managed.openshift.io/quota-rollback"
+
 // Used to name the EC2 instance we spin up when initializing an AWS region
 var EC2InstanceNameTagKey = "Name"
 var EC2InstanceNameTagValue = "red-hat-region-init"
@@ -5,9 +5,55 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in AMICopies) DeepCopyInto(out *AMICopies) {
+	{
+		in := &in
+		*out = make(AMICopies, len(*in))
+		for key, val := range *in {
+			var outVal *AMICopyStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(AMICopyStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMICopies.
+func (in AMICopies) DeepCopy() AMICopies {
+	if in == nil {
+		return nil
+	}
+	out := new(AMICopies)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMICopyStatus) DeepCopyInto(out *AMICopyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMICopyStatus.
+func (in *AMICopyStatus) DeepCopy() *AMICopyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AMICopyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSCustomPolicy) DeepCopyInto(out *AWSCustomPolicy) {
 	*out = *in
@@ -397,6 +443,22 @@ func (in *AccountClaimList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountClaimPhase) DeepCopyInto(out *AccountClaimPhase) {
+	*out = *in
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountClaimPhase.
+func (in *AccountClaimPhase) DeepCopy() *AccountClaimPhase {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountClaimPhase)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountClaimSpec) DeepCopyInto(out *AccountClaimSpec) {
 	*out = *in
@@ -405,6 +467,33 @@ func (in *AccountClaimSpec) DeepCopyInto(out *AccountClaimSpec) {
 	in.Aws.DeepCopyInto(&out.Aws)
 	out.BYOCSecretRef = in.BYOCSecretRef
 	out.FleetManagerConfig = in.FleetManagerConfig
+	if in.VpcEndpointServiceAllowedPrincipals != nil {
+		in, out := &in.VpcEndpointServiceAllowedPrincipals, &out.VpcEndpointServiceAllowedPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.STSSessionDuration != nil {
+		in, out := &in.STSSessionDuration, &out.STSSessionDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RequiredQuotas != nil {
+		in, out := &in.RequiredQuotas, &out.RequiredQuotas
+		*out = make([]RequiredQuota, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemporaryQuotas != nil {
+		in, out := &in.TemporaryQuotas, &out.TemporaryQuotas
+		*out = make([]RequiredQuota, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountClaimSpec.
@@ -427,6 +516,33 @@ func (in *AccountClaimStatus) DeepCopyInto(out *AccountClaimStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]AccountClaimPhase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeliveredSecrets != nil {
+		in, out := &in.DeliveredSecrets, &out.DeliveredSecrets
+		*out = make([]SecretRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(AppliedClaimSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemporaryQuotas != nil {
+		in, out := &in.TemporaryQuotas, &out.TemporaryQuotas
+		*out = make([]TemporaryQuotaStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.CleanupDryRun != nil {
+		in, out := &in.CleanupDryRun, &out.CleanupDryRun
+		*out = new(CleanupDryRunReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountClaimStatus.
@@ -439,6 +555,149 @@ func (in *AccountClaimStatus) DeepCopy() *AccountClaimStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountClaimTemplate) DeepCopyInto(out *AccountClaimTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountClaimTemplate.
+func (in *AccountClaimTemplate) DeepCopy() *AccountClaimTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountClaimTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountClaimTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountClaimTemplateList) DeepCopyInto(out *AccountClaimTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountClaimTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountClaimTemplateList.
+func (in *AccountClaimTemplateList) DeepCopy() *AccountClaimTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountClaimTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountClaimTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountClaimTemplateSpec) DeepCopyInto(out *AccountClaimTemplateSpec) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]AwsRegions, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegionalServiceQuotas != nil {
+		in, out := &in.RegionalServiceQuotas, &out.RegionalServiceQuotas
+		*out = make(RegionalServiceQuotas, len(*in))
+		for key, val := range *in {
+			var outVal map[SupportedServiceQuotas]*ServiceQuotaStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(AccountServiceQuota, len(*in))
+				for key, val := range *in {
+					var outVal *ServiceQuotaStatus
+					if val == nil {
+						(*out)[key] = nil
+					} else {
+						inVal := (*in)[key]
+						in, out := &inVal, &outVal
+						*out = new(ServiceQuotaStatus)
+						**out = **in
+					}
+					(*out)[key] = outVal
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountClaimTemplateSpec.
+func (in *AccountClaimTemplateSpec) DeepCopy() *AccountClaimTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountClaimTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountCleanupReport) DeepCopyInto(out *AccountCleanupReport) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]AccountCleanupStep, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountCleanupReport.
+func (in *AccountCleanupReport) DeepCopy() *AccountCleanupReport {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountCleanupReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountCleanupStep) DeepCopyInto(out *AccountCleanupStep) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountCleanupStep.
+func (in *AccountCleanupStep) DeepCopy() *AccountCleanupStep {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountCleanupStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountCondition) DeepCopyInto(out *AccountCondition) {
 	*out = *in
@@ -493,8 +752,8 @@ func (in *AccountPool) DeepCopyInto(out *AccountPool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPool.
@@ -515,6 +774,82 @@ func (in *AccountPool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPoolBootstrap) DeepCopyInto(out *AccountPoolBootstrap) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPoolBootstrap.
+func (in *AccountPoolBootstrap) DeepCopy() *AccountPoolBootstrap {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPoolBootstrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPoolBootstrapStatus) DeepCopyInto(out *AccountPoolBootstrapStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.LastCreationTime != nil {
+		in, out := &in.LastCreationTime, &out.LastCreationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPoolBootstrapStatus.
+func (in *AccountPoolBootstrapStatus) DeepCopy() *AccountPoolBootstrapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPoolBootstrapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPoolCanary) DeepCopyInto(out *AccountPoolCanary) {
+	*out = *in
+	if in.Accounts != nil {
+		in, out := &in.Accounts, &out.Accounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPoolCanary.
+func (in *AccountPoolCanary) DeepCopy() *AccountPoolCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPoolCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPoolCanaryStatus) DeepCopyInto(out *AccountPoolCanaryStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPoolCanaryStatus.
+func (in *AccountPoolCanaryStatus) DeepCopy() *AccountPoolCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPoolCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountPoolList) DeepCopyInto(out *AccountPoolList) {
 	*out = *in
@@ -550,6 +885,21 @@ func (in *AccountPoolList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountPoolSpec) DeepCopyInto(out *AccountPoolSpec) {
 	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(AccountPoolCanary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxAccountAge != nil {
+		in, out := &in.MaxAccountAge, &out.MaxAccountAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(AccountPoolBootstrap)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPoolSpec.
@@ -565,6 +915,16 @@ func (in *AccountPoolSpec) DeepCopy() *AccountPoolSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountPoolStatus) DeepCopyInto(out *AccountPoolStatus) {
 	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(AccountPoolCanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(AccountPoolBootstrapStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPoolStatus.
@@ -703,18 +1063,55 @@ func (in *AccountStatus) DeepCopyInto(out *AccountStatus) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.RegionStatuses != nil {
+		in, out := &in.RegionStatuses, &out.RegionStatuses
+		*out = make(RegionStatuses, len(*in))
+		for key, val := range *in {
+			var outVal *RegionStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(RegionStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.AMICopies != nil {
+		in, out := &in.AMICopies, &out.AMICopies
+		*out = make(AMICopies, len(*in))
+		for key, val := range *in {
+			var outVal *AMICopyStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(AMICopyStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.LastCleanup != nil {
+		in, out := &in.LastCleanup, &out.LastCleanup
+		*out = new(AccountCleanupReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountStatus.
+func (in *AccountStatus) DeepCopy() *AccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountStatus.
-func (in *AccountStatus) DeepCopy() *AccountStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(AccountStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AmiSpec) DeepCopyInto(out *AmiSpec) {
 	*out = *in
@@ -730,6 +1127,26 @@ func (in *AmiSpec) DeepCopy() *AmiSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedClaimSpec) DeepCopyInto(out *AppliedClaimSpec) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedClaimSpec.
+func (in *AppliedClaimSpec) DeepCopy() *AppliedClaimSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedClaimSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Aws) DeepCopyInto(out *Aws) {
 	*out = *in
@@ -765,6 +1182,49 @@ func (in *AwsRegions) DeepCopy() *AwsRegions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupDryRunReport) DeepCopyInto(out *CleanupDryRunReport) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]CleanupDryRunResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupDryRunReport.
+func (in *CleanupDryRunReport) DeepCopy() *CleanupDryRunReport {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupDryRunReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupDryRunResources) DeepCopyInto(out *CleanupDryRunResources) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupDryRunResources.
+func (in *CleanupDryRunResources) DeepCopy() *CleanupDryRunResources {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupDryRunResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -794,6 +1254,117 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetFederatedAccess) DeepCopyInto(out *FleetFederatedAccess) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetFederatedAccess.
+func (in *FleetFederatedAccess) DeepCopy() *FleetFederatedAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetFederatedAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetFederatedAccess) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetFederatedAccessAccountStatus) DeepCopyInto(out *FleetFederatedAccessAccountStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetFederatedAccessAccountStatus.
+func (in *FleetFederatedAccessAccountStatus) DeepCopy() *FleetFederatedAccessAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetFederatedAccessAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetFederatedAccessList) DeepCopyInto(out *FleetFederatedAccessList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FleetFederatedAccess, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetFederatedAccessList.
+func (in *FleetFederatedAccessList) DeepCopy() *FleetFederatedAccessList {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetFederatedAccessList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetFederatedAccessList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetFederatedAccessSpec) DeepCopyInto(out *FleetFederatedAccessSpec) {
+	*out = *in
+	in.AccountSelector.DeepCopyInto(&out.AccountSelector)
+	out.AWSFederatedRole = in.AWSFederatedRole
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetFederatedAccessSpec.
+func (in *FleetFederatedAccessSpec) DeepCopy() *FleetFederatedAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetFederatedAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetFederatedAccessStatus) DeepCopyInto(out *FleetFederatedAccessStatus) {
+	*out = *in
+	if in.Accounts != nil {
+		in, out := &in.Accounts, &out.Accounts
+		*out = make([]FleetFederatedAccessAccountStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetFederatedAccessStatus.
+func (in *FleetFederatedAccessStatus) DeepCopy() *FleetFederatedAccessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetFederatedAccessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FleetManagerConfig) DeepCopyInto(out *FleetManagerConfig) {
 	*out = *in
@@ -824,6 +1395,167 @@ func (in *LegalEntity) DeepCopy() *LegalEntity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfig) DeepCopyInto(out *OperatorConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfig.
+func (in *OperatorConfig) DeepCopy() *OperatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigList) DeepCopyInto(out *OperatorConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperatorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigList.
+func (in *OperatorConfigList) DeepCopy() *OperatorConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigOrganizationalUnits) DeepCopyInto(out *OperatorConfigOrganizationalUnits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigOrganizationalUnits.
+func (in *OperatorConfigOrganizationalUnits) DeepCopy() *OperatorConfigOrganizationalUnits {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigOrganizationalUnits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigSpec) DeepCopyInto(out *OperatorConfigSpec) {
+	*out = *in
+	if in.FeatureFlags != nil {
+		in, out := &in.FeatureFlags, &out.FeatureFlags
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AccountLimit != nil {
+		in, out := &in.AccountLimit, &out.AccountLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxConcurrentReconciles != nil {
+		in, out := &in.MaxConcurrentReconciles, &out.MaxConcurrentReconciles
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.OrganizationalUnits != nil {
+		in, out := &in.OrganizationalUnits, &out.OrganizationalUnits
+		*out = new(OperatorConfigOrganizationalUnits)
+		**out = **in
+	}
+	if in.STSJumpRoles != nil {
+		in, out := &in.STSJumpRoles, &out.STSJumpRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OptInRegions != nil {
+		in, out := &in.OptInRegions, &out.OptInRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigSpec.
+func (in *OperatorConfigSpec) DeepCopy() *OperatorConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigStatus) DeepCopyInto(out *OperatorConfigStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LegacyKeys != nil {
+		in, out := &in.LegacyKeys, &out.LegacyKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigStatus.
+func (in *OperatorConfigStatus) DeepCopy() *OperatorConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptInRegionStatus) DeepCopyInto(out *OptInRegionStatus) {
 	*out = *in
@@ -930,6 +1662,70 @@ func (in RegionalServiceQuotas) DeepCopy() RegionalServiceQuotas {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionStatus) DeepCopyInto(out *RegionStatus) {
+	*out = *in
+	if in.RetryAfter != nil {
+		in, out := &in.RetryAfter, &out.RetryAfter
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionStatus.
+func (in *RegionStatus) DeepCopy() *RegionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in RegionStatuses) DeepCopyInto(out *RegionStatuses) {
+	{
+		in := &in
+		*out = make(RegionStatuses, len(*in))
+		for key, val := range *in {
+			var outVal *RegionStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(RegionStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionStatuses.
+func (in RegionStatuses) DeepCopy() RegionStatuses {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionStatuses)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequiredQuota) DeepCopyInto(out *RequiredQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredQuota.
+func (in *RequiredQuota) DeepCopy() *RequiredQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(RequiredQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -994,3 +1790,18 @@ func (in *StatementEntry) DeepCopy() *StatementEntry {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemporaryQuotaStatus) DeepCopyInto(out *TemporaryQuotaStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemporaryQuotaStatus.
+func (in *TemporaryQuotaStatus) DeepCopy() *TemporaryQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TemporaryQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
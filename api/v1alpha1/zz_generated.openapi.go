@@ -14,22 +14,31 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedAccountAccess":       schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedAccountAccess(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedAccountAccessSpec":   schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedAccountAccessSpec(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedAccountAccessStatus": schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedAccountAccessStatus(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRole":                schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedRole(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRoleSpec":            schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedRoleSpec(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRoleStatus":          schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedRoleStatus(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.Account":                         schema_openshift_aws_account_operator_api_v1alpha1_Account(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaim":                    schema_openshift_aws_account_operator_api_v1alpha1_AccountClaim(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimSpec":                schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimSpec(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimStatus":              schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimStatus(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountCondition":                schema_openshift_aws_account_operator_api_v1alpha1_AccountCondition(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPool":                     schema_openshift_aws_account_operator_api_v1alpha1_AccountPool(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolSpec":                 schema_openshift_aws_account_operator_api_v1alpha1_AccountPoolSpec(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolStatus":               schema_openshift_aws_account_operator_api_v1alpha1_AccountPoolStatus(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountSpec":                     schema_openshift_aws_account_operator_api_v1alpha1_AccountSpec(ref),
-		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountStatus":                   schema_openshift_aws_account_operator_api_v1alpha1_AccountStatus(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedAccountAccess":         schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedAccountAccess(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedAccountAccessSpec":     schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedAccountAccessSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedAccountAccessStatus":   schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedAccountAccessStatus(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRole":                  schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedRole(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRoleSpec":              schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedRoleSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRoleStatus":            schema_openshift_aws_account_operator_api_v1alpha1_AWSFederatedRoleStatus(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.Account":                           schema_openshift_aws_account_operator_api_v1alpha1_Account(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaim":                      schema_openshift_aws_account_operator_api_v1alpha1_AccountClaim(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimSpec":                  schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimStatus":                schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimStatus(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimTemplate":              schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimTemplate(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimTemplateSpec":          schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimTemplateSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountCondition":                  schema_openshift_aws_account_operator_api_v1alpha1_AccountCondition(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPool":                       schema_openshift_aws_account_operator_api_v1alpha1_AccountPool(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolSpec":                   schema_openshift_aws_account_operator_api_v1alpha1_AccountPoolSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolStatus":                 schema_openshift_aws_account_operator_api_v1alpha1_AccountPoolStatus(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountSpec":                       schema_openshift_aws_account_operator_api_v1alpha1_AccountSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.AccountStatus":                     schema_openshift_aws_account_operator_api_v1alpha1_AccountStatus(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccess":              schema_openshift_aws_account_operator_api_v1alpha1_FleetFederatedAccess(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessSpec":          schema_openshift_aws_account_operator_api_v1alpha1_FleetFederatedAccessSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessStatus":        schema_openshift_aws_account_operator_api_v1alpha1_FleetFederatedAccessStatus(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfig":                    schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfig(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigOrganizationalUnits": schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfigOrganizationalUnits(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigSpec":                schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfigSpec(ref),
+		"github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigStatus":              schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfigStatus(ref),
 	}
 }
 
@@ -516,12 +525,133 @@ func schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimSpec(ref com
 							Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.FleetManagerConfig"),
 						},
 					},
+					"vpcEndpointServiceAllowedPrincipals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VpcEndpointServiceAllowedPrincipals lists the principal ARNs allowed to connect to the VPC endpoint services in the claimed account",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateRef names an AccountClaimTemplate providing defaults for fields left empty on the claim",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stsSessionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "STSSessionDuration is the duration of the session the operator assumes STSRoleARN with. It defaults to one hour, which is also the most AWS allows for sessions assumed through the STS jump role.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"stsSessionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "STSSessionPolicy is an optional IAM policy document further restricting the session assumed with STSRoleARN",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regionalCredentialSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegionalCredentialSecrets delivers a copy of the credentials secret for each region in Aws.Regions, named after the credentials secret and the region, with the region and its STS regional endpoint set",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"credentialProfile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialProfile names the credential profile, defined in the operator ConfigMap, setting the IAM policies of the IAM user whose credentials are delivered. It defaults to admin.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requiredQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredQuotas are service quotas that have to be approved in the claimed account before the claim is Ready",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.RequiredQuota"),
+									},
+								},
+							},
+						},
+					},
+					"requiredOU": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredOU is the ID of an organizational unit the claimed account has to be in before the claim is Ready. The account is moved into it instead of the OU of the legal entity, and AccountOU is set once the move is verified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"temporaryQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemporaryQuotas are service quotas raised in the claimed account for the duration of the claim. They're requested once the claim is Ready, without blocking it, and recorded in the account for rollback when the claim is deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.RequiredQuota"),
+									},
+								},
+							},
+						},
+					},
+					"regionReportConfigMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegionReportConfigMap names a ConfigMap in the namespace of the claim the operator writes the region capability report of the claimed account to, once the claim is Ready",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialSecretReader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialSecretReader names a ServiceAccount in the namespace of the credentials secret the operator grants read access to the secrets delivered for the claim, and to those only, through a Role and RoleBinding it manages",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cliConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CLIConfig adds the AWS CLI config and credentials files of a profile named after the claim, defaulting to its first region, to the delivered credentials secrets under the config and credentials keys",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags are tags the operator keeps on the AWS resources of the claim while it exists: the Organizations tags of the account and the tags of the IAM user or fleet manager role created for it. They are removed when the account is reset. Unlike CustomTags, which are only applied when the resources are created, they are reconciled.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"legalEntity", "awsCredentialSecret", "aws", "accountLink"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openshift/aws-account-operator/api/v1alpha1.Aws", "github.com/openshift/aws-account-operator/api/v1alpha1.FleetManagerConfig", "github.com/openshift/aws-account-operator/api/v1alpha1.LegalEntity", "github.com/openshift/aws-account-operator/api/v1alpha1.SecretRef"},
+			"github.com/openshift/aws-account-operator/api/v1alpha1.Aws", "github.com/openshift/aws-account-operator/api/v1alpha1.FleetManagerConfig", "github.com/openshift/aws-account-operator/api/v1alpha1.LegalEntity", "github.com/openshift/aws-account-operator/api/v1alpha1.RequiredQuota", "github.com/openshift/aws-account-operator/api/v1alpha1.SecretRef", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -560,12 +690,212 @@ func schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimStatus(ref c
 							Format:  "",
 						},
 					},
+					"phases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phases are the phases of the claim completed so far, in the order of ClaimPhases",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimPhase"),
+									},
+								},
+							},
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the percentage of the phases of the claim completed",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the claim last reconciled",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"credentialProfile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialProfile is the credential profile applied to the delivered credentials",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deliveredSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeliveredSecrets are the secrets delivered for the claim, deleted with it as they may live in other namespaces",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.SecretRef"),
+									},
+								},
+							},
+						},
+					},
+					"iamUserSweepCursor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IAMUserSweepCursor is where the sweep of the IAM users of a fleet manager account resumes on the next reconcile",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appliedSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedSpec records the fields of the spec the claim was provisioned with, which can't be changed afterwards",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.AppliedClaimSpec"),
+						},
+					},
+					"temporaryQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemporaryQuotas are the requests of the temporary quotas of the claim, by quota code and region",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.TemporaryQuotaStatus"),
+									},
+								},
+							},
+						},
+					},
+					"cleanupDryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanupDryRun lists what the cleanup of the account of the deleted claim would delete, while the claim has the cleanup-dry-run annotation",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.CleanupDryRunReport"),
+						},
+					},
 				},
 				Required: []string{"conditions", "state"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimCondition"},
+			"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimCondition", "github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimPhase", "github.com/openshift/aws-account-operator/api/v1alpha1.AppliedClaimSpec", "github.com/openshift/aws-account-operator/api/v1alpha1.CleanupDryRunReport", "github.com/openshift/aws-account-operator/api/v1alpha1.SecretRef", "github.com/openshift/aws-account-operator/api/v1alpha1.TemporaryQuotaStatus"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccountClaimTemplate is the Schema for the accountclaimtemplates API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimTemplateSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.AccountClaimTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_AccountClaimTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccountClaimTemplateSpec defines the claim fields shared by every AccountClaim referencing the template. Fields set on the AccountClaim itself always take precedence.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"regions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regions are used by claims that don't list any regions themselves",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.AwsRegions"),
+									},
+								},
+							},
+						},
+					},
+					"accountPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountPool is the pool claims are satisfied from",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"awsCredentialSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AwsCredentialSecretName is the name of the credentials secret created in the claim's namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"customTags": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kmsKeyId": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"regionalServiceQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegionalServiceQuotas are requested for the Accounts created for BYOC claims",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"object"},
+										AdditionalProperties: &spec.SchemaOrBool{
+											Allows: true,
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Ref: ref("github.com/openshift/aws-account-operator/api/v1alpha1.ServiceQuotaStatus"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.AwsRegions", "github.com/openshift/aws-account-operator/api/v1alpha1.ServiceQuotaStatus"},
 	}
 }
 
@@ -685,12 +1015,39 @@ func schema_openshift_aws_account_operator_api_v1alpha1_AccountPoolSpec(ref comm
 							Format:  "int32",
 						},
 					},
-				},
-				Required: []string{"poolSize"},
-			},
-		},
-	}
-}
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary designates accounts of the pool new operator versions reconcile first, the rest of the pool waits until they pass validation",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolCanary"),
+						},
+					},
+					"maxReuseCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReuseCount is how many times an account of the pool can be reused, an account released once more is retired instead. Accounts are never retired for their reuse count when it's 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxAccountAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAccountAge is how long after its creation an account of the pool is retired, once it isn't claimed. Accounts are never retired for their age when it's unset.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"bootstrap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bootstrap creates a number of accounts for a new pool at a controlled pace, reporting its progress in the status of the pool. The pool doesn't create accounts for its PoolSize until the bootstrap completed.",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolBootstrap"),
+						},
+					},
+				},
+				Required: []string{"poolSize"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolBootstrap", "github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolCanary", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
 
 func schema_openshift_aws_account_operator_api_v1alpha1_AccountPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
@@ -746,10 +1103,45 @@ func schema_openshift_aws_account_operator_api_v1alpha1_AccountPoolStatus(ref co
 							Format:      "int32",
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the pool last reconciled",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary is the state of the rollout of the current operator version to the pool, when it has canary accounts",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolCanaryStatus"),
+						},
+					},
+					"highestReuseCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HighestReuseCount is the highest reuse count of the accounts of the pool",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"oldestAccountAgeDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OldestAccountAgeDays is the age in days of the oldest account of the pool",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bootstrap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bootstrap is the progress of the bootstrap of the pool, while the pool has one",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolBootstrapStatus"),
+						},
+					},
 				},
 				Required: []string{"poolSize", "unclaimedAccounts", "claimedAccounts", "availableAccounts", "accountsProgressing", "awsLimitDelta"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolBootstrapStatus", "github.com/openshift/aws-account-operator/api/v1alpha1.AccountPoolCanaryStatus"},
 	}
 }
 
@@ -832,6 +1224,20 @@ func schema_openshift_aws_account_operator_api_v1alpha1_AccountSpec(ref common.R
 							},
 						},
 					},
+					"accountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountName is the name of the AWS account, the name of the Account when empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Email is the email address of the root user of the AWS account, derived from the account name when empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"awsAccountID", "iamUserSecret"},
 			},
@@ -936,10 +1342,474 @@ func schema_openshift_aws_account_operator_api_v1alpha1_AccountStatus(ref common
 							},
 						},
 					},
+					"regionStatuses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegionStatuses reports the outcome of the initialization of every region, including the regions skipped because of an AWS outage",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openshift/aws-account-operator/api/v1alpha1.RegionStatus"),
+									},
+								},
+							},
+						},
+					},
+					"amiCopies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AMICopies reports the progress of AMIs requested to be copied into another account",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openshift/aws-account-operator/api/v1alpha1.AMICopyStatus"),
+									},
+								},
+							},
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason is the root cause class of the failure when the account is in the Failed state",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastCleanup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCleanup reports what the AWS cleanup of the last reuse of the account deleted",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.AccountCleanupReport"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the account last reconciled",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reuseCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReuseCount is how many times the account was released by a claim and made available again",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.AMICopyStatus", "github.com/openshift/aws-account-operator/api/v1alpha1.AccountCleanupReport", "github.com/openshift/aws-account-operator/api/v1alpha1.AccountCondition", "github.com/openshift/aws-account-operator/api/v1alpha1.OptInRegionStatus", "github.com/openshift/aws-account-operator/api/v1alpha1.RegionStatus", "github.com/openshift/aws-account-operator/api/v1alpha1.ServiceQuotaStatus"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_FleetFederatedAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FleetFederatedAccess is the Schema for the fleetfederatedaccesses API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessSpec", "github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_FleetFederatedAccessSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FleetFederatedAccessSpec defines the desired state of FleetFederatedAccess",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"accountSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountSelector selects the Accounts the access is granted to. Accounts that stop matching lose the access.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"externalCustomerAWSIAMARN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalCustomerAWSIAMARN holds the external AWS IAM ARN",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"awsFederatedRole": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AWSFederatedRole must reference a federatedrole cr that currently exists",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRoleRef"),
+						},
+					},
+				},
+				Required: []string{"accountSelector", "externalCustomerAWSIAMARN", "awsFederatedRole"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.AWSFederatedRoleRef", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_FleetFederatedAccessStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FleetFederatedAccessStatus defines the observed state of FleetFederatedAccess",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is Ready once the access is applied to every selected Account, Failed when it failed for any of them",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"account",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Accounts is the rollout status of every selected Account",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessAccountStatus"),
+									},
+								},
+							},
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is the number of Accounts the access is applied to",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"inProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InProgress is the number of Accounts the access is being applied to",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of Accounts the access failed to be applied to",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"ready", "inProgress", "failed"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.FleetFederatedAccessAccountStatus"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperatorConfig is the Schema for the operatorconfigs API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigSpec", "github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfigOrganizationalUnits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperatorConfigOrganizationalUnits are the OUs of the organization the operator manages accounts in",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"root": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Root is the ID of the organization root",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"base": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base is the ID of the OU claimed accounts are moved under",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"root", "base"},
+			},
+		},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfigSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperatorConfigSpec is the typed configuration of the operator. Fields that are set replace the matching keys of the operator ConfigMap, the keys of fields left unset keep the value set in the ConfigMap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureFlags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureFlags enable optional features, by name without the feature. prefix of their ConfigMap key, e.g. compliance_tags",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: false,
+										Type:    []string{"boolean"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"accountLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountLimit is the number of accounts the organization may hold",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxConcurrentReconciles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentReconciles is the number of concurrent reconciles, by controller name",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"organizationalUnits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrganizationalUnits are the OUs accounts are moved to",
+							Ref:         ref("github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigOrganizationalUnits"),
+						},
+					},
+					"stsJumpRoles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "STSJumpRoles are the roles STS accounts are assumed through",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"supportJumpRole": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportJumpRole is the role support access is assumed through",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"optInRegions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OptInRegions are the opt-in regions enabled in the accounts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"quotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quotas are the desired service quotas of the accounts, by quota name, e.g. vcpu",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openshift/aws-account-operator/api/v1alpha1.OperatorConfigOrganizationalUnits"},
+	}
+}
+
+func schema_openshift_aws_account_operator_api_v1alpha1_OperatorConfigStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperatorConfigStatus defines the observed state of OperatorConfig",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation last written to the operator ConfigMap",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is when the operator ConfigMap was last updated from the OperatorConfig",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"legacyKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LegacyKeys are the keys of the operator ConfigMap the OperatorConfig doesn't set yet",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions report the state of the operator, e.g. OrganizationsAccessDenied",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openshift/aws-account-operator/api/v1alpha1.AccountCondition", "github.com/openshift/aws-account-operator/api/v1alpha1.OptInRegionStatus", "github.com/openshift/aws-account-operator/api/v1alpha1.ServiceQuotaStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
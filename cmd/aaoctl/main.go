@@ -0,0 +1,170 @@
+// aaoctl exports the Accounts, AccountClaims and AccountPools of a cluster to a snapshot file and imports them into
+// another one.
+//
+//	aaoctl export [-o yaml|json] [-f snapshot.yaml]
+//	aaoctl import -f snapshot.yaml
+//	aaoctl recover -shard hivep01ue1
+//
+// recover rebuilds the Accounts of the accounts of the organization owned by the shard, when no snapshot is available.
+// It uses the payer account credentials of the operator in the cluster.
+//
+// The cluster is the one of the current kubeconfig context. Secrets aren't exported.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	kubeconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/client"
+	"github.com/openshift/aws-account-operator/pkg/inventory"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = export(os.Args[2:])
+	case "import":
+		err = restore(os.Args[2:])
+	case "recover":
+		err = recoverAccounts(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aaoctl export [-o yaml|json] [-f file] | aaoctl import -f file | aaoctl recover -shard name")
+	os.Exit(2)
+}
+
+func newClient() (crclient.Client, error) {
+	cfg, err := kubeconfig.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	scheme, err := client.NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	return crclient.New(cfg, crclient.Options{Scheme: scheme})
+}
+
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	output := flags.String("o", "yaml", "snapshot format, yaml or json")
+	file := flags.String("f", "", "file to write the snapshot to, stdout when empty")
+	_ = flags.Parse(args)
+	if *output != "yaml" && *output != "json" {
+		return fmt.Errorf("unsupported output format %q", *output)
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	snapshot, err := inventory.Export(context.TODO(), c)
+	if err != nil {
+		return err
+	}
+	data, err := inventory.Marshal(snapshot, *output == "json")
+	if err != nil {
+		return err
+	}
+
+	if *file == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*file, data, 0600)
+}
+
+func restore(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	file := flags.String("f", "", "snapshot file to import")
+	_ = flags.Parse(args)
+	if *file == "" {
+		return fmt.Errorf("the snapshot file is required")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	snapshot, err := inventory.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	result, err := inventory.Import(context.TODO(), c, snapshot)
+	if result != nil {
+		for _, key := range result.Created {
+			fmt.Println("created", key)
+		}
+		for _, key := range result.Existing {
+			fmt.Println("unchanged", key)
+		}
+	}
+	return err
+}
+
+func recoverAccounts(args []string) error {
+	flags := flag.NewFlagSet("recover", flag.ExitOnError)
+	shardName := flags.String("shard", "", "name of the shard owning the accounts, the value of their owner tag")
+	_ = flags.Parse(args)
+	if *shardName == "" {
+		return fmt.Errorf("the shard name is required")
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	builder := &awsclient.Builder{}
+	payerClient, err := builder.GetClient("", c, awsclient.NewAwsClientInput{
+		SecretName: utils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  config.GetDefaultRegion(),
+	})
+	if err != nil {
+		return err
+	}
+
+	reqLogger := zap.New()
+	result, err := inventory.Recover(context.TODO(), reqLogger, c, payerClient, *shardName,
+		inventory.IAMClaimProbe(reqLogger, builder, c, payerClient))
+	if result != nil {
+		for _, accountID := range result.Created {
+			fmt.Println("recovered", accountID)
+		}
+		for _, accountID := range result.Existing {
+			fmt.Println("unchanged", accountID)
+		}
+		for _, accountID := range result.Skipped {
+			fmt.Println("skipped", accountID)
+		}
+	}
+	return err
+}
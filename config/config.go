@@ -77,12 +77,17 @@ func GetDefaultRegion() (regionName string) {
 	return
 }
 
-// construct an ARN
-func GetIAMArn(awsAccountID, awsResourceType, awsResourceID string) (arn string) {
-	awsAPI := "aws"
+// GetPartition returns the AWS partition the operator runs in
+func GetPartition() string {
 	if isFedramp {
-		awsAPI = "aws-us-gov"
+		return utils.PartitionAWSUSGov
 	}
+	return utils.PartitionAWS
+}
+
+// construct an ARN
+func GetIAMArn(awsAccountID, awsResourceType, awsResourceID string) (arn string) {
+	awsAPI := GetPartition()
 
 	// arn:partition:service:region:account-id:resource-type/resource-id
 	arn = strings.Join([]string{"arn:", awsAPI, ":iam::", awsAccountID, ":", awsResourceType, "/", awsResourceID}, "")
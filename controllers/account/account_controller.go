@@ -12,8 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	"github.com/aws/aws-sdk-go-v2/service/organizations"
-	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
@@ -23,14 +21,18 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/go-logr/logr"
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
 	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
 	"github.com/openshift/aws-account-operator/pkg/awsclient"
 	"github.com/openshift/aws-account-operator/pkg/totalaccountwatcher"
 	"github.com/openshift/aws-account-operator/pkg/utils"
@@ -53,6 +55,8 @@ const (
 
 	// AccountPending indicates an account is pending
 	AccountPending = "Pending"
+	// AccountPendingCreation indicates an account is queued for the creation of its AWS account
+	AccountPendingCreation = "PendingCreation"
 	// AccountCreating indicates an account is being created
 	AccountCreating = "Creating"
 	// AccountFailed indicates account creation has failed
@@ -70,7 +74,6 @@ const (
 	AccountOptInRegionEnabled    = "OptInRegionsEnabled"
 	standardAdminAccessArnPrefix = "arn:aws:iam"
 	adminAccessArnSuffix         = "::aws:policy/AdministratorAccess"
-	iamUserNameUHC               = "osdManagedAdmin"
 
 	controllerName = "account"
 	// PauseReconciliationAnnotation is the annotation key to pause all reconciliation for an account
@@ -91,6 +94,13 @@ type AccountReconciler struct {
 	Scheme           *runtime.Scheme
 	awsClientBuilder awsclient.IBuilder
 	shardName        string
+	// ownershipTags syncs the ownership tags of the AWS accounts, they're synced in the reconcile when it's nil
+	ownershipTags *ownershipTagsBatch
+}
+
+// operatorConfig returns the operator configuration, read with the client of the reconciler
+func (r *AccountReconciler) operatorConfig() *utils.OperatorConfig {
+	return utils.NewOperatorConfig(r.Client)
 }
 
 //+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=accounts,verbs=get;list;watch;create;update;patch;delete
@@ -114,6 +124,9 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		}
 		return reconcile.Result{}, err
 	}
+	reqLogger = utils.StartTrace(ctx, r.Client, controllerName, currentAcctInstance, reqLogger)
+	trace := utils.TraceFromContext(ctx)
+	trace.Step("Account state %q, claimed %t, BYOC %t", currentAcctInstance.Status.State, currentAcctInstance.Status.Claimed, currentAcctInstance.IsBYOC())
 
 	// Check if reconciliation is paused for this account (but allow deletion to proceed)
 	if currentAcctInstance.Annotations[PauseReconciliationAnnotation] == "true" && !currentAcctInstance.IsPendingDeletion() {
@@ -138,7 +151,27 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		}
 	}
 
-	configMap, err := utils.GetOperatorConfigMap(r.Client)
+	// New operator versions reconcile the canary accounts of a pool before the rest of it
+	if !currentAcctInstance.IsPendingDeletion() {
+		gated, err := r.canaryGate(ctx, reqLogger, currentAcctInstance)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if gated {
+			return reconcile.Result{RequeueAfter: canaryGateRequeue}, nil
+		}
+	}
+
+	// Keep the AWS account ID label, so the Account of an AWS account can be found with a selector
+	if !currentAcctInstance.IsPendingDeletion() && utils.SetAccountIDLabel(currentAcctInstance) {
+		err = r.Update(ctx, currentAcctInstance)
+		if err != nil {
+			reqLogger.Error(err, "failed updating the AWS account ID label")
+			return reconcile.Result{}, err
+		}
+	}
+
+	configMap, err := r.operatorConfig().ConfigMap(ctx)
 	if err != nil {
 		log.Error(err, "Failed retrieving configmap")
 		return reconcile.Result{}, err
@@ -151,14 +184,14 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	}
 	reqLogger.Info("Compliance tags loaded", "count", len(complianceTags))
 
-	isOptInRegionFeatureEnabled, err := utils.GetFeatureFlagValue(configMap, "feature.opt_in_regions")
+	isOptInRegionFeatureEnabled, err := utils.GetFeatureFlagValue(configMap, optInRegionsFeatureFlag)
 	if err != nil {
 		reqLogger.Info("Could not retrieve feature flag 'feature.opt_in_regions' - region Opt-In is disabled")
 		isOptInRegionFeatureEnabled = false
 	}
 	reqLogger.Info("Is feature.opt_in_regions enabled?", "enabled", isOptInRegionFeatureEnabled)
 
-	optInRegions, ok := configMap.Data["opt-in-regions"]
+	optInRegions, ok := configMap.Data[optInRegionsKey]
 	if !ok {
 		reqLogger.Info("Could not retrieve opt-in-regions from configMap")
 	}
@@ -176,6 +209,7 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		SecretName: utils.AwsSecretName,
 		NameSpace:  awsv1alpha1.AccountCrNamespace,
 		AwsRegion:  awsRegion,
+		Trace:      trace,
 	})
 	if err != nil {
 		reqLogger.Error(err, "failed building operator AWS client")
@@ -191,6 +225,7 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	}
 
 	if currentAcctInstance.IsPendingDeletion() {
+		trace.Step("Account pending deletion")
 		if currentAcctInstance.Spec.ManualSTSMode {
 			// if the account is STS, we don't need to do any additional cleanup aside from
 			// removing the finalizer and exiting.
@@ -255,21 +290,52 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	// This function is essential because a Fleet Manager AWS account should not possess any long-lived IAM credentials; instead, it should only require STS IAM access.
 	// However, once a Fleet Manager account claim is deleted, the AWS account no longer has long-lived IAM credentials and cannot be claimed by non-Fleet Manager account claims.
 	if currentAcctInstance.IsReusedAccountMissingIAMUser() {
-		if _, _, err = r.handleIAMUserCreation(reqLogger, currentAcctInstance, awsSetupClient, request.Namespace); err != nil {
+		deferred, result, err := r.deferToMaintenanceWindow(ctx, reqLogger, currentAcctInstance, "the IAM user recreation", time.Now())
+		if deferred || err != nil {
+			return result, err
+		}
+		if _, _, err = r.handleIAMUserCreation(ctx, reqLogger, currentAcctInstance, awsSetupClient, request.Namespace); err != nil {
 			reqLogger.Error(err, "Error during IAM user creation for reused account")
 			return reconcile.Result{}, err
 		}
 		reqLogger.Info(fmt.Sprintf("Account %s IAM user and secret has been recreated.", currentAcctInstance.Name))
 	}
 
+	// Keep the ownership tags of the AWS account in line with the account. A failure doesn't block the account, the
+	// tags are retried on the next reconcile.
+	if enabled, _ := utils.GetFeatureFlagValue(configMap, ownershipTagsFeatureFlag); enabled {
+		if err := r.syncOwnershipTags(reqLogger, currentAcctInstance, awsSetupClient, time.Now()); err != nil {
+			reqLogger.Error(err, "failed syncing the ownership tags")
+		}
+	}
+
+	// Compliance requires the security contact on every AWS account. Like the tags, a failure doesn't block the account.
+	if err := r.syncSecurityContact(reqLogger, currentAcctInstance, awsSetupClient, configMap, time.Now()); err != nil {
+		reqLogger.Error(err, "failed syncing the security contact")
+	}
+
 	// Log accounts that have failed and don't attempt to reconcile them
 	if currentAcctInstance.IsFailed() {
 		reqLogger.Info(fmt.Sprintf("Account %s is failed. Ignoring.", currentAcctInstance.Name))
 		return reconcile.Result{}, nil
 	}
 
+	// Redo initialization phases when requested through the annotation
+	if currentAcctInstance.IsReady() && hasReinitializeRequest(currentAcctInstance) {
+		return r.handleReinitialization(ctx, reqLogger, currentAcctInstance, awsSetupClient, request.Namespace, configMap.Data["ami-owner"])
+	}
+
+	// Copy AMIs into another account when requested through annotations
+	if currentAcctInstance.IsReady() && hasAMICopyRequest(currentAcctInstance) {
+		result, err := r.handleAMICopy(reqLogger, currentAcctInstance, awsSetupClient)
+		if err != nil || result.RequeueAfter > 0 {
+			return result, err
+		}
+	}
+
 	// Detect accounts for which we kicked off asynchronous region initialization
 	if currentAcctInstance.IsInitializingRegions() {
+		trace.Step("Waiting for the initialization of the regions")
 		return r.handleAccountInitializingRegions(reqLogger, currentAcctInstance)
 	}
 
@@ -279,12 +345,17 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		var initErr error
 
 		result, initErr = r.initializeNewCCSAccount(reqLogger, currentAcctInstance)
+		if awsclient.IsDryRunError(initErr) {
+			reqLogger.Info("dry-run: the new CCS account would be initialized", "skipped", initErr.Error())
+			return reconcile.Result{}, initErr
+		}
 		if initErr != nil {
 			// TODO: If we have recoverable results from above, how do we allow them to requeue if state is failed
 			_, stateErr := r.setAccountFailed(
 				reqLogger,
 				currentAcctInstance,
 				awsv1alpha1.AccountCreationFailed,
+				awsv1alpha1.FailureReasonClientError,
 				initErr.Error(),
 				"Failed to initialize new CCS account",
 				AccountFailed,
@@ -309,11 +380,13 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 
 		// Test PendingVerification state creating support case and checking for case status
 		if currentAcctInstance.IsPendingVerification() {
+			trace.Step("Checking the support case of the account pending verification")
 			return r.HandleNonCCSPendingVerification(reqLogger, currentAcctInstance, awsSetupClient)
 		}
 
 		// Update account Status.Claimed to true if the account is ready and the claim link is not empty
 		if currentAcctInstance.IsReadyUnclaimedAndHasClaimLink() {
+			trace.Step("Marking the account claimed by %s", currentAcctInstance.Spec.ClaimLink)
 			return reconcile.Result{}, ClaimAccount(r, currentAcctInstance)
 		}
 
@@ -324,6 +397,7 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 				reqLogger,
 				currentAcctInstance,
 				awsv1alpha1.AccountCreationFailed,
+				awsv1alpha1.FailureReasonCreationTimeout,
 				"CreationTimeout",
 				errMsg,
 				AccountFailed,
@@ -335,19 +409,31 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			return reconcile.Result{}, errors.New(errMsg)
 		}
 
-		if currentAcctInstance.IsUnclaimedAndHasNoState() {
+		if currentAcctInstance.IsUnclaimedAndHasNoState() || currentAcctInstance.IsPendingCreation() {
 			if !currentAcctInstance.HasAwsAccountID() {
 				// before doing anything make sure we are not over the limit if we are just error
 				if !totalaccountwatcher.TotalAccountWatcher.AccountsCanBeCreated() {
 					// fedramp clusters are all CCS, so the account limit is irrelevant there
 					if !config.IsFedramp() {
 						reqLogger.Info("AWS Account limit reached. This does not always indicate a problem, it's a limit we enforce in the configmap to prevent runaway account creation")
+						if err := r.waitOnAccountLimit(reqLogger, currentAcctInstance, totalaccountwatcher.TotalAccountWatcher); err != nil {
+							return reconcile.Result{}, err
+						}
 						// We don't expect the limit to change very frequently, so wait a while before requeueing to avoid hot lopping.
 						return reconcile.Result{Requeue: true, RequeueAfter: time.Duration(5) * time.Minute}, nil
 					}
 				}
+				if err := r.clearWaitingOnAccountLimit(currentAcctInstance); err != nil {
+					return reconcile.Result{}, err
+				}
 
-				if err := r.nonCCSAssignAccountID(reqLogger, currentAcctInstance, awsSetupClient, complianceTags); err != nil {
+				started, result, err := r.startAccountCreation(reqLogger, currentAcctInstance, configMap, time.Now())
+				if !started || err != nil {
+					return result, err
+				}
+				err = r.nonCCSAssignAccountID(reqLogger, currentAcctInstance, awsSetupClient, complianceTags)
+				accountCreationScheduler.done(currentAcctInstance.Name, err, time.Now())
+				if err != nil {
 					return reconcile.Result{}, err
 				}
 			} else {
@@ -364,6 +450,7 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 
 	// Handles account region enablement for non-BYOC accounts
 	if (currentAcctInstance.ReadyForRegionEnablement() || currentAcctInstance.IsEnablingOptInRegions()) && isOptInRegionFeatureEnabled && optInRegions != "" {
+		trace.Step("Enabling the opt-in regions %s", optInRegions)
 		return r.handleOptInRegionEnablement(reqLogger, currentAcctInstance, awsSetupClient, optInRegions)
 	}
 
@@ -422,7 +509,7 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 				return reconcile.Result{Requeue: true}, r.Update(context.TODO(), currentAcctInstance)
 			}
 
-			_, newCredentials, err := r.handleIAMUserCreation(reqLogger, currentAcctInstance, awsSetupClient, request.Namespace)
+			_, newCredentials, err := r.handleIAMUserCreation(ctx, reqLogger, currentAcctInstance, awsSetupClient, request.Namespace)
 			if err != nil {
 				reqLogger.Error(err, "Error during IAM user creation")
 				return reconcile.Result{}, err
@@ -443,6 +530,11 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		return reconcile.Result{}, err
 	}
 
+	// Initialize the regions skipped during an outage once their retry is due
+	if currentAcctInstance.IsReady() && hasSkippedRegions(currentAcctInstance) {
+		return r.retrySkippedRegions(reqLogger, currentAcctInstance, awsSetupClient, amiOwner, time.Now())
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -556,16 +648,26 @@ func isAwsOptInError(err error) bool {
 	return awsError.ErrorCode() == "OptInRequired"
 }
 
-func (r *AccountReconciler) handleIAMUserCreation(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client, namespace string) (reconcile.Result, *sts.AssumeRoleOutput, error) {
+func (r *AccountReconciler) handleIAMUserCreation(ctx context.Context, reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client, namespace string) (reconcile.Result, *sts.AssumeRoleOutput, error) {
 	var awsAssumedRoleClient awsclient.Client
 	awsAssumedRoleClient, creds, err := r.handleCreateAdminAccessRole(reqLogger, currentAcctInstance, awsSetupClient)
 	if err != nil {
 		return reconcile.Result{}, nil, err
 	}
 
+	iamUserNamePrefix, err := r.operatorConfig().IAMUserNamePrefix(ctx, currentAcctInstance.Spec.AccountPool)
+	if err != nil {
+		reqLogger.Error(err, "failed getting the IAM user name prefix of the account pool", "accountPool", currentAcctInstance.Spec.AccountPool)
+		return reconcile.Result{}, nil, err
+	}
+
 	// Use the same ID applied to the account name for IAM usernames
-	iamUserUHC := fmt.Sprintf("%s-%s", iamUserNameUHC, currentAcctInstance.Labels[awsv1alpha1.IAMUserIDLabel])
+	iamUserUHC := fmt.Sprintf("%s-%s", iamUserNamePrefix, currentAcctInstance.Labels[awsv1alpha1.IAMUserIDLabel])
 	secretName, err := r.BuildIAMUser(reqLogger, awsAssumedRoleClient, currentAcctInstance, iamUserUHC, namespace)
+	if awsclient.IsDryRunError(err) {
+		reqLogger.Info("dry-run: the IAM user would be created", "user", iamUserUHC)
+		return reconcile.Result{}, nil, err
+	}
 	if err != nil {
 		reason, errType := getBuildIAMUserErrorReason(err)
 		errMsg := fmt.Sprintf("Failed to build IAM UHC user %s: %s", iamUserUHC, err)
@@ -573,6 +675,7 @@ func (r *AccountReconciler) handleIAMUserCreation(reqLogger logr.Logger, current
 			reqLogger,
 			currentAcctInstance,
 			errType,
+			awsv1alpha1.FailureReasonClientError,
 			reason,
 			errMsg,
 			AccountFailed,
@@ -594,6 +697,10 @@ func (r *AccountReconciler) handleIAMUserCreation(reqLogger logr.Logger, current
 }
 
 func (r *AccountReconciler) handleAWSClientError(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, err error) (reconcile.Result, error) {
+	// A call skipped in dry-run mode says nothing about the account
+	if awsclient.IsDryRunError(err) {
+		return reconcile.Result{}, err
+	}
 	// Get custom failure reason to update account status
 	reason := ""
 	var aerr smithy.APIError
@@ -605,6 +712,7 @@ func (r *AccountReconciler) handleAWSClientError(reqLogger logr.Logger, currentA
 		reqLogger,
 		currentAcctInstance,
 		awsv1alpha1.AccountClientError,
+		awsv1alpha1.FailureReasonClientError,
 		reason,
 		errMsg,
 		AccountFailed,
@@ -626,6 +734,7 @@ func (r *AccountReconciler) handleAccountInitializingRegions(reqLogger logr.Logg
 			reqLogger,
 			currentAcctInstance,
 			awsv1alpha1.AccountInternalError,
+			awsv1alpha1.FailureReasonRegionInitFailed,
 			"MissingCondition",
 			errMsg,
 			AccountFailed,
@@ -671,6 +780,7 @@ func (r *AccountReconciler) handleAccountInitializingRegions(reqLogger logr.Logg
 			reqLogger,
 			currentAcctInstance,
 			awsv1alpha1.AccountCreationFailed,
+			awsv1alpha1.FailureReasonRegionInitFailed,
 			"RegionInitializationTimeout",
 			errMsg,
 			AccountFailed,
@@ -692,10 +802,37 @@ func (r *AccountReconciler) HandleNonCCSPendingVerification(reqLogger logr.Logge
 		reqLogger.Error(err, "a BYOC account passed to non-CCS function", "account", currentAcctInstance.Name)
 		return reconcile.Result{}, err
 	}
-	if !currentAcctInstance.HasSupportCaseID() {
+	verificationTimeout, err := r.verificationTimeout(context.TODO(), reqLogger)
+	if err != nil {
+		reqLogger.Error(err, "failed reading the verification timeout")
+		return reconcile.Result{}, err
+	}
+	if pendingCondition := utils.FindAccountCondition(currentAcctInstance.Status.Conditions, awsv1alpha1.AccountPendingVerification); verificationTimeout > 0 && pendingCondition != nil &&
+		time.Since(pendingCondition.LastTransitionTime.Time) > verificationTimeout {
+		errMsg := fmt.Sprintf("Verification pending for longer than %s", verificationTimeout)
+		return r.setAccountFailed(
+			reqLogger,
+			currentAcctInstance,
+			awsv1alpha1.AccountCreationFailed,
+			awsv1alpha1.FailureReasonVerificationTimeout,
+			"VerificationTimeout",
+			errMsg,
+			AccountFailed,
+		)
+	}
+	skippedVerification, err := r.supportVerificationSkipped(context.TODO(), reqLogger, currentAcctInstance)
+	if err != nil {
+		reqLogger.Error(err, "failed checking whether the support verification of the account is skipped")
+		return reconcile.Result{}, err
+	}
+	if skippedVerification != "" {
+		reqLogger.Info(skippedVerification)
+	}
+
+	if !currentAcctInstance.HasSupportCaseID() && skippedVerification == "" {
 		switch utils.DetectDevMode {
 		case utils.DevModeProduction:
-			caseID, err := createCase(reqLogger, currentAcctInstance, awsSetupClient)
+			caseID, err := CreateCase(reqLogger, currentAcctInstance, awsSetupClient)
 			if err != nil {
 				return reconcile.Result{}, err
 			}
@@ -733,9 +870,21 @@ func (r *AccountReconciler) HandleNonCCSPendingVerification(reqLogger logr.Logge
 		}
 	}
 
+	// The case may have been opened while the pool was warming up the account, make sure its quota requests exist too
+	if currentAcctInstance.Spec.RegionalServiceQuotas != nil && len(currentAcctInstance.Status.RegionalServiceQuotas) == 0 && utils.DetectDevMode == utils.DevModeProduction {
+		err := SetCurrentAccountServiceQuotas(reqLogger, r.awsClientBuilder, awsSetupClient, currentAcctInstance, r.Client)
+		if err != nil {
+			reqLogger.Error(err, "failed to set account service quotas")
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{Requeue: true}, r.statusUpdate(currentAcctInstance)
+	}
+
 	var supportCaseResolved bool
-	switch utils.DetectDevMode {
-	case utils.DevModeProduction:
+	switch {
+	case skippedVerification != "":
+		supportCaseResolved = true
+	case utils.DetectDevMode == utils.DevModeProduction:
 		resolvedScoped, err := checkCaseResolution(reqLogger, currentAcctInstance.Status.SupportCaseID, awsSetupClient)
 		if err != nil {
 			reqLogger.Error(err, "Error checking for Case Resolution")
@@ -758,6 +907,9 @@ func (r *AccountReconciler) HandleNonCCSPendingVerification(reqLogger logr.Logge
 	// Case Resolved and quota increases are all done: account is Ready
 	if supportCaseResolved && openCaseCount == 0 {
 		reqLogger.Info("case and quota increases resolved", "caseID", currentAcctInstance.Status.SupportCaseID)
+		if skippedVerification != "" {
+			setVerificationSkipped(currentAcctInstance, skippedVerification)
+		}
 		utils.SetAccountStatus(currentAcctInstance, "Account ready to be claimed", awsv1alpha1.AccountReady, AccountReady)
 		_ = r.statusUpdate(currentAcctInstance)
 		return reconcile.Result{}, nil
@@ -890,7 +1042,7 @@ func (r *AccountReconciler) nonCCSAssignAccountID(reqLogger logr.Logger, current
 	currentAcctInstance.Spec.AwsAccountID = awsAccountID
 
 	// tag account with hive shard name and compliance tags
-	err = TagAccount(awsSetupClient, awsAccountID, r.shardName, complianceTags)
+	err = accountprovision.TagAccount(awsSetupClient, awsAccountID, r.shardName, complianceTags)
 	if err != nil {
 		reqLogger.Info("Unable to tag aws account.", "account", currentAcctInstance.Name, "AWSAccountID", awsAccountID, "Error", error.Error(err))
 	}
@@ -898,36 +1050,6 @@ func (r *AccountReconciler) nonCCSAssignAccountID(reqLogger logr.Logger, current
 	return r.accountSpecUpdate(reqLogger, currentAcctInstance)
 }
 
-func TagAccount(awsSetupClient awsclient.Client, awsAccountID string, shardName string, complianceTags map[string]string) error {
-	// Start with the owner tag
-	tags := []organizationstypes.Tag{
-		{
-			Key:   aws.String("owner"),
-			Value: aws.String(shardName),
-		},
-	}
-
-	// Add compliance tags from the map
-	for key, value := range complianceTags {
-		tags = append(tags, organizationstypes.Tag{
-			Key:   aws.String(key),
-			Value: aws.String(value),
-		})
-	}
-
-	inputTag := &organizations.TagResourceInput{
-		ResourceId: aws.String(awsAccountID),
-		Tags:       tags,
-	}
-
-	_, err := awsSetupClient.TagResource(context.TODO(), inputTag)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (r *AccountReconciler) initializeRegions(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, creds *sts.AssumeRoleOutput, amiOwner string) error {
 	awsRegion := config.GetDefaultRegion()
 	// Instantiate a client with a default region to retrieve regions we want to initialize
@@ -1045,13 +1167,26 @@ func (r *AccountReconciler) asyncRegionInit(reqLogger logr.Logger, currentAcctIn
 func (r *AccountReconciler) BuildAccount(reqLogger logr.Logger, awsClient awsclient.Client, account *awsv1alpha1.Account) (string, error) {
 	reqLogger.Info("Creating Account")
 
-	email := formatAccountEmail(account.Name)
-	orgOutput, orgErr := CreateAccount(reqLogger, awsClient, account.Name, email)
+	accountName := account.GetAccountName()
+	email := account.Spec.Email
+	if email == "" {
+		email = accountprovision.FormatEmail(accountName)
+	}
+	orgOutput, requestID, orgErr := accountprovision.CreateAccount(reqLogger, awsClient, accountName, email)
+	if awsclient.IsDryRunError(orgErr) {
+		reqLogger.Info("dry-run: the AWS account would be created", "accountName", accountName, "email", email)
+		return "", orgErr
+	}
 	// If it was an api or a limit issue don't modify account and exit if anything else set to failed
 	if orgErr != nil {
 		switch orgErr {
 		case awsv1alpha1.ErrAwsFailedCreateAccount:
-			utils.SetAccountStatus(account, "Failed to create AWS Account", awsv1alpha1.AccountCreationFailed, AccountFailed)
+			message := "Failed to create AWS Account"
+			if requestID != "" {
+				message = fmt.Sprintf("%s (AWS request ID: %s)", message, requestID)
+			}
+			utils.SetAccountStatus(account, message, awsv1alpha1.AccountCreationFailed, AccountFailed)
+			account.Status.FailureReason = awsv1alpha1.FailureReasonClientError
 			err := r.statusUpdate(account)
 			if err != nil {
 				return "", err
@@ -1061,6 +1196,13 @@ func (r *AccountReconciler) BuildAccount(reqLogger logr.Logger, awsClient awscli
 			return "", orgErr
 
 		case awsv1alpha1.ErrAwsAccountLimitExceeded:
+			// The pool doesn't replace an account that waits, so it doesn't create more accounts that hit the same
+			// limit.
+			err := r.waitOnOrganizationLimit(account, "AWS Organizations refused to create the account, the organization is at the account limit")
+			if err != nil {
+				return "", err
+			}
+
 			log.Error(orgErr, "Failed to create AWS Account limit reached")
 			return "", orgErr
 
@@ -1081,78 +1223,6 @@ func (r *AccountReconciler) BuildAccount(reqLogger logr.Logger, awsClient awscli
 	return *orgOutput.CreateAccountStatus.AccountId, nil
 }
 
-// CreateAccount creates an AWS account for the specified accountName and accountEmail in the organization
-func CreateAccount(reqLogger logr.Logger, client awsclient.Client, accountName, accountEmail string) (*organizations.DescribeCreateAccountStatusOutput, error) {
-
-	createInput := organizations.CreateAccountInput{
-		AccountName: aws.String(accountName),
-		Email:       aws.String(accountEmail),
-	}
-
-	createOutput, err := client.CreateAccount(context.TODO(), &createInput)
-	if err != nil {
-		errMsg := "Error creating account"
-		var returnErr error
-
-		// Check for specific AWS Organizations exception types
-		var concurrentModErr *organizationstypes.ConcurrentModificationException
-		var constraintViolationErr *organizationstypes.ConstraintViolationException
-		var serviceErr *organizationstypes.ServiceException
-		var tooManyRequestsErr *organizationstypes.TooManyRequestsException
-
-		switch {
-		case errors.As(err, &concurrentModErr):
-			returnErr = awsv1alpha1.ErrAwsConcurrentModification
-		case errors.As(err, &constraintViolationErr):
-			returnErr = awsv1alpha1.ErrAwsAccountLimitExceeded
-		case errors.As(err, &serviceErr):
-			returnErr = awsv1alpha1.ErrAwsInternalFailure
-		case errors.As(err, &tooManyRequestsErr):
-			returnErr = awsv1alpha1.ErrAwsTooManyRequests
-		default:
-			returnErr = awsv1alpha1.ErrAwsFailedCreateAccount
-		}
-
-		utils.LogAwsError(reqLogger, errMsg, returnErr, err)
-		return &organizations.DescribeCreateAccountStatusOutput{}, returnErr
-	}
-
-	describeStatusInput := organizations.DescribeCreateAccountStatusInput{
-		CreateAccountRequestId: createOutput.CreateAccountStatus.Id,
-	}
-
-	var accountStatus *organizations.DescribeCreateAccountStatusOutput
-	for {
-		status, err := client.DescribeCreateAccountStatus(context.TODO(), &describeStatusInput)
-		if err != nil {
-			return &organizations.DescribeCreateAccountStatusOutput{}, err
-		}
-
-		accountStatus = status
-		createStatus := status.CreateAccountStatus.State
-
-		if createStatus == organizationstypes.CreateAccountStateFailed {
-			var returnErr error
-			switch status.CreateAccountStatus.FailureReason {
-			case organizationstypes.CreateAccountFailureReasonAccountLimitExceeded:
-				returnErr = awsv1alpha1.ErrAwsAccountLimitExceeded
-			case organizationstypes.CreateAccountFailureReasonInternalFailure:
-				returnErr = awsv1alpha1.ErrAwsInternalFailure
-			default:
-				returnErr = awsv1alpha1.ErrAwsFailedCreateAccount
-			}
-
-			return &organizations.DescribeCreateAccountStatusOutput{}, returnErr
-		}
-
-		if createStatus != organizationstypes.CreateAccountStateInProgress {
-			break
-		}
-	}
-
-	return accountStatus, nil
-}
-
 func ClaimAccount(r *AccountReconciler, currentAcctInstance *awsv1alpha1.Account) error {
 	currentAcctInstance.Status.Claimed = true
 	msg := fmt.Sprintf("Account %s was claimed: %s (Namespace: %s)",
@@ -1172,25 +1242,13 @@ func ClaimAccount(r *AccountReconciler, currentAcctInstance *awsv1alpha1.Account
 	return r.statusUpdate(currentAcctInstance)
 }
 
-func formatAccountEmail(name string) string {
-	// osd-creds-mgmt
-	// libra-ops
-	splitString := strings.Split(name, "-")
-	prefix := splitString[0]
-	for i := 1; i < (len(splitString) - 1); i++ {
-		prefix = prefix + "-" + splitString[i]
-	}
-
-	email := prefix + "+" + splitString[len(splitString)-1] + "@redhat.com"
-	return email
-}
-
 func (r *AccountReconciler) statusUpdate(account *awsv1alpha1.Account) error {
+	account.Status.ObservedGeneration = account.Generation
 	err := r.Client.Status().Update(context.TODO(), account)
 	return err
 }
 
-func (r *AccountReconciler) setAccountFailed(reqLogger logr.Logger, account *awsv1alpha1.Account, ctype awsv1alpha1.AccountConditionType, reason string, message string, state string) (reconcile.Result, error) {
+func (r *AccountReconciler) setAccountFailed(reqLogger logr.Logger, account *awsv1alpha1.Account, ctype awsv1alpha1.AccountConditionType, failureReason awsv1alpha1.AccountFailureReason, reason string, message string, state string) (reconcile.Result, error) {
 	reqLogger.Info(message)
 	// Update account status and condition
 	account.Status.Conditions = utils.SetAccountCondition(
@@ -1203,6 +1261,7 @@ func (r *AccountReconciler) setAccountFailed(reqLogger logr.Logger, account *aws
 		account.Spec.BYOC,
 	)
 	account.Status.State = state
+	account.Status.FailureReason = failureReason
 
 	// Set the failure in the accountClaim as well
 	err := r.accountClaimError(reqLogger, account, reason, message)
@@ -1477,7 +1536,9 @@ func (r *AccountReconciler) handleCreateAdminAccessRole(
 			adminAccessArn,
 			currentAccInstanceID,
 			tags,
+			awsclient.AccountIAMOwnership(currentAcctInstance),
 		)
+		r.reportRoleNameCollision(reqLogger, currentAcctInstance, err)
 
 		if err != nil {
 			reqLogger.Error(err, "Encountered error while creating ManagedOpenShiftSupportRole for CCS Account", "roleID", roleID)
@@ -1504,7 +1565,9 @@ func (r *AccountReconciler) handleCreateAdminAccessRole(
 			adminAccessArn,
 			currentAccInstanceID,
 			tags,
+			awsclient.AccountIAMOwnership(currentAcctInstance),
 		)
+		r.reportRoleNameCollision(reqLogger, currentAcctInstance, err)
 
 		if err != nil {
 			reqLogger.Error(err, "Encountered error while creating ManagedOpenShiftSupportRole for non-CCS Account", "roleID", roleID)
@@ -1515,9 +1578,28 @@ func (r *AccountReconciler) handleCreateAdminAccessRole(
 	return awsAssumedRoleClient, creds, err
 }
 
+// RecordPanic records a panic of the reconcile of the Account in its Failed condition, see utils.PanicRecorder
+func (r *AccountReconciler) RecordPanic(ctx context.Context, request ctrl.Request, message string) error {
+	account := &awsv1alpha1.Account{}
+	if err := r.Get(ctx, request.NamespacedName, account); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	account.Status.Conditions = utils.SetAccountCondition(
+		account.Status.Conditions,
+		awsv1alpha1.AccountFailed,
+		corev1.ConditionTrue,
+		utils.ReconcilePanicReason,
+		message,
+		utils.UpdateConditionIfReasonOrMessageChange,
+		account.IsBYOC(),
+	)
+	return r.Status().Update(ctx, account)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AccountReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
+	r.Client = utils.NewSecretAuditClient(r.Client, controllerName)
 	r.awsClientBuilder = &awsclient.Builder{}
 
 	maxReconciles, err := utils.GetControllerMaxReconciles(controllerName)
@@ -1528,9 +1610,16 @@ func (r *AccountReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Initialize shardName to empty string. It will be read from configMap in Reconcile()
 	r.shardName = ""
 
+	r.ownershipTags = newOwnershipTagsBatch(ownershipTagsBatchInterval)
+	if err := mgr.Add(r.ownershipTags); err != nil {
+		return err
+	}
+
 	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&awsv1alpha1.Account{}).
+		For(&awsv1alpha1.Account{}, utils.WithReconcilePredicates(controllerName, utils.AccountStatusTrigger)).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.accountsForConfigMap),
+			builder.WithPredicates(utils.OperatorConfigMapKeysChanged(gatingConfigMapKeys...))).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}).Complete(rwm)
@@ -29,6 +29,7 @@ import (
 	apis "github.com/openshift/aws-account-operator/api"
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
 	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
 	"github.com/openshift/aws-account-operator/pkg/awsclient"
 	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
 	"github.com/openshift/aws-account-operator/pkg/testutils"
@@ -815,7 +816,7 @@ func TestTagAccount(t *testing.T) {
 
 	r := &AccountReconciler{shardName: "hivename"}
 	complianceTags := make(map[string]string)
-	err := TagAccount(mockAWSClient, accountID, r.shardName, complianceTags)
+	err := accountprovision.TagAccount(mockAWSClient, accountID, r.shardName, complianceTags)
 	if err != nil {
 		t.Errorf("failed to tag account")
 	}
@@ -1337,12 +1338,41 @@ var _ = Describe("Account Controller", func() {
 		ctrl.Finish()
 	})
 
+	Context("Testing BuildAccount", func() {
+		It("creates the AWS account with the name and email of the spec", func() {
+			account := &awsv1alpha1.Account{
+				ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-renamed", Namespace: awsv1alpha1.AccountCrNamespace},
+				Spec:       awsv1alpha1.AccountSpec{AccountName: "osd-creds-mgmt-abcdef", Email: "osd-creds-mgmt+abcdef@redhat.com"},
+			}
+			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), &organizations.CreateAccountInput{
+				AccountName: aws.String("osd-creds-mgmt-abcdef"),
+				Email:       aws.String("osd-creds-mgmt+abcdef@redhat.com"),
+			}).Return(nil, &organizationstypes.TooManyRequestsException{Message: aws.String("Error String")})
+
+			_, err := r.BuildAccount(nullLogger, mockAWSClient, account)
+			Expect(err).To(Equal(awsv1alpha1.ErrAwsTooManyRequests))
+		})
+
+		It("derives the name and email from the Account without them", func() {
+			account := &awsv1alpha1.Account{
+				ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-abcdef", Namespace: awsv1alpha1.AccountCrNamespace},
+			}
+			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), &organizations.CreateAccountInput{
+				AccountName: aws.String("osd-creds-mgmt-abcdef"),
+				Email:       aws.String("osd-creds-mgmt+abcdef@redhat.com"),
+			}).Return(nil, &organizationstypes.TooManyRequestsException{Message: aws.String("Error String")})
+
+			_, err := r.BuildAccount(nullLogger, mockAWSClient, account)
+			Expect(err).To(Equal(awsv1alpha1.ErrAwsTooManyRequests))
+		})
+	})
+
 	Context("Testing CreateAccount", func() {
 
 		It("AWS returns ErrCodeConstraintViolationException from CreateAccount", func() {
 			// ErrCodeConstraintViolationException is mapped to awsv1alpha1.ErrAwsAccountLimitExceeded in CreateAccount
 			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &organizationstypes.ConstraintViolationException{Message: aws.String("Error String")})
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(HaveOccurred())
 			Expect(createAccountOutput).To(Equal(&organizations.DescribeCreateAccountStatusOutput{}))
 			Expect(awsv1alpha1.ErrAwsAccountLimitExceeded).To(Equal(err))
@@ -1352,7 +1382,7 @@ var _ = Describe("Account Controller", func() {
 		It("AWS returns ErrCodeServiceException from CreateAccount", func() {
 			// ErrCodeServiceException is mapped to awsv1alpha1.ErrAwsInternalFailure in CreateAccount
 			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &organizationstypes.ServiceException{Message: aws.String("Error String")})
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(HaveOccurred())
 			Expect(createAccountOutput).To(Equal(&organizations.DescribeCreateAccountStatusOutput{}))
 			Expect(awsv1alpha1.ErrAwsInternalFailure).To(Equal(err))
@@ -1362,7 +1392,7 @@ var _ = Describe("Account Controller", func() {
 		It("AWS returns ErrCodeTooManyRequestsException from CreateAccount", func() {
 			// ErrCodeTooManyRequestsException is mapped to awsv1alpha1.ErrAwsTooManyRequests in CreateAccount
 			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &organizationstypes.TooManyRequestsException{Message: aws.String("Error String")})
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(HaveOccurred())
 			Expect(createAccountOutput).To(Equal(&organizations.DescribeCreateAccountStatusOutput{}))
 			Expect(awsv1alpha1.ErrAwsTooManyRequests).To(Equal(err))
@@ -1372,7 +1402,7 @@ var _ = Describe("Account Controller", func() {
 		It("AWS returns error from CreateAccount", func() {
 			// Unhandled AWS exceptions get mapped awsv1alpha1.ErrAwsFailedCreateAccount in CreateAccount
 			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &organizationstypes.DuplicateAccountException{Message: aws.String("Error String")})
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(HaveOccurred())
 			Expect(createAccountOutput).To(Equal(&organizations.DescribeCreateAccountStatusOutput{}))
 			Expect(awsv1alpha1.ErrAwsFailedCreateAccount).To(Equal(err))
@@ -1382,7 +1412,7 @@ var _ = Describe("Account Controller", func() {
 		It("AWS returns ErrCodeConcurrentModificationException from CreateAccount", func() {
 			// ErrCodeConcurrentModificationException is mapped to awsv1alpha1.ErrAwsConcurrentModification in CreateAccount
 			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &organizationstypes.ConcurrentModificationException{Message: aws.String("Error String")})
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(HaveOccurred())
 			Expect(createAccountOutput).To(Equal(&organizations.DescribeCreateAccountStatusOutput{}))
 			Expect(awsv1alpha1.ErrAwsConcurrentModification).To(Equal(err))
@@ -1401,7 +1431,7 @@ var _ = Describe("Account Controller", func() {
 
 			expectedErr := &organizationstypes.ServiceException{Message: aws.String("Error String")}
 			mockAWSClient.EXPECT().DescribeCreateAccountStatus(gomock.Any(), gomock.Any()).Return(nil, expectedErr) //errors.New("MyError")) //)
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(HaveOccurred())
 			Expect(createAccountOutput).To(Equal(&organizations.DescribeCreateAccountStatusOutput{}))
 			Expect(expectedErr).To(Equal(err))
@@ -1423,7 +1453,7 @@ var _ = Describe("Account Controller", func() {
 				},
 			}
 			mockAWSClient.EXPECT().DescribeCreateAccountStatus(gomock.Any(), gomock.Any()).Return(describeCreateAccountStatusOutput, nil)
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(HaveOccurred())
 
 			Expect(createAccountOutput).To(Equal(&organizations.DescribeCreateAccountStatusOutput{}))
@@ -1444,7 +1474,7 @@ var _ = Describe("Account Controller", func() {
 				},
 			}
 			mockAWSClient.EXPECT().DescribeCreateAccountStatus(gomock.Any(), gomock.Any()).Return(describeCreateAccountStatusOutput, nil)
-			createAccountOutput, err := CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
+			createAccountOutput, _, err := accountprovision.CreateAccount(nullLogger, mockAWSClient, accountName, accountEmail)
 			Expect(err).To(Succeed())
 			Expect(createAccountOutput).To(Equal(describeCreateAccountStatusOutput))
 			Expect(err).Should(BeNil())
@@ -1479,9 +1509,10 @@ var _ = Describe("Account Controller", func() {
 				},
 			}
 		)
-		It("Should not modify the AccountCR when encountering a known error during Account Creation", func() {
+		It("Should not fail the AccountCR when encountering a known error during Account Creation", func() {
 			account = &newTestAccountBuilder().WithoutState().acct
 			account.Name = accountName
+			r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account}...).Build()
 			for name, tc := range knownErrors {
 				mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, tc.err)
 				acctId, actualErr := r.BuildAccount(nullLogger, mockAWSClient, account)
@@ -1504,6 +1535,62 @@ var _ = Describe("Account Controller", func() {
 			Expect(actualErr).To(MatchError(awsv1alpha1.ErrAwsFailedCreateAccount))
 			Expect(nullTestLogger.Messages()).Should(ContainElement(ContainSubstring(errCodeAccessDeniedException)))
 			Expect(account.Status.State).To(BeEquivalentTo(awsv1alpha1.AccountFailed))
+			Expect(account.Status.FailureReason).To(Equal(awsv1alpha1.FailureReasonClientError))
+		})
+	})
+
+	Context("Testing BuildAccount in dry-run mode", func() {
+		It("Should neither fail nor change the Account when the account creation is skipped", func() {
+			account = &newTestAccountBuilder().WithoutState().acct
+			account.Name = accountName
+			r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account}...).Build()
+			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &awsclient.DryRunError{Service: "Organizations", Operation: "CreateAccount"})
+			acctId, actualErr := r.BuildAccount(nullLogger, mockAWSClient, account)
+			Expect(awsclient.IsDryRunError(actualErr)).To(BeTrue())
+			Expect(acctId).To(BeEmpty())
+			Expect(account.Status.State).To(BeEmpty())
+			Expect(account.Status.FailureReason).To(BeEmpty())
+		})
+	})
+
+	Context("Testing BuildAccount at the organization account limit", func() {
+		It("Should wait on the account limit without failing the Account", func() {
+			account = &newTestAccountBuilder().WithoutState().acct
+			account.Name = accountName
+			r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account}...).Build()
+			mockAWSClient.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &organizationstypes.ConstraintViolationException{Message: aws.String("Error String")})
+			acctId, actualErr := r.BuildAccount(nullLogger, mockAWSClient, account)
+			Expect(actualErr).To(MatchError(awsv1alpha1.ErrAwsAccountLimitExceeded))
+			Expect(acctId).To(BeEmpty())
+			Expect(account.Status.State).To(BeEmpty())
+			Expect(account.Status.FailureReason).To(BeEmpty())
+			condition := utils.FindAccountCondition(account.Status.Conditions, awsv1alpha1.AccountWaitingOnAccountLimit)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(v1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(accountLimitExceededReason))
+		})
+	})
+
+	Context("Testing setAccountFailed", func() {
+		It("Should record the failure reason alongside the failed condition", func() {
+			account = &newTestAccountBuilder().WithState(awsv1alpha1.AccountCreating).acct
+			r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account}...).Build()
+			_, err := r.setAccountFailed(
+				nullLogger,
+				account,
+				awsv1alpha1.AccountCreationFailed,
+				awsv1alpha1.FailureReasonCreationTimeout,
+				"CreationTimeout",
+				"Creation pending for too long",
+				AccountFailed,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := &awsv1alpha1.Account{}
+			Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+			Expect(updated.Status.State).To(Equal(AccountFailed))
+			Expect(updated.Status.FailureReason).To(Equal(awsv1alpha1.FailureReasonCreationTimeout))
+			Expect(updated.GetCondition(awsv1alpha1.AccountCreationFailed)).ToNot(BeNil())
 		})
 	})
 
@@ -1588,6 +1675,10 @@ var _ = Describe("Account Controller", func() {
 					Key:   aws.String("clusterClaimLinkNamespace"),
 					Value: aws.String(""),
 				},
+				{
+					Key:   aws.String("managed-by"),
+					Value: aws.String("aws-account-operator"),
+				},
 			}
 			mockAWSClient.EXPECT().CreateRole(gomock.Any(), &iam.CreateRoleInput{
 				AssumeRolePolicyDocument: &rolePolicyDoc,
@@ -1809,6 +1900,10 @@ var _ = Describe("Account Controller", func() {
 					Key:   aws.String("clusterClaimLinkNamespace"),
 					Value: aws.String(""),
 				},
+				{
+					Key:   aws.String("managed-by"),
+					Value: aws.String("aws-account-operator"),
+				},
 			}
 			mockAWSClient.EXPECT().CreateRole(gomock.Any(), &iam.CreateRoleInput{
 				AssumeRolePolicyDocument: &rolePolicyDoc,
@@ -2026,6 +2121,7 @@ var _ = Describe("Account Controller", func() {
 			})
 			When("No service quotas are defined for the account", func() {
 				It("does does not open service quota requests for the account", func() {
+					expectNoOpenSupportCases(mockAWSClient)
 					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
 						CaseId: aws.String("123456"),
 					}, nil)
@@ -2045,6 +2141,112 @@ var _ = Describe("Account Controller", func() {
 					}).Should(Equal([]string{AccountReady, "123456"}))
 				})
 			})
+			When("The account pool skips the support verification", func() {
+				BeforeEach(func() {
+					account.Spec.AccountPool = "staging"
+					configMap = &v1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      awsv1alpha1.DefaultConfigMap,
+							Namespace: awsv1alpha1.AccountCrNamespace,
+						},
+						Data: map[string]string{
+							"accountpool": "staging:\n  skipSupportVerification: true\n",
+						},
+					}
+					r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account, configMap}...).Build()
+				})
+				It("marks the account ready without creating a support case", func() {
+					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Times(0)
+					mockAWSClient.EXPECT().DescribeCases(gomock.Any(), gomock.Any()).Times(0)
+					_, err := r.HandleNonCCSPendingVerification(nullLogger, account, mockAWSClient)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(account.Status.State).To(Equal(AccountReady))
+					Expect(account.Status.SupportCaseID).To(BeEmpty())
+					condition := account.GetCondition(awsv1alpha1.AccountSkippedVerification)
+					Expect(condition).NotTo(BeNil())
+					Expect(condition.Status).To(Equal(v1.ConditionTrue))
+					Expect(condition.Reason).To(Equal(supportVerificationSkippedReason))
+				})
+			})
+			When("The account is pending verification for longer than the verification timeout", func() {
+				BeforeEach(func() {
+					account.Status.Conditions = []awsv1alpha1.AccountCondition{{
+						Type:               awsv1alpha1.AccountPendingVerification,
+						Status:             v1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-25 * time.Hour)),
+					}}
+					configMap = &v1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      awsv1alpha1.DefaultConfigMap,
+							Namespace: awsv1alpha1.AccountCrNamespace,
+						},
+						Data: map[string]string{
+							verificationTimeoutKey: "24h",
+						},
+					}
+					r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account, configMap}...).Build()
+				})
+				It("fails the account with the VerificationTimeout reason", func() {
+					mockAWSClient.EXPECT().DescribeCases(gomock.Any(), gomock.Any()).Times(0)
+					_, err := r.HandleNonCCSPendingVerification(nullLogger, account, mockAWSClient)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(account.Status.State).To(Equal(AccountFailed))
+					Expect(account.Status.FailureReason).To(Equal(awsv1alpha1.FailureReasonVerificationTimeout))
+				})
+			})
+			When("The account is pending verification for long without a verification timeout", func() {
+				BeforeEach(func() {
+					account.Spec.AccountPool = "staging"
+					account.Status.Conditions = []awsv1alpha1.AccountCondition{{
+						Type:               awsv1alpha1.AccountPendingVerification,
+						Status:             v1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-30 * 24 * time.Hour)),
+					}}
+					configMap = &v1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      awsv1alpha1.DefaultConfigMap,
+							Namespace: awsv1alpha1.AccountCrNamespace,
+						},
+						Data: map[string]string{
+							"accountpool": "staging:\n  skipSupportVerification: true\n",
+						},
+					}
+					r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account, configMap}...).Build()
+				})
+				It("doesn't fail the account", func() {
+					_, err := r.HandleNonCCSPendingVerification(nullLogger, account, mockAWSClient)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(account.Status.State).To(Equal(AccountReady))
+					Expect(account.Status.FailureReason).To(BeEmpty())
+				})
+			})
+			When("The verification timeout isn't positive", func() {
+				BeforeEach(func() {
+					account.Spec.AccountPool = "staging"
+					account.Status.Conditions = []awsv1alpha1.AccountCondition{{
+						Type:               awsv1alpha1.AccountPendingVerification,
+						Status:             v1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-25 * time.Hour)),
+					}}
+					configMap = &v1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      awsv1alpha1.DefaultConfigMap,
+							Namespace: awsv1alpha1.AccountCrNamespace,
+						},
+						Data: map[string]string{
+							"accountpool":          "staging:\n  skipSupportVerification: true\n",
+							verificationTimeoutKey: "0s",
+						},
+					}
+					r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{account, configMap}...).Build()
+				})
+				It("ignores the timeout and doesn't fail the account", func() {
+					_, err := r.HandleNonCCSPendingVerification(nullLogger, account, mockAWSClient)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(account.Status.State).To(Equal(AccountReady))
+					Expect(account.Status.FailureReason).To(BeEmpty())
+				})
+			})
 			When("Opt-In regions are defined in the ConfigMap and feature flag is enabled", func() {
 				BeforeEach(func() {
 					account = &newTestAccountBuilder().BYOC(false).Claimed(false).WithState(awsv1alpha1.AccountCreating).WithAwsAccountID("4321").acct
@@ -2179,6 +2381,7 @@ var _ = Describe("Account Controller", func() {
 						return subClient, &sts.AssumeRoleOutput{}, nil
 					}
 
+					expectNoOpenSupportCases(mockAWSClient)
 					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
 						CaseId: aws.String("123456"),
 					}, nil)
@@ -2206,6 +2409,7 @@ var _ = Describe("Account Controller", func() {
 						return subClient, &sts.AssumeRoleOutput{}, nil
 					}
 					// Reconciliation loop 1
+					expectNoOpenSupportCases(mockAWSClient)
 					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
 						CaseId: aws.String("123456"),
 					}, nil)
@@ -2251,6 +2455,7 @@ var _ = Describe("Account Controller", func() {
 						return subClient, &sts.AssumeRoleOutput{}, nil
 					}
 					// Reconciliation loop 1
+					expectNoOpenSupportCases(mockAWSClient)
 					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
 						CaseId: aws.String("123456"),
 					}, nil)
@@ -2325,6 +2530,7 @@ var _ = Describe("Account Controller", func() {
 						return subClient, &sts.AssumeRoleOutput{}, nil
 					}
 					// Reconciliation loop 1
+					expectNoOpenSupportCases(mockAWSClient)
 					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
 						CaseId: aws.String("123456"),
 					}, nil)
@@ -2387,6 +2593,7 @@ var _ = Describe("Account Controller", func() {
 						return subClient, &sts.AssumeRoleOutput{}, nil
 					}
 					// Reconciliation loop 1
+					expectNoOpenSupportCases(mockAWSClient)
 					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
 						CaseId: aws.String("123456"),
 					}, nil)
@@ -2467,6 +2674,7 @@ var _ = Describe("Account Controller", func() {
 						return subClient, &sts.AssumeRoleOutput{}, nil
 					}
 					// Reconciliation loop 1
+					expectNoOpenSupportCases(mockAWSClient)
 					mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
 						CaseId: aws.String("123456"),
 					}, nil)
@@ -2509,6 +2717,7 @@ var _ = Describe("Account Controller", func() {
 					_, err = r.HandleNonCCSPendingVerification(nullLogger, account, mockAWSClient)
 					Expect(account.Status.RegionalServiceQuotas["us-east-1"][awsv1alpha1.RunningStandardInstances].Status).To(Equal(awsv1alpha1.ServiceRequestDenied))
 					Expect(account.Status.State).To(Equal(AccountFailed))
+					Expect(account.Status.FailureReason).To(Equal(awsv1alpha1.FailureReasonQuotaDenied))
 				})
 			})
 		})
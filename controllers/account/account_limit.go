@@ -0,0 +1,84 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/totalaccountwatcher"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// accountLimitReachedReason is the reason of the WaitingOnAccountLimit condition while the organization is at the
+	// account limit
+	accountLimitReachedReason = "AccountLimitReached"
+	// accountLimitUnknownReason is the reason of the WaitingOnAccountLimit condition while the account count or limit
+	// of the organization couldn't be read
+	accountLimitUnknownReason = "AccountLimitUnknown"
+	// accountLimitExceededReason is the reason of the WaitingOnAccountLimit condition while AWS Organizations refuses
+	// to create the account because the organization is at the account limit
+	accountLimitExceededReason = "OrgLimitExceeded"
+	// accountLimitAvailableReason is the reason of the WaitingOnAccountLimit condition once the account may be created
+	accountLimitAvailableReason = "AccountLimitAvailable"
+)
+
+// waitOnAccountLimit sets the WaitingOnAccountLimit condition of an account whose AWS account can't be created
+// because the organization is at the account limit, with the current and limit numbers, so it doesn't requeue
+// silently
+func (r *AccountReconciler) waitOnAccountLimit(reqLogger logr.Logger, account *awsv1alpha1.Account, watcher totalaccountwatcher.AccountWatcherIface) error {
+	reason := accountLimitReachedReason
+	message := fmt.Sprintf("The organization has %d accounts, the account limit is %d", watcher.GetAccountCount(), watcher.GetLimit())
+	if watcher.GetLimit() <= 0 {
+		reason = accountLimitUnknownReason
+		message = "The account count or the account limit of the organization couldn't be read"
+	}
+	reqLogger.Info("Waiting on the account limit", "accounts", watcher.GetAccountCount(), "limit", watcher.GetLimit())
+	account.Status.Conditions = utils.SetAccountCondition(
+		account.Status.Conditions,
+		awsv1alpha1.AccountWaitingOnAccountLimit,
+		corev1.ConditionTrue,
+		reason,
+		message,
+		utils.UpdateConditionIfReasonOrMessageChange,
+		account.Spec.BYOC,
+	)
+	return r.statusUpdate(account)
+}
+
+// waitOnOrganizationLimit sets the WaitingOnAccountLimit condition of an account whose AWS account creation AWS
+// Organizations refused because the organization is at the account limit. The account isn't failed, it's requeued
+// until the organization is under its limit again
+func (r *AccountReconciler) waitOnOrganizationLimit(account *awsv1alpha1.Account, message string) error {
+	account.Status.Conditions = utils.SetAccountCondition(
+		account.Status.Conditions,
+		awsv1alpha1.AccountWaitingOnAccountLimit,
+		corev1.ConditionTrue,
+		accountLimitExceededReason,
+		message,
+		utils.UpdateConditionIfReasonOrMessageChange,
+		account.Spec.BYOC,
+	)
+	return r.statusUpdate(account)
+}
+
+// clearWaitingOnAccountLimit sets the WaitingOnAccountLimit condition of an account that waited on the account limit
+// to false once its AWS account may be created
+func (r *AccountReconciler) clearWaitingOnAccountLimit(account *awsv1alpha1.Account) error {
+	condition := utils.FindAccountCondition(account.Status.Conditions, awsv1alpha1.AccountWaitingOnAccountLimit)
+	if condition == nil || condition.Status != corev1.ConditionTrue {
+		return nil
+	}
+	account.Status.Conditions = utils.SetAccountCondition(
+		account.Status.Conditions,
+		awsv1alpha1.AccountWaitingOnAccountLimit,
+		corev1.ConditionFalse,
+		accountLimitAvailableReason,
+		"The organization is under the account limit",
+		utils.UpdateConditionIfReasonOrMessageChange,
+		account.Spec.BYOC,
+	)
+	return r.statusUpdate(account)
+}
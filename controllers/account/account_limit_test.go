@@ -0,0 +1,75 @@
+package account
+
+import (
+	"context"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeAccountWatcher struct {
+	count int
+	limit int
+}
+
+func (w *fakeAccountWatcher) GetAccountCount() int { return w.count }
+func (w *fakeAccountWatcher) GetLimit() int        { return w.limit }
+
+var _ = Describe("Account limit", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		r          *AccountReconciler
+		account    *awsv1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+		}
+		r = &AccountReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	getCondition := func() *awsv1alpha1.AccountCondition {
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		account = updated
+		return utils.FindAccountCondition(updated.Status.Conditions, awsv1alpha1.AccountWaitingOnAccountLimit)
+	}
+
+	It("reports the account count and limit while waiting", func() {
+		Expect(r.waitOnAccountLimit(nullLogger, account, &fakeAccountWatcher{count: 4801, limit: 4800})).To(Succeed())
+
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(accountLimitReachedReason))
+		Expect(condition.Message).To(Equal("The organization has 4801 accounts, the account limit is 4800"))
+
+		By("clearing the condition once the account may be created")
+		Expect(r.clearWaitingOnAccountLimit(account)).To(Succeed())
+		condition = getCondition()
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(accountLimitAvailableReason))
+	})
+
+	It("reports when the limit couldn't be read", func() {
+		Expect(r.waitOnAccountLimit(nullLogger, account, &fakeAccountWatcher{})).To(Succeed())
+		Expect(getCondition().Reason).To(Equal(accountLimitUnknownReason))
+	})
+
+	It("doesn't add the condition to accounts that never waited", func() {
+		Expect(r.clearWaitingOnAccountLimit(account)).To(Succeed())
+		Expect(getCondition()).To(BeNil())
+	})
+})
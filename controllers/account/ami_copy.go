@@ -0,0 +1,318 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+)
+
+const (
+	// AMICopyAnnotation holds a comma separated list of AMI IDs to copy out of the account
+	AMICopyAnnotation = "aws.managed.openshift.io/copy-amis"
+	// AMICopyDestinationAnnotation names the Account CR the AMIs are copied into
+	AMICopyDestinationAnnotation = "aws.managed.openshift.io/copy-amis-destination"
+	// AMICopyRegionAnnotation optionally sets the region of the AMIs, the default region is used otherwise
+	AMICopyRegionAnnotation = "aws.managed.openshift.io/copy-amis-region"
+
+	// copiedFromTagKey is added to copied AMIs and points back to the source image
+	copiedFromTagKey    = "aws.managed.openshift.io/copied-from"
+	amiCopyPollInterval = 1 * time.Minute
+)
+
+// errAMINotFound is returned when DescribeImages doesn't return the AMI, which copying it won't fix
+var errAMINotFound = errors.New("AMI not found")
+
+func hasAMICopyRequest(account *awsv1alpha1.Account) bool {
+	return account.Annotations[AMICopyAnnotation] != "" && account.Annotations[AMICopyDestinationAnnotation] != ""
+}
+
+func requestedAMIs(account *awsv1alpha1.Account) []string {
+	imageIDs := []string{}
+	for _, imageID := range strings.Split(account.Annotations[AMICopyAnnotation], ",") {
+		imageID = strings.TrimSpace(imageID)
+		if imageID != "" {
+			imageIDs = append(imageIDs, imageID)
+		}
+	}
+	return imageIDs
+}
+
+func amiCopyFinished(copyStatus *awsv1alpha1.AMICopyStatus) bool {
+	return copyStatus != nil && (copyStatus.State == awsv1alpha1.AMICopyCompleted || copyStatus.State == awsv1alpha1.AMICopyFailed)
+}
+
+// handleAMICopy shares the AMIs named in the AMICopyAnnotation with the destination account, copies them
+// there and re-tags the copies. Progress is reported in Status.AMICopies. A non-empty result is returned
+// while copies are still in progress.
+func (r *AccountReconciler) handleAMICopy(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client) (reconcile.Result, error) {
+	imageIDs := requestedAMIs(currentAcctInstance)
+
+	pending := false
+	for _, imageID := range imageIDs {
+		if !amiCopyFinished(currentAcctInstance.Status.AMICopies[imageID]) {
+			pending = true
+		}
+	}
+	if !pending {
+		return reconcile.Result{}, nil
+	}
+
+	destination := &awsv1alpha1.Account{}
+	err := r.Get(context.TODO(), types.NamespacedName{Name: currentAcctInstance.Annotations[AMICopyDestinationAnnotation], Namespace: awsv1alpha1.AccountCrNamespace}, destination)
+	if err != nil {
+		reqLogger.Error(err, "failed to get AMI copy destination account")
+		return reconcile.Result{}, err
+	}
+	if !destination.HasAwsAccountID() {
+		return reconcile.Result{}, fmt.Errorf("AMI copy destination account %s has no AWS account ID yet", destination.Name)
+	}
+
+	region := currentAcctInstance.Annotations[AMICopyRegionAnnotation]
+	if region == "" {
+		region = config.GetDefaultRegion()
+	}
+
+	sourceClient, _, err := AssumeRoleAndCreateClient(reqLogger, r.awsClientBuilder, currentAcctInstance, r.Client, awsSetupClient, region, currentAcctInstance.GetAssumeRole(), "")
+	if err != nil {
+		reqLogger.Error(err, "could not impersonate AMI copy source account", "aws-account", currentAcctInstance.Spec.AwsAccountID)
+		return reconcile.Result{}, err
+	}
+	destinationClient, _, err := AssumeRoleAndCreateClient(reqLogger, r.awsClientBuilder, destination, r.Client, awsSetupClient, region, destination.GetAssumeRole(), "")
+	if err != nil {
+		reqLogger.Error(err, "could not impersonate AMI copy destination account", "aws-account", destination.Spec.AwsAccountID)
+		return reconcile.Result{}, err
+	}
+
+	if currentAcctInstance.Status.AMICopies == nil {
+		currentAcctInstance.Status.AMICopies = make(awsv1alpha1.AMICopies)
+	}
+
+	inProgress := false
+	for _, imageID := range imageIDs {
+		copyStatus, ok := currentAcctInstance.Status.AMICopies[imageID]
+		if !ok || copyStatus == nil {
+			copyStatus = &awsv1alpha1.AMICopyStatus{}
+			currentAcctInstance.Status.AMICopies[imageID] = copyStatus
+		}
+		if amiCopyFinished(copyStatus) {
+			continue
+		}
+
+		err = advanceAMICopy(sourceClient, destinationClient, imageID, destination.Spec.AwsAccountID, region, copyStatus)
+		if err != nil && isTransientAMICopyError(err) {
+			reqLogger.Info("AMI copy step failed, retrying", "image", imageID, "error", err.Error())
+			copyStatus.Message = err.Error()
+			inProgress = true
+			continue
+		}
+		if err != nil {
+			reqLogger.Error(err, "AMI copy failed", "image", imageID)
+			if copyStatus.State == awsv1alpha1.AMICopyShared || copyStatus.State == awsv1alpha1.AMICopyCopying {
+				if revokeErr := revokeImageShare(sourceClient, imageID, destination.Spec.AwsAccountID); revokeErr != nil {
+					reqLogger.Error(revokeErr, "failed revoking the AMI share of the failed copy", "image", imageID)
+				}
+			}
+			copyStatus.State = awsv1alpha1.AMICopyFailed
+			copyStatus.Message = err.Error()
+			continue
+		}
+		if !amiCopyFinished(copyStatus) {
+			inProgress = true
+		}
+	}
+
+	err = r.statusUpdate(currentAcctInstance)
+	if err != nil {
+		reqLogger.Error(err, "failed to update AMI copy status")
+		return reconcile.Result{}, err
+	}
+
+	if inProgress {
+		return reconcile.Result{RequeueAfter: amiCopyPollInterval}, nil
+	}
+	reqLogger.Info("AMI copy finished", "destination", destination.Name)
+	return reconcile.Result{}, nil
+}
+
+// advanceAMICopy moves a single AMI copy forward as far as possible without waiting on AWS
+func advanceAMICopy(sourceClient awsclient.Client, destinationClient awsclient.Client, imageID string, destinationAccountID string, region string, copyStatus *awsv1alpha1.AMICopyStatus) error {
+	source, err := describeImage(sourceClient, imageID)
+	if err != nil {
+		return err
+	}
+
+	if copyStatus.State == "" {
+		err = shareImage(sourceClient, source, destinationAccountID)
+		if err != nil {
+			return fmt.Errorf("failed sharing AMI with %s: %w", destinationAccountID, err)
+		}
+		copyStatus.State = awsv1alpha1.AMICopyShared
+	}
+
+	if copyStatus.State == awsv1alpha1.AMICopyShared {
+		output, err := destinationClient.CopyImage(context.TODO(), &ec2.CopyImageInput{
+			Name:          source.Name,
+			Description:   source.Description,
+			SourceImageId: aws.String(imageID),
+			SourceRegion:  aws.String(region),
+		})
+		if err != nil {
+			return fmt.Errorf("failed copying AMI: %w", err)
+		}
+		copyStatus.DestinationImageID = *output.ImageId
+		copyStatus.State = awsv1alpha1.AMICopyCopying
+		return nil
+	}
+
+	if copyStatus.State == awsv1alpha1.AMICopyCopying {
+		copied, err := describeImage(destinationClient, copyStatus.DestinationImageID)
+		if err != nil {
+			return err
+		}
+		switch copied.State {
+		case ec2types.ImageStateAvailable:
+			tags := append(copyableTags(source.Tags), ec2types.Tag{
+				Key:   aws.String(copiedFromTagKey),
+				Value: aws.String(imageID),
+			})
+			_, err = destinationClient.CreateTags(context.TODO(), &ec2.CreateTagsInput{
+				Resources: []string{copyStatus.DestinationImageID},
+				Tags:      tags,
+			})
+			if err != nil {
+				return fmt.Errorf("failed tagging copied AMI %s: %w", copyStatus.DestinationImageID, err)
+			}
+			err = unshareImage(sourceClient, source, destinationAccountID)
+			if err != nil {
+				return fmt.Errorf("failed revoking the AMI share with %s: %w", destinationAccountID, err)
+			}
+			copyStatus.State = awsv1alpha1.AMICopyCompleted
+			copyStatus.Message = ""
+		case ec2types.ImageStateFailed, ec2types.ImageStateError, ec2types.ImageStateInvalid:
+			err = unshareImage(sourceClient, source, destinationAccountID)
+			if err != nil {
+				return fmt.Errorf("failed revoking the AMI share with %s: %w", destinationAccountID, err)
+			}
+			copyStatus.State = awsv1alpha1.AMICopyFailed
+			copyStatus.Message = fmt.Sprintf("copied AMI %s is in state %s", copyStatus.DestinationImageID, copied.State)
+		}
+	}
+	return nil
+}
+
+// copyableTags returns the tags of the source AMI that can be set on its copy, without the aws: prefixed tags
+// reserved to AWS
+func copyableTags(tags []ec2types.Tag) []ec2types.Tag {
+	copyable := []ec2types.Tag{}
+	for _, tag := range tags {
+		if strings.HasPrefix(strings.ToLower(aws.ToString(tag.Key)), "aws:") {
+			continue
+		}
+		copyable = append(copyable, tag)
+	}
+	return copyable
+}
+
+// isTransientAMICopyError returns whether a failed AMI copy step may succeed when retried: server side faults,
+// throttling and the other errors the SDK retries, like connection errors, once its own retries ran out
+func isTransientAMICopyError(err error) bool {
+	if errors.Is(err, errAMINotFound) {
+		return false
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorFault() == smithy.FaultServer {
+		return true
+	}
+	if retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err) == aws.TrueTernary {
+		return true
+	}
+	return retry.IsErrorRetryables(retry.DefaultRetryables).IsErrorRetryable(err) == aws.TrueTernary
+}
+
+func describeImage(awsClient awsclient.Client, imageID string) (*ec2types.Image, error) {
+	output, err := awsClient.DescribeImages(context.TODO(), &ec2.DescribeImagesInput{
+		ImageIds: []string{imageID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed describing AMI %s: %w", imageID, err)
+	}
+	if len(output.Images) == 0 {
+		return nil, fmt.Errorf("AMI %s: %w", imageID, errAMINotFound)
+	}
+	return &output.Images[0], nil
+}
+
+// shareImage grants the destination account launch permission on the AMI and create volume
+// permission on its backing snapshots, both of which CopyImage requires
+func shareImage(sourceClient awsclient.Client, image *ec2types.Image, destinationAccountID string) error {
+	return modifyImageShare(sourceClient, image, destinationAccountID, true)
+}
+
+// unshareImage revokes the permissions granted by shareImage once the copy is done, so the source AMI doesn't
+// stay shared with the destination account
+func unshareImage(sourceClient awsclient.Client, image *ec2types.Image, destinationAccountID string) error {
+	return modifyImageShare(sourceClient, image, destinationAccountID, false)
+}
+
+// revokeImageShare revokes the permissions granted by shareImage on an AMI that may be gone. There's nothing left to
+// revoke then.
+func revokeImageShare(sourceClient awsclient.Client, imageID string, destinationAccountID string) error {
+	image, err := describeImage(sourceClient, imageID)
+	if errors.Is(err, errAMINotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return unshareImage(sourceClient, image, destinationAccountID)
+}
+
+func modifyImageShare(sourceClient awsclient.Client, image *ec2types.Image, destinationAccountID string, share bool) error {
+	launchPermissions := &ec2types.LaunchPermissionModifications{}
+	volumePermissions := &ec2types.CreateVolumePermissionModifications{}
+	if share {
+		launchPermissions.Add = []ec2types.LaunchPermission{{UserId: aws.String(destinationAccountID)}}
+		volumePermissions.Add = []ec2types.CreateVolumePermission{{UserId: aws.String(destinationAccountID)}}
+	} else {
+		launchPermissions.Remove = []ec2types.LaunchPermission{{UserId: aws.String(destinationAccountID)}}
+		volumePermissions.Remove = []ec2types.CreateVolumePermission{{UserId: aws.String(destinationAccountID)}}
+	}
+
+	_, err := sourceClient.ModifyImageAttribute(context.TODO(), &ec2.ModifyImageAttributeInput{
+		ImageId:          image.ImageId,
+		LaunchPermission: launchPermissions,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, mapping := range image.BlockDeviceMappings {
+		if mapping.Ebs == nil || mapping.Ebs.SnapshotId == nil {
+			continue
+		}
+		_, err = sourceClient.ModifySnapshotAttribute(context.TODO(), &ec2.ModifySnapshotAttributeInput{
+			SnapshotId:             mapping.Ebs.SnapshotId,
+			Attribute:              ec2types.SnapshotAttributeNameCreateVolumePermission,
+			CreateVolumePermission: volumePermissions,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
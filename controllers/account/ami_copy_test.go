@@ -0,0 +1,164 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AMI copy", func() {
+	var (
+		ctrl              *gomock.Controller
+		sourceClient      *mock.MockClient
+		destinationClient *mock.MockClient
+		sourceImage       ec2types.Image
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		sourceClient = mock.NewMockClient(ctrl)
+		destinationClient = mock.NewMockClient(ctrl)
+		sourceImage = ec2types.Image{
+			ImageId: aws.String("ami-source"),
+			Name:    aws.String("golden"),
+			BlockDeviceMappings: []ec2types.BlockDeviceMapping{
+				{Ebs: &ec2types.EbsBlockDevice{SnapshotId: aws.String("snap-123")}},
+			},
+			Tags: []ec2types.Tag{
+				{Key: aws.String("team"), Value: aws.String("sre")},
+				{Key: aws.String("aws:backup:source-resource"), Value: aws.String("vol-123")},
+			},
+		}
+	})
+
+	expectSourceImage := func() {
+		sourceClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{sourceImage},
+		}, nil)
+	}
+
+	expectUnshare := func() {
+		sourceClient.EXPECT().ModifyImageAttribute(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.ModifyImageAttributeInput) {
+			Expect(input.LaunchPermission.Add).To(BeEmpty())
+			Expect(*input.LaunchPermission.Remove[0].UserId).To(Equal("123456789012"))
+		}).Return(&ec2.ModifyImageAttributeOutput{}, nil)
+		sourceClient.EXPECT().ModifySnapshotAttribute(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.ModifySnapshotAttributeInput) {
+			Expect(*input.SnapshotId).To(Equal("snap-123"))
+			Expect(input.CreateVolumePermission.Add).To(BeEmpty())
+			Expect(*input.CreateVolumePermission.Remove[0].UserId).To(Equal("123456789012"))
+		}).Return(&ec2.ModifySnapshotAttributeOutput{}, nil)
+	}
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("parses the requested AMIs from the annotation", func() {
+		account := &awsv1alpha1.Account{}
+		account.Annotations = map[string]string{AMICopyAnnotation: "ami-1, ami-2,,"}
+		Expect(requestedAMIs(account)).To(Equal([]string{"ami-1", "ami-2"}))
+		Expect(hasAMICopyRequest(account)).To(BeFalse())
+	})
+
+	It("shares the AMI and its snapshots before starting the copy", func() {
+		expectSourceImage()
+		copyStatus := &awsv1alpha1.AMICopyStatus{}
+		sourceClient.EXPECT().ModifyImageAttribute(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.ModifyImageAttributeInput) {
+			Expect(*input.LaunchPermission.Add[0].UserId).To(Equal("123456789012"))
+		}).Return(&ec2.ModifyImageAttributeOutput{}, nil)
+		sourceClient.EXPECT().ModifySnapshotAttribute(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.ModifySnapshotAttributeInput) {
+			Expect(*input.SnapshotId).To(Equal("snap-123"))
+		}).Return(&ec2.ModifySnapshotAttributeOutput{}, nil)
+		destinationClient.EXPECT().CopyImage(gomock.Any(), gomock.Any()).Return(&ec2.CopyImageOutput{
+			ImageId: aws.String("ami-copy"),
+		}, nil)
+
+		err := advanceAMICopy(sourceClient, destinationClient, "ami-source", "123456789012", "us-east-1", copyStatus)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(copyStatus.State).To(Equal(awsv1alpha1.AMICopyCopying))
+		Expect(copyStatus.DestinationImageID).To(Equal("ami-copy"))
+	})
+
+	It("re-tags the copy once it is available", func() {
+		expectSourceImage()
+		copyStatus := &awsv1alpha1.AMICopyStatus{State: awsv1alpha1.AMICopyCopying, DestinationImageID: "ami-copy"}
+		destinationClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{ImageId: aws.String("ami-copy"), State: ec2types.ImageStateAvailable}},
+		}, nil)
+		destinationClient.EXPECT().CreateTags(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.CreateTagsInput) {
+			Expect(input.Resources).To(Equal([]string{"ami-copy"}))
+			Expect(input.Tags).To(HaveLen(2))
+			Expect(*input.Tags[0].Key).To(Equal("team"))
+			Expect(*input.Tags[1].Key).To(Equal(copiedFromTagKey))
+		}).Return(&ec2.CreateTagsOutput{}, nil)
+		expectUnshare()
+
+		err := advanceAMICopy(sourceClient, destinationClient, "ami-source", "123456789012", "us-east-1", copyStatus)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(copyStatus.State).To(Equal(awsv1alpha1.AMICopyCompleted))
+	})
+
+	It("keeps the copy in progress until the share is revoked", func() {
+		expectSourceImage()
+		copyStatus := &awsv1alpha1.AMICopyStatus{State: awsv1alpha1.AMICopyCopying, DestinationImageID: "ami-copy"}
+		destinationClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{ImageId: aws.String("ami-copy"), State: ec2types.ImageStateAvailable}},
+		}, nil)
+		destinationClient.EXPECT().CreateTags(gomock.Any(), gomock.Any()).Return(&ec2.CreateTagsOutput{}, nil)
+		sourceClient.EXPECT().ModifyImageAttribute(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "RequestLimitExceeded"})
+
+		err := advanceAMICopy(sourceClient, destinationClient, "ami-source", "123456789012", "us-east-1", copyStatus)
+		Expect(isTransientAMICopyError(err)).To(BeTrue())
+		Expect(copyStatus.State).To(Equal(awsv1alpha1.AMICopyCopying))
+	})
+
+	It("revokes the share when the copy fails", func() {
+		expectSourceImage()
+		copyStatus := &awsv1alpha1.AMICopyStatus{State: awsv1alpha1.AMICopyCopying, DestinationImageID: "ami-copy"}
+		destinationClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{ImageId: aws.String("ami-copy"), State: ec2types.ImageStateFailed}},
+		}, nil)
+		expectUnshare()
+
+		err := advanceAMICopy(sourceClient, destinationClient, "ami-source", "123456789012", "us-east-1", copyStatus)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(copyStatus.State).To(Equal(awsv1alpha1.AMICopyFailed))
+	})
+
+	It("has nothing to revoke on a deleted AMI", func() {
+		sourceClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{}, nil)
+		Expect(revokeImageShare(sourceClient, "ami-source", "123456789012")).To(Succeed())
+	})
+
+	It("keeps waiting while the copy is pending", func() {
+		expectSourceImage()
+		copyStatus := &awsv1alpha1.AMICopyStatus{State: awsv1alpha1.AMICopyCopying, DestinationImageID: "ami-copy"}
+		destinationClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{ImageId: aws.String("ami-copy"), State: ec2types.ImageStatePending}},
+		}, nil)
+
+		err := advanceAMICopy(sourceClient, destinationClient, "ami-source", "123456789012", "us-east-1", copyStatus)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(copyStatus.State).To(Equal(awsv1alpha1.AMICopyCopying))
+	})
+
+	DescribeTable("tells transient errors apart from the ones failing the copy",
+		func(err error, transient bool) {
+			Expect(isTransientAMICopyError(err)).To(Equal(transient))
+		},
+		Entry("throttling", fmt.Errorf("failed copying AMI: %w", &smithy.GenericAPIError{Code: "RequestLimitExceeded"}), true),
+		Entry("server fault", &smithy.GenericAPIError{Code: "InternalError", Fault: smithy.FaultServer}, true),
+		Entry("missing AMI", fmt.Errorf("AMI ami-source: %w", errAMINotFound), false),
+		Entry("invalid AMI", &smithy.GenericAPIError{Code: "InvalidAMIID.Malformed", Fault: smithy.FaultClient}, false),
+		Entry("denied", &smithy.GenericAPIError{Code: "UnauthorizedOperation", Fault: smithy.FaultClient}, false),
+	)
+})
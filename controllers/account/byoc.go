@@ -254,18 +254,21 @@ func (r *AccountReconciler) getSTSClient(log logr.Logger, accountClaim *awsv1alp
 		return nil, nil, cmErr
 	}
 
-	stsAccessARN := cm.Data["sts-jump-role"]
-	if stsAccessARN == "" {
-		log.Error(awsv1alpha1.ErrInvalidConfigMap, "configmap key missing", "keyName", "sts-jump-role")
-		return nil, nil, cmErr
+	stsAccessARN, err := stsclient.JumpRoles.Select(stsclient.JumpRolesFromConfigMap(cm))
+	if err != nil {
+		log.Error(awsv1alpha1.ErrInvalidConfigMap, "configmap key missing", "keyName", stsclient.JumpRoleKey)
+		return nil, nil, err
 	}
 
 	awsRegion := config.GetDefaultRegion()
 
 	jumpRoleCreds, err := stsclient.GetSTSCredentials(log, operatorAWSClient, stsAccessARN, "", "awsAccountOperator")
 	if err != nil {
+		failures := stsclient.JumpRoles.ReportFailure(stsAccessARN)
+		log.Error(err, "failed assuming the STS jump role", "jumpRole", stsAccessARN, "consecutiveFailures", failures)
 		return nil, nil, err
 	}
+	stsclient.JumpRoles.ReportSuccess(stsAccessARN)
 
 	jumpRoleClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
 		AwsCredsSecretIDKey:     *jumpRoleCreds.Credentials.AccessKeyId,
@@ -277,8 +280,12 @@ func (r *AccountReconciler) getSTSClient(log logr.Logger, accountClaim *awsv1alp
 		return nil, nil, err
 	}
 
-	customerAccountCreds, err := stsclient.GetSTSCredentials(log, jumpRoleClient,
-		accountClaim.Spec.STSRoleARN, accountClaim.Spec.STSExternalID, "RH-Account-Initialization")
+	sessionOptions := stsclient.SessionOptions{Policy: accountClaim.Spec.STSSessionPolicy}
+	if accountClaim.Spec.STSSessionDuration != nil {
+		sessionOptions.Duration = accountClaim.Spec.STSSessionDuration.Duration
+	}
+	customerAccountCreds, err := stsclient.GetSTSCredentialsWithOptions(log, jumpRoleClient,
+		accountClaim.Spec.STSRoleARN, accountClaim.Spec.STSExternalID, "RH-Account-Initialization", sessionOptions)
 	if err != nil {
 		return nil, nil, err
 	}
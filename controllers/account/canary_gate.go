@@ -0,0 +1,31 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/canary"
+	"github.com/openshift/aws-account-operator/version"
+)
+
+// canaryGateRequeue is how long an account waiting for the canary accounts of its pool waits before checking again
+const canaryGateRequeue = 5 * time.Minute
+
+// canaryGate returns whether the account waits for the canary accounts of its pool to be validated with the running
+// operator version before being reconciled by it. Accounts going on are marked as reconciled by the version, so they're
+// gated by the next version once their pool designates canaries, and the validation controller checks the canaries.
+func (r *AccountReconciler) canaryGate(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account) (bool, error) {
+	pool, err := canary.PoolOf(ctx, r.Client, account)
+	if err != nil {
+		return false, err
+	}
+	if canary.Gated(pool, account, version.Version) {
+		reqLogger.Info("Waiting for the canary accounts of the pool to be validated with the operator version",
+			"accountPool", pool.Name, "version", version.Version, "reconciledVersion", account.Annotations[awsv1alpha1.ReconciledVersionAnnotation])
+		return true, nil
+	}
+	return false, canary.MarkReconciled(ctx, r.Client, account, version.Version)
+}
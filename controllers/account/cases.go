@@ -27,9 +27,26 @@ const (
 	intervalBetweenChecksMinutes  = 10
 )
 
-func createCase(reqLogger logr.Logger, account *v1alpha1.Account, client awsclient.Client) (string, error) {
+// caseSubject is also used to find the cases previously opened for an AWS account
+func caseSubject(accountID string) string {
+	return fmt.Sprintf("Add account %s to Enterprise Support", accountID)
+}
+
+// CreateCase opens the support case enrolling the account into Enterprise Support and returns its ID.
+// If an open case already exists for the AWS account, e.g. because the Account CR was recreated, its ID is
+// returned instead of opening a duplicate.
+func CreateCase(reqLogger logr.Logger, account *v1alpha1.Account, client awsclient.Client) (string, error) {
 	accountID := account.Spec.AwsAccountID
 
+	existingCaseID, err := findOpenCase(reqLogger, accountID, client)
+	if err != nil {
+		return "", err
+	}
+	if existingCaseID != "" {
+		reqLogger.Info("Adopting existing support case", "AccountID", accountID, "CaseID", existingCaseID)
+		return existingCaseID, nil
+	}
+
 	// Initialize basic communication body and case subject
 	caseCommunicationBody := fmt.Sprintf(
 		`Hello AWS,
@@ -41,14 +58,12 @@ Thanks.
 [rh-internal-account-name: %s]`, accountID, account.Name,
 	)
 
-	caseSubject := fmt.Sprintf("Add account %s to Enterprise Support", accountID)
-
 	createCaseInput := support.CreateCaseInput{
 		CategoryCode:      aws.String(caseCategoryCode),
 		ServiceCode:       aws.String(caseServiceCode),
 		IssueType:         aws.String(caseIssueType),
 		CommunicationBody: aws.String(caseCommunicationBody),
-		Subject:           aws.String(caseSubject),
+		Subject:           aws.String(caseSubject(accountID)),
 		SeverityCode:      aws.String(caseSeverity),
 		Language:          aws.String(caseLanguage),
 	}
@@ -81,6 +96,43 @@ Thanks.
 	return *caseResult.CaseId, nil
 }
 
+// findOpenCase returns the ID of an unresolved Enterprise Support case opened for the AWS account, or an
+// empty string if there is none
+func findOpenCase(reqLogger logr.Logger, accountID string, client awsclient.Client) (string, error) {
+	subject := caseSubject(accountID)
+	describeCasesInput := support.DescribeCasesInput{
+		IncludeResolvedCases: false,
+	}
+
+	for {
+		caseResult, caseErr := client.DescribeCases(context.TODO(), &describeCasesInput)
+		if caseErr != nil {
+			var returnErr error
+
+			var internalServerErr *supporttypes.InternalServerError
+			if errors.As(caseErr, &internalServerErr) {
+				returnErr = v1alpha1.ErrAwsInternalFailure
+			} else {
+				returnErr = v1alpha1.ErrAwsFailedDescribeSupportCase
+			}
+
+			controllerutils.LogAwsError(reqLogger, "New AWS Error while looking for existing cases", returnErr, caseErr)
+			return "", returnErr
+		}
+
+		for _, c := range caseResult.Cases {
+			if aws.ToString(c.Subject) == subject && c.CaseId != nil {
+				return *c.CaseId, nil
+			}
+		}
+
+		if caseResult.NextToken == nil {
+			return "", nil
+		}
+		describeCasesInput.NextToken = caseResult.NextToken
+	}
+}
+
 func checkCaseResolution(reqLogger logr.Logger, caseID string, client awsclient.Client) (bool, error) {
 	// Look for the case using the unique ID provided
 	describeCasesInput := support.DescribeCasesInput{
@@ -0,0 +1,74 @@
+package account
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/support"
+	supporttypes "github.com/aws/aws-sdk-go-v2/service/support/types"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// isOpenCaseLookup matches the DescribeCases calls listing open cases, as opposed to the ones checking a single case
+var isOpenCaseLookup = gomock.Cond(func(x any) bool {
+	input, ok := x.(*support.DescribeCasesInput)
+	return ok && len(input.CaseIdList) == 0
+})
+
+// expectNoOpenSupportCases makes the lookup done before creating a case find nothing to adopt
+func expectNoOpenSupportCases(mockAWSClient *mock.MockClient) {
+	mockAWSClient.EXPECT().DescribeCases(gomock.Any(), isOpenCaseLookup).Return(&support.DescribeCasesOutput{}, nil)
+}
+
+var _ = Describe("Support cases", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		account       *awsv1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWSClient = mock.NewMockClient(ctrl)
+		account = &newTestAccountBuilder().WithAwsAccountID("4321").acct
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("adopts an open case opened for the same AWS account", func() {
+		mockAWSClient.EXPECT().DescribeCases(gomock.Any(), isOpenCaseLookup).Return(&support.DescribeCasesOutput{
+			Cases: []supporttypes.CaseDetails{
+				{CaseId: aws.String("other"), Subject: aws.String(caseSubject("1234"))},
+			},
+			NextToken: aws.String("next"),
+		}, nil)
+		mockAWSClient.EXPECT().DescribeCases(gomock.Any(), isOpenCaseLookup).Return(&support.DescribeCasesOutput{
+			Cases: []supporttypes.CaseDetails{
+				{CaseId: aws.String("existing"), Subject: aws.String(caseSubject("4321"))},
+			},
+		}, nil)
+		mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Times(0)
+
+		caseID, err := CreateCase(nullLogger, account, mockAWSClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(caseID).To(Equal("existing"))
+	})
+
+	It("opens a new case when none is open", func() {
+		expectNoOpenSupportCases(mockAWSClient)
+		mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{
+			CaseId: aws.String("new"),
+		}, nil)
+
+		caseID, err := CreateCase(nullLogger, account, mockAWSClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(caseID).To(Equal("new"))
+	})
+})
@@ -0,0 +1,47 @@
+package account
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+const (
+	// optInRegionsFeatureFlag enables the opt-in of the regions of optInRegionsKey on new accounts
+	optInRegionsFeatureFlag = "feature.opt_in_regions"
+	// optInRegionsKey is the operator ConfigMap key of the comma separated regions new accounts opt in to
+	optInRegionsKey = "opt-in-regions"
+)
+
+// gatingConfigMapKeys are the operator ConfigMap keys gating steps of accounts, which are reconciled again when they
+// change
+var gatingConfigMapKeys = []string{
+	optInRegionsFeatureFlag,
+	optInRegionsKey,
+}
+
+// gatedByConfigMap returns whether the account is in a state one of the gatingConfigMapKeys acts upon: waiting for or
+// opting in to regions
+func gatedByConfigMap(account *awsv1alpha1.Account) bool {
+	return account.ReadyForRegionEnablement() || account.IsEnablingOptInRegions()
+}
+
+// accountsForConfigMap reconciles the accounts gated by the operator ConfigMap when their gating keys change, so
+// flipping a feature flag takes effect without waiting for the accounts to be reconciled for another reason
+func (r *AccountReconciler) accountsForConfigMap(obj client.Object) []reconcile.Request {
+	accounts := &awsv1alpha1.AccountList{}
+	if err := r.List(context.TODO(), accounts, client.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		log.Error(err, "failed to list Accounts", "ConfigMap", obj.GetName())
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for i := range accounts.Items {
+		if gatedByConfigMap(&accounts.Items[i]) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&accounts.Items[i])})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,176 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// accountCreationMaxInFlightKey is the operator ConfigMap key of the maximum number of AWS accounts created at once
+	accountCreationMaxInFlightKey = "account-creation.max-in-flight"
+	// accountCreationIntervalKey is the operator ConfigMap key of the minimum duration between two CreateAccount calls
+	accountCreationIntervalKey = "account-creation.interval"
+
+	defaultAccountCreationMaxInFlight = 1
+
+	// accountCreationQueueRequeue is how long queued accounts wait before asking the scheduler for a slot again
+	accountCreationQueueRequeue = 10 * time.Second
+	// accountCreationQueueExpiry drops queued accounts that stopped asking for a slot, e.g. deleted ones, so they don't
+	// hold the queue
+	accountCreationQueueExpiry = 3 * accountCreationQueueRequeue
+	// accountCreationThrottleBackoff pauses the account creation after Organizations throttled a CreateAccount call
+	accountCreationThrottleBackoff = time.Minute
+)
+
+// accountCreationScheduler paces the CreateAccount calls of all the reconciles of the operator
+var accountCreationScheduler = newCreationScheduler()
+
+// creationSchedulerSettings are the limits of the account creation, read from the operator ConfigMap
+type creationSchedulerSettings struct {
+	maxInFlight int
+	interval    time.Duration
+}
+
+// accountCreationSettings reads the account creation limits from the operator ConfigMap. Invalid values are reported
+// and replaced by their default.
+func accountCreationSettings(configMap *corev1.ConfigMap) (creationSchedulerSettings, error) {
+	settings := creationSchedulerSettings{maxInFlight: defaultAccountCreationMaxInFlight}
+	var errs []error
+
+	if value, ok := configMap.Data[accountCreationMaxInFlightKey]; ok {
+		maxInFlight, err := strconv.Atoi(value)
+		if err != nil || maxInFlight < 1 {
+			errs = append(errs, fmt.Errorf("invalid %s %q: must be a positive integer", accountCreationMaxInFlightKey, value))
+		} else {
+			settings.maxInFlight = maxInFlight
+		}
+	}
+	if value, ok := configMap.Data[accountCreationIntervalKey]; ok {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval < 0 {
+			errs = append(errs, fmt.Errorf("invalid %s %q: must be a positive duration", accountCreationIntervalKey, value))
+		} else {
+			settings.interval = interval
+		}
+	}
+	return settings, errors.Join(errs...)
+}
+
+// queuedCreation is an account waiting for a slot to create its AWS account
+type queuedCreation struct {
+	since    time.Time
+	lastSeen time.Time
+}
+
+// creationScheduler hands out the slots to create AWS accounts in the order accounts asked for them, allowing at most
+// maxInFlight creations at once and starting them at least interval apart
+type creationScheduler struct {
+	mu        sync.Mutex
+	inFlight  map[string]bool
+	queue     map[string]*queuedCreation
+	notBefore time.Time
+}
+
+func newCreationScheduler() *creationScheduler {
+	return &creationScheduler{
+		inFlight: map[string]bool{},
+		queue:    map[string]*queuedCreation{},
+	}
+}
+
+// tryStart returns whether the account can create its AWS account now. Otherwise the account is queued and should
+// ask again after the returned duration.
+func (s *creationScheduler) tryStart(name string, settings creationSchedulerSettings, now time.Time) (bool, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for queued, creation := range s.queue {
+		if now.Sub(creation.lastSeen) > accountCreationQueueExpiry {
+			delete(s.queue, queued)
+		}
+	}
+	creation, ok := s.queue[name]
+	if !ok {
+		creation = &queuedCreation{since: now}
+		s.queue[name] = creation
+	}
+	creation.lastSeen = now
+
+	if s.position(name) >= settings.maxInFlight-len(s.inFlight) {
+		return false, accountCreationQueueRequeue
+	}
+	if wait := s.notBefore.Sub(now); wait > 0 {
+		return false, wait
+	}
+
+	delete(s.queue, name)
+	s.inFlight[name] = true
+	s.notBefore = now.Add(settings.interval)
+	return true, 0
+}
+
+// position returns the number of accounts queued before the account
+func (s *creationScheduler) position(name string) int {
+	names := make([]string, 0, len(s.queue))
+	for queued := range s.queue {
+		names = append(names, queued)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if s.queue[names[i]].since.Equal(s.queue[names[j]].since) {
+			return names[i] < names[j]
+		}
+		return s.queue[names[i]].since.Before(s.queue[names[j]].since)
+	})
+	for i, queued := range names {
+		if queued == name {
+			return i
+		}
+	}
+	return len(names)
+}
+
+// done frees the slot of the account once its CreateAccount call returned. Creations are paused for a while when
+// Organizations throttled the call.
+func (s *creationScheduler) done(name string, err error, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.inFlight, name)
+	if errors.Is(err, awsv1alpha1.ErrAwsTooManyRequests) && s.notBefore.Before(now.Add(accountCreationThrottleBackoff)) {
+		s.notBefore = now.Add(accountCreationThrottleBackoff)
+	}
+}
+
+// startAccountCreation asks the creation scheduler for a slot to create the AWS account of the account. Accounts that
+// don't get one are queued in the PendingCreation state until they do.
+func (r *AccountReconciler) startAccountCreation(reqLogger logr.Logger, account *awsv1alpha1.Account, configMap *corev1.ConfigMap, now time.Time) (bool, reconcile.Result, error) {
+	settings, err := accountCreationSettings(configMap)
+	if err != nil {
+		reqLogger.Error(err, "invalid account creation settings, using the defaults")
+	}
+
+	started, retryAfter := accountCreationScheduler.tryStart(account.Name, settings, now)
+	if started {
+		return true, reconcile.Result{}, nil
+	}
+
+	reqLogger.Info("Account creation queued", "retryAfter", retryAfter)
+	if !account.IsPendingCreation() {
+		utils.SetAccountStatus(account, "Waiting for a slot to create the AWS account", awsv1alpha1.AccountPendingCreation, AccountPendingCreation)
+		if err := r.statusUpdate(account); err != nil {
+			return false, reconcile.Result{}, err
+		}
+	}
+	return false, reconcile.Result{RequeueAfter: retryAfter}, nil
+}
@@ -0,0 +1,135 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Account creation scheduler", func() {
+	var (
+		scheduler *creationScheduler
+		settings  creationSchedulerSettings
+		now       = time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		scheduler = newCreationScheduler()
+		settings = creationSchedulerSettings{maxInFlight: 1}
+	})
+
+	It("limits the creations in flight", func() {
+		started, _ := scheduler.tryStart("first", settings, now)
+		Expect(started).To(BeTrue())
+
+		started, retryAfter := scheduler.tryStart("second", settings, now)
+		Expect(started).To(BeFalse())
+		Expect(retryAfter).To(Equal(accountCreationQueueRequeue))
+
+		scheduler.done("first", nil, now)
+		started, _ = scheduler.tryStart("second", settings, now)
+		Expect(started).To(BeTrue())
+	})
+
+	It("starts the queued accounts in order", func() {
+		started, _ := scheduler.tryStart("first", settings, now)
+		Expect(started).To(BeTrue())
+		started, _ = scheduler.tryStart("second", settings, now.Add(time.Second))
+		Expect(started).To(BeFalse())
+		started, _ = scheduler.tryStart("third", settings, now.Add(2*time.Second))
+		Expect(started).To(BeFalse())
+
+		scheduler.done("first", nil, now.Add(3*time.Second))
+		started, _ = scheduler.tryStart("third", settings, now.Add(3*time.Second))
+		Expect(started).To(BeFalse())
+		started, _ = scheduler.tryStart("second", settings, now.Add(4*time.Second))
+		Expect(started).To(BeTrue())
+	})
+
+	It("drops queued accounts that stopped asking", func() {
+		started, _ := scheduler.tryStart("first", settings, now)
+		Expect(started).To(BeTrue())
+		scheduler.tryStart("deleted", settings, now)
+		scheduler.tryStart("second", settings, now.Add(time.Second))
+		scheduler.done("first", nil, now)
+
+		started, _ = scheduler.tryStart("second", settings, now.Add(accountCreationQueueExpiry+time.Minute))
+		Expect(started).To(BeTrue())
+	})
+
+	It("paces the creations", func() {
+		settings = creationSchedulerSettings{maxInFlight: 2, interval: 30 * time.Second}
+		started, _ := scheduler.tryStart("first", settings, now)
+		Expect(started).To(BeTrue())
+
+		started, retryAfter := scheduler.tryStart("second", settings, now.Add(10*time.Second))
+		Expect(started).To(BeFalse())
+		Expect(retryAfter).To(Equal(20 * time.Second))
+
+		started, _ = scheduler.tryStart("second", settings, now.Add(30*time.Second))
+		Expect(started).To(BeTrue())
+	})
+
+	It("backs off when Organizations throttles", func() {
+		started, _ := scheduler.tryStart("first", settings, now)
+		Expect(started).To(BeTrue())
+		scheduler.done("first", awsv1alpha1.ErrAwsTooManyRequests, now)
+
+		started, retryAfter := scheduler.tryStart("first", settings, now)
+		Expect(started).To(BeFalse())
+		Expect(retryAfter).To(Equal(accountCreationThrottleBackoff))
+	})
+
+	It("reads its settings from the operator ConfigMap", func() {
+		configMap := &corev1.ConfigMap{Data: map[string]string{
+			accountCreationMaxInFlightKey: "3",
+			accountCreationIntervalKey:    "5s",
+		}}
+		settings, err := accountCreationSettings(configMap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings).To(Equal(creationSchedulerSettings{maxInFlight: 3, interval: 5 * time.Second}))
+
+		configMap.Data[accountCreationMaxInFlightKey] = "0"
+		settings, err = accountCreationSettings(configMap)
+		Expect(err).To(HaveOccurred())
+		Expect(settings.maxInFlight).To(Equal(defaultAccountCreationMaxInFlight))
+	})
+
+	It("queues accounts without a slot in the PendingCreation state", func() {
+		account := &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+		}
+		r := &AccountReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), account)).To(Succeed())
+
+		defer func(scheduler *creationScheduler) {
+			accountCreationScheduler = scheduler
+		}(accountCreationScheduler)
+		accountCreationScheduler = newCreationScheduler()
+		started, _ := accountCreationScheduler.tryStart("osd-creds-mgmt-other", settings, time.Now())
+		Expect(started).To(BeTrue())
+
+		started, result, err := r.startAccountCreation(testutils.NewTestLogger().Logger(), account, &corev1.ConfigMap{}, time.Now())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(started).To(BeFalse())
+		Expect(result.RequeueAfter).To(Equal(accountCreationQueueRequeue))
+
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		Expect(updated.IsPendingCreation()).To(BeTrue())
+		Expect(updated.IsProgressing()).To(BeTrue())
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,12 +33,61 @@ const EXECUTABLEBY = "all"
 const T3INSTANCETYPE = "t3.micro"
 const T2INSTANCETYPE = "t2.micro"
 
+// regionInitializationMaxConcurrencyKey is the operator ConfigMap key of the maximum number of regions of an account
+// initialized at once. All the regions are initialized at once when it's not set.
+const regionInitializationMaxConcurrencyKey = "region-initialization.max-concurrency"
+
+// regionInitializationMaxConcurrency returns the maximum number of regions of an account initialized at once, 0 for
+// no limit. An invalid value is reported and ignored.
+func regionInitializationMaxConcurrency(data map[string]string) (int, error) {
+	value, ok := data[regionInitializationMaxConcurrencyKey]
+	if !ok {
+		return 0, nil
+	}
+	maxConcurrency, err := strconv.Atoi(value)
+	if err != nil || maxConcurrency < 1 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive integer", regionInitializationMaxConcurrencyKey, value)
+	}
+	return maxConcurrency, nil
+}
+
 // InitializeSupportedRegions concurrently calls InitializeRegion to create instances in all supported regions
 // This should ensure we don't see any AWS API "PendingVerification" errors when launching instances
 // NOTE: GovCloud regions skip initialization entirely as they are always BYOVPC.
 // NOTE: This function does not have any returns. In particular, error conditions from the
 // goroutines are logged, but do not result in a failure up the stack.
 func (r *AccountReconciler) InitializeSupportedRegions(reqLogger logr.Logger, account *awsv1alpha1.Account, regions []awsv1alpha1.AwsRegions, creds *sts.AssumeRoleOutput, amiOwner string) {
+	regionInitErrors := r.initializeRegionsOutsideOutages(reqLogger, account, regions, creds, amiOwner)
+
+	var regionInitFailedRegion []string
+	var regionInitFailedMessages []string
+	for _, regionInitError := range regionInitErrors {
+		regionInitFailedRegion = append(regionInitFailedRegion, regionInitError.Region)
+		regionInitFailedMessages = append(regionInitFailedMessages, regionInitError.ErrorMsg)
+	}
+	// If an account is BYOC or CCS and region initialization fails for the region expected, we want to fail the account else output success log
+	if len(regionInitErrors) > 0 && len(regions) == 1 {
+		controllerutils.SetAccountStatus(
+			account,
+			fmt.Sprintf("Account %s failed to initialize expected region %v: %s", account.Name, regionInitFailedRegion, strings.Join(regionInitFailedMessages, "; ")),
+			awsv1alpha1.AccountInitializingRegions,
+			AccountFailed,
+		)
+		account.Status.FailureReason = awsv1alpha1.FailureReasonRegionInitFailed
+	} else {
+		reqLogger.Info("Successfully completed initializing desired regions")
+	}
+}
+
+// initializeRegionsOutsideOutages initializes the regions in parallel, skipping the regions whose circuit is open
+// because of an outage. It records the outcome of every region in the account status and returns the errors of the
+// regions that failed.
+func (r *AccountReconciler) initializeRegionsOutsideOutages(reqLogger logr.Logger, account *awsv1alpha1.Account, regions []awsv1alpha1.AwsRegions, creds *sts.AssumeRoleOutput, amiOwner string) []regionInitializationError {
+	regions, skipped := regionsOutsideOutages(regions, time.Now())
+	for region, retryAfter := range skipped {
+		reqLogger.Info("skipping region initialization during an outage of the region", "region", region, "retryAfter", retryAfter)
+	}
+
 	// Create some channels to listen and error on when creating EC2 instances in all supported regions
 	ec2Notifications, ec2Errors := make(chan string), make(chan regionInitializationError)
 
@@ -47,8 +97,9 @@ func (r *AccountReconciler) InitializeSupportedRegions(reqLogger logr.Logger, ac
 
 	// We should not bomb out just because we can't retrieve the vCPU value
 	// and we'll just continue with a "0"
-	// Errors are logged already in getDesiredVCPUValue
-	vCPUQuota, _ := r.getDesiredServiceQuotaValue(reqLogger, "vcpu")
+	// Errors are logged already in getDesiredVCPUValue. Region initialization outlives the reconcile that started it,
+	// so it isn't bound to its context.
+	vCPUQuota, _ := r.getDesiredServiceQuotaValue(context.Background(), reqLogger, "vcpu")
 	reqLogger.Info("retrieved desired vCPU quota value from configMap", "quota.vcpu", vCPUQuota)
 
 	var kmsKeyId string
@@ -63,39 +114,47 @@ func (r *AccountReconciler) InitializeSupportedRegions(reqLogger logr.Logger, ac
 	managedTags := r.getManagedTags(reqLogger)
 	customerTags := r.getCustomTags(reqLogger, account)
 
+	// Bound the regions initialized at once when the operator ConfigMap limits them
+	var slots chan struct{}
+	configMap, err := r.operatorConfig().ConfigMap(context.Background())
+	if err == nil {
+		var maxConcurrency int
+		maxConcurrency, err = regionInitializationMaxConcurrency(configMap.Data)
+		if maxConcurrency > 0 {
+			slots = make(chan struct{}, maxConcurrency)
+		}
+	}
+	if err != nil {
+		reqLogger.Info("initializing all regions at once", "reason", err.Error())
+	}
+
 	// Create go routines to initialize regions in parallel
 	for _, region := range regions {
 		go func() {
+			if slots != nil {
+				slots <- struct{}{}
+				defer func() { <-slots }()
+			}
 			// Errors are returned on the ec2Errors channel
 			_ = r.InitializeRegion(reqLogger, account, region.Name, amiOwner, vCPUQuota, ec2Notifications, ec2Errors, creds, managedTags, customerTags, kmsKeyId)
 		}()
 	}
 
-	var regionInitFailedRegion []string
-	var regionInitFailed bool
+	var regionInitErrors []regionInitializationError
 	// Wait for all go routines to send a message or error to notify that the region initialization has finished
 	for i := 0; i < len(regions); i++ {
 		select {
 		case msg := <-ec2Notifications:
 			reqLogger.Info(msg)
 		case errMsg := <-ec2Errors:
-			regionInitFailed = true
 			// If we fail to initialize the desired region we want to fail the account
 			reqLogger.Error(errors.New(errMsg.ErrorMsg), errMsg.ErrorMsg)
-			regionInitFailedRegion = append(regionInitFailedRegion, errMsg.Region)
+			regionInitErrors = append(regionInitErrors, errMsg)
 		}
 	}
-	// If an account is BYOC or CCS and region initialization fails for the region expected, we want to fail the account else output success log
-	if regionInitFailed && len(regions) == 1 {
-		controllerutils.SetAccountStatus(
-			account,
-			fmt.Sprintf("Account %s failed to initialize expected region %v", account.Name, regionInitFailedRegion),
-			awsv1alpha1.AccountInitializingRegions,
-			AccountFailed,
-		)
-	} else {
-		reqLogger.Info("Successfully completed initializing desired regions")
-	}
+
+	recordRegionOutcomes(account, regions, regionInitErrors, skipped, time.Now())
+	return regionInitErrors
 }
 
 // InitializeRegion initializes AWS regions for non-GovCloud environments by creating and terminating a test EC2 instance
@@ -158,14 +217,14 @@ func (r *AccountReconciler) InitializeRegion(
 	if err != nil {
 		determineTypesErr := fmt.Sprintf("Unable to determine available instance types in region: %s", region)
 		controllerutils.LogAwsError(reqLogger, determineTypesErr, nil, err)
-		ec2Errors <- regionInitializationError{ErrorMsg: determineTypesErr, Region: region}
+		ec2Errors <- regionInitializationError{ErrorMsg: controllerutils.WithAwsRequestID(determineTypesErr, err), Region: region}
 		return err
 	}
 	ami, err := RetrieveAmi(awsClient, amiOwner)
 	if err != nil {
 		retrieveAmiErr := fmt.Sprintf("Unable to find suitable AMI in region: %s", region)
 		controllerutils.LogAwsError(reqLogger, retrieveAmiErr, nil, err)
-		ec2Errors <- regionInitializationError{ErrorMsg: retrieveAmiErr, Region: region}
+		ec2Errors <- regionInitializationError{ErrorMsg: controllerutils.WithAwsRequestID(retrieveAmiErr, err), Region: region}
 		return err
 	}
 	instanceInfo := awsv1alpha1.AmiSpec{
@@ -190,7 +249,7 @@ func (r *AccountReconciler) InitializeRegion(
 		createErr := fmt.Sprintf("Unable to create instance in region: %s", region)
 		controllerutils.LogAwsError(reqLogger, createErr, nil, err)
 		// Notify Error channel that this region has errored and to move on
-		ec2Errors <- regionInitializationError{ErrorMsg: createErr, Region: region}
+		ec2Errors <- regionInitializationError{ErrorMsg: controllerutils.WithAwsRequestID(createErr, err), Region: region}
 		return err
 	}
 
@@ -477,3 +477,24 @@ func TestRetrieveAmi(t *testing.T) {
 		})
 	}
 }
+
+func TestRegionInitializationMaxConcurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    int
+		wantErr bool
+	}{
+		{name: "not set", data: map[string]string{}, want: 0},
+		{name: "set", data: map[string]string{regionInitializationMaxConcurrencyKey: "4"}, want: 4},
+		{name: "zero", data: map[string]string{regionInitializationMaxConcurrencyKey: "0"}, wantErr: true},
+		{name: "not a number", data: map[string]string{regionInitializationMaxConcurrencyKey: "all"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := regionInitializationMaxConcurrency(tt.data)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
 	"github.com/openshift/aws-account-operator/pkg/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -35,3 +36,10 @@ func GenerateAccountCRName(uuid string) string {
 	emailID := awsv1alpha1.EmailID
 	return fmt.Sprintf("%s-%s", emailID, uuid)
 }
+
+// SetAWSAccountIdentity sets the name and email of the AWS account created for the Account, so they no longer depend
+// on the name of the Account once it's created
+func SetAWSAccountIdentity(account *awsv1alpha1.Account) {
+	account.Spec.AccountName = account.Name
+	account.Spec.Email = accountprovision.FormatEmail(account.Name)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -68,6 +67,7 @@ func (r *AccountReconciler) CreateSecret(reqLogger logr.Logger, account *awsv1al
 	if createErr != nil {
 		failedToCreateUserSecretMsg := fmt.Sprintf("Failed to create secret %s", secret.Name)
 		utils.SetAccountStatus(account, failedToCreateUserSecretMsg, awsv1alpha1.AccountFailed, "Failed")
+		account.Status.FailureReason = awsv1alpha1.FailureReasonClientError
 		err := r.Client.Status().Update(context.TODO(), account)
 		if err != nil {
 			return err
@@ -106,7 +106,7 @@ func retryIfAwsServiceFailureOrInvalidToken(err error) bool {
 	return false
 }
 
-func listAccessKeys(client awsclient.Client, iamUser *iamtypes.User) (*iam.ListAccessKeysOutput, error) {
+func listAccessKeys(client awsclient.IAMClient, iamUser *iamtypes.User) (*iam.ListAccessKeysOutput, error) {
 	var result *iam.ListAccessKeysOutput
 	var err error
 
@@ -127,7 +127,7 @@ func listAccessKeys(client awsclient.Client, iamUser *iamtypes.User) (*iam.ListA
 	return result, err
 }
 
-func deleteAccessKey(client awsclient.Client, accessKeyID *string, username *string) (*iam.DeleteAccessKeyOutput, error) {
+func deleteAccessKey(client awsclient.IAMClient, accessKeyID *string, username *string) (*iam.DeleteAccessKeyOutput, error) {
 	var result *iam.DeleteAccessKeyOutput
 	var err error
 
@@ -153,7 +153,7 @@ func deleteAccessKey(client awsclient.Client, accessKeyID *string, username *str
 
 // deleteAllAccessKeys deletes all access key pairs for a given user
 // Takes a logger, an AWS client, and the target IAM user's username
-func deleteAllAccessKeys(client awsclient.Client, iamUser *iamtypes.User) error {
+func deleteAllAccessKeys(client awsclient.IAMClient, iamUser *iamtypes.User) error {
 	accessKeyList, err := listAccessKeys(client, iamUser)
 	if err != nil {
 		return err
@@ -337,6 +337,10 @@ func (r *AccountReconciler) BuildIAMUser(reqLogger logr.Logger, awsClient awscli
 	if iamUserExists {
 		// If user exists extract iam.User pointer
 		createdIAMUser = iamUserExistsOutput.User
+		if err := awsclient.EnsureUserOwnershipTags(awsClient, iamUserName, awsclient.AccountIAMOwnership(account)); err != nil {
+			reqLogger.Error(err, fmt.Sprintf("Failed to update the ownership tags of IAM user %s", iamUserName))
+			return nil, err
+		}
 	} else {
 		CreateUserOutput, err := awsclient.CreateIAMUser(reqLogger, awsClient, account, iamUserName, managedTags, customTags)
 		// Err is handled within the function and returns a error message
@@ -450,7 +454,30 @@ func DeleteIAMUsers(reqLogger logr.Logger, awsClient awsclient.Client, accountCR
 	if err != nil {
 		return fmt.Errorf("failed to list aws iam users: %v", err)
 	}
+	return deleteAccountIAMUsers(reqLogger, awsClient, accountCR, users)
+}
+
+// DeleteIAMUsersPage deletes the IAM users of the account CR among a page of at most budget users of the AWS account
+// starting at marker, so the users of large accounts can be swept over several reconciles. It returns the marker of the
+// next page, which is empty once all the users were swept.
+func DeleteIAMUsersPage(reqLogger logr.Logger, awsClient awsclient.Client, accountCR *awsv1alpha1.Account, marker string, budget int32) (string, error) {
+	var start *string
+	if marker != "" {
+		start = aws.String(marker)
+	}
+	users, next, err := awsclient.ListIAMUsersPage(awsClient, start, budget)
+	if err != nil {
+		return marker, fmt.Errorf("failed to list aws iam users: %v", err)
+	}
+	reqLogger.Info("Cleaning up a page of IAM users", "users", len(users), "last", next == nil)
+	if err := deleteAccountIAMUsers(reqLogger, awsClient, accountCR, users); err != nil {
+		return marker, err
+	}
+	return aws.ToString(next), nil
+}
 
+// deleteAccountIAMUsers deletes the users tagged with the name and namespace of the account CR
+func deleteAccountIAMUsers(reqLogger logr.Logger, awsClient awsclient.Client, accountCR *awsv1alpha1.Account, users []iamtypes.User) error {
 	for _, user := range users {
 		clusterNameTag := false
 		clusterNamespaceTag := false
@@ -598,6 +625,7 @@ func (r *AccountReconciler) createIAMUserSecret(reqLogger logr.Logger, account *
 
 	// Create new secret
 	iamUserSecret := CreateSecret(secretName.Name, secretName.Namespace, userSecretData)
+	iamUserSecret.Labels = map[string]string{utils.ManagedLabel: "true"}
 
 	// Set controller as owner of secret
 	if err := controllerutil.SetControllerReference(account, iamUserSecret, r.Scheme); err != nil {
@@ -623,13 +651,39 @@ func (r *AccountReconciler) DoesSecretExist(namespacedName types.NamespacedName)
 	return true, nil
 }
 
-// createIAMUserSecretName returns a lower case concatenated string of the input separated by "-"
+// createIAMUserSecretName returns the name of the secret holding the credentials of the IAM user of the account
 func createIAMUserSecretName(account string) string {
-	suffix := "secret"
-	return strings.ToLower(fmt.Sprintf("%s-%s", account, suffix))
+	return utils.IAMUserSecretName(account)
 }
 
-func (r *AccountReconciler) createManagedOpenShiftSupportRole(reqLogger logr.Logger, setupClient awsclient.Client, client awsclient.Client, policyArn string, instanceID string, tags []iamtypes.Tag) (roleID string, err error) {
+// reportRoleNameCollision sets the RoleNameCollision condition of the account when err is a RoleCollisionError, and
+// resolves it once the role was created
+func (r *AccountReconciler) reportRoleNameCollision(reqLogger logr.Logger, account *awsv1alpha1.Account, err error) {
+	existing := account.GetCondition(awsv1alpha1.AccountRoleNameCollision)
+	status, reason, message := corev1.ConditionFalse, "RoleManaged", "The role is managed by the operator"
+	if awsclient.IsRoleCollision(err) {
+		status, reason, message = corev1.ConditionTrue, "UnmanagedRoleExists", err.Error()
+	} else if err != nil || existing == nil || existing.Status != corev1.ConditionTrue {
+		return
+	}
+	if existing != nil && existing.Status == status && existing.Message == message {
+		return
+	}
+	account.Status.Conditions = utils.SetAccountCondition(
+		account.Status.Conditions,
+		awsv1alpha1.AccountRoleNameCollision,
+		status,
+		reason,
+		message,
+		utils.UpdateConditionIfReasonOrMessageChange,
+		account.Spec.BYOC,
+	)
+	if err := r.statusUpdate(account); err != nil {
+		reqLogger.Error(err, "failed updating the RoleNameCollision condition")
+	}
+}
+
+func (r *AccountReconciler) createManagedOpenShiftSupportRole(reqLogger logr.Logger, setupClient awsclient.Client, client awsclient.Client, policyArn string, instanceID string, tags []iamtypes.Tag, ownership awsclient.IAMOwnership) (roleID string, err error) {
 	reqLogger.Info("Creating ManagedOpenShiftSupportRole")
 
 	getCallerIdentityOutput, err := setupClient.GetCallerIdentity(context.TODO(), &sts.GetCallerIdentityInput{})
@@ -658,6 +712,12 @@ func (r *AccountReconciler) createManagedOpenShiftSupportRole(reqLogger logr.Log
 	// We found the role already exists, we need to ensure the policies attached are as expected.
 	if existingRole.Role != nil {
 		reqLogger.Info(fmt.Sprintf("Found pre-existing role: %s", managedSupRoleWithID))
+		if err := awsclient.VerifyRoleOwnership(client, existingRole.Role); err != nil {
+			return roleID, err
+		}
+		if err := awsclient.EnsureRoleOwnershipTags(client, managedSupRoleWithID, ownership); err != nil {
+			return roleID, err
+		}
 		reqLogger.Info("Verifying role policies are correct")
 		roleID = aws.ToString(existingRole.Role.RoleId)
 		// existingRole is not empty
@@ -687,7 +747,7 @@ func (r *AccountReconciler) createManagedOpenShiftSupportRole(reqLogger logr.Log
 	// Role doesn't exist, create new role and attach desired Policy.
 	if roleID == "" {
 		// Create the base role
-		roleID, err = CreateRole(reqLogger, managedSupRoleWithID, accessArnList, client, tags)
+		roleID, err = CreateRole(reqLogger, managedSupRoleWithID, accessArnList, client, ownership.Apply(tags))
 		if err != nil {
 			return roleID, err
 		}
@@ -114,7 +114,7 @@ func TestListAccessKeys(t *testing.T) {
 
 	mocks := setupDefaultMocks(t, []runtime.Object{})
 
-	mockAWSClient := mock.NewMockClient(mocks.mockCtrl)
+	mockAWSClient := mock.NewMockIAMClient(mocks.mockCtrl)
 	username := "AwesomeUser"
 	user := iamtypes.User{UserName: &username}
 
@@ -136,7 +136,7 @@ func TestListAccessKeys(t *testing.T) {
 	assert.Len(t, returnValue.AccessKeyMetadata, 1)
 	assert.Equal(t, returnValue.AccessKeyMetadata[0].AccessKeyId, expectedAccessKeyID)
 
-	mockAWSClient = mock.NewMockClient(mocks.mockCtrl)
+	mockAWSClient = mock.NewMockIAMClient(mocks.mockCtrl)
 	returnErr := &smithy.GenericAPIError{Code: "AccessDenied", Message: ""}
 
 	// Should retry 5 times
@@ -150,7 +150,7 @@ func TestListAccessKeys(t *testing.T) {
 func TestDeleteAccessKey(t *testing.T) {
 	mocks := setupDefaultMocks(t, []runtime.Object{})
 
-	mockAWSClient := mock.NewMockClient(mocks.mockCtrl)
+	mockAWSClient := mock.NewMockIAMClient(mocks.mockCtrl)
 
 	mockAWSClient.EXPECT().DeleteAccessKey(gomock.Any(), gomock.Any()).Return(
 		&iam.DeleteAccessKeyOutput{},
@@ -164,7 +164,7 @@ func TestDeleteAccessKey(t *testing.T) {
 	assert.Equal(t, deleteAccessKeyOutput, &iam.DeleteAccessKeyOutput{})
 	assert.Nil(t, err)
 
-	mockAWSClient = mock.NewMockClient(mocks.mockCtrl)
+	mockAWSClient = mock.NewMockIAMClient(mocks.mockCtrl)
 	returnErr := &smithy.GenericAPIError{Code: "AccessDenied", Message: ""}
 
 	// Should retry 5 times
@@ -178,7 +178,7 @@ func TestDeleteAccessKey(t *testing.T) {
 func TestDeleteAllAccessKeys(t *testing.T) {
 	mocks := setupDefaultMocks(t, []runtime.Object{})
 
-	mockAWSClient := mock.NewMockClient(mocks.mockCtrl)
+	mockAWSClient := mock.NewMockIAMClient(mocks.mockCtrl)
 	username := "AwesomeUser"
 	user := iamtypes.User{UserName: &username}
 
@@ -440,6 +440,16 @@ func TestBuildIAMUser(t *testing.T) {
 			Arn:      aws.String("arn:aws:iam::1234567890:user/AwesomeUser"),
 		},
 	}, nil)
+	// The existing user predates the ownership tags, which are added on resync
+	account := newTestAccountBuilder().acct
+	account.Name = username
+	mockAWSClient.EXPECT().ListUserTags(gomock.Any(), &iam.ListUserTagsInput{
+		UserName: aws.String(username),
+	}).Return(&iam.ListUserTagsOutput{}, nil)
+	mockAWSClient.EXPECT().TagUser(gomock.Any(), &iam.TagUserInput{
+		UserName: aws.String(username),
+		Tags:     awsclient.AccountIAMOwnership(&account).Tags(),
+	}).Return(&iam.TagUserOutput{}, nil)
 	mockAWSClient.EXPECT().AttachUserPolicy(gomock.Any(), &iam.AttachUserPolicyInput{
 		UserName:  &username,
 		PolicyArn: aws.String(strings.Join([]string{standardAdminAccessArnPrefix, adminAccessArnSuffix}, "")),
@@ -451,8 +461,6 @@ func TestBuildIAMUser(t *testing.T) {
 	}
 
 	nullLogger := testutils.NewTestLogger().Logger()
-	account := newTestAccountBuilder().acct
-	account.Name = username
 	iamUserSecretName, err := r.BuildIAMUser(nullLogger, mockAWSClient, &account, username, namespace)
 	assert.Equal(t, *iamUserSecretName, expectedSecretName)
 	assert.Nil(t, err)
@@ -528,7 +536,7 @@ func TestDeleteIAMUsers(t *testing.T) {
 
 	// Need to Monkey Patch awsclient.ListIAMUsers to return a list of users we define.
 	old := listIAMUsers
-	listIAMUsers = func(reqLogger logr.Logger, client awsclient.Client) ([]iamtypes.User, error) {
+	listIAMUsers = func(reqLogger logr.Logger, client awsclient.IAMClient) ([]iamtypes.User, error) {
 		return []iamtypes.User{{UserName: username}}, nil
 	}
 
@@ -872,3 +880,44 @@ func TestCreateIAMUserSecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteIAMUsersPage(t *testing.T) {
+	mocks := setupDefaultMocks(t, []runtime.Object{})
+	mockAWSClient := mock.NewMockClient(mocks.mockCtrl)
+	accountCR := &v1alpha1.Account{}
+	accountCR.Name = "osd-creds-mgmt-aaaaaa"
+	accountCR.Namespace = v1alpha1.AccountCrNamespace
+	nullLogger := testutils.NewTestLogger().Logger()
+
+	// A page of users not created for the account isn't deleted, and the sweep resumes after it
+	mockAWSClient.EXPECT().ListUsers(gomock.Any(), &iam.ListUsersInput{Marker: aws.String("page-2"), MaxItems: aws.Int32(1)}).Return(
+		&iam.ListUsersOutput{
+			Users:       []iamtypes.User{{UserName: aws.String("someone")}},
+			IsTruncated: true,
+			Marker:      aws.String("page-3"),
+		},
+		nil,
+	)
+	mockAWSClient.EXPECT().GetUser(gomock.Any(), &iam.GetUserInput{UserName: aws.String("someone")}).Return(
+		&iam.GetUserOutput{User: &iamtypes.User{UserName: aws.String("someone")}},
+		nil,
+	)
+	marker, err := DeleteIAMUsersPage(nullLogger, mockAWSClient, accountCR, "page-2", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "page-3", marker)
+
+	// The last page ends the sweep
+	mockAWSClient.EXPECT().ListUsers(gomock.Any(), &iam.ListUsersInput{Marker: aws.String("page-3"), MaxItems: aws.Int32(1)}).Return(
+		&iam.ListUsersOutput{},
+		nil,
+	)
+	marker, err = DeleteIAMUsersPage(nullLogger, mockAWSClient, accountCR, "page-3", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "", marker)
+
+	// The sweep resumes at the same page after an error
+	mockAWSClient.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(nil, errors.New("throttled"))
+	marker, err = DeleteIAMUsersPage(nullLogger, mockAWSClient, accountCR, "page-3", 1)
+	assert.Error(t, err)
+	assert.Equal(t, "page-3", marker)
+}
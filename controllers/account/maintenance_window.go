@@ -0,0 +1,70 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// maintenanceWindowClosedReason is the reason of the MaintenancePending condition while an operation waits
+	maintenanceWindowClosedReason = "OutsideMaintenanceWindow"
+	// maintenanceWindowOpenReason is the reason of the MaintenancePending condition once the operation may run
+	maintenanceWindowOpenReason = "InsideMaintenanceWindow"
+)
+
+// deferToMaintenanceWindow reports whether a disruptive operation on the account has to wait for the maintenance
+// window of its pool. While it waits, the account has a MaintenancePending condition and the returned result requeues
+// it when the window opens. Accounts of pools without a maintenance window are never deferred.
+func (r *AccountReconciler) deferToMaintenanceWindow(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account, operation string, now time.Time) (bool, reconcile.Result, error) {
+	window, err := r.operatorConfig().MaintenanceWindow(ctx, account.Spec.AccountPool)
+	if err != nil {
+		reqLogger.Error(err, "failed getting the maintenance window of the account pool", "accountPool", account.Spec.AccountPool)
+		return true, reconcile.Result{}, err
+	}
+
+	open, next := true, time.Time{}
+	if window != nil {
+		open, next, err = window.Open(now)
+		if err != nil {
+			return true, reconcile.Result{}, err
+		}
+	}
+
+	if open {
+		condition := utils.FindAccountCondition(account.Status.Conditions, awsv1alpha1.AccountMaintenancePending)
+		if condition == nil || condition.Status != corev1.ConditionTrue {
+			return false, reconcile.Result{}, nil
+		}
+		account.Status.Conditions = utils.SetAccountCondition(
+			account.Status.Conditions,
+			awsv1alpha1.AccountMaintenancePending,
+			corev1.ConditionFalse,
+			maintenanceWindowOpenReason,
+			fmt.Sprintf("The maintenance window is open, running %s", operation),
+			utils.UpdateConditionIfReasonOrMessageChange,
+			account.Spec.BYOC,
+		)
+		return false, reconcile.Result{}, r.statusUpdate(account)
+	}
+
+	message := fmt.Sprintf("Deferring %s to the maintenance window opening at %s", operation, next.Format(time.RFC3339))
+	reqLogger.Info(message)
+	account.Status.Conditions = utils.SetAccountCondition(
+		account.Status.Conditions,
+		awsv1alpha1.AccountMaintenancePending,
+		corev1.ConditionTrue,
+		maintenanceWindowClosedReason,
+		message,
+		utils.UpdateConditionIfReasonOrMessageChange,
+		account.Spec.BYOC,
+	)
+	return true, reconcile.Result{RequeueAfter: next.Sub(now)}, r.statusUpdate(account)
+}
@@ -0,0 +1,90 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Maintenance window", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		r          *AccountReconciler
+		account    *awsv1alpha1.Account
+		// A Saturday
+		saturday = time.Date(2026, time.October, 17, 3, 30, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+			Spec:       awsv1alpha1.AccountSpec{AccountPool: "production"},
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				"accountpool": `production:
+  maintenanceWindow:
+    schedule: "0 2 * * 6"
+    duration: 4h
+staging: {}
+`,
+			},
+		}
+		r = &AccountReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account, configMap).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	getCondition := func() *awsv1alpha1.AccountCondition {
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		account = updated
+		return utils.FindAccountCondition(updated.Status.Conditions, awsv1alpha1.AccountMaintenancePending)
+	}
+
+	It("runs operations inside the window", func() {
+		deferred, result, err := r.deferToMaintenanceWindow(context.TODO(), nullLogger, account, "the IAM user recreation", saturday)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deferred).To(BeFalse())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(getCondition()).To(BeNil())
+	})
+
+	It("defers operations until the window opens", func() {
+		deferred, result, err := r.deferToMaintenanceWindow(context.TODO(), nullLogger, account, "the IAM user recreation", saturday.Add(3*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deferred).To(BeTrue())
+		Expect(result.RequeueAfter).To(Equal(7*24*time.Hour - 4*time.Hour - 30*time.Minute))
+
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(maintenanceWindowClosedReason))
+		Expect(condition.Message).To(ContainSubstring("2026-10-24T02:00:00Z"))
+
+		By("clearing the condition once the window opens")
+		deferred, _, err = r.deferToMaintenanceWindow(context.TODO(), nullLogger, account, "the IAM user recreation", saturday.AddDate(0, 0, 7))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deferred).To(BeFalse())
+		Expect(getCondition().Status).To(Equal(corev1.ConditionFalse))
+	})
+
+	It("never defers operations of pools without a window", func() {
+		account.Spec.AccountPool = "staging"
+		deferred, _, err := r.deferToMaintenanceWindow(context.TODO(), nullLogger, account, "the IAM user recreation", saturday.Add(3*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deferred).To(BeFalse())
+	})
+})
@@ -0,0 +1,206 @@
+package account
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// ownershipTagsBatchInterval paces the ownership tag batch, so a change of the tags of the whole fleet, e.g. a
+	// rename of the shard, stays within the Organizations API rate
+	ownershipTagsBatchInterval = 500 * time.Millisecond
+	// ownershipTagsCacheTTL is how long the tags of an AWS account listed or written by the batch are trusted. It's
+	// shorter than ownershipTagsVerifyInterval, so a verification always reads the tags of the account.
+	ownershipTagsCacheTTL = time.Hour
+	// ownershipTagsProgressEvery is how many accounts the batch syncs between two progress logs
+	ownershipTagsProgressEvery = 50
+
+	// The results of syncing the ownership tags of an account
+	ownershipTagsApplied   = "applied"
+	ownershipTagsUnchanged = "unchanged"
+	ownershipTagsFailed    = "failed"
+)
+
+// ownershipTagsClient is the part of the AWS Organizations API the ownership tags are synced with. awsclient.Client
+// implements it.
+type ownershipTagsClient interface {
+	ListTagsForResource(context.Context, *organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error)
+	TagResource(context.Context, *organizations.TagResourceInput) (*organizations.TagResourceOutput, error)
+	UntagResource(context.Context, *organizations.UntagResourceInput) (*organizations.UntagResourceOutput, error)
+}
+
+// ownershipTagsUpdate is the ownership tags an AWS account should have
+type ownershipTagsUpdate struct {
+	accountID string
+	desired   map[string]string
+	client    ownershipTagsClient
+	// done records on the Account that its tags were verified to be desired
+	done func(ctx context.Context, verified time.Time) error
+}
+
+// cachedTags are the tags of an AWS account as last listed or written
+type cachedTags struct {
+	tags map[string]string
+	at   time.Time
+}
+
+// ownershipTagsBatch syncs the ownership tags of the AWS accounts one at a time, every interval, instead of every
+// reconcile of the accounts tagging at once. An account is queued once, submitting it again replaces its desired
+// tags. The tags of the accounts are cached for ownershipTagsCacheTTL, so accounts whose tags already match aren't
+// listed again nor written. Accounts failing to be synced are dropped, the next reconcile of their Account submits
+// them again.
+type ownershipTagsBatch struct {
+	interval time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	pending map[string]ownershipTagsUpdate
+	queue   []string
+	cache   map[string]cachedTags
+	// synced counts the results of the accounts synced since the queue was last empty
+	synced map[string]int
+}
+
+func newOwnershipTagsBatch(interval time.Duration) *ownershipTagsBatch {
+	return &ownershipTagsBatch{
+		interval: interval,
+		now:      time.Now,
+		pending:  map[string]ownershipTagsUpdate{},
+		cache:    map[string]cachedTags{},
+		synced:   map[string]int{},
+	}
+}
+
+// submit queues the update of an account, replacing the one pending for it
+func (b *ownershipTagsBatch) submit(update ownershipTagsUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.pending[update.accountID]; !ok {
+		b.queue = append(b.queue, update.accountID)
+	}
+	b.pending[update.accountID] = update
+	if localmetrics.Collector != nil {
+		localmetrics.Collector.SetOwnershipTagsBatchPending(len(b.queue))
+	}
+}
+
+// Start syncs the queued accounts until ctx is done. It makes the batch a manager.Runnable.
+func (b *ownershipTagsBatch) Start(ctx context.Context) error {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			b.syncNext(ctx)
+		}
+	}
+}
+
+// syncNext syncs the first queued account, and returns false when the queue is empty
+func (b *ownershipTagsBatch) syncNext(ctx context.Context) bool {
+	b.mu.Lock()
+	if len(b.queue) == 0 {
+		b.mu.Unlock()
+		return false
+	}
+	accountID := b.queue[0]
+	b.queue = b.queue[1:]
+	update := b.pending[accountID]
+	delete(b.pending, accountID)
+	remaining := len(b.queue)
+	b.mu.Unlock()
+
+	result, err := b.sync(ctx, update)
+	if err != nil {
+		log.Error(err, "failed syncing the ownership tags", "awsAccountID", accountID, "awsRequestID", utils.AwsRequestID(err))
+	}
+	if localmetrics.Collector != nil {
+		localmetrics.Collector.SetOwnershipTagsBatchPending(remaining)
+		localmetrics.Collector.AddOwnershipTagsBatchUpdate(result)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.synced[result]++
+	total := b.synced[ownershipTagsApplied] + b.synced[ownershipTagsUnchanged] + b.synced[ownershipTagsFailed]
+	if len(b.queue) == 0 || total%ownershipTagsProgressEvery == 0 {
+		log.Info("ownership tag batch progress", "pending", len(b.queue), ownershipTagsApplied, b.synced[ownershipTagsApplied],
+			ownershipTagsUnchanged, b.synced[ownershipTagsUnchanged], ownershipTagsFailed, b.synced[ownershipTagsFailed])
+	}
+	if len(b.queue) == 0 {
+		b.synced = map[string]int{}
+	}
+	return true
+}
+
+// sync brings the ownership tags of the account of the update in line, and returns the result
+func (b *ownershipTagsBatch) sync(ctx context.Context, update ownershipTagsUpdate) (string, error) {
+	now := b.now()
+	current, ok := b.cached(update.accountID, now)
+	if !ok {
+		var err error
+		current, err = listOwnershipTags(ctx, update.client, update.accountID)
+		if err != nil {
+			return ownershipTagsFailed, err
+		}
+		b.store(update.accountID, current, now)
+	}
+
+	toSet, toRemove := ownershipTagsDiff(current, update.desired)
+	result := ownershipTagsUnchanged
+	if len(toSet) > 0 || len(toRemove) > 0 {
+		if err := writeOwnershipTags(ctx, update.client, update.accountID, toSet, toRemove); err != nil {
+			b.invalidate(update.accountID)
+			return ownershipTagsFailed, err
+		}
+		written := map[string]string{}
+		for key, value := range current {
+			written[key] = value
+		}
+		for _, tag := range toSet {
+			written[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		for _, key := range toRemove {
+			delete(written, key)
+		}
+		b.store(update.accountID, written, now)
+		result = ownershipTagsApplied
+	}
+
+	if err := update.done(ctx, now); err != nil {
+		return ownershipTagsFailed, err
+	}
+	return result, nil
+}
+
+// cached returns the tags of the account cached less than ownershipTagsCacheTTL ago
+func (b *ownershipTagsBatch) cached(accountID string, now time.Time) (map[string]string, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	cached, ok := b.cache[accountID]
+	if !ok || now.Sub(cached.at) >= ownershipTagsCacheTTL {
+		return nil, false
+	}
+	return cached.tags, true
+}
+
+func (b *ownershipTagsBatch) store(accountID string, tags map[string]string, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cache[accountID] = cachedTags{tags: tags, at: now}
+}
+
+func (b *ownershipTagsBatch) invalidate(accountID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.cache, accountID)
+}
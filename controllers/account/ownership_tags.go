@@ -0,0 +1,179 @@
+package account
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// ownershipTagsFeatureFlag enables writing the ownership tags to the AWS accounts
+	ownershipTagsFeatureFlag = "feature.ownership_tags"
+
+	// OwnershipTagsAnnotation holds a hash of the ownership tags last written to the AWS account
+	OwnershipTagsAnnotation = "aws.managed.openshift.io/ownership-tags"
+	// OwnershipTagsVerifiedAnnotation holds the time the ownership tags of the AWS account were last compared to the
+	// Account
+	OwnershipTagsVerifiedAnnotation = "aws.managed.openshift.io/ownership-tags-verified"
+
+	// ownershipTagsVerifyInterval is how often the ownership tags are compared to the AWS account when the Account
+	// didn't change
+	ownershipTagsVerifyInterval = 12 * time.Hour
+)
+
+// syncOwnershipTags writes the ownership tags of the account whenever they change, e.g. on a state transition or when
+// it's claimed, and compares them to the tags of the AWS account every ownershipTagsVerifyInterval to repair drift.
+// Ownership tags the account doesn't have anymore, like the claim once it's released, are removed. Accounts outside
+// the organization aren't tagged. Once the controller runs, the tags are synced by the ownership tag batch rather than
+// in the reconcile, so changes to the tags of the whole fleet are paced.
+func (r *AccountReconciler) syncOwnershipTags(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client, now time.Time) error {
+	if currentAcctInstance.IsBYOC() || currentAcctInstance.IsSTS() || !currentAcctInstance.HasAwsAccountID() || r.shardName == "" {
+		return nil
+	}
+
+	desired := accountprovision.OwnershipTags(currentAcctInstance, r.shardName)
+	hash := hashOwnershipTags(desired)
+	verified, err := time.Parse(time.RFC3339, currentAcctInstance.Annotations[OwnershipTagsVerifiedAnnotation])
+	if currentAcctInstance.Annotations[OwnershipTagsAnnotation] == hash && err == nil && now.Sub(verified) < ownershipTagsVerifyInterval {
+		return nil
+	}
+
+	if r.ownershipTags != nil {
+		name := currentAcctInstance.Name
+		r.ownershipTags.submit(ownershipTagsUpdate{
+			accountID: currentAcctInstance.Spec.AwsAccountID,
+			desired:   desired,
+			client:    awsSetupClient,
+			done: func(ctx context.Context, verified time.Time) error {
+				return r.recordOwnershipTags(ctx, name, hash, verified)
+			},
+		})
+		return nil
+	}
+
+	accountID := currentAcctInstance.Spec.AwsAccountID
+	current, err := listOwnershipTags(context.TODO(), awsSetupClient, accountID)
+	if err != nil {
+		reqLogger.Error(err, "failed listing the tags of the AWS account", "awsRequestID", utils.AwsRequestID(err))
+		return err
+	}
+	toSet, toRemove := ownershipTagsDiff(current, desired)
+	if err := writeOwnershipTags(context.TODO(), awsSetupClient, accountID, toSet, toRemove); err != nil {
+		reqLogger.Error(err, "failed writing the ownership tags", "awsRequestID", utils.AwsRequestID(err))
+		return err
+	}
+	if len(toSet) > 0 || len(toRemove) > 0 {
+		reqLogger.Info("synced ownership tags", "awsAccountID", accountID, "set", len(toSet), "removed", toRemove)
+	}
+
+	if currentAcctInstance.Annotations == nil {
+		currentAcctInstance.Annotations = map[string]string{}
+	}
+	currentAcctInstance.Annotations[OwnershipTagsAnnotation] = hash
+	currentAcctInstance.Annotations[OwnershipTagsVerifiedAnnotation] = now.UTC().Format(time.RFC3339)
+	return r.Update(context.TODO(), currentAcctInstance)
+}
+
+// recordOwnershipTags records on the Account the hash of the ownership tags synced by the batch and when
+func (r *AccountReconciler) recordOwnershipTags(ctx context.Context, name string, hash string, verified time.Time) error {
+	account := &awsv1alpha1.Account{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: awsv1alpha1.AccountCrNamespace}, account); err != nil {
+		return err
+	}
+	patch := client.MergeFrom(account.DeepCopy())
+	if account.Annotations == nil {
+		account.Annotations = map[string]string{}
+	}
+	account.Annotations[OwnershipTagsAnnotation] = hash
+	account.Annotations[OwnershipTagsVerifiedAnnotation] = verified.UTC().Format(time.RFC3339)
+	return r.Patch(ctx, account, patch)
+}
+
+// listOwnershipTags returns the tags of the AWS account
+func listOwnershipTags(ctx context.Context, awsClient ownershipTagsClient, accountID string) (map[string]string, error) {
+	current := map[string]string{}
+	input := &organizations.ListTagsForResourceInput{ResourceId: aws.String(accountID)}
+	for {
+		output, err := awsClient.ListTagsForResource(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range output.Tags {
+			current[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		if output.NextToken == nil {
+			return current, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// ownershipTagsDiff returns the tags to write to an AWS account with the current tags so it has the desired ownership
+// tags, and the ownership tags to remove from it
+func ownershipTagsDiff(current map[string]string, desired map[string]string) ([]organizationstypes.Tag, []string) {
+	toSet := []organizationstypes.Tag{}
+	for key, value := range desired {
+		if currentValue, ok := current[key]; !ok || currentValue != value {
+			toSet = append(toSet, organizationstypes.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+	}
+	sort.Slice(toSet, func(i, j int) bool { return *toSet[i].Key < *toSet[j].Key })
+	toRemove := []string{}
+	for key := range current {
+		if _, ok := desired[key]; !ok && isOwnershipTag(key) {
+			toRemove = append(toRemove, key)
+		}
+	}
+	sort.Strings(toRemove)
+	return toSet, toRemove
+}
+
+// writeOwnershipTags tags the AWS account with toSet and untags toRemove
+func writeOwnershipTags(ctx context.Context, awsClient ownershipTagsClient, accountID string, toSet []organizationstypes.Tag, toRemove []string) error {
+	if len(toSet) > 0 {
+		_, err := awsClient.TagResource(ctx, &organizations.TagResourceInput{ResourceId: aws.String(accountID), Tags: toSet})
+		if err != nil {
+			return err
+		}
+	}
+	if len(toRemove) > 0 {
+		_, err := awsClient.UntagResource(ctx, &organizations.UntagResourceInput{ResourceId: aws.String(accountID), TagKeys: toRemove})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isOwnershipTag(key string) bool {
+	return strings.HasPrefix(key, accountprovision.OwnershipTagPrefix)
+}
+
+// hashOwnershipTags returns a hash of the tags, independent of their order
+func hashOwnershipTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key + "=" + tags[key] + "\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil)[:8])
+}
@@ -0,0 +1,175 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ownership tags", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		ctrl       *gomock.Controller
+		awsClient  *mock.MockClient
+		r          *AccountReconciler
+		account    *awsv1alpha1.Account
+		now        = time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mock.NewMockClient(ctrl)
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+			Spec: awsv1alpha1.AccountSpec{
+				AwsAccountID:       "123456789012",
+				ClaimLink:          "claim",
+				ClaimLinkNamespace: "claim-ns",
+			},
+			Status: awsv1alpha1.AccountStatus{State: AccountReady},
+		}
+		r = &AccountReconciler{
+			Client:    fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account).Build(),
+			Scheme:    scheme.Scheme,
+			shardName: "hivep01ue1",
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("writes the changed tags and removes the stale ones", func() {
+		awsClient.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{
+			Tags: []organizationstypes.Tag{
+				{Key: aws.String(accountprovision.OwnerTagKey), Value: aws.String("hivep01ue1")},
+				{Key: aws.String(accountprovision.StateTagKey), Value: aws.String("Creating")},
+				{Key: aws.String(accountprovision.PoolTagKey), Value: aws.String("old-pool")},
+				{Key: aws.String("app-code"), Value: aws.String("OSD-002")},
+			},
+		}, nil)
+		awsClient.EXPECT().TagResource(gomock.Any(), &organizations.TagResourceInput{
+			ResourceId: aws.String("123456789012"),
+			Tags: []organizationstypes.Tag{
+				{Key: aws.String(accountprovision.ClaimTagKey), Value: aws.String("claim-ns/claim")},
+				{Key: aws.String(accountprovision.StateTagKey), Value: aws.String(AccountReady)},
+			},
+		}).Return(&organizations.TagResourceOutput{}, nil)
+		awsClient.EXPECT().UntagResource(gomock.Any(), &organizations.UntagResourceInput{
+			ResourceId: aws.String("123456789012"),
+			TagKeys:    []string{accountprovision.PoolTagKey},
+		}).Return(&organizations.UntagResourceOutput{}, nil)
+
+		Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		Expect(updated.Annotations).To(HaveKey(OwnershipTagsAnnotation))
+		Expect(updated.Annotations[OwnershipTagsVerifiedAnnotation]).To(Equal("2026-10-16T12:00:00Z"))
+	})
+
+	It("only verifies unchanged tags once per interval", func() {
+		awsClient.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{}, nil).Times(2)
+		awsClient.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(&organizations.TagResourceOutput{}, nil).Times(2)
+
+		Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+		Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now.Add(time.Hour))).To(Succeed())
+		Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now.Add(ownershipTagsVerifyInterval))).To(Succeed())
+	})
+
+	It("writes the tags again on a state transition", func() {
+		awsClient.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{}, nil).Times(2)
+		awsClient.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(&organizations.TagResourceOutput{}, nil).Times(2)
+
+		Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+		account.Status.State = string(awsv1alpha1.AccountFailed)
+		Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now.Add(time.Minute))).To(Succeed())
+	})
+
+	It("doesn't tag accounts outside the organization", func() {
+		account.Spec.BYOC = true
+		Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+	})
+
+	Context("batch", func() {
+		var batch *ownershipTagsBatch
+
+		BeforeEach(func() {
+			batch = newOwnershipTagsBatch(time.Millisecond)
+			batch.now = func() time.Time { return now }
+			r.ownershipTags = batch
+		})
+
+		getAccount := func() *awsv1alpha1.Account {
+			updated := &awsv1alpha1.Account{}
+			Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+			return updated
+		}
+
+		It("queues the account instead of tagging it in the reconcile", func() {
+			Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+			Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+			Expect(batch.queue).To(Equal([]string{"123456789012"}))
+
+			awsClient.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{}, nil)
+			awsClient.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(&organizations.TagResourceOutput{}, nil)
+			Expect(batch.syncNext(context.TODO())).To(BeTrue())
+			Expect(batch.syncNext(context.TODO())).To(BeFalse())
+
+			Expect(getAccount().Annotations).To(HaveKeyWithValue(OwnershipTagsAnnotation, hashOwnershipTags(accountprovision.OwnershipTags(account, "hivep01ue1"))))
+			Expect(getAccount().Annotations[OwnershipTagsVerifiedAnnotation]).To(Equal("2026-10-16T12:00:00Z"))
+		})
+
+		It("skips the accounts whose cached tags are unchanged", func() {
+			awsClient.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{}, nil).Times(1)
+			awsClient.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(&organizations.TagResourceOutput{}, nil).Times(1)
+
+			Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+			Expect(batch.syncNext(context.TODO())).To(BeTrue())
+
+			// Submitted again, e.g. by a reconcile before the annotations were recorded
+			account = getAccount()
+			delete(account.Annotations, OwnershipTagsAnnotation)
+			Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+			Expect(batch.syncNext(context.TODO())).To(BeTrue())
+		})
+
+		It("lists the tags again once the cache expired", func() {
+			awsClient.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{}, nil).Times(2)
+			awsClient.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(&organizations.TagResourceOutput{}, nil).Times(2)
+
+			Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+			Expect(batch.syncNext(context.TODO())).To(BeTrue())
+
+			batch.now = func() time.Time { return now.Add(ownershipTagsCacheTTL) }
+			Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now.Add(ownershipTagsVerifyInterval))).To(Succeed())
+			Expect(batch.syncNext(context.TODO())).To(BeTrue())
+		})
+
+		It("drops the cache of the accounts failing to be tagged", func() {
+			awsClient.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{}, nil)
+			awsClient.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(nil, errors.New("throttled"))
+
+			Expect(r.syncOwnershipTags(nullLogger, account, awsClient, now)).To(Succeed())
+			Expect(batch.syncNext(context.TODO())).To(BeTrue())
+			Expect(batch.cache).To(BeEmpty())
+			Expect(getAccount().Annotations).NotTo(HaveKey(OwnershipTagsAnnotation))
+		})
+	})
+})
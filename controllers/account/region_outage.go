@@ -0,0 +1,130 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// regionsOutsideOutages splits the regions whose circuit allows initializing them from the ones skipped because of an
+// outage, returned with when they are retried
+func regionsOutsideOutages(regions []awsv1alpha1.AwsRegions, now time.Time) ([]awsv1alpha1.AwsRegions, map[string]time.Time) {
+	allowed := []awsv1alpha1.AwsRegions{}
+	skipped := map[string]time.Time{}
+	for _, region := range regions {
+		ok, retryAfter := controllerutils.RegionCircuits.Allow(region.Name, now)
+		if !ok {
+			skipped[region.Name] = retryAfter
+			continue
+		}
+		allowed = append(allowed, region)
+	}
+	return allowed, skipped
+}
+
+// recordRegionOutcomes adds the outcome of the initialized regions to their circuit, and records the outcome of every
+// region in the status of the account
+func recordRegionOutcomes(account *awsv1alpha1.Account, initialized []awsv1alpha1.AwsRegions, regionInitErrors []regionInitializationError, skipped map[string]time.Time, now time.Time) {
+	if account.Status.RegionStatuses == nil {
+		account.Status.RegionStatuses = awsv1alpha1.RegionStatuses{}
+	}
+	failed := map[string]string{}
+	for _, regionInitError := range regionInitErrors {
+		failed[regionInitError.Region] = regionInitError.ErrorMsg
+	}
+	for _, region := range initialized {
+		message, isFailed := failed[region.Name]
+		controllerutils.RegionCircuits.Record(region.Name, isFailed, now)
+		if isFailed {
+			account.Status.RegionStatuses[region.Name] = &awsv1alpha1.RegionStatus{State: awsv1alpha1.RegionInitFailed, Message: message}
+			continue
+		}
+		account.Status.RegionStatuses[region.Name] = &awsv1alpha1.RegionStatus{State: awsv1alpha1.RegionInitialized}
+	}
+	for region, retryAfter := range skipped {
+		account.Status.RegionStatuses[region] = &awsv1alpha1.RegionStatus{
+			State:      awsv1alpha1.RegionSkippedDueToOutage,
+			RetryAfter: &metav1.Time{Time: retryAfter},
+			Message:    "The error rate of the region is too high, its initialization is retried later",
+		}
+	}
+}
+
+// hasSkippedRegions returns whether the initialization of a region of the account was skipped because of an outage
+func hasSkippedRegions(account *awsv1alpha1.Account) bool {
+	for _, status := range account.Status.RegionStatuses {
+		if status != nil && status.State == awsv1alpha1.RegionSkippedDueToOutage {
+			return true
+		}
+	}
+	return false
+}
+
+// retrySkippedRegions initializes the regions of a Ready account that were skipped because of an outage once their
+// retry is due. They are initialized in the background, like the regions of a new account, and the account stays
+// Ready meanwhile.
+func (r *AccountReconciler) retrySkippedRegions(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client, amiOwner string, now time.Time) (reconcile.Result, error) {
+	due := []awsv1alpha1.AwsRegions{}
+	var next time.Time
+	for region, status := range currentAcctInstance.Status.RegionStatuses {
+		if status == nil || status.State != awsv1alpha1.RegionSkippedDueToOutage {
+			continue
+		}
+		if status.RetryAfter != nil && now.Before(status.RetryAfter.Time) {
+			if next.IsZero() || status.RetryAfter.Time.Before(next) {
+				next = status.RetryAfter.Time
+			}
+			continue
+		}
+		due = append(due, awsv1alpha1.AwsRegions{Name: region})
+	}
+	if len(due) == 0 {
+		return reconcile.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+
+	// Push the retry out first, so the reconciles running until the regions are initialized don't start it again
+	for _, region := range due {
+		currentAcctInstance.Status.RegionStatuses[region.Name].RetryAfter = &metav1.Time{Time: now.Add(controllerutils.RegionCircuitOpenDuration)}
+	}
+	if err := r.statusUpdate(currentAcctInstance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	creds, err := r.getInitializationCredentials(reqLogger, currentAcctInstance, awsSetupClient)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	reqLogger.Info("retrying the initialization of regions skipped during an outage", "regions", due)
+	go r.asyncSkippedRegionInit(reqLogger, currentAcctInstance.DeepCopy(), creds, amiOwner, due)
+	return reconcile.Result{RequeueAfter: controllerutils.RegionCircuitOpenDuration}, nil
+}
+
+// asyncSkippedRegionInit initializes the skipped regions and records their outcome on the latest version of the
+// account, as it may have changed while they were initialized. Regions still in an outage are skipped again.
+func (r *AccountReconciler) asyncSkippedRegionInit(reqLogger logr.Logger, account *awsv1alpha1.Account, creds *sts.AssumeRoleOutput, amiOwner string, regions []awsv1alpha1.AwsRegions) {
+	_ = r.initializeRegionsOutsideOutages(reqLogger, account, regions, creds, amiOwner)
+
+	current := &awsv1alpha1.Account{}
+	if err := r.Get(context.TODO(), client.ObjectKeyFromObject(account), current); err != nil {
+		reqLogger.Error(err, "failed getting the account to record the retried regions")
+		return
+	}
+	if current.Status.RegionStatuses == nil {
+		current.Status.RegionStatuses = awsv1alpha1.RegionStatuses{}
+	}
+	for _, region := range regions {
+		current.Status.RegionStatuses[region.Name] = account.Status.RegionStatuses[region.Name]
+	}
+	if err := r.statusUpdate(current); err != nil {
+		reqLogger.Error(err, "failed recording the retried regions")
+	}
+}
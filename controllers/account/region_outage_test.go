@@ -0,0 +1,57 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+func TestRegionOutcomes(t *testing.T) {
+	defer func(circuits *controllerutils.RegionCircuitBreaker) { controllerutils.RegionCircuits = circuits }(controllerutils.RegionCircuits)
+	controllerutils.RegionCircuits = controllerutils.NewRegionCircuitBreaker()
+	now := time.Now()
+
+	// Three failed initializations in a region open its circuit
+	for i := 0; i < 3; i++ {
+		account := &awsv1alpha1.Account{}
+		recordRegionOutcomes(account, []awsv1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "us-west-2"}},
+			[]regionInitializationError{{Region: "us-east-1", ErrorMsg: "InternalError"}}, nil, now)
+		assert.Equal(t, awsv1alpha1.RegionInitFailed, account.Status.RegionStatuses["us-east-1"].State)
+		assert.Equal(t, "InternalError", account.Status.RegionStatuses["us-east-1"].Message)
+		assert.Equal(t, awsv1alpha1.RegionInitialized, account.Status.RegionStatuses["us-west-2"].State)
+	}
+
+	allowed, skipped := regionsOutsideOutages([]awsv1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "us-west-2"}}, now)
+	assert.Equal(t, []awsv1alpha1.AwsRegions{{Name: "us-west-2"}}, allowed)
+	assert.Equal(t, map[string]time.Time{"us-east-1": now.Add(controllerutils.RegionCircuitOpenDuration)}, skipped)
+
+	account := &awsv1alpha1.Account{}
+	recordRegionOutcomes(account, allowed, nil, skipped, now)
+	assert.True(t, hasSkippedRegions(account))
+	assert.Equal(t, awsv1alpha1.RegionSkippedDueToOutage, account.Status.RegionStatuses["us-east-1"].State)
+	assert.Equal(t, now.Add(controllerutils.RegionCircuitOpenDuration), account.Status.RegionStatuses["us-east-1"].RetryAfter.Time)
+}
+
+func TestRetrySkippedRegionsNotDue(t *testing.T) {
+	now := time.Now()
+	account := &awsv1alpha1.Account{
+		Status: awsv1alpha1.AccountStatus{
+			RegionStatuses: awsv1alpha1.RegionStatuses{
+				"us-east-1": {State: awsv1alpha1.RegionInitialized},
+				"us-west-2": {State: awsv1alpha1.RegionSkippedDueToOutage, RetryAfter: &metav1.Time{Time: now.Add(20 * time.Minute)}},
+				"eu-west-1": {State: awsv1alpha1.RegionSkippedDueToOutage, RetryAfter: &metav1.Time{Time: now.Add(10 * time.Minute)}},
+			},
+		},
+	}
+	r := &AccountReconciler{}
+
+	result, err := r.retrySkippedRegions(testutils.NewTestLogger().Logger(), account, nil, "", now)
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, result.RequeueAfter)
+}
@@ -0,0 +1,128 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+)
+
+const (
+	// ReinitializeAnnotation requests the initialization phases of a Ready account to be redone, set to one of
+	// ReinitializeRegions, ReinitializeIAM or ReinitializeAll. It's removed once the phases are started.
+	ReinitializeAnnotation = "aws.managed.openshift.io/reinitialize"
+	// ReinitializeRegions redoes the region initialization
+	ReinitializeRegions = "regions"
+	// ReinitializeIAM recreates the access keys and secret of the managed IAM user
+	ReinitializeIAM = "iam"
+	// ReinitializeAll redoes both phases
+	ReinitializeAll = "all"
+)
+
+func hasReinitializeRequest(account *awsv1alpha1.Account) bool {
+	_, ok := account.Annotations[ReinitializeAnnotation]
+	return ok
+}
+
+// handleReinitialization redoes the initialization phases requested by the ReinitializeAnnotation on a Ready
+// account, within the maintenance window of its pool. The annotation is removed before the phases start, so they run
+// once and a failed phase has to be requested again. Re-initializing the regions moves the account back to
+// InitializingRegions until it's done.
+func (r *AccountReconciler) handleReinitialization(ctx context.Context, reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client, namespace string, amiOwner string) (reconcile.Result, error) {
+	phases := currentAcctInstance.Annotations[ReinitializeAnnotation]
+	switch phases {
+	case ReinitializeRegions, ReinitializeIAM, ReinitializeAll:
+	default:
+		reqLogger.Error(fmt.Errorf("invalid value %q, expected %s, %s or %s", phases, ReinitializeRegions, ReinitializeIAM, ReinitializeAll),
+			"ignoring re-initialization request", "annotation", ReinitializeAnnotation)
+		return reconcile.Result{}, r.removeReinitializeAnnotation(reqLogger, currentAcctInstance)
+	}
+
+	deferred, result, err := r.deferToMaintenanceWindow(ctx, reqLogger, currentAcctInstance, fmt.Sprintf("the %s re-initialization", phases), time.Now())
+	if deferred || err != nil {
+		return result, err
+	}
+
+	err = r.removeReinitializeAnnotation(reqLogger, currentAcctInstance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	reqLogger.Info("re-initializing account", "phases", phases, "awsAccountID", currentAcctInstance.Spec.AwsAccountID)
+
+	var creds *sts.AssumeRoleOutput
+	switch {
+	case phases == ReinitializeRegions:
+	case currentAcctInstance.Spec.ManualSTSMode:
+		reqLogger.Info("STS accounts have no IAM user to re-initialize")
+	default:
+		_, creds, err = r.handleIAMUserCreation(ctx, reqLogger, currentAcctInstance, awsSetupClient, namespace)
+		if err != nil {
+			reqLogger.Error(err, "failed re-initializing the IAM user")
+			return reconcile.Result{}, err
+		}
+		// The credentials were just rotated
+		currentAcctInstance.Status.RotateCredentials = false
+		currentAcctInstance.Status.RotateConsoleCredentials = false
+		err = r.statusUpdate(currentAcctInstance)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if phases == ReinitializeIAM {
+		return reconcile.Result{}, nil
+	}
+
+	if creds == nil {
+		creds, err = r.getInitializationCredentials(reqLogger, currentAcctInstance, awsSetupClient)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	// Start the region initialization over, so its timeout counts from now
+	currentAcctInstance.Status.Conditions = removeAccountCondition(currentAcctInstance.Status.Conditions, awsv1alpha1.AccountInitializingRegions)
+	err = r.initializeRegions(reqLogger, currentAcctInstance, creds, amiOwner)
+	if err != nil {
+		reqLogger.Error(err, "failed re-initializing regions")
+	}
+	return reconcile.Result{}, err
+}
+
+// getInitializationCredentials returns the credentials the regions of the account are initialized with
+func (r *AccountReconciler) getInitializationCredentials(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client) (*sts.AssumeRoleOutput, error) {
+	if !currentAcctInstance.Spec.ManualSTSMode {
+		_, creds, err := r.handleCreateAdminAccessRole(reqLogger, currentAcctInstance, awsSetupClient)
+		return creds, err
+	}
+	accountClaim, err := r.getAccountClaim(currentAcctInstance)
+	if err != nil {
+		reqLogger.Error(err, "unable to get accountclaim for sts account")
+		return nil, err
+	}
+	_, creds, err := r.getSTSClient(reqLogger, accountClaim, awsSetupClient)
+	return creds, err
+}
+
+func (r *AccountReconciler) removeReinitializeAnnotation(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account) error {
+	delete(currentAcctInstance.Annotations, ReinitializeAnnotation)
+	err := r.Update(context.TODO(), currentAcctInstance)
+	if err != nil {
+		reqLogger.Error(err, "failed removing the re-initialization annotation")
+	}
+	return err
+}
+
+func removeAccountCondition(conditions []awsv1alpha1.AccountCondition, conditionType awsv1alpha1.AccountConditionType) []awsv1alpha1.AccountCondition {
+	kept := conditions[:0]
+	for _, condition := range conditions {
+		if condition.Type != conditionType {
+			kept = append(kept, condition)
+		}
+	}
+	return kept
+}
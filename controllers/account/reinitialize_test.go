@@ -0,0 +1,111 @@
+package account
+
+import (
+	"context"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Re-initialization", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		r          *AccountReconciler
+		account    *awsv1alpha1.Account
+		configMap  *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "osd-creds-mgmt-aaaaaa",
+				Namespace:   awsv1alpha1.AccountCrNamespace,
+				Annotations: map[string]string{ReinitializeAnnotation: ReinitializeIAM},
+			},
+			Spec:   awsv1alpha1.AccountSpec{AwsAccountID: "123456789012", ManualSTSMode: true},
+			Status: awsv1alpha1.AccountStatus{State: AccountReady},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string]string{},
+		}
+	})
+
+	JustBeforeEach(func() {
+		r = &AccountReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account, configMap).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	getAccount := func() *awsv1alpha1.Account {
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		return updated
+	}
+
+	It("detects re-initialization requests", func() {
+		Expect(hasReinitializeRequest(account)).To(BeTrue())
+		Expect(hasReinitializeRequest(&awsv1alpha1.Account{})).To(BeFalse())
+	})
+
+	It("removes the annotation once the phases are started", func() {
+		_, err := r.handleReinitialization(context.TODO(), nullLogger, getAccount(), nil, awsv1alpha1.AccountCrNamespace, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getAccount().Annotations).NotTo(HaveKey(ReinitializeAnnotation))
+	})
+
+	When("the value is invalid", func() {
+		BeforeEach(func() {
+			account.Annotations[ReinitializeAnnotation] = "everything"
+		})
+
+		It("ignores the request", func() {
+			_, err := r.handleReinitialization(context.TODO(), nullLogger, getAccount(), nil, awsv1alpha1.AccountCrNamespace, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getAccount().Annotations).NotTo(HaveKey(ReinitializeAnnotation))
+		})
+	})
+
+	When("the maintenance window of the pool is closed", func() {
+		BeforeEach(func() {
+			account.Spec.AccountPool = "production"
+			configMap.Data["accountpool"] = `production:
+  maintenanceWindow:
+    schedule: "0 0 1 1 *"
+    duration: 1m
+`
+		})
+
+		It("defers the request", func() {
+			result, err := r.handleReinitialization(context.TODO(), nullLogger, getAccount(), nil, awsv1alpha1.AccountCrNamespace, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			updated := getAccount()
+			Expect(updated.Annotations).To(HaveKeyWithValue(ReinitializeAnnotation, ReinitializeIAM))
+			Expect(utils.FindAccountCondition(updated.Status.Conditions, awsv1alpha1.AccountMaintenancePending)).NotTo(BeNil())
+		})
+	})
+
+	It("clears the region initialization condition", func() {
+		conditions := []awsv1alpha1.AccountCondition{
+			{Type: awsv1alpha1.AccountCreating},
+			{Type: awsv1alpha1.AccountInitializingRegions},
+			{Type: awsv1alpha1.AccountReady},
+		}
+		Expect(removeAccountCondition(conditions, awsv1alpha1.AccountInitializingRegions)).To(Equal([]awsv1alpha1.AccountCondition{
+			{Type: awsv1alpha1.AccountCreating},
+			{Type: awsv1alpha1.AccountReady},
+		}))
+	})
+})
@@ -0,0 +1,81 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	"github.com/openshift/aws-account-operator/test/fixtures"
+)
+
+const (
+	// RetiredForReuseCount is the retirement reason of accounts reused the MaxReuseCount of their pool
+	RetiredForReuseCount = "MaxReuseCount"
+	// RetiredForAge is the retirement reason of accounts older than the MaxAccountAge of their pool
+	RetiredForAge = "MaxAccountAge"
+)
+
+// RetirementReason returns the limit of pool reached by an account reused reuseCount times and created at created, or
+// an empty string when the account is within the limits of its pool
+func RetirementReason(pool *awsv1alpha1.AccountPool, reuseCount int, created time.Time, now time.Time) string {
+	if pool == nil {
+		return ""
+	}
+	if pool.Spec.MaxReuseCount > 0 && reuseCount > pool.Spec.MaxReuseCount {
+		return RetiredForReuseCount
+	}
+	if pool.Spec.MaxAccountAge != nil && pool.Spec.MaxAccountAge.Duration > 0 && !created.IsZero() && now.Sub(created) >= pool.Spec.MaxAccountAge.Duration {
+		return RetiredForAge
+	}
+	return ""
+}
+
+// GetAccountPool returns the pool of the account, the default pool for accounts without one, or nil when the pool
+// doesn't exist or there's no default pool
+func GetAccountPool(ctx context.Context, reqLogger logr.Logger, kubeClient client.Client, account *awsv1alpha1.Account) (*awsv1alpha1.AccountPool, error) {
+	poolName := account.Spec.AccountPool
+	if poolName == "" {
+		var err error
+		poolName, err = config.GetDefaultAccountPoolName(reqLogger, kubeClient)
+		if k8serr.IsNotFound(err) || errors.Is(err, fixtures.NotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	pool := &awsv1alpha1.AccountPool{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Name: poolName, Namespace: awsv1alpha1.AccountCrNamespace}, pool)
+	if k8serr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// RetireAccount deletes an account which reached a limit of its pool, so the pool replaces it with a new account. The
+// account is only deleted if it didn't change since it was read, e.g. by being claimed. Its AWS account is left in the
+// organization, with its ownership tags if they are enabled.
+func RetireAccount(ctx context.Context, reqLogger logr.Logger, kubeClient client.Client, account *awsv1alpha1.Account, reason string) error {
+	reqLogger.Info("Retiring account which reached a limit of its pool", "account", account.Name,
+		"awsAccountID", account.Spec.AwsAccountID, "reason", reason, "reuseCount", account.Status.ReuseCount,
+		"created", account.CreationTimestamp.Time)
+	err := kubeClient.Delete(ctx, account, client.Preconditions{UID: &account.UID, ResourceVersion: &account.ResourceVersion})
+	if err != nil {
+		return err
+	}
+	if localmetrics.Collector != nil {
+		localmetrics.Collector.AddAccountRetired(reason)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Account retirement", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		now        = time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+		pool       *awsv1alpha1.AccountPool
+	)
+
+	BeforeEach(func() {
+		pool = &awsv1alpha1.AccountPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: awsv1alpha1.AccountCrNamespace},
+			Spec: awsv1alpha1.AccountPoolSpec{
+				PoolSize:      1,
+				MaxReuseCount: 3,
+				MaxAccountAge: &metav1.Duration{Duration: 180 * 24 * time.Hour},
+			},
+		}
+	})
+
+	Context("RetirementReason", func() {
+		It("keeps accounts within the limits of their pool", func() {
+			Expect(RetirementReason(pool, 3, now.Add(-24*time.Hour), now)).To(BeEmpty())
+		})
+
+		It("retires accounts reused more often than their pool allows", func() {
+			Expect(RetirementReason(pool, 4, now.Add(-24*time.Hour), now)).To(Equal(RetiredForReuseCount))
+		})
+
+		It("retires accounts older than their pool allows", func() {
+			Expect(RetirementReason(pool, 0, now.Add(-200*24*time.Hour), now)).To(Equal(RetiredForAge))
+		})
+
+		It("doesn't retire accounts of pools without limits", func() {
+			pool.Spec.MaxReuseCount = 0
+			pool.Spec.MaxAccountAge = nil
+			Expect(RetirementReason(pool, 100, now.Add(-1000*24*time.Hour), now)).To(BeEmpty())
+			Expect(RetirementReason(nil, 100, now.Add(-1000*24*time.Hour), now)).To(BeEmpty())
+		})
+	})
+
+	Context("GetAccountPool", func() {
+		It("returns the default pool for accounts without one", func() {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+				Data:       map[string]string{"accountpool": "production:\n  default: true\n"},
+			}
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap, pool).Build()
+			found, err := GetAccountPool(context.TODO(), nullLogger, kubeClient, &awsv1alpha1.Account{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.Name).To(Equal("production"))
+		})
+
+		It("returns no pool when the pool of the account doesn't exist", func() {
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+			found, err := GetAccountPool(context.TODO(), nullLogger, kubeClient, &awsv1alpha1.Account{Spec: awsv1alpha1.AccountSpec{AccountPool: "staging"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeNil())
+		})
+	})
+})
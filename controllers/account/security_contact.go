@@ -0,0 +1,138 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	accounttypes "github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// securityContactNameKey, securityContactTitleKey, securityContactEmailKey and securityContactPhoneKey are the
+	// operator ConfigMap keys of the security contact set on the AWS accounts
+	securityContactNameKey  = "security-contact.name"
+	securityContactTitleKey = "security-contact.title"
+	securityContactEmailKey = "security-contact.email"
+	securityContactPhoneKey = "security-contact.phone"
+
+	// SecurityContactAnnotation holds a hash of the security contact last written to the AWS account
+	SecurityContactAnnotation = "aws.managed.openshift.io/security-contact"
+	// SecurityContactVerifiedAnnotation holds the time the security contact of the AWS account was last compared to
+	// the operator ConfigMap
+	SecurityContactVerifiedAnnotation = "aws.managed.openshift.io/security-contact-verified"
+
+	// securityContactVerifyInterval is how often the security contact is compared to the AWS account when the
+	// ConfigMap didn't change
+	securityContactVerifyInterval = 12 * time.Hour
+)
+
+// securityContact is the SECURITY alternate contact of the AWS accounts
+type securityContact struct {
+	name  string
+	title string
+	email string
+	phone string
+}
+
+// securityContactFromConfigMap reads the security contact from the operator ConfigMap. It's nil when none is
+// configured, and an error is returned when only some of its fields are, as AWS requires all of them.
+func securityContactFromConfigMap(configMap *corev1.ConfigMap) (*securityContact, error) {
+	contact := &securityContact{
+		name:  configMap.Data[securityContactNameKey],
+		title: configMap.Data[securityContactTitleKey],
+		email: configMap.Data[securityContactEmailKey],
+		phone: configMap.Data[securityContactPhoneKey],
+	}
+	if *contact == (securityContact{}) {
+		return nil, nil
+	}
+	if contact.name == "" || contact.title == "" || contact.email == "" || contact.phone == "" {
+		return nil, fmt.Errorf("the security contact needs %s, %s, %s and %s", securityContactNameKey, securityContactTitleKey, securityContactEmailKey, securityContactPhoneKey)
+	}
+	return contact, nil
+}
+
+// hash returns a hash of the contact, to notice ConfigMap changes without calling AWS
+func (c *securityContact) hash() string {
+	return hashOwnershipTags(map[string]string{"name": c.name, "title": c.title, "email": c.email, "phone": c.phone})
+}
+
+// matches returns whether the alternate contact of the AWS account is the contact
+func (c *securityContact) matches(current *accounttypes.AlternateContact) bool {
+	return current != nil &&
+		aws.ToString(current.Name) == c.name &&
+		aws.ToString(current.Title) == c.title &&
+		aws.ToString(current.EmailAddress) == c.email &&
+		aws.ToString(current.PhoneNumber) == c.phone
+}
+
+// syncSecurityContact sets the security contact of the operator ConfigMap on the AWS account of a ready account when
+// the contact changes, and compares it to the AWS account every securityContactVerifyInterval to repair drift. Accounts
+// outside the organization aren't updated.
+func (r *AccountReconciler) syncSecurityContact(reqLogger logr.Logger, currentAcctInstance *awsv1alpha1.Account, awsSetupClient awsclient.Client, configMap *corev1.ConfigMap, now time.Time) error {
+	if !currentAcctInstance.IsReady() || currentAcctInstance.IsBYOC() || currentAcctInstance.IsSTS() || !currentAcctInstance.HasAwsAccountID() {
+		return nil
+	}
+	contact, err := securityContactFromConfigMap(configMap)
+	if err != nil || contact == nil {
+		return err
+	}
+
+	hash := contact.hash()
+	verified, err := time.Parse(time.RFC3339, currentAcctInstance.Annotations[SecurityContactVerifiedAnnotation])
+	if currentAcctInstance.Annotations[SecurityContactAnnotation] == hash && err == nil && now.Sub(verified) < securityContactVerifyInterval {
+		return nil
+	}
+
+	awsClient, _, err := stsclient.HandleRoleAssumption(reqLogger, r.awsClientBuilder, currentAcctInstance, r.Client, awsSetupClient, "", awsv1alpha1.AccountOperatorIAMRole, "")
+	if err != nil {
+		reqLogger.Error(err, "failed building AWS client from assume_role")
+		return err
+	}
+
+	output, err := awsClient.GetAlternateContact(context.TODO(), &account.GetAlternateContactInput{
+		AlternateContactType: accounttypes.AlternateContactTypeSecurity,
+	})
+	var notFound *accounttypes.ResourceNotFoundException
+	if err != nil && !errors.As(err, &notFound) {
+		reqLogger.Error(err, "failed getting the security contact of the AWS account", "awsRequestID", utils.AwsRequestID(err))
+		return err
+	}
+	var current *accounttypes.AlternateContact
+	if output != nil {
+		current = output.AlternateContact
+	}
+
+	if !contact.matches(current) {
+		_, err = awsClient.PutAlternateContact(context.TODO(), &account.PutAlternateContactInput{
+			AlternateContactType: accounttypes.AlternateContactTypeSecurity,
+			Name:                 aws.String(contact.name),
+			Title:                aws.String(contact.title),
+			EmailAddress:         aws.String(contact.email),
+			PhoneNumber:          aws.String(contact.phone),
+		})
+		if err != nil {
+			reqLogger.Error(err, "failed setting the security contact of the AWS account", "awsRequestID", utils.AwsRequestID(err))
+			return err
+		}
+		reqLogger.Info("set the security contact", "awsAccountID", currentAcctInstance.Spec.AwsAccountID)
+	}
+
+	if currentAcctInstance.Annotations == nil {
+		currentAcctInstance.Annotations = map[string]string{}
+	}
+	currentAcctInstance.Annotations[SecurityContactAnnotation] = hash
+	currentAcctInstance.Annotations[SecurityContactVerifiedAnnotation] = now.UTC().Format(time.RFC3339)
+	return r.Update(context.TODO(), currentAcctInstance)
+}
@@ -0,0 +1,136 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsaccount "github.com/aws/aws-sdk-go-v2/service/account"
+	accounttypes "github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security contact", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		ctrl       *gomock.Controller
+		awsClient  *mock.MockClient
+		r          *AccountReconciler
+		account    *awsv1alpha1.Account
+		configMap  *corev1.ConfigMap
+		now        = time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		builder := &mock.Builder{MockController: ctrl}
+		awsClient = mock.GetMockClient(builder)
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+			Spec:       awsv1alpha1.AccountSpec{AwsAccountID: "123456789012"},
+			Status:     awsv1alpha1.AccountStatus{State: AccountReady},
+		}
+		configMap = &corev1.ConfigMap{Data: map[string]string{
+			securityContactNameKey:  "Security Team",
+			securityContactTitleKey: "SRE",
+			securityContactEmailKey: "security@example.com",
+			securityContactPhoneKey: "+1-555-0100",
+		}}
+		r = &AccountReconciler{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account).Build(),
+			Scheme:           scheme.Scheme,
+			awsClientBuilder: builder,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectAssumeRole := func(times int) {
+		awsClient.EXPECT().AssumeRole(gomock.Any(), gomock.Any()).Return(&sts.AssumeRoleOutput{
+			AssumedRoleUser: &ststypes.AssumedRoleUser{AssumedRoleId: aws.String("role/awsAccountOperator")},
+			Credentials: &ststypes.Credentials{
+				AccessKeyId:     aws.String("ACCESS_KEY"),
+				SecretAccessKey: aws.String("SECRET_KEY"),
+				SessionToken:    aws.String("SESSION_TOKEN"),
+			},
+		}, nil).Times(times)
+	}
+
+	It("sets a missing security contact", func() {
+		expectAssumeRole(1)
+		awsClient.EXPECT().GetAlternateContact(gomock.Any(), gomock.Any()).Return(nil, &accounttypes.ResourceNotFoundException{})
+		awsClient.EXPECT().PutAlternateContact(gomock.Any(), &awsaccount.PutAlternateContactInput{
+			AlternateContactType: accounttypes.AlternateContactTypeSecurity,
+			Name:                 aws.String("Security Team"),
+			Title:                aws.String("SRE"),
+			EmailAddress:         aws.String("security@example.com"),
+			PhoneNumber:          aws.String("+1-555-0100"),
+		}).Return(&awsaccount.PutAlternateContactOutput{}, nil)
+
+		Expect(r.syncSecurityContact(nullLogger, account, awsClient, configMap, now)).To(Succeed())
+
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		Expect(updated.Annotations).To(HaveKey(SecurityContactAnnotation))
+		Expect(updated.Annotations[SecurityContactVerifiedAnnotation]).To(Equal("2026-10-16T12:00:00Z"))
+	})
+
+	It("only verifies an unchanged contact once per interval", func() {
+		expectAssumeRole(2)
+		awsClient.EXPECT().GetAlternateContact(gomock.Any(), gomock.Any()).Return(&awsaccount.GetAlternateContactOutput{
+			AlternateContact: &accounttypes.AlternateContact{
+				Name:         aws.String("Security Team"),
+				Title:        aws.String("SRE"),
+				EmailAddress: aws.String("security@example.com"),
+				PhoneNumber:  aws.String("+1-555-0100"),
+			},
+		}, nil).Times(2)
+
+		Expect(r.syncSecurityContact(nullLogger, account, awsClient, configMap, now)).To(Succeed())
+		Expect(r.syncSecurityContact(nullLogger, account, awsClient, configMap, now.Add(time.Hour))).To(Succeed())
+		Expect(r.syncSecurityContact(nullLogger, account, awsClient, configMap, now.Add(securityContactVerifyInterval))).To(Succeed())
+	})
+
+	It("repairs a drifted contact when the ConfigMap changes", func() {
+		expectAssumeRole(1)
+		account.Annotations = map[string]string{
+			SecurityContactAnnotation:         "outdated",
+			SecurityContactVerifiedAnnotation: now.Format(time.RFC3339),
+		}
+		awsClient.EXPECT().GetAlternateContact(gomock.Any(), gomock.Any()).Return(&awsaccount.GetAlternateContactOutput{
+			AlternateContact: &accounttypes.AlternateContact{Name: aws.String("Former Team")},
+		}, nil)
+		awsClient.EXPECT().PutAlternateContact(gomock.Any(), gomock.Any()).Return(&awsaccount.PutAlternateContactOutput{}, nil)
+
+		Expect(r.syncSecurityContact(nullLogger, account, awsClient, configMap, now.Add(time.Minute))).To(Succeed())
+	})
+
+	It("skips accounts outside the organization and unconfigured contacts", func() {
+		account.Spec.BYOC = true
+		Expect(r.syncSecurityContact(nullLogger, account, awsClient, configMap, now)).To(Succeed())
+
+		account.Spec.BYOC = false
+		Expect(r.syncSecurityContact(nullLogger, account, awsClient, &corev1.ConfigMap{}, now)).To(Succeed())
+	})
+
+	It("rejects incomplete contacts", func() {
+		delete(configMap.Data, securityContactPhoneKey)
+		_, err := securityContactFromConfigMap(configMap)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -25,7 +25,7 @@ import (
 func HandleServiceQuotaRequests(reqLogger logr.Logger, awsClient awsclient.Client, quotaCode awsv1alpha1.SupportedServiceQuotas, serviceQuotaStatus *awsv1alpha1.ServiceQuotaStatus) error {
 
 	reqLogger.Info("Handling ServiceQuota Requests")
-	serviceCode, found := getServiceCode(quotaCode)
+	serviceCode, found := GetServiceCode(quotaCode)
 	if !found {
 		reqLogger.Error(fixtures.NotFound, "cannot find corresponding ServiceCode for QuotaCode", "QuotaCode", string(quotaCode))
 		return fixtures.NotFound
@@ -100,7 +100,9 @@ func HandleServiceQuotaRequests(reqLogger logr.Logger, awsClient awsclient.Clien
 	return nil
 }
 
-func getServiceCode(quotaCode awsv1alpha1.SupportedServiceQuotas) (string, bool) {
+// GetServiceCode returns the code of the service the quota belongs to, and false for the quotas the operator doesn't
+// support
+func GetServiceCode(quotaCode awsv1alpha1.SupportedServiceQuotas) (string, bool) {
 
 	servicesMap := map[awsv1alpha1.SupportedServiceQuotas]string{
 		awsv1alpha1.RunningStandardInstances:  string(awsv1alpha1.EC2ServiceQuota),
@@ -116,11 +118,11 @@ func getServiceCode(quotaCode awsv1alpha1.SupportedServiceQuotas) (string, bool)
 }
 
 // getDesiredServiceQuotaValue retrieves the desired quota information from the operator configmap and converts it to a float64
-func (r *AccountReconciler) getDesiredServiceQuotaValue(reqLogger logr.Logger, quota string) (float64, error) {
+func (r *AccountReconciler) getDesiredServiceQuotaValue(ctx context.Context, reqLogger logr.Logger, quota string) (float64, error) {
 	var err error
 	var vCPUQuota float64
 
-	configMap, err := controllerutils.GetOperatorConfigMap(r.Client)
+	configMap, err := r.operatorConfig().ConfigMap(ctx)
 	v, ok := configMap.Data[fmt.Sprintf("quota.%s", quota)]
 	if !ok {
 		err = awsv1alpha1.ErrInvalidConfigMap
@@ -456,6 +458,7 @@ func UpdateServiceQuotaRequests(reqLogger logr.Logger, awsClientBuilder awsclien
 
 	if deniedCount > 0 {
 		controllerutils.SetAccountStatus(currentAcctInstance, "ServiceQuota increase got denied", awsv1alpha1.AccountFailed, AccountFailed)
+		currentAcctInstance.Status.FailureReason = awsv1alpha1.FailureReasonQuotaDenied
 	}
 
 	return nil
@@ -0,0 +1,95 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// skipSupportVerificationFeatureFlag skips the Enterprise Support verification of every account when "true", e.g.
+	// for payer accounts of test environments without Support API access
+	skipSupportVerificationFeatureFlag = "feature.skip_support_verification"
+	// supportVerificationSkippedReason is the reason of the SkippedVerification condition
+	supportVerificationSkippedReason = "SupportVerificationSkipped"
+	// verificationTimeoutKey is the operator ConfigMap key holding how long an account may be PendingVerification
+	// before it's set to Failed with the VerificationTimeout reason. Accounts wait indefinitely when unset.
+	verificationTimeoutKey = "account-verification-timeout"
+)
+
+// supportVerificationSkipped returns why the Enterprise Support verification of the account is skipped, by the
+// feature flag or by the skipSupportVerification setting of its pool, or an empty string when it isn't. The
+// verification runs when there is no operator ConfigMap.
+func (r *AccountReconciler) supportVerificationSkipped(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account) (string, error) {
+	configMap, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	enabled, err := utils.GetFeatureFlagValue(configMap, skipSupportVerificationFeatureFlag)
+	if err != nil {
+		reqLogger.Info("Could not retrieve feature flag '" + skipSupportVerificationFeatureFlag + "' - support verification is not skipped")
+	}
+	if enabled {
+		return "Enterprise Support verification skipped by " + skipSupportVerificationFeatureFlag, nil
+	}
+
+	skip, err := r.operatorConfig().SkipSupportVerification(ctx, account.Spec.AccountPool)
+	if err != nil {
+		return "", err
+	}
+	if skip {
+		return "Enterprise Support verification skipped by skipSupportVerification of the account pool", nil
+	}
+	return "", nil
+}
+
+// setVerificationSkipped sets the SkippedVerification condition of an account turning ready without Enterprise
+// Support verification, so it's told apart from the verified accounts
+func setVerificationSkipped(account *awsv1alpha1.Account, message string) {
+	account.Status.Conditions = utils.SetAccountCondition(
+		account.Status.Conditions,
+		awsv1alpha1.AccountSkippedVerification,
+		corev1.ConditionTrue,
+		supportVerificationSkippedReason,
+		message,
+		utils.UpdateConditionIfReasonOrMessageChange,
+		account.Spec.BYOC,
+	)
+}
+
+// verificationTimeout returns how long an account may be pending verification before it fails, 0 when there is no
+// timeout. Invalid values are reported and ignored.
+func (r *AccountReconciler) verificationTimeout(ctx context.Context, reqLogger logr.Logger) (time.Duration, error) {
+	configMap, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	value := configMap.Data[verificationTimeoutKey]
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		reqLogger.Error(err, "invalid verification timeout, ignoring it", "timeout", value)
+		return 0, nil
+	}
+	if timeout <= 0 {
+		reqLogger.Info("verification timeout isn't positive, ignoring it", "timeout", value)
+		return 0, nil
+	}
+	return timeout, nil
+}
@@ -0,0 +1,84 @@
+package accountclaim
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// summarizeAccountFailure returns the reason and message of the LinkedAccountFailed condition for a failed Account.
+// Claim consumers usually can't read Accounts in the operator namespace, so the message carries the details of the
+// Account's most recent failing condition.
+func summarizeAccountFailure(account *awsv1alpha1.Account) (string, string) {
+	var latest *awsv1alpha1.AccountCondition
+	for i := range account.Status.Conditions {
+		condition := &account.Status.Conditions[i]
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		if latest == nil || !condition.LastTransitionTime.Before(&latest.LastTransitionTime) {
+			latest = condition
+		}
+	}
+
+	reason := string(account.Status.FailureReason)
+	if reason == "" && latest != nil {
+		reason = latest.Reason
+	}
+	if reason == "" {
+		reason = account.Status.State
+	}
+
+	message := fmt.Sprintf("Account %s is in state %s", account.Name, account.Status.State)
+	if latest != nil && latest.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, latest.Message)
+	}
+	return reason, message
+}
+
+// reconcileAccountFailure propagates the failure of the linked Account to the claim. It returns true when the Account
+// failed, in which case the claim is left in the Error state until the Account recovers.
+func (r *AccountClaimReconciler) reconcileAccountFailure(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) (bool, error) {
+	isCCS := accountClaim.Spec.BYOCAWSAccountID != ""
+	condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.LinkedAccountFailed)
+
+	if !account.IsFailed() {
+		if condition == nil || condition.Status != corev1.ConditionTrue {
+			return false, nil
+		}
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.LinkedAccountFailed,
+			corev1.ConditionFalse,
+			"AccountRecovered",
+			fmt.Sprintf("Account %s is in state %s", account.Name, account.Status.State),
+			controllerutils.UpdateConditionAlways,
+			isCCS,
+		)
+		accountClaim.Status.State = awsv1alpha1.ClaimStatusPending
+		return false, r.statusUpdate(reqLogger, accountClaim)
+	}
+
+	reason, message := summarizeAccountFailure(account)
+	if condition != nil && condition.Status == corev1.ConditionTrue && condition.Reason == reason &&
+		condition.Message == message && accountClaim.Status.State == awsv1alpha1.ClaimStatusError {
+		return true, nil
+	}
+
+	reqLogger.Info("linked account failed", "account", account.Name, "reason", reason)
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.LinkedAccountFailed,
+		corev1.ConditionTrue,
+		reason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		isCCS,
+	)
+	accountClaim.Status.State = awsv1alpha1.ClaimStatusError
+	return true, r.statusUpdate(reqLogger, accountClaim)
+}
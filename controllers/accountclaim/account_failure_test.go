@@ -0,0 +1,105 @@
+package accountclaim
+
+import (
+	"context"
+	"time"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Linked Account failures", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		r            *AccountClaimReconciler
+		accountClaim *awsv1alpha1.AccountClaim
+		account      *awsv1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec:       awsv1alpha1.AccountClaimSpec{AccountLink: "osd-creds-mgmt-aaaaaa"},
+			Status:     awsv1alpha1.AccountClaimStatus{State: awsv1alpha1.ClaimStatusPending},
+		}
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+			Status: awsv1alpha1.AccountStatus{
+				State:         string(awsv1alpha1.AccountFailed),
+				FailureReason: awsv1alpha1.FailureReasonRegionInitFailed,
+				Conditions: []awsv1alpha1.AccountCondition{
+					{
+						Type:               awsv1alpha1.AccountCreating,
+						Status:             corev1.ConditionTrue,
+						Message:            "Creating account",
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+					},
+					{
+						Type:               awsv1alpha1.AccountFailed,
+						Status:             corev1.ConditionTrue,
+						Reason:             "RegionInitializationTimeout",
+						Message:            "Region us-east-1 did not initialize in time",
+						LastTransitionTime: metav1.Now(),
+					},
+				},
+			},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+	})
+
+	It("summarizes the failure of the account on the claim", func() {
+		failed, err := r.reconcileAccountFailure(nullLogger, accountClaim, account)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failed).To(BeTrue())
+
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+		Expect(accountClaim.Status.State).To(Equal(awsv1alpha1.ClaimStatusError))
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.LinkedAccountFailed)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(string(awsv1alpha1.FailureReasonRegionInitFailed)))
+		Expect(condition.Message).To(ContainSubstring("Region us-east-1 did not initialize in time"))
+	})
+
+	It("falls back to the reason of the failing condition", func() {
+		account.Status.FailureReason = ""
+
+		reason, _ := summarizeAccountFailure(account)
+		Expect(reason).To(Equal("RegionInitializationTimeout"))
+	})
+
+	It("clears the condition once the account recovered", func() {
+		_, err := r.reconcileAccountFailure(nullLogger, accountClaim, account)
+		Expect(err).NotTo(HaveOccurred())
+
+		account.Status.State = string(awsv1alpha1.AccountReady)
+		failed, err := r.reconcileAccountFailure(nullLogger, accountClaim, account)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failed).To(BeFalse())
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.LinkedAccountFailed)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(accountClaim.Status.State).To(Equal(awsv1alpha1.ClaimStatusPending))
+	})
+
+	It("leaves claims of healthy accounts alone", func() {
+		account.Status.State = string(awsv1alpha1.AccountReady)
+
+		failed, err := r.reconcileAccountFailure(nullLogger, accountClaim, account)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failed).To(BeFalse())
+		Expect(accountClaim.Status.Conditions).To(BeEmpty())
+	})
+})
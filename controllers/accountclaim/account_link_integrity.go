@@ -0,0 +1,116 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const accountLinkConflictReason = "AccountLinkedToAnotherClaim"
+
+// reconcileAccountLinkIntegrity verifies that the Account the claim links to links back to the claim. Back-references
+// that are missing their namespace or point at a claim that's gone are repaired. When the Account is linked to another
+// claim that still uses it, the claim is blocked with the AccountLinkConflict condition, it returns true in that case.
+func (r *AccountClaimReconciler) reconcileAccountLinkIntegrity(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	if accountClaim.Spec.AccountLink == "" {
+		return false, nil
+	}
+
+	account := &awsv1alpha1.Account{}
+	err := r.Get(context.TODO(), types.NamespacedName{Name: accountClaim.Spec.AccountLink, Namespace: awsv1alpha1.AccountCrNamespace}, account)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			// Claims of missing accounts are handled by the regular claim flow
+			return false, nil
+		}
+		return false, err
+	}
+
+	// The claim flow sets the back-reference of accounts that don't have one yet
+	if account.Spec.ClaimLink == "" {
+		return false, r.clearAccountLinkConflict(reqLogger, accountClaim)
+	}
+
+	if account.Spec.ClaimLink == accountClaim.Name {
+		if account.Spec.ClaimLinkNamespace == accountClaim.Namespace {
+			return false, r.clearAccountLinkConflict(reqLogger, accountClaim)
+		}
+		if account.Spec.ClaimLinkNamespace == "" {
+			reqLogger.Info("repairing the claim link namespace of the account", "account", account.Name)
+			account.Spec.ClaimLinkNamespace = accountClaim.Namespace
+			if err := r.accountSpecUpdate(reqLogger, account); err != nil {
+				return false, err
+			}
+			return false, r.clearAccountLinkConflict(reqLogger, accountClaim)
+		}
+	}
+
+	// The account is linked to another claim, it only belongs to this one if the other claim moved on
+	otherClaim := &awsv1alpha1.AccountClaim{}
+	err = r.Get(context.TODO(), types.NamespacedName{Name: account.Spec.ClaimLink, Namespace: account.Spec.ClaimLinkNamespace}, otherClaim)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return false, err
+	}
+	if k8serr.IsNotFound(err) || (otherClaim.Spec.AccountLink != "" && otherClaim.Spec.AccountLink != account.Name) {
+		reqLogger.Info("repairing the stale claim link of the account", "account", account.Name,
+			"staleClaimLink", account.Spec.ClaimLink, "staleClaimLinkNamespace", account.Spec.ClaimLinkNamespace)
+		account.Spec.ClaimLink = accountClaim.Name
+		account.Spec.ClaimLinkNamespace = accountClaim.Namespace
+		if err := r.accountSpecUpdate(reqLogger, account); err != nil {
+			return false, err
+		}
+		return false, r.clearAccountLinkConflict(reqLogger, accountClaim)
+	}
+
+	message := fmt.Sprintf("Account %s is linked to AccountClaim %s/%s", account.Name, account.Spec.ClaimLinkNamespace, account.Spec.ClaimLink)
+	reqLogger.Info("account is linked to another claim", "account", account.Name,
+		"claimLink", account.Spec.ClaimLink, "claimLinkNamespace", account.Spec.ClaimLinkNamespace)
+
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.AccountLinkConflict)
+	if existing != nil && existing.Status == corev1.ConditionTrue && existing.Message == message &&
+		accountClaim.Status.State == awsv1alpha1.ClaimStatusError {
+		return true, nil
+	}
+
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.AccountLinkConflict,
+		corev1.ConditionTrue,
+		accountLinkConflictReason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	accountClaim.Status.State = awsv1alpha1.ClaimStatusError
+	if r.recorder != nil {
+		r.recorder.Event(accountClaim, corev1.EventTypeWarning, string(awsv1alpha1.AccountLinkConflict), message)
+	}
+	return true, r.statusUpdate(reqLogger, accountClaim)
+}
+
+// clearAccountLinkConflict resolves the AccountLinkConflict condition of a claim whose account links back to it
+func (r *AccountClaimReconciler) clearAccountLinkConflict(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.AccountLinkConflict)
+	if existing == nil || existing.Status != corev1.ConditionTrue {
+		return nil
+	}
+
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.AccountLinkConflict,
+		corev1.ConditionFalse,
+		"AccountLinkResolved",
+		fmt.Sprintf("Account %s is linked to this claim", accountClaim.Spec.AccountLink),
+		controllerutils.UpdateConditionAlways,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	accountClaim.Status.State = awsv1alpha1.ClaimStatusPending
+	return r.statusUpdate(reqLogger, accountClaim)
+}
@@ -0,0 +1,112 @@
+package accountclaim
+
+import (
+	"context"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Account link integrity", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *awsv1alpha1.AccountClaim
+		account      *awsv1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec:       awsv1alpha1.AccountClaimSpec{AccountLink: "osd-creds-mgmt-aaaaaa"},
+			Status:     awsv1alpha1.AccountClaimStatus{State: awsv1alpha1.ClaimStatusReady},
+		}
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+			Spec:       awsv1alpha1.AccountSpec{ClaimLink: "claim", ClaimLinkNamespace: "claim-namespace"},
+		}
+	})
+
+	reconciler := func(objects ...client.Object) *AccountClaimReconciler {
+		r := &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+		return r
+	}
+
+	getAccount := func(r *AccountClaimReconciler) *awsv1alpha1.Account {
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		return updated
+	}
+
+	It("accepts accounts that link back to the claim", func() {
+		r := reconciler(accountClaim, account)
+
+		conflict, err := r.reconcileAccountLinkIntegrity(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflict).To(BeFalse())
+		Expect(accountClaim.Status.Conditions).To(BeEmpty())
+	})
+
+	It("repairs a missing claim link namespace", func() {
+		account.Spec.ClaimLinkNamespace = ""
+		r := reconciler(accountClaim, account)
+
+		conflict, err := r.reconcileAccountLinkIntegrity(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflict).To(BeFalse())
+		Expect(getAccount(r).Spec.ClaimLinkNamespace).To(Equal("claim-namespace"))
+	})
+
+	It("repairs a claim link to a claim that's gone", func() {
+		account.Spec.ClaimLink = "deleted-claim"
+		r := reconciler(accountClaim, account)
+
+		conflict, err := r.reconcileAccountLinkIntegrity(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflict).To(BeFalse())
+		Expect(getAccount(r).Spec.ClaimLink).To(Equal("claim"))
+	})
+
+	It("blocks the claim when another claim uses the account", func() {
+		account.Spec.ClaimLink = "other-claim"
+		otherClaim := &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-claim", Namespace: "claim-namespace"},
+			Spec:       awsv1alpha1.AccountClaimSpec{AccountLink: account.Name},
+		}
+		r := reconciler(accountClaim, account, otherClaim)
+
+		conflict, err := r.reconcileAccountLinkIntegrity(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflict).To(BeTrue())
+		Expect(getAccount(r).Spec.ClaimLink).To(Equal("other-claim"))
+
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+		Expect(accountClaim.Status.State).To(Equal(awsv1alpha1.ClaimStatusError))
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.AccountLinkConflict)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("claim-namespace/other-claim"))
+
+		// Once the other claim releases the account, the back-reference is repaired and the condition cleared
+		otherClaim.Spec.AccountLink = "osd-creds-mgmt-bbbbbb"
+		Expect(r.Update(context.TODO(), otherClaim)).To(Succeed())
+		conflict, err = r.reconcileAccountLinkIntegrity(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflict).To(BeFalse())
+		Expect(getAccount(r).Spec.ClaimLink).To(Equal("claim"))
+		condition = controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.AccountLinkConflict)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	})
+})
@@ -3,9 +3,9 @@ package accountclaim
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -22,8 +22,12 @@ import (
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -150,11 +154,19 @@ type AccountClaimReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	awsClientBuilder awsclient.IBuilder
+	recorder         record.EventRecorder
+}
+
+// operatorConfig returns the operator configuration, read with the client of the reconciler
+func (r *AccountClaimReconciler) operatorConfig() *controllerutils.OperatorConfig {
+	return controllerutils.NewOperatorConfig(r.Client)
 }
 
 //+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=accountclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=accountclaims/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=accountclaims/finalizers,verbs=update
+//+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=accountclaimtemplates,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // NewReconcileAccountClaim initializes ReconcileAccountClaim
 //
@@ -171,11 +183,11 @@ func NewAccountClaimReconciler(client client.Client, scheme *runtime.Scheme, aws
 // and what is in the AccountClaim.Spec
 // The Controller will requeue the Request to be processed again if the returned error is non-nil or
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
-func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
 	reqLogger := log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	// Watch AccountClaim
 	accountClaim := &awsv1alpha1.AccountClaim{}
-	err := r.Get(context.TODO(), request.NamespacedName, accountClaim)
+	err = r.Get(context.TODO(), request.NamespacedName, accountClaim)
 	if err != nil {
 		if k8serr.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
@@ -186,6 +198,9 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
+	reqLogger = controllerutils.StartTrace(ctx, r.Client, controllerName, accountClaim, reqLogger)
+	trace := controllerutils.TraceFromContext(ctx)
+	trace.Step("Claim state %q, account link %q, BYOC %t", accountClaim.Status.State, accountClaim.Spec.AccountLink, accountClaim.Spec.BYOC)
 
 	// Fake Account Claim Process for Hive Testing ..
 	// Fake account claims are account claims which have the label `managed.openshift.com/fake: true`
@@ -225,6 +240,17 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 	}
 
 	if accountClaim.DeletionTimestamp != nil {
+		trace.Step("Claim pending deletion")
+		// Give external deprovisioners a chance to run before we touch the account
+		waitForHook, err := r.handlePreResetHook(reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if waitForHook {
+			trace.Step("Waiting for the pre-reset hook")
+			return reconcile.Result{RequeueAfter: preResetHookRetryInterval}, nil
+		}
+
 		if accountClaim.Spec.FleetManagerConfig.TrustedARN != "" {
 			if r.checkIAMSecretExists(accountClaim.Spec.AwsCredentialSecret.Name, accountClaim.Spec.AwsCredentialSecret.Namespace) {
 				err = r.deleteIAMSecret(reqLogger, accountClaim.Spec.AwsCredentialSecret.Name, accountClaim.Spec.AwsCredentialSecret.Namespace)
@@ -245,6 +271,7 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 					SecretName: controllerutils.AwsSecretName,
 					NameSpace:  awsv1alpha1.AccountCrNamespace,
 					AwsRegion:  awsRegion,
+					Trace:      trace,
 				})
 				if err != nil {
 					reqLogger.Error(err, "failed building operator AWS client")
@@ -262,7 +289,26 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 				reqLogger.V(1).Info("successfully cleaned up IAM role and policies", "accountclaim", accountClaim.Name)
 			}
 		}
-		return reconcile.Result{}, r.handleAccountClaimDeletion(reqLogger, accountClaim)
+		return reconcile.Result{}, r.handleAccountClaimDeletion(ctx, reqLogger, accountClaim)
+	}
+
+	untilDeadline, err := r.reconcileFulfillmentDeadline(ctx, reqLogger, accountClaim, time.Now())
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if untilDeadline > 0 {
+		// Reconcile the claim at its deadline, even when nothing else happens to it
+		defer func() {
+			result = requeueBefore(result, err, untilDeadline)
+		}()
+	}
+
+	templateApplied, err := r.applyAccountClaimTemplate(reqLogger, accountClaim)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if templateApplied {
+		return reconcile.Result{}, r.specUpdate(reqLogger, accountClaim)
 	}
 
 	isCCS := accountClaim.Spec.BYOCAWSAccountID != ""
@@ -273,17 +319,105 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		localmetrics.Collector.SetAccountClaimPendingDuration(isCCS, pendingDuration.Seconds())
 	}
 
+	if accountClaim.Status.State != awsv1alpha1.ClaimStatusReady {
+		trimmed, err := r.trimUnsupportedRegions(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if trimmed {
+			return reconcile.Result{}, nil
+		}
+
+		unsupported, err := r.reconcilePartitionSupport(reqLogger, accountClaim)
+		if err != nil || unsupported {
+			return reconcile.Result{}, err
+		}
+
+		err = r.admitClaim(ctx, reqLogger, accountClaim)
+		if err != nil && !isAdmissionDenied(err) {
+			reqLogger.Error(err, "failed evaluating the claim admission")
+			return reconcile.Result{}, err
+		}
+		if err != nil {
+			reqLogger.Error(err, "claim not admitted")
+			controllerutils.SetAccountClaimStatus(
+				accountClaim,
+				"Invalid AccountClaim",
+				err.Error(),
+				awsv1alpha1.InvalidAccountClaim,
+				awsv1alpha1.ClaimStatusError,
+			)
+			if updateErr := r.statusUpdate(reqLogger, accountClaim); updateErr != nil {
+				return reconcile.Result{}, updateErr
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	conflict, err := r.reconcileAccountLinkIntegrity(reqLogger, accountClaim)
+	if err != nil || conflict {
+		return reconcile.Result{}, err
+	}
+
+	drifted, err := r.reconcileSpecDrift(reqLogger, accountClaim)
+	if err != nil || drifted {
+		return reconcile.Result{}, err
+	}
+
+	if accountClaim.Status.State == awsv1alpha1.ClaimStatusReady {
+		err = r.propagateClaimLabels(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		err = r.propagateClaimTags(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if accountClaim.Spec.BYOC {
-		return r.handleBYOCAccountClaim(reqLogger, accountClaim)
+		trace.Step("Handling the BYOC claim")
+		return r.handleBYOCAccountClaim(ctx, reqLogger, accountClaim)
 	}
 
 	// Return if this claim has been satisfied
 	if claimIsSatisfied(accountClaim) {
 		reqLogger.Info(fmt.Sprintf("Claim %s has been satisfied ignoring", accountClaim.Name))
-		return reconcile.Result{}, nil
+		err = r.reconcileRegionalSecrets(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		err = r.reconcileSecretReaderRBAC(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		err = r.reconcileRegionReport(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		result, err := r.reconcileTemporaryQuotas(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		permissionsResult, err := r.reconcileVpcEndpointServicePermissions(reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		return earliestRequeue(result, permissionsResult), nil
 	}
 
 	if accountClaim.Status.State == "" {
+		needsApproval, err := r.requiresApproval(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if needsApproval {
+			message := "Waiting for the claim to be approved"
+			reqLogger.Info(message)
+			controllerutils.SetAccountClaimStatus(accountClaim, message, "WaitingForApproval", awsv1alpha1.ApprovalPending, awsv1alpha1.ClaimStatusPendingApproval)
+			return reconcile.Result{}, r.statusUpdate(reqLogger, accountClaim)
+		}
+
 		message := "Attempting to claim account"
 		reqLogger.Info(message)
 		accountClaim.Status.State = awsv1alpha1.ClaimStatusPending
@@ -302,6 +436,14 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		return reconcile.Result{}, r.statusUpdate(reqLogger, accountClaim)
 	}
 
+	if accountClaim.Status.State == awsv1alpha1.ClaimStatusPendingApproval {
+		return r.handlePendingApproval(ctx, reqLogger, accountClaim, time.Now())
+	}
+	if isApprovalDenied(accountClaim) {
+		reqLogger.Info(fmt.Sprintf("Claim %s wasn't approved, ignoring", accountClaim.Name))
+		return reconcile.Result{}, nil
+	}
+
 	var unclaimedAccount *awsv1alpha1.Account
 
 	// Get an unclaimed account from the pool
@@ -318,14 +460,23 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 			return reconcile.Result{}, err
 		}
 		reqLogger.V(1).Info("successfully got claimed account", "accountclaim", accountClaim.Name)
+
+		if failed, err := r.reconcileAccountFailure(reqLogger, accountClaim, unclaimedAccount); err != nil || failed {
+			return reconcile.Result{}, err
+		}
 	}
 
 	// Set Account.Spec.ClaimLink
 	// This will trigger the reconcile loop for the account which will mark the account as claimed in its status
 	if unclaimedAccount.Spec.ClaimLink == "" {
-		updateClaimedAccountFields(reqLogger, unclaimedAccount, accountClaim)
-		err := r.accountSpecUpdate(reqLogger, unclaimedAccount)
+		err := r.claimAccount(reqLogger, unclaimedAccount, accountClaim)
 		if err != nil {
+			if k8serr.IsConflict(err) {
+				// Another claim was faster, select another account
+				reqLogger.Info("account was claimed concurrently, retrying", "account", unclaimedAccount.Name)
+				return reconcile.Result{Requeue: true}, nil
+			}
+			reqLogger.Error(err, fmt.Sprintf("Failed to claim account %s", unclaimedAccount.Name))
 			return reconcile.Result{}, err
 		}
 		reqLogger.V(1).Info("successfully updated claimLink", "accountclaim", accountClaim.Name)
@@ -338,6 +489,11 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		return reconcile.Result{}, r.specUpdate(reqLogger, accountClaim)
 	}
 
+	err = r.recordClaimPhase(reqLogger, accountClaim, awsv1alpha1.ClaimPhaseSelected)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !accountClaim.Spec.ManualSTSMode {
 		err = r.setSupportRoleARNManagedOpenshift(reqLogger, accountClaim, unclaimedAccount)
 		reqLogger.V(1).Info("successfully set the support role ARN", "accountclaim", accountClaim.Name)
@@ -346,8 +502,9 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		}
 	}
 
-	// Set awsAccountClaim.Spec.AwsAccountOU
-	if accountClaim.Spec.AccountOU == "" || accountClaim.Spec.AccountOU == "ROOT" {
+	// Set awsAccountClaim.Spec.AwsAccountOU, to the required OU of the claim once the account is verified in it
+	notInRequiredOU := accountClaim.Spec.RequiredOU != "" && accountClaim.Spec.AccountOU != accountClaim.Spec.RequiredOU
+	if accountClaim.Spec.AccountOU == "" || accountClaim.Spec.AccountOU == "ROOT" || notInRequiredOU {
 		// Determine if in fedramp env
 		awsRegion := config.GetDefaultRegion()
 
@@ -356,6 +513,7 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 			SecretName: controllerutils.AwsSecretName,
 			NameSpace:  awsv1alpha1.AccountCrNamespace,
 			AwsRegion:  awsRegion,
+			Trace:      trace,
 		})
 		if err != nil {
 			unexpectedErrorMsg := "OU: Failed to build aws client"
@@ -363,8 +521,13 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 			return reconcile.Result{}, err
 		}
 
-		err = MoveAccountToOU(r, reqLogger, awsClient, accountClaim, unclaimedAccount)
-		if err != nil {
+		if accountClaim.Spec.RequiredOU != "" {
+			waiting, result, err := r.waitForRequiredOU(reqLogger, awsClient, accountClaim, unclaimedAccount)
+			if err != nil || waiting {
+				trace.Step("Waiting for the account to be in the required OU")
+				return result, err
+			}
+		} else if err = MoveAccountToOU(r, reqLogger, awsClient, accountClaim, unclaimedAccount); err != nil {
 			if err == awsv1alpha1.ErrAccMoveRaceCondition {
 				// Due to a race condition, we need to requeue the reconcile to ensure that the account was correctly moved into the correct OU
 				return reconcile.Result{Requeue: true}, nil
@@ -373,13 +536,18 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		}
 		reqLogger.V(1).Info("successfully moved account to OU", "accountclaimName", accountClaim.Name, "account", unclaimedAccount.Name)
 	}
-	cm, err := controllerutils.GetOperatorConfigMap(r.Client)
+	err = r.recordClaimPhase(reqLogger, accountClaim, awsv1alpha1.ClaimPhaseRolesConfigured)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	cm, err := r.operatorConfig().ConfigMap(ctx)
 	if err != nil {
 		log.Error(err, "Could not retrieve the operator configmap")
 		return controllerutils.RequeueAfter(5 * time.Minute)
 	}
 
-	enabled, err := strconv.ParseBool(cm.Data["feature.accountclaim_fleet_manager_trusted_arn"])
+	enabled, err := strconv.ParseBool(cm.Data[fleetManagerTrustedARNFeatureFlag])
 	if err != nil {
 		log.Info("Could not retrieve feature flag 'feature.accountclaim_fleet_manager_trusted_arn' - fleet manager accountclaim is disabled")
 	} else {
@@ -396,6 +564,7 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 				SecretName: controllerutils.AwsSecretName,
 				NameSpace:  awsv1alpha1.AccountCrNamespace,
 				AwsRegion:  awsRegion,
+				Trace:      trace,
 			})
 			if err != nil {
 				reqLogger.Error(err, "failed building operator AWS client")
@@ -407,21 +576,24 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 				return reconcile.Result{}, err
 			}
 
-			err = r.CleanUpIAMRoleAndPolicies(reqLogger, awsClient, stsRoleName)
+			// Implement IAM user deletion logic, a page of users per reconcile
+			swept, err := r.sweepIAMUsers(reqLogger, awsClient, accountClaim, unclaimedAccount)
 			if err != nil {
-				return reconcile.Result{}, err
+				return reconcile.Result{}, fmt.Errorf("failed deleting IAM users: %v", err)
+			}
+			if !swept {
+				trace.Step("IAM users left to delete")
+				return reconcile.Result{Requeue: true}, nil
 			}
 
-			roleARN, err := r.createIAMRoleWithPermissions(reqLogger, awsClient, stsRoleName, accountClaim.Spec.FleetManagerConfig.TrustedARN)
+			roleARN, err := r.reconcileFleetManagerRole(reqLogger, awsClient, stsRoleName, accountClaim.Spec.FleetManagerConfig.TrustedARN, awsclient.ClaimIAMOwnership(accountClaim))
+			if statusErr := r.reportRoleNameCollision(reqLogger, accountClaim, err); statusErr != nil {
+				reqLogger.Error(statusErr, "failed updating the RoleNameCollision condition")
+			}
 			if err != nil {
 				return reconcile.Result{}, err
 			}
 
-			// Implement IAM user deletion logic
-			if err := account.DeleteIAMUsers(reqLogger, awsClient, unclaimedAccount); err != nil {
-				return reconcile.Result{}, fmt.Errorf("failed deleting IAM users: %v", err)
-			}
-
 			// Deletes account IAM user Secret
 			if r.checkIAMSecretExists(unclaimedAccount.Spec.IAMUserSecret, unclaimedAccount.Namespace) {
 				err := r.deleteIAMSecret(reqLogger, unclaimedAccount.Spec.IAMUserSecret, unclaimedAccount.Namespace)
@@ -456,6 +628,14 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 			log.Info("Would attempt to create IAM Role with permission here, but fleet manager accountclaim is disabled.")
 		}
 	} else {
+		// Restrict the IAM user to the credential profile of the claim before delivering its credentials
+		if !accountClaim.Spec.ManualSTSMode {
+			err = r.applyCredentialProfile(ctx, reqLogger, accountClaim, unclaimedAccount)
+			if err != nil {
+				reqLogger.Error(err, "failed applying the credential profile")
+				return reconcile.Result{}, err
+			}
+		}
 
 		// Create secret for OCM to consume
 		if !r.checkIAMSecretExists(accountClaim.Spec.AwsCredentialSecret.Name, accountClaim.Spec.AwsCredentialSecret.Namespace) {
@@ -467,7 +647,31 @@ func (r *AccountClaimReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		}
 	}
 
+	if r.checkIAMSecretExists(accountClaim.Spec.AwsCredentialSecret.Name, accountClaim.Spec.AwsCredentialSecret.Namespace) {
+		err = r.recordClaimPhase(reqLogger, accountClaim, awsv1alpha1.ClaimPhaseCredentialsProvisioned)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		err = r.reconcileRegionalSecrets(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		err = r.trackDeliveredSecrets(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		err = r.reconcileSecretReaderRBAC(ctx, reqLogger, accountClaim)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if accountClaim.Status.State != awsv1alpha1.ClaimStatusReady && accountClaim.Spec.AccountLink != "" {
+		waiting, result, err := r.waitForRequiredQuotas(reqLogger, accountClaim, unclaimedAccount)
+		if err != nil || waiting {
+			trace.Step("Waiting for the required quotas")
+			return result, err
+		}
 		// Set AccountClaim.Status.Conditions and AccountClaim.Status.State to Ready
 		setAccountClaimStatus(reqLogger, unclaimedAccount, accountClaim)
 		reqLogger.V(1).Info("successfully updated accountclaim status to Ready", "accountclaim", accountClaim.Name)
@@ -577,6 +781,10 @@ func (r *AccountClaimReconciler) createIAMRoleSecret(reqLogger logr.Logger, acco
 	}
 
 	OCMSecret := newStsSecretforCR(OCMSecretName, OCMSecretNamespace, []byte(roleARN))
+	OCMSecret.Labels = controllerutils.ClaimSecretLabels(accountClaim)
+	if err := setCLIFiles(accountClaim, OCMSecret, cliRegion(accountClaim)); err != nil {
+		reqLogger.Error(err, "delivering the role without AWS CLI files")
+	}
 
 	err := r.Create(context.TODO(), OCMSecret)
 	if err != nil {
@@ -593,73 +801,6 @@ func (r *AccountClaimReconciler) createIAMRoleSecret(reqLogger logr.Logger, acco
 	return nil
 }
 
-// CreateIAMRoleWithPermissions creates an IAM role with the specified permissions' policy.
-func (r *AccountClaimReconciler) createIAMRoleWithPermissions(reqLogger logr.Logger, awsClient awsclient.Client, roleName string, trustedARN string) (string, error) {
-	type awsStatement struct {
-		Effect    string                 `json:"Effect"`
-		Action    []string               `json:"Action"`
-		Resource  []string               `json:"Resource,omitempty"`
-		Principal *awsv1alpha1.Principal `json:"Principal,omitempty"`
-	}
-
-	assumeRolePolicyDoc := struct {
-		Version   string
-		Statement []awsStatement
-	}{
-		Version: "2012-10-17",
-		Statement: []awsStatement{{
-			Effect: "Allow",
-			Action: []string{"sts:AssumeRole"},
-			Principal: &awsv1alpha1.Principal{
-				AWS: []string{trustedARN},
-			},
-		}},
-	}
-	// Convert role to JSON
-	jsonAssumeRolePolicyDoc, err := json.Marshal(assumeRolePolicyDoc)
-	if err != nil {
-		return "", err
-	}
-
-	reqLogger.Info(fmt.Sprintf("Creating role: %s", roleName))
-	createRoleOutput, err := awsClient.CreateRole(context.TODO(), &iam.CreateRoleInput{
-		RoleName:                 aws.String(roleName),
-		Description:              aws.String("Managed by AAO"),
-		AssumeRolePolicyDocument: aws.String(string(jsonAssumeRolePolicyDoc)),
-	})
-	if err != nil {
-		return "", err
-	}
-	reqLogger.Info(fmt.Sprintf("Role %s created", *createRoleOutput.Role.RoleName))
-
-	arnComponents := strings.Split(*createRoleOutput.Role.Arn, ":")
-	accountId := arnComponents[4]
-
-	policyDocument, err := generateInlinePolicy(accountId)
-	if err != nil {
-		return "", err
-	}
-
-	// Attach the permissions policy to the role
-	_, err = awsClient.PutRolePolicy(context.TODO(), &iam.PutRolePolicyInput{
-		PolicyName:     aws.String(stsPolicyName),
-		RoleName:       aws.String(roleName),
-		PolicyDocument: aws.String(policyDocument),
-	})
-
-	if err != nil {
-		// If there was an error, clean up by deleting the role
-		_, roleDeleteErr := awsClient.DeleteRole(context.TODO(), &iam.DeleteRoleInput{
-			RoleName: aws.String(roleName),
-		})
-		if roleDeleteErr != nil {
-			reqLogger.Error(roleDeleteErr, "Failed to delete role")
-		}
-		return ``, err
-	}
-
-	return *createRoleOutput.Role.Arn, nil
-}
 func (r *AccountClaimReconciler) setSupportRoleARNManagedOpenshift(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) error {
 	if accountClaim.Spec.STSRoleARN == "" {
 		instanceID := account.Labels[awsv1alpha1.IAMUserIDLabel]
@@ -669,7 +810,7 @@ func (r *AccountClaimReconciler) setSupportRoleARNManagedOpenshift(reqLogger log
 	return nil
 }
 
-func (r *AccountClaimReconciler) handleAccountClaimDeletion(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+func (r *AccountClaimReconciler) handleAccountClaimDeletion(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
 
 	if !controllerutils.Contains(accountClaim.GetFinalizers(), accountClaimFinalizer) {
 		return nil
@@ -677,15 +818,18 @@ func (r *AccountClaimReconciler) handleAccountClaimDeletion(reqLogger logr.Logge
 
 	// Workaround for FleetManagers special account handling, see
 	// https://issues.redhat.com/browse/OSD-19093
-	if len(accountClaim.GetFinalizers()) > 1 {
-		reqLogger.Info("Found additional finalizers on AccountClaim. Not attempting cleanup.")
-		return nil
+	if blocking := blockingFinalizers(accountClaim); len(blocking) > 0 {
+		reqLogger.Info("Found additional finalizers on AccountClaim. Not attempting cleanup.", "finalizers", blocking)
+		return r.reportBlockingFinalizers(reqLogger, accountClaim, blocking)
 	}
 
 	// Only do AWS cleanup and account reset if accountLink is not empty
 	// We will not attempt AWS cleanup if the account is BYOC since we're not going to reuse these accounts
 	if accountClaim.Spec.AccountLink != "" {
-		err := r.finalizeAccountClaim(reqLogger, accountClaim)
+		// When claims are deleted together, assume their roles as a batch ahead of their cleanups
+		r.prewarmCleanupCredentials(ctx, reqLogger)
+
+		err := r.finalizeAccountClaim(ctx, reqLogger, accountClaim)
 		if err != nil {
 			// If the finalize/cleanup process fails for an account we don't want to return
 			// we will flag the account with the Failed Reuse condition, and with state = Failed
@@ -696,6 +840,27 @@ func (r *AccountClaimReconciler) handleAccountClaimDeletion(reqLogger logr.Logge
 				return fmt.Errorf("account CR modified during reset: %w", err)
 			}
 
+			// The cleanup is retried once the region of the claim recovers from its outage, the account isn't broken
+			if isRegionOutage(err) {
+				return err
+			}
+
+			// A mislinked CCS claim says nothing about the state of the account, the cleanup is retried once it's fixed
+			if isBYOCOwnershipMismatch(err) {
+				return err
+			}
+
+			// The dry run of the cleanup keeps the claim until its annotation is removed, and is retried when it failed
+			if isCleanupDryRun(err) {
+				return errors.Unwrap(err)
+			}
+
+			// In dry-run mode the cleanup stops at its first AWS write, the account isn't broken
+			if awsclient.IsDryRunError(err) {
+				reqLogger.Info("dry-run: the AWS account would be cleaned up", "skipped", err.Error())
+				return err
+			}
+
 			// Get account claimed by deleted accountclaim
 			failedReusedAccount, accountErr := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
 			if accountErr != nil {
@@ -713,11 +878,26 @@ func (r *AccountClaimReconciler) handleAccountClaimDeletion(reqLogger logr.Logge
 		}
 	}
 
+	// Regional credentials secrets may live outside the claim namespace, so they aren't deleted along with it
+	if err := r.reconcileRegionalSecrets(ctx, reqLogger, accountClaim); err != nil {
+		return err
+	}
+
+	// Neither are the other delivered secrets when the claim and its credentials secret are in different namespaces
+	if err := r.deleteDeliveredSecrets(ctx, reqLogger, accountClaim); err != nil {
+		return err
+	}
+
+	// Nor the Role and RoleBinding granting access to them
+	if err := r.reconcileSecretReaderRBAC(ctx, reqLogger, accountClaim); err != nil {
+		return err
+	}
+
 	// Remove finalizer to unlock deletion of the accountClaim
 	return r.removeFinalizer(reqLogger, accountClaim, accountClaimFinalizer)
 }
 
-func (r *AccountClaimReconciler) handleBYOCAccountClaim(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (reconcile.Result, error) {
+func (r *AccountClaimReconciler) handleBYOCAccountClaim(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (reconcile.Result, error) {
 	if !accountClaim.Spec.BYOC {
 		return reconcile.Result{}, nil
 	}
@@ -746,7 +926,7 @@ func (r *AccountClaimReconciler) handleBYOCAccountClaim(reqLogger logr.Logger, a
 				awsv1alpha1.InvalidAccountClaim,
 				awsv1alpha1.ClaimStatusError,
 			)
-			err := r.Client.Status().Update(context.TODO(), accountClaim)
+			err := r.statusUpdate(reqLogger, accountClaim)
 			if err != nil {
 				reqLogger.Error(err, "Failed to Update AccountClaim Status")
 			}
@@ -756,6 +936,17 @@ func (r *AccountClaimReconciler) handleBYOCAccountClaim(reqLogger logr.Logger, a
 		}
 		reqLogger.V(1).Info("successfully validated account linked to accountclaim ", "accountclaim", accountClaim.Name)
 
+		// Catch a role that doesn't trust the operator now, rather than with an AccessDenied during account init
+		if accountClaim.Spec.ManualSTSMode {
+			waitForTrust, err := r.reconcileSTSRoleTrust(ctx, reqLogger, accountClaim)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if waitForTrust {
+				return reconcile.Result{RequeueAfter: stsPreflightRetryInterval}, nil
+			}
+		}
+
 		// Create a new account with BYOC flag
 		err := r.createAccountForBYOCClaim(accountClaim)
 		if err != nil {
@@ -774,22 +965,29 @@ func (r *AccountClaimReconciler) handleBYOCAccountClaim(reqLogger logr.Logger, a
 		return reconcile.Result{}, err
 	}
 
+	err = r.recordClaimPhase(reqLogger, accountClaim, awsv1alpha1.ClaimPhaseSelected)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if byocAccount.IsFailed() {
+		message := "CCS Account Failed"
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.CCSAccountClaimFailed,
+			corev1.ConditionTrue,
+			string(awsv1alpha1.CCSAccountClaimFailed),
+			message,
+			controllerutils.UpdateConditionNever,
+			accountClaim.Spec.BYOCAWSAccountID != "",
+		)
+	}
+	// Surface why the account failed on the claim, or clear it once the account recovered
+	if failed, err := r.reconcileAccountFailure(reqLogger, accountClaim, byocAccount); err != nil || failed {
+		return reconcile.Result{}, err
+	}
+
 	if !byocAccount.IsReady() {
-		if byocAccount.IsFailed() {
-			accountClaim.Status.State = awsv1alpha1.ClaimStatusError
-			message := "CCS Account Failed"
-			accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
-				accountClaim.Status.Conditions,
-				awsv1alpha1.CCSAccountClaimFailed,
-				corev1.ConditionTrue,
-				string(awsv1alpha1.CCSAccountClaimFailed),
-				message,
-				controllerutils.UpdateConditionNever,
-				accountClaim.Spec.BYOCAWSAccountID != "",
-			)
-			// Update the status on AccountClaim
-			return reconcile.Result{}, r.statusUpdate(reqLogger, accountClaim)
-		}
 		waitMsg := fmt.Sprintf("%s is not Ready yet, requeuing in %d seconds", byocAccount.Name, waitPeriod)
 		reqLogger.Info(waitMsg, "Account Status", byocAccount.Status.State)
 		return reconcile.Result{RequeueAfter: time.Second * waitPeriod}, nil
@@ -807,6 +1005,7 @@ func (r *AccountClaimReconciler) handleBYOCAccountClaim(reqLogger logr.Logger, a
 			controllerutils.UpdateConditionNever,
 			accountClaim.Spec.BYOCAWSAccountID != "",
 		)
+		markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseReady, metav1.Now())
 		reqLogger.V(1).Info(fmt.Sprintf("%s is Ready", byocAccount.Name), "accountclaim", accountClaim.Name, "Account Status", byocAccount.Status.State)
 		// Update the status on AccountClaim
 		return reconcile.Result{}, r.statusUpdate(reqLogger, accountClaim)
@@ -829,14 +1028,28 @@ func (r *AccountClaimReconciler) handleBYOCAccountClaim(reqLogger logr.Logger, a
 		}
 	}
 
-	return reconcile.Result{}, nil
-
+	err = r.reconcileRegionalSecrets(ctx, reqLogger, accountClaim)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	err = r.trackDeliveredSecrets(ctx, reqLogger, accountClaim)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, r.reconcileSecretReaderRBAC(ctx, reqLogger, accountClaim)
 }
 
 func (r *AccountClaimReconciler) createAccountForBYOCClaim(accountClaim *awsv1alpha1.AccountClaim) error {
 	// Create a new account with BYOC flag
 	newAccount := account.GenerateAccountCR(awsv1alpha1.AccountCrNamespace)
 	populateBYOCSpec(newAccount, accountClaim)
+	if accountClaim.Spec.TemplateRef != "" {
+		template, err := r.getAccountClaimTemplate(accountClaim.Spec.TemplateRef)
+		if err != nil {
+			return err
+		}
+		newAccount.Spec.RegionalServiceQuotas = template.Spec.RegionalServiceQuotas.DeepCopy()
+	}
 	controllerutils.AddFinalizer(newAccount, accountClaimFinalizer)
 
 	// Create the new account
@@ -890,6 +1103,7 @@ func (r *AccountClaimReconciler) getUnclaimedAccount(reqLogger logr.Logger, acco
 	}
 
 	var unusedAccount *awsv1alpha1.Account
+	candidates := []*awsv1alpha1.Account{}
 
 	for _, loopAccount := range accountList.Items {
 		// assign to new variable to prevent issues with using a pointer to the loop var later
@@ -902,6 +1116,12 @@ func (r *AccountClaimReconciler) getUnclaimedAccount(reqLogger logr.Logger, acco
 			continue
 		}
 
+		// Claims with required quotas pick the account whose quotas satisfy them best, see below
+		if len(accountClaim.Spec.RequiredQuotas) > 0 {
+			candidates = append(candidates, &account)
+			continue
+		}
+
 		if account.Status.Reused {
 			reqLogger.Info(fmt.Sprintf("Reusing account: %s", account.Name))
 			return &account, nil
@@ -910,6 +1130,13 @@ func (r *AccountClaimReconciler) getUnclaimedAccount(reqLogger logr.Logger, acco
 		}
 	}
 
+	if len(candidates) > 0 {
+		selected, unmet := selectAccountForRequiredQuotas(accountClaim, candidates)
+		reqLogger.Info(fmt.Sprintf("Claiming account: %s", selected.Name), "candidates", len(candidates), "unmetQuotas", unmet)
+		r.recordAccountSelection(accountClaim, selected, unmet, len(candidates))
+		return selected, nil
+	}
+
 	if unusedAccount != nil {
 		reqLogger.Info(fmt.Sprintf("Claiming account: %s", unusedAccount.Name))
 		return unusedAccount, nil
@@ -1006,6 +1233,10 @@ func (r *AccountClaimReconciler) createIAMSecret(reqLogger logr.Logger, accountC
 	}
 
 	OCMSecret := newSecretforCR(OCMSecretName, OCMSecretNamespace, awsAccessKeyID, awsSecretAccessKey)
+	OCMSecret.Labels = controllerutils.ClaimSecretLabels(accountClaim)
+	if err := setCLIFiles(accountClaim, OCMSecret, cliRegion(accountClaim)); err != nil {
+		reqLogger.Error(err, "delivering the credentials without AWS CLI files")
+	}
 
 	err = r.Create(context.TODO(), OCMSecret)
 	if err != nil {
@@ -1029,11 +1260,19 @@ func (r *AccountClaimReconciler) checkIAMSecretExists(name string, namespace str
 }
 
 func (r *AccountClaimReconciler) statusUpdate(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	previous := &awsv1alpha1.AccountClaim{}
+	previousErr := r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), previous)
+
+	accountClaim.Status.ObservedGeneration = accountClaim.Generation
 	err := r.Client.Status().Update(context.TODO(), accountClaim)
 	if err != nil {
 		reqLogger.Error(err, fmt.Sprintf("Status update for %s failed", accountClaim.Name))
+		return err
 	}
-	return err
+	if previousErr == nil && previous.Status.State != accountClaim.Status.State {
+		r.notifyClaimState(reqLogger, accountClaim)
+	}
+	return nil
 }
 
 func (r *AccountClaimReconciler) specUpdate(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
@@ -1077,6 +1316,7 @@ func setAccountClaimStatus(reqLogger logr.Logger, awsAccount *awsv1alpha1.Accoun
 		awsAccountClaim.Spec.BYOCAWSAccountID != "",
 	)
 	awsAccountClaim.Status.State = awsv1alpha1.ClaimStatusReady
+	markClaimPhase(awsAccountClaim, awsv1alpha1.ClaimPhaseReady, metav1.Now())
 	reqLogger.Info(fmt.Sprintf("Account %s condition status updated", awsAccountClaim.Name))
 }
 
@@ -1095,6 +1335,19 @@ func claimIsSatisfied(accountClaim *awsv1alpha1.AccountClaim) bool {
 	return accountClaim.Spec.AccountLink != "" && accountClaim.Status.State == awsv1alpha1.ClaimStatusReady && accountClaim.Spec.AccountOU != ""
 }
 
+// earliestRequeue combines the results of the periodic reconciles of a satisfied claim, so it's requeued by the
+// earliest of them
+func earliestRequeue(results ...reconcile.Result) reconcile.Result {
+	earliest := reconcile.Result{}
+	for _, result := range results {
+		if result.RequeueAfter > 0 && (earliest.RequeueAfter == 0 || result.RequeueAfter < earliest.RequeueAfter) {
+			earliest.RequeueAfter = result.RequeueAfter
+		}
+		earliest.Requeue = earliest.Requeue || result.Requeue
+	}
+	return earliest
+}
+
 func newSecretforCR(secretName string, secretNameSpace string, awsAccessKeyID []byte, awsSecretAccessKey []byte) *corev1.Secret {
 	return &corev1.Secret{
 		Type: "Opaque",
@@ -1124,9 +1377,30 @@ func populateBYOCSpec(account *awsv1alpha1.Account, accountClaim *awsv1alpha1.Ac
 	account.Spec.ManualSTSMode = accountClaim.Spec.ManualSTSMode
 }
 
+// RecordPanic records a panic of the reconcile of the claim in its AccountClaimFailed condition, see
+// controllerutils.PanicRecorder
+func (r *AccountClaimReconciler) RecordPanic(ctx context.Context, request ctrl.Request, message string) error {
+	accountClaim := &awsv1alpha1.AccountClaim{}
+	if err := r.Get(ctx, request.NamespacedName, accountClaim); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.AccountClaimFailed,
+		corev1.ConditionTrue,
+		controllerutils.ReconcilePanicReason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	return r.Status().Update(ctx, accountClaim)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AccountClaimReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = controllerutils.NewSecretAuditClient(r.Client, controllerName)
 	r.awsClientBuilder = &awsclient.Builder{}
+	r.recorder = controllerutils.NewDedupingEventRecorder(mgr.GetEventRecorderFor(controllerName), controllerutils.DefaultEventDeduplicationWindow)
 	maxReconciles, err := controllerutils.GetControllerMaxReconciles(controllerName)
 	if err != nil {
 		log.Error(err, "missing max reconciles for controller", "controller", controllerName)
@@ -1134,8 +1408,10 @@ func (r *AccountClaimReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	rwm := controllerutils.NewReconcilerWithMetrics(r, controllerName)
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&awsv1alpha1.AccountClaim{}).
-		Owns(&awsv1alpha1.Account{}).
+		For(&awsv1alpha1.AccountClaim{}, controllerutils.WithReconcilePredicates(controllerName, controllerutils.AccountClaimStatusTrigger)).
+		Owns(&awsv1alpha1.Account{}, controllerutils.WithReconcilePredicates(controllerName, controllerutils.AccountStatusTrigger)).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.claimsForConfigMap),
+			builder.WithPredicates(controllerutils.OperatorConfigMapKeysChanged(gatingConfigMapKeys...))).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}).Complete(rwm)
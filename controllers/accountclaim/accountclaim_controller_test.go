@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -33,6 +34,7 @@ import (
 	"github.com/openshift/aws-account-operator/config"
 	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
 	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
 	"github.com/openshift/aws-account-operator/test/fixtures"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -187,6 +189,7 @@ var _ = Describe("AccountClaim", func() {
 				mockAWSClient.EXPECT().ListHostedZones(gomock.Any(), gomock.Any()).Return(lhzo, nil)
 				mockAWSClient.EXPECT().ListBuckets(gomock.Any(), gomock.Any()).Return(lbo, nil)
 				mockAWSClient.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any()).Return(dvpcesco, nil)
+				mockAWSClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{}, nil)
 				mockAWSClient.EXPECT().DescribeSnapshots(gomock.Any(), gomock.Any()).Return(dso, nil)
 				mockAWSClient.EXPECT().DescribeVolumes(gomock.Any(), gomock.Any()).Return(dvo, nil)
 
@@ -210,6 +213,9 @@ var _ = Describe("AccountClaim", func() {
 				Expect(acc.Spec.ClaimLinkNamespace).To(BeEmpty())
 				Expect(acc.Status.State).To(Equal(string(awsv1alpha1.AccountReady)))
 				Expect(acc.Status.Reused).To(BeTrue())
+				Expect(acc.Status.LastCleanup).NotTo(BeNil())
+				Expect(acc.Status.LastCleanup.Succeeded).To(BeTrue())
+				Expect(acc.Status.LastCleanup.Steps).To(HaveLen(5))
 			})
 
 			It("should retry on a conflict error", func() {
@@ -256,6 +262,7 @@ var _ = Describe("AccountClaim", func() {
 				mockAWSClient.EXPECT().ListHostedZones(gomock.Any(), gomock.Any()).Return(lhzo, nil)
 				mockAWSClient.EXPECT().ListBuckets(gomock.Any(), gomock.Any()).Return(lbo, nil)
 				mockAWSClient.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any()).Return(dvpcesco, nil)
+				mockAWSClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{}, nil)
 				mockAWSClient.EXPECT().DescribeSnapshots(gomock.Any(), gomock.Any()).Return(dso, nil)
 				mockAWSClient.EXPECT().DescribeVolumes(gomock.Any(), gomock.Any()).Return(dvo, nil)
 
@@ -296,7 +303,7 @@ var _ = Describe("AccountClaim", func() {
 				mockAWSClient.EXPECT().ListHostedZones(gomock.Any(), gomock.Any()).Return(nil, theErr)
 				mockAWSClient.EXPECT().ListBuckets(gomock.Any(), gomock.Any()).Return(nil, theErr)
 				mockAWSClient.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any()).Return(nil, theErr)
-				mockAWSClient.EXPECT().DescribeSnapshots(gomock.Any(), gomock.Any()).Return(nil, theErr)
+				mockAWSClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(nil, theErr)
 				mockAWSClient.EXPECT().DescribeVolumes(gomock.Any(), gomock.Any()).Return(nil, theErr)
 
 				_, err := r.Reconcile(context.TODO(), req)
@@ -313,6 +320,8 @@ var _ = Describe("AccountClaim", func() {
 			It("should do nothing when there are additional finalizers present", func() {
 				accountClaim.SetFinalizers(append(accountClaim.GetFinalizers(), "another.blocking.finalizer"))
 				r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objs...).Build()
+				recorder := record.NewFakeRecorder(10)
+				r.recorder = recorder
 				_, err := r.Reconcile(context.TODO(), req)
 
 				Expect(err).NotTo(HaveOccurred())
@@ -323,6 +332,18 @@ var _ = Describe("AccountClaim", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(ac.Finalizers).To(Equal(accountClaim.GetFinalizers()))
 
+				// validate that the blocking finalizer is reported
+				condition := controllerutils.FindAccountClaimCondition(ac.Status.Conditions, awsv1alpha1.BlockedByFinalizers)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(v1.ConditionTrue))
+				Expect(condition.Message).To(ContainSubstring("another.blocking.finalizer"))
+				Expect(recorder.Events).To(HaveLen(1))
+
+				// a second reconcile doesn't emit the event again
+				_, err = r.Reconcile(context.TODO(), req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(HaveLen(1))
+
 			})
 		})
 		When("accountClaim.Spec.FleetManagerConfig.TrustedARN & accountClaim.Spec.AccountPool defined", func() {
@@ -408,10 +429,9 @@ var _ = Describe("AccountClaim", func() {
 					PackedPolicySize: aws.Int32(40),
 				}, nil)
 
-				mockAWSClient.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{}, nil)
+				mockAWSClient.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{Message: aws.String("")})
 				mockAWSClient.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{}, nil)
-				mockAWSClient.EXPECT().DeleteRole(gomock.Any(), gomock.Any()).Return(&iam.DeleteRoleOutput{}, nil)
-				mockAWSClient.EXPECT().ListUsersPages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockAWSClient.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&iam.ListUsersOutput{}, nil)
 
 				expectedCreateRoleOutput := &iam.CreateRoleOutput{
 					Role: &iamtypes.Role{
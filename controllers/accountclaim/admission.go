@@ -0,0 +1,270 @@
+package accountclaim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// admissionPolicyKey is the operator ConfigMap key holding the claim admission rules
+	admissionPolicyKey = "accountclaim-admission-policy"
+	// admissionOPAURLKey is the operator ConfigMap key holding the URL of an OPA decision, e.g.
+	// http://opa:8181/v1/data/aao/claims/admission
+	admissionOPAURLKey = "accountclaim-admission-opa-url"
+)
+
+var admissionHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// ClaimAdmissionInput is what a claim admission decision is made on
+type ClaimAdmissionInput struct {
+	Name                      string            `json:"name"`
+	Namespace                 string            `json:"namespace"`
+	Labels                    map[string]string `json:"labels,omitempty"`
+	AccountPool               string            `json:"accountPool,omitempty"`
+	Regions                   []string          `json:"regions,omitempty"`
+	BYOC                      bool              `json:"byoc"`
+	ManualSTSMode             bool              `json:"manualSTSMode"`
+	CredentialSecretNamespace string            `json:"credentialSecretNamespace,omitempty"`
+}
+
+// ClaimAdmitter decides whether a claim is admitted. It returns an error describing why a claim is denied, or an error
+// it failed with, which are told apart with isAdmissionDenied.
+type ClaimAdmitter interface {
+	Admit(ctx context.Context, input ClaimAdmissionInput) error
+}
+
+// admissionDeniedError is returned by admitters denying a claim
+type admissionDeniedError struct {
+	reasons []string
+}
+
+func (e *admissionDeniedError) Error() string {
+	return strings.Join(e.reasons, "; ")
+}
+
+func isAdmissionDenied(err error) bool {
+	_, ok := err.(*admissionDeniedError)
+	return ok
+}
+
+func newClaimAdmissionInput(accountClaim *awsv1alpha1.AccountClaim) ClaimAdmissionInput {
+	input := ClaimAdmissionInput{
+		Name:                      accountClaim.Name,
+		Namespace:                 accountClaim.Namespace,
+		Labels:                    accountClaim.Labels,
+		AccountPool:               accountClaim.Spec.AccountPool,
+		BYOC:                      accountClaim.Spec.BYOC,
+		ManualSTSMode:             accountClaim.Spec.ManualSTSMode,
+		CredentialSecretNamespace: accountClaim.Spec.AwsCredentialSecret.Namespace,
+	}
+	for _, region := range accountClaim.Spec.Aws.Regions {
+		input.Regions = append(input.Regions, region.Name)
+	}
+	return input
+}
+
+// admitClaim runs the claim through the admitters: the credential secret namespace policy, the supported regions of
+// its pool, the admission rules of the operator ConfigMap and the OPA decision, in that order. It returns the first
+// denial.
+func (r *AccountClaimReconciler) admitClaim(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	err := r.validateCredentialSecretNamespace(ctx, reqLogger, accountClaim)
+	if err != nil {
+		return &admissionDeniedError{reasons: []string{err.Error()}}
+	}
+	err = r.validateSupportedRegions(ctx, accountClaim)
+	if err != nil {
+		return err
+	}
+	err = r.validateCredentialProfile(ctx, accountClaim)
+	if err != nil {
+		return err
+	}
+	err = validateRequiredQuotas(accountClaim)
+	if err != nil {
+		return err
+	}
+	err = validateTemporaryQuotas(accountClaim)
+	if err != nil {
+		return err
+	}
+	err = validateRequiredOU(accountClaim)
+	if err != nil {
+		return err
+	}
+	err = r.validateClaimTags(ctx, accountClaim)
+	if err != nil {
+		return err
+	}
+
+	admitters, err := r.claimAdmitters(ctx)
+	if err != nil {
+		return err
+	}
+	input := newClaimAdmissionInput(accountClaim)
+	for _, admitter := range admitters {
+		if err := admitter.Admit(ctx, input); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// claimAdmitters returns the admitters configured in the operator ConfigMap
+func (r *AccountClaimReconciler) claimAdmitters(ctx context.Context) ([]ClaimAdmitter, error) {
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return claimAdmittersFromConfigMap(cm)
+}
+
+func claimAdmittersFromConfigMap(cm *corev1.ConfigMap) ([]ClaimAdmitter, error) {
+	admitters := []ClaimAdmitter{}
+	if policy := cm.Data[admissionPolicyKey]; policy != "" {
+		rules := admissionRules{}
+		if err := yaml.UnmarshalStrict([]byte(policy), &rules); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", admissionPolicyKey, err)
+		}
+		admitters = append(admitters, rules)
+	}
+	if url := cm.Data[admissionOPAURLKey]; url != "" {
+		admitters = append(admitters, opaAdmitter{url: url})
+	}
+	return admitters, nil
+}
+
+// admissionRule restricts the claims of the namespaces matching one of its patterns. Unset fields don't restrict.
+type admissionRule struct {
+	// Namespaces are shell patterns, e.g. uhc-staging-*
+	Namespaces   []string `yaml:"namespaces"`
+	AllowedPools []string `yaml:"allowedPools,omitempty"`
+	MaxRegions   *int     `yaml:"maxRegions,omitempty"`
+	AllowBYOC    *bool    `yaml:"allowBYOC,omitempty"`
+	AllowSTS     *bool    `yaml:"allowSTS,omitempty"`
+}
+
+// admissionRules admits claims with the first rule matching their namespace, claims matching no rule are admitted
+type admissionRules []admissionRule
+
+func (rules admissionRules) Admit(_ context.Context, input ClaimAdmissionInput) error {
+	for _, rule := range rules {
+		if rule.matches(input.Namespace) {
+			return rule.admit(input)
+		}
+	}
+	return nil
+}
+
+func (rule admissionRule) matches(namespace string) bool {
+	for _, pattern := range rule.Namespaces {
+		if matched, _ := path.Match(pattern, namespace); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule admissionRule) admit(input ClaimAdmissionInput) error {
+	reasons := []string{}
+	if len(rule.AllowedPools) > 0 && !input.BYOC {
+		pool := input.AccountPool
+		if pool == "" {
+			pool = "default"
+		}
+		if !controllerutils.Contains(rule.AllowedPools, pool) {
+			reasons = append(reasons, fmt.Sprintf("namespace %s may not use account pool %s", input.Namespace, pool))
+		}
+	}
+	if rule.MaxRegions != nil && len(input.Regions) > *rule.MaxRegions {
+		reasons = append(reasons, fmt.Sprintf("namespace %s may not request more than %d regions", input.Namespace, *rule.MaxRegions))
+	}
+	if rule.AllowBYOC != nil && !*rule.AllowBYOC && input.BYOC {
+		reasons = append(reasons, fmt.Sprintf("namespace %s may not claim BYOC accounts", input.Namespace))
+	}
+	if rule.AllowSTS != nil && !*rule.AllowSTS && input.ManualSTSMode {
+		reasons = append(reasons, fmt.Sprintf("namespace %s may not claim manual STS mode accounts", input.Namespace))
+	}
+	if len(reasons) > 0 {
+		return &admissionDeniedError{reasons: reasons}
+	}
+	return nil
+}
+
+// opaAdmitter asks an OPA decision about the claim through the OPA data API. The decision is either a boolean or an
+// object with an allowed boolean and the reasons of a denial.
+type opaAdmitter struct {
+	url string
+}
+
+type opaDecision struct {
+	Allowed bool     `json:"allowed"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+func (a opaAdmitter) Admit(ctx context.Context, input ClaimAdmissionInput) error {
+	// Claims are admitted without asking OPA in dry-run mode
+	if controllerutils.IsDryRun() {
+		controllerutils.LogDryRunCall("admission", "url", a.url, "accountClaim", input.Name, "namespace", input.Namespace)
+		return nil
+	}
+	body, err := json.Marshal(map[string]ClaimAdmissionInput{"input": input})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := admissionHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("OPA returned status %d", resp.StatusCode)
+	}
+
+	response := struct {
+		Result json.RawMessage `json:"result"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("invalid OPA response: %w", err)
+	}
+	// An undefined decision has no result, e.g. when the policy isn't loaded
+	if len(response.Result) == 0 {
+		return fmt.Errorf("OPA returned no decision")
+	}
+
+	decision := opaDecision{}
+	if err := json.Unmarshal(response.Result, &decision.Allowed); err != nil {
+		if err := json.Unmarshal(response.Result, &decision); err != nil {
+			return fmt.Errorf("invalid OPA decision: %w", err)
+		}
+	}
+	if decision.Allowed {
+		return nil
+	}
+	if len(decision.Reasons) == 0 {
+		decision.Reasons = []string{"denied by OPA"}
+	}
+	return &admissionDeniedError{reasons: decision.Reasons}
+}
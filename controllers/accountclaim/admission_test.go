@@ -0,0 +1,146 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccountClaim admission", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		configMap    *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "uhc-staging-abc"},
+			Spec: v1alpha1.AccountClaimSpec{
+				AccountPool: "fm-pool",
+				Aws:         v1alpha1.Aws{Regions: []v1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "us-west-2"}}},
+			},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultConfigMap, Namespace: v1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				admissionPolicyKey: `
+- namespaces: ["uhc-staging-*"]
+  allowedPools: ["default", "staging-pool"]
+  maxRegions: 1
+  allowBYOC: false
+- namespaces: ["*"]
+  allowedPools: ["default", "fm-pool"]
+`,
+			},
+		}
+	})
+
+	admit := func() error {
+		r := &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build(),
+			Scheme: scheme.Scheme,
+		}
+		return r.admitClaim(context.TODO(), nullLogger, accountClaim)
+	}
+
+	It("denies claims breaking the first matching rule with all its reasons", func() {
+		err := admit()
+		Expect(isAdmissionDenied(err)).To(BeTrue())
+		Expect(err.Error()).To(Equal("namespace uhc-staging-abc may not use account pool fm-pool; namespace uhc-staging-abc may not request more than 1 regions"))
+	})
+
+	It("admits claims following the rule of their namespace", func() {
+		accountClaim.Namespace = "uhc-production-abc"
+		Expect(admit()).To(Succeed())
+
+		accountClaim.Spec.AccountPool = ""
+		Expect(admit()).To(Succeed())
+	})
+
+	It("denies BYOC claims where they aren't allowed", func() {
+		accountClaim.Spec.BYOC = true
+		accountClaim.Spec.Aws.Regions = nil
+		err := admit()
+		Expect(isAdmissionDenied(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("may not claim BYOC accounts"))
+	})
+
+	It("admits every claim without a configuration", func() {
+		configMap.Data = nil
+		Expect(admit()).To(Succeed())
+	})
+
+	It("fails on an invalid policy", func() {
+		configMap.Data[admissionPolicyKey] = "- namespace: typo"
+		err := admit()
+		Expect(err).To(HaveOccurred())
+		Expect(isAdmissionDenied(err)).To(BeFalse())
+	})
+
+	Context("with OPA", func() {
+		var (
+			server   *httptest.Server
+			decision string
+			received ClaimAdmissionInput
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				body := map[string]ClaimAdmissionInput{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				received = body["input"]
+				_, _ = w.Write([]byte(decision))
+			}))
+			configMap.Data = map[string]string{admissionOPAURLKey: server.URL}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("sends the claim and admits on a true decision", func() {
+			decision = `{"result": true}`
+			Expect(admit()).To(Succeed())
+			Expect(received.Namespace).To(Equal("uhc-staging-abc"))
+			Expect(received.AccountPool).To(Equal("fm-pool"))
+			Expect(received.Regions).To(Equal([]string{"us-east-1", "us-west-2"}))
+		})
+
+		It("denies with the reasons of the decision", func() {
+			decision = `{"result": {"allowed": false, "reasons": ["fm-pool is reserved"]}}`
+			err := admit()
+			Expect(isAdmissionDenied(err)).To(BeTrue())
+			Expect(err.Error()).To(Equal("fm-pool is reserved"))
+		})
+
+		It("admits without asking OPA in dry-run mode", func() {
+			controllerutils.SetDryRun(true)
+			defer controllerutils.SetDryRun(false)
+
+			received = ClaimAdmissionInput{}
+			decision = `{"result": false}`
+			Expect(admit()).To(Succeed())
+			Expect(received.Name).To(BeEmpty())
+		})
+
+		It("fails on an undefined decision", func() {
+			decision = `{}`
+			err := admit()
+			Expect(err).To(HaveOccurred())
+			Expect(isAdmissionDenied(err)).To(BeFalse())
+		})
+	})
+})
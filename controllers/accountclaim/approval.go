@@ -0,0 +1,211 @@
+package accountclaim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// ApprovalAnnotation is set by the approver of a claim waiting for approval, to ApprovalApproved or ApprovalDenied
+	ApprovalAnnotation = "aws.managed.openshift.io/approval"
+	// ApprovalMessageAnnotation optionally explains the decision of the approver
+	ApprovalMessageAnnotation = "aws.managed.openshift.io/approval-message"
+	// ApprovalApproved lets the claim be matched with an account
+	ApprovalApproved = "approved"
+	// ApprovalDenied fails the claim
+	ApprovalDenied = "denied"
+	// approvalRequestedAnnotation records that the approval webhook was notified of the claim
+	approvalRequestedAnnotation = "aws.managed.openshift.io/approval-requested"
+
+	// approvalFeatureFlag makes new claims for pool accounts wait for approval
+	approvalFeatureFlag = "feature.accountclaim_approval"
+	// approvalWebhookKey is the operator ConfigMap key holding the URL notified of claims waiting for approval
+	approvalWebhookKey = "accountclaim-approval-webhook"
+	// approvalTimeoutKey is the operator ConfigMap key holding how long a claim waits for approval
+	approvalTimeoutKey = "accountclaim-approval-timeout"
+
+	defaultApprovalTimeout = 24 * time.Hour
+	approvalRetryInterval  = 30 * time.Second
+)
+
+var approvalHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// approvalRequestPayload is the body POSTed to the approval webhook
+type approvalRequestPayload struct {
+	AccountClaim string `json:"accountClaim"`
+	Namespace    string `json:"namespace"`
+	AccountPool  string `json:"accountPool,omitempty"`
+	LegalEntity  string `json:"legalEntity,omitempty"`
+	Deadline     string `json:"deadline"`
+}
+
+// approvalSettings returns whether claims wait for approval, the webhook to notify and how long they wait
+func approvalSettings(reqLogger logr.Logger, cm *corev1.ConfigMap) (bool, string, time.Duration) {
+	enabled, err := controllerutils.GetFeatureFlagValue(cm, approvalFeatureFlag)
+	if err != nil || !enabled {
+		return false, "", 0
+	}
+	timeout := defaultApprovalTimeout
+	if value, ok := cm.Data[approvalTimeoutKey]; ok {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			reqLogger.Error(err, "invalid approval timeout, using default", "timeout", value)
+		} else {
+			timeout = parsed
+		}
+	}
+	return true, cm.Data[approvalWebhookKey], timeout
+}
+
+// requiresApproval returns whether a new claim has to be approved before an account is handed out. Only claims for
+// pool accounts are gated, CCS claims bring their own account.
+func (r *AccountClaimReconciler) requiresApproval(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	if accountClaim.Spec.BYOC || accountClaim.Spec.AccountLink != "" {
+		return false, nil
+	}
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	enabled, _, _ := approvalSettings(reqLogger, cm)
+	return enabled, nil
+}
+
+// handlePendingApproval waits for the approver to set the ApprovalAnnotation on the claim. The approval webhook, when
+// configured, is notified once. An approved claim moves on to Pending and is matched with an account. A denied claim,
+// or one that wasn't approved within the approval timeout, fails with the ApprovalDenied condition.
+func (r *AccountClaimReconciler) handlePendingApproval(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, now time.Time) (reconcile.Result, error) {
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	enabled, webhook, timeout := approvalSettings(reqLogger, cm)
+	if !enabled {
+		return reconcile.Result{}, r.grantApproval(reqLogger, accountClaim, "ApprovalDisabled", "Claims don't need to be approved anymore")
+	}
+
+	message := accountClaim.Annotations[ApprovalMessageAnnotation]
+	switch accountClaim.Annotations[ApprovalAnnotation] {
+	case ApprovalApproved:
+		if message == "" {
+			message = "The claim was approved"
+		}
+		return reconcile.Result{}, r.grantApproval(reqLogger, accountClaim, "Approved", message)
+	case ApprovalDenied:
+		if message == "" {
+			message = "The claim was denied"
+		}
+		return reconcile.Result{}, r.denyApproval(reqLogger, accountClaim, "Denied", message)
+	}
+
+	waitingSince := accountClaim.CreationTimestamp.Time
+	if condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.ApprovalPending); condition != nil {
+		waitingSince = condition.LastTransitionTime.Time
+	}
+	deadline := waitingSince.Add(timeout)
+	if !now.Before(deadline) {
+		return reconcile.Result{}, r.denyApproval(reqLogger, accountClaim, "TimedOut", fmt.Sprintf("The claim wasn't approved within %s", timeout))
+	}
+
+	if webhook != "" && accountClaim.Annotations[approvalRequestedAnnotation] == "" {
+		err = r.requestApproval(ctx, accountClaim, webhook, deadline)
+		if err != nil {
+			reqLogger.Info("failed notifying the approval webhook, retrying", "error", err.Error())
+			return reconcile.Result{RequeueAfter: approvalRetryInterval}, nil
+		}
+		if accountClaim.Annotations == nil {
+			accountClaim.Annotations = map[string]string{}
+		}
+		accountClaim.Annotations[approvalRequestedAnnotation] = now.UTC().Format(time.RFC3339)
+		if err := r.specUpdate(reqLogger, accountClaim); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: deadline.Sub(now)}, nil
+}
+
+// isApprovalDenied returns whether the claim was denied or timed out waiting for approval. Such claims aren't
+// matched with an account, they have to be recreated.
+func isApprovalDenied(accountClaim *awsv1alpha1.AccountClaim) bool {
+	if accountClaim.Status.State != awsv1alpha1.ClaimStatusError {
+		return false
+	}
+	condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.ApprovalDenied)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
+
+func (r *AccountClaimReconciler) grantApproval(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, reason string, message string) error {
+	reqLogger.Info("claim approved", "reason", reason)
+	accountClaim.Status.State = awsv1alpha1.ClaimStatusPending
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.ApprovalPending,
+		corev1.ConditionFalse,
+		reason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		false,
+	)
+	return r.statusUpdate(reqLogger, accountClaim)
+}
+
+func (r *AccountClaimReconciler) denyApproval(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, reason string, message string) error {
+	reqLogger.Info("claim not approved", "reason", reason)
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.ApprovalPending,
+		corev1.ConditionFalse,
+		reason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		false,
+	)
+	controllerutils.SetAccountClaimStatus(accountClaim, message, reason, awsv1alpha1.ApprovalDenied, awsv1alpha1.ClaimStatusError)
+	return r.statusUpdate(reqLogger, accountClaim)
+}
+
+func (r *AccountClaimReconciler) requestApproval(ctx context.Context, accountClaim *awsv1alpha1.AccountClaim, webhook string, deadline time.Time) error {
+	body, err := json.Marshal(approvalRequestPayload{
+		AccountClaim: accountClaim.Name,
+		Namespace:    accountClaim.Namespace,
+		AccountPool:  accountClaim.Spec.AccountPool,
+		LegalEntity:  accountClaim.Spec.LegalEntity.Name,
+		Deadline:     deadline.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := approvalHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("approval webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	apis "github.com/openshift/aws-account-operator/api"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccountClaim approval", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		configMap    *corev1.ConfigMap
+		r            *AccountClaimReconciler
+		server       *httptest.Server
+		received     []approvalRequestPayload
+		now          time.Time
+	)
+
+	err := apis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding apis to scheme in approval tests")
+	}
+
+	BeforeEach(func() {
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			payload := approvalRequestPayload{}
+			Expect(json.NewDecoder(req.Body).Decode(&payload)).To(Succeed())
+			received = append(received, payload)
+		}))
+
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-ns"},
+			Spec:       v1alpha1.AccountClaimSpec{AccountPool: "fm-pool"},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultConfigMap, Namespace: v1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				approvalFeatureFlag: "true",
+				approvalWebhookKey:  server.URL,
+				approvalTimeoutKey:  "1h",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	// startWaiting builds the reconciler and puts the claim in PendingApproval, as Reconcile does for new claims
+	startWaiting := func() {
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim, configMap).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+		needsApproval, err := r.requiresApproval(context.TODO(), nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(needsApproval).To(BeTrue())
+		controllerutils.SetAccountClaimStatus(accountClaim, "Waiting for the claim to be approved", "WaitingForApproval", v1alpha1.ApprovalPending, v1alpha1.ClaimStatusPendingApproval)
+		Expect(r.statusUpdate(nullLogger, accountClaim)).To(Succeed())
+		now = controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, v1alpha1.ApprovalPending).LastTransitionTime.Time
+	}
+
+	setApproval := func(value string) {
+		accountClaim.Annotations = map[string]string{ApprovalAnnotation: value, ApprovalMessageAnnotation: "ticket 42"}
+		Expect(r.Update(context.TODO(), accountClaim)).To(Succeed())
+	}
+
+	It("doesn't gate CCS claims or claims when the feature is disabled", func() {
+		accountClaim.Spec.BYOC = true
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.requiresApproval(context.TODO(), nullLogger, accountClaim)).To(BeFalse())
+
+		accountClaim.Spec.BYOC = false
+		configMap.Data[approvalFeatureFlag] = "false"
+		Expect(r.Update(context.TODO(), configMap)).To(Succeed())
+		Expect(r.requiresApproval(context.TODO(), nullLogger, accountClaim)).To(BeFalse())
+	})
+
+	It("notifies the webhook once and waits until the deadline", func() {
+		startWaiting()
+
+		result, err := r.handlePendingApproval(context.TODO(), nullLogger, accountClaim, now.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(59 * time.Minute))
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].AccountClaim).To(Equal("claim"))
+		Expect(received[0].AccountPool).To(Equal("fm-pool"))
+
+		_, err = r.handlePendingApproval(context.TODO(), nullLogger, accountClaim, now.Add(2*time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(HaveLen(1))
+		Expect(accountClaim.Status.State).To(Equal(v1alpha1.ClaimStatusPendingApproval))
+	})
+
+	It("moves approved claims on to matching", func() {
+		startWaiting()
+		setApproval(ApprovalApproved)
+
+		_, err := r.handlePendingApproval(context.TODO(), nullLogger, accountClaim, now.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(accountClaim.Status.State).To(Equal(v1alpha1.ClaimStatusPending))
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, v1alpha1.ApprovalPending)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("Approved"))
+		Expect(condition.Message).To(Equal("ticket 42"))
+		Expect(isApprovalDenied(accountClaim)).To(BeFalse())
+	})
+
+	It("fails denied claims", func() {
+		startWaiting()
+		setApproval(ApprovalDenied)
+
+		_, err := r.handlePendingApproval(context.TODO(), nullLogger, accountClaim, now.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(accountClaim.Status.State).To(Equal(v1alpha1.ClaimStatusError))
+		Expect(isApprovalDenied(accountClaim)).To(BeTrue())
+		Expect(controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, v1alpha1.ApprovalDenied).Reason).To(Equal("Denied"))
+	})
+
+	It("fails claims that aren't approved in time", func() {
+		startWaiting()
+
+		_, err := r.handlePendingApproval(context.TODO(), nullLogger, accountClaim, now.Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isApprovalDenied(accountClaim)).To(BeTrue())
+		Expect(controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, v1alpha1.ApprovalDenied).Reason).To(Equal("TimedOut"))
+	})
+
+	It("releases waiting claims when the feature is disabled", func() {
+		startWaiting()
+		configMap.Data[approvalFeatureFlag] = "false"
+		Expect(r.Update(context.TODO(), configMap)).To(Succeed())
+
+		_, err := r.handlePendingApproval(context.TODO(), nullLogger, accountClaim, now.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(accountClaim.Status.State).To(Equal(v1alpha1.ClaimStatusPending))
+	})
+})
@@ -0,0 +1,57 @@
+package accountclaim
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const blockedByFinalizersReason = "FinalizersPresent"
+
+// blockingFinalizers returns the finalizers not owned by this controller, which have to be removed
+// by their owners before the AccountClaim can be cleaned up
+func blockingFinalizers(accountClaim *awsv1alpha1.AccountClaim) []string {
+	blocking := []string{}
+	for _, finalizer := range accountClaim.GetFinalizers() {
+		if finalizer != accountClaimFinalizer {
+			blocking = append(blocking, finalizer)
+		}
+	}
+	return blocking
+}
+
+// reportBlockingFinalizers sets the BlockedByFinalizers condition and emits an event whenever the set of
+// finalizers blocking the deletion changes
+func (r *AccountClaimReconciler) reportBlockingFinalizers(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, blocking []string) error {
+	blockedSince := time.Now()
+	if accountClaim.DeletionTimestamp != nil {
+		blockedSince = accountClaim.DeletionTimestamp.Time
+	}
+	message := fmt.Sprintf("Deletion is blocked by finalizers [%s] since %s", strings.Join(blocking, ", "), blockedSince.UTC().Format(time.RFC3339))
+	reqLogger.Info("AccountClaim deletion blocked by other finalizers", "finalizers", blocking, "blockedFor", time.Since(blockedSince).Round(time.Second).String())
+
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.BlockedByFinalizers)
+	if existing != nil && existing.Status == corev1.ConditionTrue && existing.Message == message {
+		return nil
+	}
+
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.BlockedByFinalizers,
+		corev1.ConditionTrue,
+		blockedByFinalizersReason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	if r.recorder != nil {
+		r.recorder.Event(accountClaim, corev1.EventTypeWarning, string(awsv1alpha1.BlockedByFinalizers), message)
+	}
+	return r.statusUpdate(reqLogger, accountClaim)
+}
@@ -0,0 +1,103 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const byocOwnershipMismatchReason = "AccountOwnershipMismatch"
+
+// byocOwnershipError refuses the cleanup of a CCS account that can't be verified to be the customer account of the claim
+type byocOwnershipError struct {
+	message string
+}
+
+func (e *byocOwnershipError) Error() string {
+	return fmt.Sprintf("refusing to clean up the CCS account: %s", e.message)
+}
+
+func isBYOCOwnershipMismatch(err error) bool {
+	_, ok := err.(*byocOwnershipError)
+	return ok
+}
+
+// verifyBYOCOwnership verifies that the credentials the cleanup of a CCS claim runs with belong to the customer
+// account recorded in the claim, that the Account is for that account as well, and that it isn't one of the accounts
+// of the operator's organization, before anything gets deleted in it. A mislinked claim is marked with the
+// BYOCAccountMismatch condition and a byocOwnershipError is returned, so the cleanup is retried once the claim is
+// fixed.
+func (r *AccountClaimReconciler) verifyBYOCOwnership(ctx context.Context, reqLogger logr.Logger, awsClient awsclient.Client, accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) error {
+	identity, err := awsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		reqLogger.Error(err, "failed getting the identity of the CCS credentials")
+		return err
+	}
+	callerAccountID := aws.ToString(identity.Account)
+
+	mismatch := ""
+	switch {
+	case accountClaim.Spec.BYOCAWSAccountID == "":
+		mismatch = "the claim records no customer account"
+	case callerAccountID != accountClaim.Spec.BYOCAWSAccountID:
+		mismatch = fmt.Sprintf("the credentials of the claim belong to account %s, not to its customer account %s", callerAccountID, accountClaim.Spec.BYOCAWSAccountID)
+	case account.Spec.AwsAccountID != accountClaim.Spec.BYOCAWSAccountID:
+		mismatch = fmt.Sprintf("Account %s is for account %s, not for the customer account %s of the claim", account.Name, account.Spec.AwsAccountID, accountClaim.Spec.BYOCAWSAccountID)
+	default:
+		organizationAccount, err := r.organizationAccount(ctx, callerAccountID)
+		if err != nil {
+			return err
+		}
+		if organizationAccount != "" {
+			mismatch = fmt.Sprintf("account %s is an account of the operator's organization, held by Account %s", callerAccountID, organizationAccount)
+		}
+	}
+	if mismatch == "" {
+		return nil
+	}
+
+	message := fmt.Sprintf("Cleanup refused: %s. Fix spec.byocAWSAccountID, spec.byocSecretRef or spec.accountLink of the claim", mismatch)
+	reqLogger.Info("refusing to clean up a CCS account that doesn't match the claim", "reason", mismatch)
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.BYOCAccountMismatch)
+	if existing == nil || existing.Status != corev1.ConditionTrue || existing.Message != message {
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.BYOCAccountMismatch,
+			corev1.ConditionTrue,
+			byocOwnershipMismatchReason,
+			message,
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+			true,
+		)
+		if r.recorder != nil {
+			r.recorder.Event(accountClaim, corev1.EventTypeWarning, string(awsv1alpha1.BYOCAccountMismatch), message)
+		}
+		if err := r.statusUpdate(reqLogger, accountClaim); err != nil {
+			return err
+		}
+	}
+	return &byocOwnershipError{message: mismatch}
+}
+
+// organizationAccount returns the name of the non-CCS Account holding the AWS account, empty when there is none
+func (r *AccountClaimReconciler) organizationAccount(ctx context.Context, awsAccountID string) (string, error) {
+	accounts := &awsv1alpha1.AccountList{}
+	if err := r.List(ctx, accounts, client.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		return "", err
+	}
+	for _, account := range accounts.Items {
+		if !account.IsBYOC() && account.Spec.AwsAccountID == awsAccountID {
+			return account.Name, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,107 @@
+package accountclaim
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BYOC ownership verification", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		ctrl         *gomock.Controller
+		mockAWS      *mock.MockClient
+		accountClaim *v1alpha1.AccountClaim
+		account      *v1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWS = mock.NewMockClient(ctrl)
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: v1alpha1.AccountClaimSpec{
+				BYOC:             true,
+				BYOCAWSAccountID: "123456789012",
+				AccountLink:      "byoc-account",
+			},
+		}
+		account = &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "byoc-account", Namespace: v1alpha1.AccountCrNamespace},
+			Spec:       v1alpha1.AccountSpec{BYOC: true, AwsAccountID: "123456789012"},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	reconciler := func(objects ...client.Object) *AccountClaimReconciler {
+		return &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+			Scheme: scheme.Scheme,
+		}
+	}
+
+	expectCaller := func(accountID string) {
+		mockAWS.EXPECT().GetCallerIdentity(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String(accountID)}, nil)
+	}
+
+	It("accepts the customer account of the claim", func() {
+		r := reconciler(accountClaim, account)
+		expectCaller("123456789012")
+
+		Expect(r.verifyBYOCOwnership(context.TODO(), nullLogger, mockAWS, accountClaim, account)).To(Succeed())
+		Expect(accountClaim.Status.Conditions).To(BeEmpty())
+	})
+
+	It("refuses credentials of another account", func() {
+		r := reconciler(accountClaim, account)
+		expectCaller("210987654321")
+
+		err := r.verifyBYOCOwnership(context.TODO(), nullLogger, mockAWS, accountClaim, account)
+		Expect(isBYOCOwnershipMismatch(err)).To(BeTrue())
+		updated := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		condition := controllerutils.FindAccountClaimCondition(updated.Status.Conditions, v1alpha1.BYOCAccountMismatch)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("belong to account 210987654321, not to its customer account 123456789012"))
+	})
+
+	It("refuses an Account for another account", func() {
+		account.Spec.AwsAccountID = "210987654321"
+		r := reconciler(accountClaim, account)
+		expectCaller("123456789012")
+
+		err := r.verifyBYOCOwnership(context.TODO(), nullLogger, mockAWS, accountClaim, account)
+		Expect(isBYOCOwnershipMismatch(err)).To(BeTrue())
+	})
+
+	It("refuses accounts of the operator's organization", func() {
+		poolAccount := &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: v1alpha1.AccountCrNamespace},
+			Spec:       v1alpha1.AccountSpec{AwsAccountID: "123456789012"},
+		}
+		r := reconciler(accountClaim, account, poolAccount)
+		expectCaller("123456789012")
+
+		err := r.verifyBYOCOwnership(context.TODO(), nullLogger, mockAWS, accountClaim, account)
+		Expect(isBYOCOwnershipMismatch(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("held by Account osd-creds-mgmt-aaaaaa"))
+	})
+})
@@ -0,0 +1,246 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// operatorTagPrefix prefixes the tag keys written by the operator itself, which claims can't set, like
+	// awsv1alpha1.ManagedByTagKey
+	operatorTagPrefix = "aws.managed.openshift.io/"
+
+	maxTagKeyLength   = 128
+	maxTagValueLength = 256
+
+	// invalidTagsReason is the reason of the event recorded when the tags of a Ready claim are changed to invalid ones
+	invalidTagsReason = "InvalidTags"
+)
+
+// validateClaimTags denies claims with tags the operator can't apply: CCS accounts don't get claim tags, AWS rejects
+// keys prefixed with aws: and keys or values over its length limits, and the keys of the operator and of the propagated
+// claim labels would be overwritten
+func (r *AccountClaimReconciler) validateClaimTags(ctx context.Context, accountClaim *awsv1alpha1.AccountClaim) error {
+	if len(accountClaim.Spec.Tags) == 0 {
+		return nil
+	}
+	if accountClaim.Spec.BYOC {
+		return &admissionDeniedError{reasons: []string{"tags only apply to accounts of the organization, not CCS accounts"}}
+	}
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return err
+	}
+	propagated := []string{}
+	if cm != nil {
+		propagated = propagatedLabelKeys(cm)
+	}
+
+	reasons := []string{}
+	for _, key := range sortedKeys(accountClaim.Spec.Tags) {
+		value := accountClaim.Spec.Tags[key]
+		switch {
+		case key == "" || len(key) > maxTagKeyLength:
+			reasons = append(reasons, fmt.Sprintf("tag key %q must be 1 to %d characters long", key, maxTagKeyLength))
+		case len(value) > maxTagValueLength:
+			reasons = append(reasons, fmt.Sprintf("the value of tag %q must be at most %d characters long", key, maxTagValueLength))
+		case strings.HasPrefix(strings.ToLower(key), "aws:"):
+			reasons = append(reasons, fmt.Sprintf("tag key %q is reserved by AWS", key))
+		case key == awsv1alpha1.ManagedByTagKey || strings.HasPrefix(key, operatorTagPrefix):
+			reasons = append(reasons, fmt.Sprintf("tag key %q is reserved by the operator", key))
+		case controllerutils.Contains(propagated, key):
+			reasons = append(reasons, fmt.Sprintf("tag key %q is propagated from the claim labels", key))
+		}
+	}
+	if len(reasons) > 0 {
+		return &admissionDeniedError{reasons: reasons}
+	}
+	return nil
+}
+
+// propagateClaimTags keeps the tags of the claim on the AWS resources of its account: the Organizations tags of the
+// account, and the tags of the IAM user whose credentials are delivered or of the fleet manager role. The tags applied
+// are recorded in the ClaimTagsAnnotation of the Account, so the ones removed from the claim are untagged, and all of
+// them when the account is released. Tags changed to invalid ones once the claim is Ready are reported by an event and
+// left unapplied.
+func (r *AccountClaimReconciler) propagateClaimTags(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	if accountClaim.Spec.AccountLink == "" || accountClaim.Spec.BYOC {
+		return nil
+	}
+	if err := r.validateClaimTags(ctx, accountClaim); err != nil {
+		if !isAdmissionDenied(err) {
+			return err
+		}
+		reqLogger.Info("Not applying the invalid claim tags", "reasons", err.Error())
+		if r.recorder != nil {
+			r.recorder.Event(accountClaim, corev1.EventTypeWarning, invalidTagsReason, err.Error())
+		}
+		return nil
+	}
+	account, err := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return r.syncClaimTags(ctx, reqLogger, account, accountClaim.Spec.Tags)
+}
+
+// releaseClaimTags removes the tags of the claim the account is released from
+func (r *AccountClaimReconciler) releaseClaimTags(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account) error {
+	return r.syncClaimTags(ctx, reqLogger, account, nil)
+}
+
+// syncClaimTags applies tags to the AWS resources of the account, and removes the tags applied before which tags
+// doesn't have
+func (r *AccountClaimReconciler) syncClaimTags(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account, tags map[string]string) error {
+	applied, err := appliedClaimTags(account)
+	if err != nil {
+		return err
+	}
+	toSet, toRemove := claimTagsDiff(applied, tags)
+	if len(toSet) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+
+	// Tag first, the annotation records what's been tagged
+	if !account.IsBYOC() && account.Spec.AwsAccountID != "" {
+		awsSetupClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+			SecretName: controllerutils.AwsSecretName,
+			NameSpace:  awsv1alpha1.AccountCrNamespace,
+			AwsRegion:  config.GetDefaultRegion(),
+		})
+		if err != nil {
+			return err
+		}
+		err = syncOrganizationsTags(awsSetupClient, account.Spec.AwsAccountID, toSet, toRemove)
+		if err != nil {
+			reqLogger.Error(err, "Failed to sync the claim tags as Organizations tags", "awsRequestID", controllerutils.AwsRequestID(err))
+			return err
+		}
+
+		iamUserNamePrefix, err := r.operatorConfig().IAMUserNamePrefix(ctx, account.Spec.AccountPool)
+		if err != nil {
+			return err
+		}
+		awsClient, _, err := stsclient.HandleRoleAssumption(reqLogger, r.awsClientBuilder, account, r.Client, awsSetupClient, "", awsv1alpha1.AccountOperatorIAMRole, "")
+		if err != nil {
+			reqLogger.Error(err, "failed building AWS client from assume_role")
+			return err
+		}
+		iamUserName := fmt.Sprintf("%s-%s", iamUserNamePrefix, account.Labels[awsv1alpha1.IAMUserIDLabel])
+		err = syncIAMTags(awsClient, iamUserName, stsRoleName, toSet, toRemove)
+		if err != nil {
+			reqLogger.Error(err, "Failed to sync the claim tags on the IAM resources", "awsRequestID", controllerutils.AwsRequestID(err))
+			return err
+		}
+	}
+
+	if len(tags) == 0 {
+		delete(account.Annotations, awsv1alpha1.ClaimTagsAnnotation)
+	} else {
+		encoded, err := json.Marshal(tags)
+		if err != nil {
+			return err
+		}
+		if account.Annotations == nil {
+			account.Annotations = map[string]string{}
+		}
+		account.Annotations[awsv1alpha1.ClaimTagsAnnotation] = string(encoded)
+	}
+	reqLogger.Info("Syncing claim tags on the account", "account", account.Name, "set", toSet, "removed", toRemove)
+	return r.Update(ctx, account)
+}
+
+// appliedClaimTags returns the claim tags recorded in the ClaimTagsAnnotation of the account
+func appliedClaimTags(account *awsv1alpha1.Account) (map[string]string, error) {
+	applied := map[string]string{}
+	annotation := account.Annotations[awsv1alpha1.ClaimTagsAnnotation]
+	if annotation == "" {
+		return applied, nil
+	}
+	if err := json.Unmarshal([]byte(annotation), &applied); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation on account %s: %w", awsv1alpha1.ClaimTagsAnnotation, account.Name, err)
+	}
+	return applied, nil
+}
+
+// claimTagsDiff returns the tags to write to go from the applied tags to the wanted ones, and the keys to remove
+func claimTagsDiff(applied map[string]string, wanted map[string]string) (map[string]string, []string) {
+	toSet := map[string]string{}
+	for key, value := range wanted {
+		if current, ok := applied[key]; !ok || current != value {
+			toSet[key] = value
+		}
+	}
+	toRemove := []string{}
+	for _, key := range sortedKeys(applied) {
+		if _, ok := wanted[key]; !ok {
+			toRemove = append(toRemove, key)
+		}
+	}
+	return toSet, toRemove
+}
+
+// syncIAMTags tags the IAM user and role with the tags to set and untags the removed ones. Either of them may not
+// exist: fleet manager accounts have the role instead of the user.
+func syncIAMTags(awsClient awsclient.Client, userName string, roleName string, toSet map[string]string, toRemove []string) error {
+	tags := make([]iamtypes.Tag, 0, len(toSet))
+	for _, key := range sortedKeys(toSet) {
+		tags = append(tags, iamtypes.Tag{Key: aws.String(key), Value: aws.String(toSet[key])})
+	}
+
+	if len(tags) > 0 {
+		_, err := awsClient.TagUser(context.TODO(), &iam.TagUserInput{UserName: aws.String(userName), Tags: tags})
+		if err != nil && !isNoSuchEntity(err) {
+			return fmt.Errorf("unable to tag IAM user %s: %w", userName, err)
+		}
+		_, err = awsClient.TagRole(context.TODO(), &iam.TagRoleInput{RoleName: aws.String(roleName), Tags: tags})
+		if err != nil && !isNoSuchEntity(err) {
+			return fmt.Errorf("unable to tag IAM role %s: %w", roleName, err)
+		}
+	}
+	if len(toRemove) > 0 {
+		_, err := awsClient.UntagUser(context.TODO(), &iam.UntagUserInput{UserName: aws.String(userName), TagKeys: toRemove})
+		if err != nil && !isNoSuchEntity(err) {
+			return fmt.Errorf("unable to untag IAM user %s: %w", userName, err)
+		}
+		_, err = awsClient.UntagRole(context.TODO(), &iam.UntagRoleInput{RoleName: aws.String(roleName), TagKeys: toRemove})
+		if err != nil && !isNoSuchEntity(err) {
+			return fmt.Errorf("unable to untag IAM role %s: %w", roleName, err)
+		}
+	}
+	return nil
+}
+
+func isNoSuchEntity(err error) bool {
+	var noSuchEntity *iamtypes.NoSuchEntityException
+	return errors.As(err, &noSuchEntity)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,146 @@
+package accountclaim
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Claim tags", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		r             *AccountClaimReconciler
+		accountClaim  *awsv1alpha1.AccountClaim
+		account       *awsv1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				AccountLink: "osd-creds-mgmt-aaaaaa",
+				Tags:        map[string]string{"cost-center": "1234", "cluster-id": "abc"},
+			},
+		}
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "osd-creds-mgmt-aaaaaa",
+				Namespace:   awsv1alpha1.AccountCrNamespace,
+				Annotations: map[string]string{awsv1alpha1.ClaimTagsAnnotation: `{"cost-center":"1234","team":"sre"}`},
+			},
+			Spec: awsv1alpha1.AccountSpec{BYOC: true},
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string]string{propagatedLabelsKey: "api.openshift.com/id"},
+		}
+		r = &AccountClaimReconciler{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account, configMap).Build(),
+			Scheme:           scheme.Scheme,
+			awsClientBuilder: &mock.Builder{MockController: ctrl},
+		}
+		mockAWSClient = mock.GetMockClient(r.awsClientBuilder)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	getAccount := func() *awsv1alpha1.Account {
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		return updated
+	}
+
+	Context("validateClaimTags", func() {
+		It("admits valid tags", func() {
+			Expect(r.validateClaimTags(context.TODO(), accountClaim)).To(Succeed())
+		})
+
+		It("denies tags on CCS claims", func() {
+			accountClaim.Spec.BYOC = true
+			Expect(isAdmissionDenied(r.validateClaimTags(context.TODO(), accountClaim))).To(BeTrue())
+		})
+
+		It("denies the reserved and propagated keys", func() {
+			accountClaim.Spec.Tags = map[string]string{
+				"AWS:createdBy":             "me",
+				awsv1alpha1.ManagedByTagKey: "me",
+				"api.openshift.com/id":      "abc",
+			}
+			err := r.validateClaimTags(context.TODO(), accountClaim)
+			Expect(isAdmissionDenied(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`tag key "AWS:createdBy" is reserved by AWS`))
+			Expect(err.Error()).To(ContainSubstring("is reserved by the operator"))
+			Expect(err.Error()).To(ContainSubstring(`tag key "api.openshift.com/id" is propagated from the claim labels`))
+		})
+	})
+
+	It("diffs the applied and wanted tags", func() {
+		toSet, toRemove := claimTagsDiff(
+			map[string]string{"cost-center": "1234", "team": "sre", "cluster-id": "old"},
+			map[string]string{"cost-center": "1234", "cluster-id": "abc", "env": "prod"},
+		)
+		Expect(toSet).To(Equal(map[string]string{"cluster-id": "abc", "env": "prod"}))
+		Expect(toRemove).To(Equal([]string{"team"}))
+	})
+
+	It("tags the IAM user and role which exist", func() {
+		mockAWSClient.EXPECT().TagUser(gomock.Any(), &iam.TagUserInput{
+			UserName: aws.String("osdManagedAdmin-abcdef"),
+			Tags:     []iamtypes.Tag{{Key: aws.String("cluster-id"), Value: aws.String("abc")}},
+		}).Return(nil, &iamtypes.NoSuchEntityException{})
+		mockAWSClient.EXPECT().TagRole(gomock.Any(), &iam.TagRoleInput{
+			RoleName: aws.String(stsRoleName),
+			Tags:     []iamtypes.Tag{{Key: aws.String("cluster-id"), Value: aws.String("abc")}},
+		}).Return(&iam.TagRoleOutput{}, nil)
+		mockAWSClient.EXPECT().UntagUser(gomock.Any(), &iam.UntagUserInput{
+			UserName: aws.String("osdManagedAdmin-abcdef"),
+			TagKeys:  []string{"team"},
+		}).Return(nil, &iamtypes.NoSuchEntityException{})
+		mockAWSClient.EXPECT().UntagRole(gomock.Any(), &iam.UntagRoleInput{
+			RoleName: aws.String(stsRoleName),
+			TagKeys:  []string{"team"},
+		}).Return(&iam.UntagRoleOutput{}, nil)
+
+		Expect(syncIAMTags(mockAWSClient, "osdManagedAdmin-abcdef", stsRoleName, map[string]string{"cluster-id": "abc"}, []string{"team"})).To(Succeed())
+	})
+
+	It("records the applied tags on the account", func() {
+		Expect(r.syncClaimTags(context.TODO(), nullLogger, getAccount(), accountClaim.Spec.Tags)).To(Succeed())
+		Expect(appliedClaimTags(getAccount())).To(Equal(accountClaim.Spec.Tags))
+	})
+
+	It("removes the tags when the account is released", func() {
+		Expect(r.releaseClaimTags(context.TODO(), nullLogger, getAccount())).To(Succeed())
+		Expect(getAccount().Annotations).NotTo(HaveKey(awsv1alpha1.ClaimTagsAnnotation))
+	})
+
+	It("reports invalid tags of Ready claims without applying them", func() {
+		recorder := record.NewFakeRecorder(1)
+		r.recorder = recorder
+		accountClaim.Spec.Tags = map[string]string{"aws:cost-center": "1234"}
+
+		Expect(r.propagateClaimTags(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		Expect(recorder.Events).To(Receive(ContainSubstring(invalidTagsReason)))
+		Expect(getAccount().Annotations).To(Equal(account.Annotations))
+	})
+})
@@ -0,0 +1,30 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// claimAccount links an unclaimed account to the claim by patching its claim link. The patch is conditional on the
+// resourceVersion the account was selected at, so when several claims select the same account at once only the first
+// patch is applied. The others fail with a conflict and have to select another account.
+func (r *AccountClaimReconciler) claimAccount(reqLogger logr.Logger, account *awsv1alpha1.Account, accountClaim *awsv1alpha1.AccountClaim) error {
+	if account.Spec.ClaimLink != "" {
+		return fmt.Errorf("account %s is already linked to AccountClaim %s/%s", account.Name, account.Spec.ClaimLinkNamespace, account.Spec.ClaimLink)
+	}
+
+	selected := account.DeepCopy()
+	updateClaimedAccountFields(reqLogger, account, accountClaim)
+	err := r.Patch(context.TODO(), account, client.MergeFromWithOptions(selected, client.MergeFromWithOptimisticLock{}))
+	if err != nil {
+		// Leave the account as it was selected, it's not ours
+		selected.DeepCopyInto(account)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package accountclaim
+
+import (
+	"context"
+	"sync"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Claiming accounts", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		r          *AccountClaimReconciler
+		account    *awsv1alpha1.Account
+		claims     []*awsv1alpha1.AccountClaim
+	)
+
+	BeforeEach(func() {
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+			Status:     awsv1alpha1.AccountStatus{State: string(awsv1alpha1.AccountReady)},
+		}
+		claims = []*awsv1alpha1.AccountClaim{
+			{ObjectMeta: metav1.ObjectMeta{Name: "claim-a", Namespace: "namespace-a"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "claim-b", Namespace: "namespace-b"}},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), account)).To(Succeed())
+	})
+
+	It("links the account to the claim", func() {
+		Expect(r.claimAccount(nullLogger, account, claims[0])).To(Succeed())
+
+		claimed := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), claimed)).To(Succeed())
+		Expect(claimed.Spec.ClaimLink).To(Equal("claim-a"))
+		Expect(claimed.Spec.ClaimLinkNamespace).To(Equal("namespace-a"))
+	})
+
+	It("fails when the account changed since it was selected", func() {
+		first, second := account.DeepCopy(), account.DeepCopy()
+
+		Expect(r.claimAccount(nullLogger, first, claims[0])).To(Succeed())
+		err := r.claimAccount(nullLogger, second, claims[1])
+		Expect(k8serr.IsConflict(err)).To(BeTrue())
+		Expect(second.Spec.ClaimLink).To(BeEmpty())
+
+		claimed := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), claimed)).To(Succeed())
+		Expect(claimed.Spec.ClaimLink).To(Equal("claim-a"))
+	})
+
+	It("never binds an account to two simultaneous claims", func() {
+		for attempt := 0; attempt < 20; attempt++ {
+			selected := &awsv1alpha1.Account{}
+			Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), selected)).To(Succeed())
+			selected.Spec.ClaimLink, selected.Spec.ClaimLinkNamespace = "", ""
+			Expect(r.Update(context.TODO(), selected)).To(Succeed())
+
+			var wg sync.WaitGroup
+			errs := make([]error, len(claims))
+			for i := range claims {
+				wg.Add(1)
+				go func(i int, account *awsv1alpha1.Account) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					// The test logger isn't safe for concurrent use
+					errs[i] = r.claimAccount(testutils.NewTestLogger().Logger(), account, claims[i])
+				}(i, selected.DeepCopy())
+			}
+			wg.Wait()
+
+			succeeded := 0
+			winner := ""
+			for i, err := range errs {
+				if err == nil {
+					succeeded++
+					winner = claims[i].Name
+				} else {
+					Expect(k8serr.IsConflict(err)).To(BeTrue())
+				}
+			}
+			Expect(succeeded).To(Equal(1))
+
+			claimed := &awsv1alpha1.Account{}
+			Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), claimed)).To(Succeed())
+			Expect(claimed.Spec.ClaimLink).To(Equal(winner))
+		}
+	})
+})
@@ -0,0 +1,209 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// CleanupDryRunAnnotation makes the cleanup of the account of a deleted claim only list what it would delete, in
+	// the status of the claim, when set to "true". The claim is kept until the annotation is removed, which cleans the
+	// account up for real.
+	CleanupDryRunAnnotation = "aws.managed.openshift.io/cleanup-dry-run"
+
+	cleanupDryRunCompletedReason = "CleanupListed"
+	cleanupDryRunFailedReason    = "CleanupListingFailed"
+
+	// cleanupDryRunMaxNames bounds the names listed per kind, so accounts with many resources don't grow the claim
+	// past the size limit of objects
+	cleanupDryRunMaxNames = 50
+)
+
+// cleanupDryRunError stops the finalization of a claim after the dry run of its cleanup. It wraps the error the
+// listing failed with, if any, so a failed listing is retried without failing the account.
+type cleanupDryRunError struct {
+	err error
+}
+
+func (e *cleanupDryRunError) Error() string {
+	if e.err == nil {
+		return "cleanup dry run completed, the account isn't cleaned up"
+	}
+	return fmt.Sprintf("cleanup dry run failed: %s", e.err)
+}
+
+func (e *cleanupDryRunError) Unwrap() error {
+	return e.err
+}
+
+func isCleanupDryRun(err error) bool {
+	_, ok := err.(*cleanupDryRunError)
+	return ok
+}
+
+func hasCleanupDryRunAnnotation(accountClaim *awsv1alpha1.AccountClaim) bool {
+	return accountClaim.Annotations[CleanupDryRunAnnotation] == "true"
+}
+
+// cleanupDryRunLister lists the resources of a kind the cleanup of an account deletes
+type cleanupDryRunLister struct {
+	kind string
+	list func(awsclient.Client) ([]string, error)
+}
+
+// cleanupDryRunListers list the resources deleted by the cleanup steps every reused account goes through, in the order
+// of the steps. The AMIs are deregistered by the snapshots step.
+var cleanupDryRunListers = []cleanupDryRunLister{
+	{"AMIs", listCleanupImages},
+	{"Snapshots", listCleanupSnapshots},
+	{"EBSVolumes", listCleanupVolumes},
+	{"S3Buckets", listCleanupBuckets},
+	{"VPCEndpointServices", listCleanupVpcEndpointServices},
+	{"Route53HostedZones", listCleanupHostedZones},
+}
+
+// cleanUpDryRun lists what the cleanup of the account would delete into the CleanupDryRun status and condition of
+// the claim, without deleting anything. It always returns a cleanupDryRunError, so the claim is kept until its
+// annotation is removed.
+func (r *AccountClaimReconciler) cleanUpDryRun(reqLogger logr.Logger, awsClient awsclient.Client, accountClaim *awsv1alpha1.AccountClaim) error {
+	report := &awsv1alpha1.CleanupDryRunReport{Time: metav1.Now()}
+	summary := []string{}
+	for _, lister := range cleanupDryRunListers {
+		names, err := lister.list(awsClient)
+		if err != nil {
+			err = fmt.Errorf("failed listing the %s of the account: %w", lister.kind, err)
+			reqLogger.Error(err, "cleanup dry run failed")
+			r.setCleanupDryRunCondition(accountClaim, cleanupDryRunFailedReason, err.Error())
+			if updateErr := r.statusUpdate(reqLogger, accountClaim); updateErr != nil {
+				return &cleanupDryRunError{err: updateErr}
+			}
+			return &cleanupDryRunError{err: err}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		resources := awsv1alpha1.CleanupDryRunResources{Kind: lister.kind, Count: len(names), Names: names}
+		if len(names) > cleanupDryRunMaxNames {
+			resources.Names = names[:cleanupDryRunMaxNames]
+		}
+		report.Resources = append(report.Resources, resources)
+		summary = append(summary, fmt.Sprintf("%d %s", len(names), lister.kind))
+	}
+
+	message := "The cleanup would delete nothing"
+	if len(summary) > 0 {
+		message = fmt.Sprintf("The cleanup would delete %s", strings.Join(summary, ", "))
+	}
+	message += fmt.Sprintf(". Remove the %s annotation to clean up the account", CleanupDryRunAnnotation)
+	reqLogger.Info("cleanup dry run completed", "resources", summary)
+
+	accountClaim.Status.CleanupDryRun = report
+	r.setCleanupDryRunCondition(accountClaim, cleanupDryRunCompletedReason, message)
+	return &cleanupDryRunError{err: r.statusUpdate(reqLogger, accountClaim)}
+}
+
+func (r *AccountClaimReconciler) setCleanupDryRunCondition(accountClaim *awsv1alpha1.AccountClaim, reason string, message string) {
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.CleanupDryRun,
+		corev1.ConditionTrue,
+		reason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		accountClaim.Spec.BYOC,
+	)
+}
+
+func listCleanupImages(awsClient awsclient.Client) ([]string, error) {
+	images, err := awsClient.DescribeImages(context.TODO(), &ec2.DescribeImagesInput{Owners: []string{"self"}})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, image := range images.Images {
+		names = append(names, aws.ToString(image.ImageId))
+	}
+	return names, nil
+}
+
+func listCleanupSnapshots(awsClient awsclient.Client) ([]string, error) {
+	snapshots, err := awsClient.DescribeSnapshots(context.TODO(), &ec2.DescribeSnapshotsInput{
+		Filters: []ec2types.Filter{{Name: aws.String("owner-alias"), Values: []string{"self"}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, snapshot := range snapshots.Snapshots {
+		names = append(names, aws.ToString(snapshot.SnapshotId))
+	}
+	return names, nil
+}
+
+func listCleanupVolumes(awsClient awsclient.Client) ([]string, error) {
+	volumes, err := awsClient.DescribeVolumes(context.TODO(), &ec2.DescribeVolumesInput{})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, volume := range volumes.Volumes {
+		names = append(names, aws.ToString(volume.VolumeId))
+	}
+	return names, nil
+}
+
+func listCleanupBuckets(awsClient awsclient.Client) ([]string, error) {
+	buckets, err := awsClient.ListBuckets(context.TODO(), &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, bucket := range buckets.Buckets {
+		names = append(names, aws.ToString(bucket.Name))
+	}
+	return names, nil
+}
+
+func listCleanupVpcEndpointServices(awsClient awsclient.Client) ([]string, error) {
+	services, err := awsClient.DescribeVpcEndpointServiceConfigurations(context.TODO(), &ec2.DescribeVpcEndpointServiceConfigurationsInput{})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, service := range services.ServiceConfigurations {
+		names = append(names, aws.ToString(service.ServiceId))
+	}
+	return names, nil
+}
+
+func listCleanupHostedZones(awsClient awsclient.Client) ([]string, error) {
+	names := []string{}
+	var marker *string
+	for {
+		zones, err := awsClient.ListHostedZones(context.TODO(), &route53.ListHostedZonesInput{Marker: marker})
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range zones.HostedZones {
+			names = append(names, aws.ToString(zone.Name))
+		}
+		if !zones.IsTruncated {
+			return names, nil
+		}
+		marker = zones.NextMarker
+	}
+}
@@ -0,0 +1,128 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cleanup dry run", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		ctrl         *gomock.Controller
+		mockAWS      *mock.MockClient
+		accountClaim *v1alpha1.AccountClaim
+		r            *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWS = mock.NewMockClient(ctrl)
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "claim",
+				Namespace:   "claim-namespace",
+				Annotations: map[string]string{CleanupDryRunAnnotation: "true"},
+			},
+			Spec: v1alpha1.AccountClaimSpec{AccountLink: "account"},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectListings := func(buckets int) {
+		mockAWS.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{}, nil)
+		mockAWS.EXPECT().DescribeSnapshots(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSnapshotsOutput{
+			Snapshots: []ec2types.Snapshot{{SnapshotId: aws.String("snap-1")}},
+		}, nil)
+		mockAWS.EXPECT().DescribeVolumes(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVolumesOutput{
+			Volumes: []ec2types.Volume{{VolumeId: aws.String("vol-1")}, {VolumeId: aws.String("vol-2")}},
+		}, nil)
+		bucketList := []s3types.Bucket{}
+		for i := 0; i < buckets; i++ {
+			bucketList = append(bucketList, s3types.Bucket{Name: aws.String(fmt.Sprintf("bucket-%d", i))})
+		}
+		mockAWS.EXPECT().ListBuckets(gomock.Any(), gomock.Any()).Return(&s3.ListBucketsOutput{Buckets: bucketList}, nil)
+		mockAWS.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcEndpointServiceConfigurationsOutput{}, nil)
+		gomock.InOrder(
+			mockAWS.EXPECT().ListHostedZones(gomock.Any(), &route53.ListHostedZonesInput{}).Return(&route53.ListHostedZonesOutput{
+				HostedZones: []route53types.HostedZone{{Name: aws.String("a.example.com.")}},
+				IsTruncated: true,
+				NextMarker:  aws.String("next"),
+			}, nil),
+			mockAWS.EXPECT().ListHostedZones(gomock.Any(), &route53.ListHostedZonesInput{Marker: aws.String("next")}).Return(&route53.ListHostedZonesOutput{
+				HostedZones: []route53types.HostedZone{{Name: aws.String("b.example.com.")}},
+			}, nil),
+		)
+	}
+
+	It("lists what the cleanup would delete in the status of the claim, deleting nothing", func() {
+		expectListings(1)
+
+		err := r.cleanUpDryRun(nullLogger, mockAWS, accountClaim)
+		Expect(isCleanupDryRun(err)).To(BeTrue())
+		Expect(errors.Unwrap(err)).NotTo(HaveOccurred())
+
+		updated := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		Expect(updated.Status.CleanupDryRun.Resources).To(Equal([]v1alpha1.CleanupDryRunResources{
+			{Kind: "Snapshots", Count: 1, Names: []string{"snap-1"}},
+			{Kind: "EBSVolumes", Count: 2, Names: []string{"vol-1", "vol-2"}},
+			{Kind: "S3Buckets", Count: 1, Names: []string{"bucket-0"}},
+			{Kind: "Route53HostedZones", Count: 2, Names: []string{"a.example.com.", "b.example.com."}},
+		}))
+		condition := controllerutils.FindAccountClaimCondition(updated.Status.Conditions, v1alpha1.CleanupDryRun)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(cleanupDryRunCompletedReason))
+		Expect(condition.Message).To(Equal("The cleanup would delete 1 Snapshots, 2 EBSVolumes, 1 S3Buckets, 2 Route53HostedZones. " +
+			"Remove the aws.managed.openshift.io/cleanup-dry-run annotation to clean up the account"))
+	})
+
+	It("only lists the first names of kinds with many resources", func() {
+		expectListings(cleanupDryRunMaxNames + 10)
+
+		Expect(errors.Unwrap(r.cleanUpDryRun(nullLogger, mockAWS, accountClaim))).NotTo(HaveOccurred())
+		buckets := accountClaim.Status.CleanupDryRun.Resources[2]
+		Expect(buckets.Count).To(Equal(cleanupDryRunMaxNames + 10))
+		Expect(buckets.Names).To(HaveLen(cleanupDryRunMaxNames))
+	})
+
+	It("is retried when the listing fails, without failing the account", func() {
+		mockAWS.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("throttled"))
+
+		err := r.cleanUpDryRun(nullLogger, mockAWS, accountClaim)
+		Expect(isCleanupDryRun(err)).To(BeTrue())
+		Expect(errors.Unwrap(err)).To(MatchError("failed listing the AMIs of the account: throttled"))
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, v1alpha1.CleanupDryRun)
+		Expect(condition.Reason).To(Equal(cleanupDryRunFailedReason))
+		Expect(accountClaim.Status.CleanupDryRun).To(BeNil())
+	})
+})
@@ -0,0 +1,121 @@
+package accountclaim
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	cleanupDynamoDBTablesKey = "cleanup.dynamodb_tables"
+	cleanupSQSQueuesKey      = "cleanup.sqs_queues"
+	cleanupSNSTopicsKey      = "cleanup.sns_topics"
+	cleanupLambdaKey         = "cleanup.lambda_functions"
+	cleanupEventBridgeKey    = "cleanup.eventbridge_rules"
+	cleanupRDSKey            = "cleanup.rds"
+	cleanupSpendFreezeKey    = "cleanup.spend_freeze"
+
+	// cleanupConcurrency bounds the parallel delete calls of a single cleanup step
+	cleanupConcurrency = 5
+)
+
+type cleanUpFunction func(logr.Logger, awsclient.Client, chan string, chan string, *cleanupCounter) error
+
+// cleanUpStep names a cleanup function for the cleanup report
+type cleanUpStep struct {
+	name string
+	run  cleanUpFunction
+}
+
+// cleanupPolicy holds the optional cleanup steps enabled in the operator ConfigMap. They default to
+// disabled, so reused accounts keep the historical cleanup unless the step is set to "true".
+type cleanupPolicy struct {
+	dynamoDBTables bool
+	sqsQueues      bool
+	snsTopics      bool
+	lambda         bool
+	eventBridge    bool
+	rds            bool
+	spendFreeze    bool
+}
+
+func (r *AccountClaimReconciler) getCleanupPolicy(ctx context.Context, reqLogger logr.Logger) cleanupPolicy {
+	policy := cleanupPolicy{}
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		reqLogger.Info("could not retrieve the operator configmap, optional cleanup steps are disabled")
+		return policy
+	}
+
+	for key, enabled := range map[string]*bool{
+		cleanupDynamoDBTablesKey: &policy.dynamoDBTables,
+		cleanupSQSQueuesKey:      &policy.sqsQueues,
+		cleanupSNSTopicsKey:      &policy.snsTopics,
+		cleanupLambdaKey:         &policy.lambda,
+		cleanupEventBridgeKey:    &policy.eventBridge,
+		cleanupRDSKey:            &policy.rds,
+		cleanupSpendFreezeKey:    &policy.spendFreeze,
+	} {
+		value, err := controllerutils.GetFeatureFlagValue(cm, key)
+		if err != nil {
+			reqLogger.Info("invalid cleanup policy value, step is disabled", "key", key)
+			continue
+		}
+		*enabled = value
+	}
+	return policy
+}
+
+// optionalCleanUpSteps returns the cleanup steps enabled by the policy
+func (r *AccountClaimReconciler) optionalCleanUpSteps(policy cleanupPolicy) []cleanUpStep {
+	steps := []cleanUpStep{}
+	if policy.dynamoDBTables {
+		steps = append(steps, cleanUpStep{"DynamoDBTables", r.cleanUpAwsDynamoDBTables})
+	}
+	if policy.sqsQueues {
+		steps = append(steps, cleanUpStep{"SQSQueues", r.cleanUpAwsSQSQueues})
+	}
+	if policy.snsTopics {
+		steps = append(steps, cleanUpStep{"SNSTopics", r.cleanUpAwsSNSTopics})
+	}
+	if policy.lambda {
+		steps = append(steps, cleanUpStep{"Lambda", r.cleanUpAwsLambda})
+	}
+	if policy.eventBridge {
+		steps = append(steps, cleanUpStep{"EventBridge", r.cleanUpAwsEventBridgeRules})
+	}
+	// RDS is part of the spend freeze when that is enabled
+	if policy.rds && !policy.spendFreeze {
+		steps = append(steps, cleanUpStep{"RDS", r.cleanUpAwsRDS})
+	}
+	return steps
+}
+
+// deleteConcurrently calls del for every item, at most cleanupConcurrency at a time, and returns the first error.
+// Every item del succeeds for is counted as deleted.
+func deleteConcurrently(items []string, deleted *cleanupCounter, del func(string) error) error {
+	sem := make(chan struct{}, cleanupConcurrency)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(item string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := del(item); err != nil {
+				once.Do(func() { firstErr = err })
+				return
+			}
+			deleted.add(1)
+		}(item)
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,97 @@
+package accountclaim
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// cleanupPrewarmMinBatch is how many claims have to be deleted together before their credentials are pre-warmed,
+	// a single deletion assumes its role itself
+	cleanupPrewarmMinBatch = 2
+	// cleanupPrewarmConcurrency is how many roles are assumed at once while pre-warming
+	cleanupPrewarmConcurrency = 5
+)
+
+// cleanupPrewarmMutex lets a single reconcile pre-warm the credentials of a batch, the others go on with their cleanup
+var cleanupPrewarmMutex sync.Mutex
+
+// prewarmCleanupCredentials assumes the roles of the accounts of every claim being deleted ahead of their cleanup and
+// shares the credentials through the credential cache, so that tearing down a fleet of clusters doesn't start an
+// AssumeRole chain per cleanup. Failures are logged, each cleanup assumes its role itself when it finds none cached.
+func (r *AccountClaimReconciler) prewarmCleanupCredentials(ctx context.Context, reqLogger logr.Logger) {
+	if !cleanupPrewarmMutex.TryLock() {
+		return
+	}
+	defer cleanupPrewarmMutex.Unlock()
+
+	accounts, err := r.accountsPendingCleanup(ctx)
+	if err != nil {
+		reqLogger.Error(err, "failed listing the accounts pending cleanup")
+		return
+	}
+	if len(accounts) < cleanupPrewarmMinBatch {
+		return
+	}
+
+	awsSetupClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		SecretName: controllerutils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  config.GetDefaultRegion(),
+	})
+	if err != nil {
+		reqLogger.Error(err, "failed building operator AWS client")
+		return
+	}
+
+	reqLogger.Info("Pre-warming the credentials of the accounts pending cleanup", "accounts", len(accounts))
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, cleanupPrewarmConcurrency)
+	for _, account := range accounts {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(account *awsv1alpha1.Account) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := stsclient.PrewarmRoleAssumption(reqLogger, awsSetupClient, account, awsv1alpha1.AccountOperatorIAMRole); err != nil {
+				reqLogger.Error(err, "failed pre-warming the credentials of the account", "account", account.Name)
+			}
+		}(account)
+	}
+	wg.Wait()
+}
+
+// accountsPendingCleanup returns the accounts of the deleted claims whose AWS resources are cleaned up through the
+// operator role, i.e. neither CCS nor STS accounts
+func (r *AccountClaimReconciler) accountsPendingCleanup(ctx context.Context) ([]*awsv1alpha1.Account, error) {
+	claims := &awsv1alpha1.AccountClaimList{}
+	if err := r.List(ctx, claims); err != nil {
+		return nil, err
+	}
+	accounts := []*awsv1alpha1.Account{}
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if claim.DeletionTimestamp == nil || claim.Spec.AccountLink == "" || claim.Spec.BYOC ||
+			!controllerutils.Contains(claim.GetFinalizers(), accountClaimFinalizer) {
+			continue
+		}
+		account, err := r.getClaimedAccount(claim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
+		if err != nil {
+			// The cleanup of the claim reports it
+			continue
+		}
+		if account.IsBYOC() || account.Spec.ManualSTSMode || account.Spec.AwsAccountID == "" {
+			continue
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
@@ -0,0 +1,117 @@
+package accountclaim
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cleanup credential pre-warming", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		objects       []client.Object
+	)
+
+	deletedClaim := func(name string, accountLink string) *v1alpha1.AccountClaim {
+		return &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "claim-namespace",
+				DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				Finalizers:        []string{accountClaimFinalizer},
+			},
+			Spec: v1alpha1.AccountClaimSpec{AccountLink: accountLink},
+		}
+	}
+
+	claimedAccount := func(name string, accountID string) *v1alpha1.Account {
+		return &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: v1alpha1.AccountCrNamespace},
+			Spec:       v1alpha1.AccountSpec{AwsAccountID: accountID},
+		}
+	}
+
+	reconciler := func() *AccountClaimReconciler {
+		r := &AccountClaimReconciler{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+			Scheme:           scheme.Scheme,
+			awsClientBuilder: &mock.Builder{MockController: ctrl},
+		}
+		mockAWSClient = mock.GetMockClient(r.awsClientBuilder)
+		return r
+	}
+
+	expectAssumeRole := func(accountID string) {
+		roleArn := config.GetIAMArn(accountID, config.AwsResourceTypeRole, v1alpha1.AccountOperatorIAMRole)
+		mockAWSClient.EXPECT().AssumeRole(gomock.Any(), &sts.AssumeRoleInput{
+			DurationSeconds: aws.Int32(3600),
+			RoleArn:         aws.String(roleArn),
+			RoleSessionName: aws.String("awsAccountOperator"),
+		}).Return(&sts.AssumeRoleOutput{
+			Credentials: &ststypes.Credentials{
+				AccessKeyId:     aws.String("ACCESS_KEY"),
+				Expiration:      aws.Time(time.Now().Add(time.Hour)),
+				SecretAccessKey: aws.String("SECRET_KEY"),
+				SessionToken:    aws.String("SESSION_TOKEN"),
+			},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		stsclient.Credentials = stsclient.NewCredentialCache()
+		objects = []client.Object{
+			deletedClaim("claim-a", "osd-creds-mgmt-aaaaaa"),
+			claimedAccount("osd-creds-mgmt-aaaaaa", "111111111111"),
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		stsclient.Credentials = stsclient.NewCredentialCache()
+	})
+
+	It("leaves a single deletion to its cleanup", func() {
+		r := reconciler()
+		r.prewarmCleanupCredentials(context.TODO(), nullLogger)
+	})
+
+	It("assumes the roles of the claims deleted together once", func() {
+		byocAccount := claimedAccount("osd-creds-mgmt-cccccc", "333333333333")
+		byocAccount.Spec.BYOC = true
+		objects = append(objects,
+			deletedClaim("claim-b", "osd-creds-mgmt-bbbbbb"),
+			claimedAccount("osd-creds-mgmt-bbbbbb", "222222222222"),
+			deletedClaim("claim-c", "osd-creds-mgmt-cccccc"),
+			byocAccount,
+		)
+		r := reconciler()
+		expectAssumeRole("111111111111")
+		expectAssumeRole("222222222222")
+
+		r.prewarmCleanupCredentials(context.TODO(), nullLogger)
+		roleArn := config.GetIAMArn("222222222222", config.AwsResourceTypeRole, v1alpha1.AccountOperatorIAMRole)
+		Expect(stsclient.Credentials.Get(roleArn)).NotTo(BeNil())
+
+		By("reusing the cached credentials on the next batch")
+		r.prewarmCleanupCredentials(context.TODO(), nullLogger)
+	})
+})
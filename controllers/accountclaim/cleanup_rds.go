@@ -0,0 +1,191 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/go-logr/logr"
+
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+)
+
+// cleanUpAwsRDS deletes the DB instances, then the DB clusters and finally the manual snapshots of both, turning off
+// deletion protection where it is set. No final snapshots are taken and automated backups are deleted with their
+// instance or cluster, nothing of the previous tenant's data is kept.
+func (r *AccountClaimReconciler) cleanUpAwsRDS(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	for _, step := range []func(awsclient.Client, *cleanupCounter) error{
+		deleteRDSInstances,
+		deleteRDSClusters,
+		deleteRDSSnapshots,
+		deleteRDSClusterSnapshots,
+	} {
+		if err := step(awsClient, deleted); err != nil {
+			awsErrors <- err.Error()
+			return err
+		}
+	}
+
+	awsNotifications <- "RDS cleanup finished successfully"
+	return nil
+}
+
+func deleteRDSInstances(awsClient awsclient.Client, deleted *cleanupCounter) error {
+	protected := map[string]bool{}
+	// Members of a cluster don't take a final snapshot of their own, the cluster does
+	clusterMembers := map[string]bool{}
+	ids := []string{}
+	input := &rds.DescribeDBInstancesInput{}
+	for {
+		output, err := awsClient.DescribeDBInstances(context.TODO(), input)
+		if err != nil {
+			return fmt.Errorf("failed describing RDS instances: %w", err)
+		}
+		for _, instance := range output.DBInstances {
+			if aws.ToString(instance.DBInstanceStatus) == "deleting" {
+				continue
+			}
+			id := aws.ToString(instance.DBInstanceIdentifier)
+			protected[id] = aws.ToBool(instance.DeletionProtection)
+			clusterMembers[id] = instance.DBClusterIdentifier != nil
+			ids = append(ids, id)
+		}
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	return deleteConcurrently(ids, deleted, func(id string) error {
+		var notFoundErr *rdstypes.DBInstanceNotFoundFault
+		if protected[id] {
+			_, err := awsClient.ModifyDBInstance(context.TODO(), &rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier: aws.String(id),
+				DeletionProtection:   aws.Bool(false),
+				ApplyImmediately:     aws.Bool(true),
+			})
+			if err != nil && !errors.As(err, &notFoundErr) {
+				return fmt.Errorf("failed removing deletion protection of RDS instance: %s: %w", id, err)
+			}
+		}
+
+		input := &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier:   aws.String(id),
+			DeleteAutomatedBackups: aws.Bool(true),
+		}
+		if !clusterMembers[id] {
+			input.SkipFinalSnapshot = aws.Bool(true)
+		}
+		_, err := awsClient.DeleteDBInstance(context.TODO(), input)
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting RDS instance: %s: %w", id, err)
+		}
+		return nil
+	})
+}
+
+func deleteRDSClusters(awsClient awsclient.Client, deleted *cleanupCounter) error {
+	protected := map[string]bool{}
+	ids := []string{}
+	input := &rds.DescribeDBClustersInput{}
+	for {
+		output, err := awsClient.DescribeDBClusters(context.TODO(), input)
+		if err != nil {
+			return fmt.Errorf("failed describing RDS clusters: %w", err)
+		}
+		for _, cluster := range output.DBClusters {
+			if aws.ToString(cluster.Status) == "deleting" {
+				continue
+			}
+			id := aws.ToString(cluster.DBClusterIdentifier)
+			protected[id] = aws.ToBool(cluster.DeletionProtection)
+			ids = append(ids, id)
+		}
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	return deleteConcurrently(ids, deleted, func(id string) error {
+		var notFoundErr *rdstypes.DBClusterNotFoundFault
+		if protected[id] {
+			_, err := awsClient.ModifyDBCluster(context.TODO(), &rds.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(id),
+				DeletionProtection:  aws.Bool(false),
+				ApplyImmediately:    aws.Bool(true),
+			})
+			if err != nil && !errors.As(err, &notFoundErr) {
+				return fmt.Errorf("failed removing deletion protection of RDS cluster: %s: %w", id, err)
+			}
+		}
+
+		// Clusters whose instances are still being deleted fail with an invalid state and are deleted on the next attempt
+		_, err := awsClient.DeleteDBCluster(context.TODO(), &rds.DeleteDBClusterInput{
+			DBClusterIdentifier:    aws.String(id),
+			SkipFinalSnapshot:      aws.Bool(true),
+			DeleteAutomatedBackups: aws.Bool(true),
+		})
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting RDS cluster: %s: %w", id, err)
+		}
+		return nil
+	})
+}
+
+func deleteRDSSnapshots(awsClient awsclient.Client, deleted *cleanupCounter) error {
+	ids := []string{}
+	input := &rds.DescribeDBSnapshotsInput{SnapshotType: aws.String("manual")}
+	for {
+		output, err := awsClient.DescribeDBSnapshots(context.TODO(), input)
+		if err != nil {
+			return fmt.Errorf("failed describing RDS snapshots: %w", err)
+		}
+		for _, snapshot := range output.DBSnapshots {
+			ids = append(ids, aws.ToString(snapshot.DBSnapshotIdentifier))
+		}
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	return deleteConcurrently(ids, deleted, func(id string) error {
+		var notFoundErr *rdstypes.DBSnapshotNotFoundFault
+		_, err := awsClient.DeleteDBSnapshot(context.TODO(), &rds.DeleteDBSnapshotInput{DBSnapshotIdentifier: aws.String(id)})
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting RDS snapshot: %s: %w", id, err)
+		}
+		return nil
+	})
+}
+
+func deleteRDSClusterSnapshots(awsClient awsclient.Client, deleted *cleanupCounter) error {
+	ids := []string{}
+	input := &rds.DescribeDBClusterSnapshotsInput{SnapshotType: aws.String("manual")}
+	for {
+		output, err := awsClient.DescribeDBClusterSnapshots(context.TODO(), input)
+		if err != nil {
+			return fmt.Errorf("failed describing RDS cluster snapshots: %w", err)
+		}
+		for _, snapshot := range output.DBClusterSnapshots {
+			ids = append(ids, aws.ToString(snapshot.DBClusterSnapshotIdentifier))
+		}
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	return deleteConcurrently(ids, deleted, func(id string) error {
+		var notFoundErr *rdstypes.DBClusterSnapshotNotFoundFault
+		_, err := awsClient.DeleteDBClusterSnapshot(context.TODO(), &rds.DeleteDBClusterSnapshotInput{DBClusterSnapshotIdentifier: aws.String(id)})
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting RDS cluster snapshot: %s: %w", id, err)
+		}
+		return nil
+	})
+}
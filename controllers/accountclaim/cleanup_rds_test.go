@@ -0,0 +1,96 @@
+package accountclaim
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RDS cleanup", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		r             *AccountClaimReconciler
+		notifications chan string
+		errs          chan string
+		deleted       *cleanupCounter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWSClient = mock.NewMockClient(ctrl)
+		r = &AccountClaimReconciler{}
+		notifications, errs = make(chan string, 1), make(chan string, 1)
+		deleted = &cleanupCounter{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("runs in the spend freeze when that is enabled", func() {
+		Expect(r.optionalCleanUpSteps(cleanupPolicy{rds: true})).To(HaveLen(1))
+		Expect(r.optionalCleanUpSteps(cleanupPolicy{rds: true, spendFreeze: true})).To(BeEmpty())
+		Expect(r.spendFreezeCleanUpSteps(cleanupPolicy{rds: true, spendFreeze: true})).To(HaveLen(4))
+	})
+
+	It("removes deletion protection and deletes without final snapshots", func() {
+		mockAWSClient.EXPECT().DescribeDBInstances(gomock.Any(), gomock.Any()).Return(&rds.DescribeDBInstancesOutput{
+			DBInstances: []rdstypes.DBInstance{
+				{DBInstanceIdentifier: aws.String("protected"), DeletionProtection: aws.Bool(true)},
+				{DBInstanceIdentifier: aws.String("member"), DBClusterIdentifier: aws.String("cluster")},
+				{DBInstanceIdentifier: aws.String("gone"), DBInstanceStatus: aws.String("deleting")},
+			},
+		}, nil)
+		gomock.InOrder(
+			mockAWSClient.EXPECT().ModifyDBInstance(gomock.Any(), &rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier: aws.String("protected"),
+				DeletionProtection:   aws.Bool(false),
+				ApplyImmediately:     aws.Bool(true),
+			}).Return(&rds.ModifyDBInstanceOutput{}, nil),
+			mockAWSClient.EXPECT().DeleteDBInstance(gomock.Any(), &rds.DeleteDBInstanceInput{
+				DBInstanceIdentifier:   aws.String("protected"),
+				DeleteAutomatedBackups: aws.Bool(true),
+				SkipFinalSnapshot:      aws.Bool(true),
+			}).Return(&rds.DeleteDBInstanceOutput{}, nil),
+		)
+		mockAWSClient.EXPECT().DeleteDBInstance(gomock.Any(), &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier:   aws.String("member"),
+			DeleteAutomatedBackups: aws.Bool(true),
+		}).Return(nil, &rdstypes.DBInstanceNotFoundFault{})
+		mockAWSClient.EXPECT().DescribeDBClusters(gomock.Any(), gomock.Any()).Return(&rds.DescribeDBClustersOutput{
+			DBClusters: []rdstypes.DBCluster{{DBClusterIdentifier: aws.String("cluster")}},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteDBCluster(gomock.Any(), &rds.DeleteDBClusterInput{
+			DBClusterIdentifier:    aws.String("cluster"),
+			SkipFinalSnapshot:      aws.Bool(true),
+			DeleteAutomatedBackups: aws.Bool(true),
+		}).Return(&rds.DeleteDBClusterOutput{}, nil)
+		mockAWSClient.EXPECT().DescribeDBSnapshots(gomock.Any(), gomock.Any()).Return(&rds.DescribeDBSnapshotsOutput{
+			DBSnapshots: []rdstypes.DBSnapshot{{DBSnapshotIdentifier: aws.String("snapshot")}},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteDBSnapshot(gomock.Any(), gomock.Any()).Return(&rds.DeleteDBSnapshotOutput{}, nil)
+		mockAWSClient.EXPECT().DescribeDBClusterSnapshots(gomock.Any(), gomock.Any()).Return(&rds.DescribeDBClusterSnapshotsOutput{}, nil)
+
+		Expect(r.cleanUpAwsRDS(nullLogger, mockAWSClient, notifications, errs, deleted)).To(Succeed())
+		Expect(<-notifications).To(ContainSubstring("RDS"))
+	})
+
+	It("reports clusters that can't be deleted yet", func() {
+		mockAWSClient.EXPECT().DescribeDBInstances(gomock.Any(), gomock.Any()).Return(&rds.DescribeDBInstancesOutput{}, nil)
+		mockAWSClient.EXPECT().DescribeDBClusters(gomock.Any(), gomock.Any()).Return(&rds.DescribeDBClustersOutput{
+			DBClusters: []rdstypes.DBCluster{{DBClusterIdentifier: aws.String("cluster")}},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteDBCluster(gomock.Any(), gomock.Any()).Return(nil, &rdstypes.InvalidDBClusterStateFault{})
+
+		Expect(r.cleanUpAwsRDS(nullLogger, mockAWSClient, notifications, errs, deleted)).NotTo(Succeed())
+		Expect(<-errs).To(ContainSubstring("cluster"))
+	})
+})
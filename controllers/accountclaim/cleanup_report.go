@@ -0,0 +1,94 @@
+package accountclaim
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+)
+
+// cleanupCounter counts the resources a cleanup step deleted. Steps delete concurrently, so it is safe for
+// concurrent use. A nil counter doesn't count anything.
+type cleanupCounter struct {
+	deleted atomic.Int64
+}
+
+func (c *cleanupCounter) add(n int) {
+	if c != nil {
+		c.deleted.Add(int64(n))
+	}
+}
+
+func (c *cleanupCounter) count() int {
+	return int(c.deleted.Load())
+}
+
+// runCleanUpSteps calls the clean up steps in parallel and returns their outcome together with the last error they
+// reported. In dry-run mode, the error of a step stopped by a skipped AWS write is returned instead, so the caller
+// knows nothing failed.
+func runCleanUpSteps(reqLogger logr.Logger, awsClient awsclient.Client, cleanUpSteps []cleanUpStep) ([]awsv1alpha1.AccountCleanupStep, error) {
+	// Channels to track clean up functions
+	awsNotifications, awsErrors := make(chan string), make(chan string)
+
+	defer close(awsNotifications)
+	defer close(awsErrors)
+
+	results := make([]awsv1alpha1.AccountCleanupStep, len(cleanUpSteps))
+	stepErrs := make([]error, len(cleanUpSteps))
+	var wg sync.WaitGroup
+
+	// Call the clean up functions in parallel
+	for i, step := range cleanUpSteps {
+		wg.Add(1)
+		go func(i int, step cleanUpStep) {
+			defer wg.Done()
+			deleted := &cleanupCounter{}
+			start := time.Now()
+			err := step.run(reqLogger, awsClient, awsNotifications, awsErrors, deleted)
+			results[i] = awsv1alpha1.AccountCleanupStep{
+				Name:     step.name,
+				Deleted:  deleted.count(),
+				Duration: metav1.Duration{Duration: time.Since(start).Round(time.Millisecond)},
+			}
+			if err != nil {
+				results[i].Error = err.Error()
+				stepErrs[i] = err
+			}
+		}(i, step)
+	}
+
+	var err error
+	// Wait for clean up functions to end
+	for i := 0; i < len(cleanUpSteps); i++ {
+		select {
+		case msg := <-awsNotifications:
+			reqLogger.Info(msg)
+		case errMsg := <-awsErrors:
+			err = errors.New(errMsg)
+			reqLogger.Error(err, errMsg)
+		}
+	}
+	wg.Wait()
+
+	for _, stepErr := range stepErrs {
+		if awsclient.IsDryRunError(stepErr) {
+			return results, stepErr
+		}
+	}
+	return results, err
+}
+
+// recordCleanupReport stores the report on the account status. The cleanup already happened at this point, so a
+// failure to store it is only logged.
+func (r *AccountClaimReconciler) recordCleanupReport(reqLogger logr.Logger, account *awsv1alpha1.Account, report *awsv1alpha1.AccountCleanupReport) {
+	account.Status.LastCleanup = report
+	if err := r.accountStatusUpdate(reqLogger, account); err != nil {
+		reqLogger.Error(err, "failed to record the cleanup report")
+	}
+}
@@ -0,0 +1,357 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/go-logr/logr"
+
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+)
+
+const (
+	// eventBridgeDefaultEventBus can't be deleted, only its rules are removed
+	eventBridgeDefaultEventBus = "default"
+	// eventBridgeMaxTargetsPerCall is the maximum number of target IDs RemoveTargets accepts
+	eventBridgeMaxTargetsPerCall = 10
+)
+
+func (r *AccountClaimReconciler) cleanUpAwsDynamoDBTables(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	tables := []string{}
+	input := &dynamodb.ListTablesInput{}
+	for {
+		output, err := awsClient.ListTables(context.TODO(), input)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed listing DynamoDB tables: %w", err).Error()
+			return err
+		}
+		tables = append(tables, output.TableNames...)
+		if output.LastEvaluatedTableName == nil {
+			break
+		}
+		input.ExclusiveStartTableName = output.LastEvaluatedTableName
+	}
+
+	err := deleteConcurrently(tables, deleted, func(table string) error {
+		_, err := awsClient.DeleteTable(context.TODO(), &dynamodb.DeleteTableInput{TableName: aws.String(table)})
+		var notFoundErr *dynamodbtypes.ResourceNotFoundException
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting DynamoDB table: %s: %w", table, err)
+		}
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	awsNotifications <- "DynamoDB cleanup finished successfully"
+	return nil
+}
+
+func (r *AccountClaimReconciler) cleanUpAwsSQSQueues(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	queues := []string{}
+	// NextToken is only returned when MaxResults is set
+	input := &sqs.ListQueuesInput{MaxResults: aws.Int32(1000)}
+	for {
+		output, err := awsClient.ListQueues(context.TODO(), input)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed listing SQS queues: %w", err).Error()
+			return err
+		}
+		queues = append(queues, output.QueueUrls...)
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	err := deleteConcurrently(queues, deleted, func(queueURL string) error {
+		_, err := awsClient.DeleteQueue(context.TODO(), &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)})
+		var notFoundErr *sqstypes.QueueDoesNotExist
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting SQS queue: %s: %w", queueURL, err)
+		}
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	awsNotifications <- "SQS cleanup finished successfully"
+	return nil
+}
+
+func (r *AccountClaimReconciler) cleanUpAwsSNSTopics(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	topics := []string{}
+	input := &sns.ListTopicsInput{}
+	for {
+		output, err := awsClient.ListTopics(context.TODO(), input)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed listing SNS topics: %w", err).Error()
+			return err
+		}
+		for _, topic := range output.Topics {
+			topics = append(topics, aws.ToString(topic.TopicArn))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	err := deleteConcurrently(topics, deleted, func(topicARN string) error {
+		_, err := awsClient.DeleteTopic(context.TODO(), &sns.DeleteTopicInput{TopicArn: aws.String(topicARN)})
+		var notFoundErr *snstypes.NotFoundException
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting SNS topic: %s: %w", topicARN, err)
+		}
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	awsNotifications <- "SNS cleanup finished successfully"
+	return nil
+}
+
+// cleanUpAwsLambda deletes the event source mappings first, so no invocations are queued for functions that are
+// being deleted, then the functions and every version of the account's layers
+func (r *AccountClaimReconciler) cleanUpAwsLambda(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	mappings := []string{}
+	mappingsInput := &lambda.ListEventSourceMappingsInput{}
+	for {
+		output, err := awsClient.ListEventSourceMappings(context.TODO(), mappingsInput)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed listing Lambda event source mappings: %w", err).Error()
+			return err
+		}
+		for _, mapping := range output.EventSourceMappings {
+			mappings = append(mappings, aws.ToString(mapping.UUID))
+		}
+		if output.NextMarker == nil {
+			break
+		}
+		mappingsInput.Marker = output.NextMarker
+	}
+
+	err := deleteConcurrently(mappings, deleted, func(uuid string) error {
+		_, err := awsClient.DeleteEventSourceMapping(context.TODO(), &lambda.DeleteEventSourceMappingInput{UUID: aws.String(uuid)})
+		if err != nil && !isLambdaNotFound(err) {
+			return fmt.Errorf("failed deleting Lambda event source mapping: %s: %w", uuid, err)
+		}
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	functions := []string{}
+	functionsInput := &lambda.ListFunctionsInput{}
+	for {
+		output, err := awsClient.ListFunctions(context.TODO(), functionsInput)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed listing Lambda functions: %w", err).Error()
+			return err
+		}
+		for _, function := range output.Functions {
+			functions = append(functions, aws.ToString(function.FunctionName))
+		}
+		if output.NextMarker == nil {
+			break
+		}
+		functionsInput.Marker = output.NextMarker
+	}
+
+	err = deleteConcurrently(functions, deleted, func(function string) error {
+		_, err := awsClient.DeleteFunction(context.TODO(), &lambda.DeleteFunctionInput{FunctionName: aws.String(function)})
+		if err != nil && !isLambdaNotFound(err) {
+			return fmt.Errorf("failed deleting Lambda function: %s: %w", function, err)
+		}
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	layers := []string{}
+	layersInput := &lambda.ListLayersInput{}
+	for {
+		output, err := awsClient.ListLayers(context.TODO(), layersInput)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed listing Lambda layers: %w", err).Error()
+			return err
+		}
+		for _, layer := range output.Layers {
+			layers = append(layers, aws.ToString(layer.LayerName))
+		}
+		if output.NextMarker == nil {
+			break
+		}
+		layersInput.Marker = output.NextMarker
+	}
+
+	err = deleteConcurrently(layers, deleted, func(layer string) error {
+		input := &lambda.ListLayerVersionsInput{LayerName: aws.String(layer)}
+		for {
+			output, err := awsClient.ListLayerVersions(context.TODO(), input)
+			if err != nil {
+				return fmt.Errorf("failed listing Lambda layer versions: %s: %w", layer, err)
+			}
+			for _, version := range output.LayerVersions {
+				_, err := awsClient.DeleteLayerVersion(context.TODO(), &lambda.DeleteLayerVersionInput{
+					LayerName:     aws.String(layer),
+					VersionNumber: aws.Int64(version.Version),
+				})
+				if err != nil && !isLambdaNotFound(err) {
+					return fmt.Errorf("failed deleting Lambda layer version: %s:%d: %w", layer, version.Version, err)
+				}
+			}
+			if output.NextMarker == nil {
+				return nil
+			}
+			input.Marker = output.NextMarker
+		}
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	awsNotifications <- "Lambda cleanup finished successfully"
+	return nil
+}
+
+// isLambdaNotFound is called from concurrent deletes, so every call needs its own errors.As target
+func isLambdaNotFound(err error) bool {
+	var notFoundErr *lambdatypes.ResourceNotFoundException
+	return errors.As(err, &notFoundErr)
+}
+
+// cleanUpAwsEventBridgeRules deletes the rules on every event bus together with their targets, and the custom event
+// buses themselves. Rules managed by other AWS services are left to the service that created them.
+func (r *AccountClaimReconciler) cleanUpAwsEventBridgeRules(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	buses := []string{}
+	input := &eventbridge.ListEventBusesInput{}
+	for {
+		output, err := awsClient.ListEventBuses(context.TODO(), input)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed listing EventBridge event buses: %w", err).Error()
+			return err
+		}
+		for _, bus := range output.EventBuses {
+			buses = append(buses, aws.ToString(bus.Name))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	// The default bus isn't deleted, so the rules and custom buses are counted instead of the buses
+	err := deleteConcurrently(buses, nil, func(bus string) error {
+		if err := deleteEventBridgeRules(awsClient, bus, deleted); err != nil {
+			return err
+		}
+		if bus == eventBridgeDefaultEventBus {
+			return nil
+		}
+		_, err := awsClient.DeleteEventBus(context.TODO(), &eventbridge.DeleteEventBusInput{Name: aws.String(bus)})
+		if err != nil {
+			return fmt.Errorf("failed deleting EventBridge event bus: %s: %w", bus, err)
+		}
+		deleted.add(1)
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	awsNotifications <- "EventBridge cleanup finished successfully"
+	return nil
+}
+
+func deleteEventBridgeRules(awsClient awsclient.Client, bus string, deleted *cleanupCounter) error {
+	var notFoundErr *eventbridgetypes.ResourceNotFoundException
+
+	rules := []string{}
+	input := &eventbridge.ListRulesInput{EventBusName: aws.String(bus)}
+	for {
+		output, err := awsClient.ListRules(context.TODO(), input)
+		if err != nil {
+			return fmt.Errorf("failed listing EventBridge rules: %s: %w", bus, err)
+		}
+		for _, rule := range output.Rules {
+			if rule.ManagedBy != nil {
+				continue
+			}
+			rules = append(rules, aws.ToString(rule.Name))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	for _, rule := range rules {
+		// A rule can only be deleted once all of its targets are removed
+		targets := []string{}
+		targetsInput := &eventbridge.ListTargetsByRuleInput{Rule: aws.String(rule), EventBusName: aws.String(bus)}
+		for {
+			output, err := awsClient.ListTargetsByRule(context.TODO(), targetsInput)
+			if err != nil && !errors.As(err, &notFoundErr) {
+				return fmt.Errorf("failed listing EventBridge targets: %s/%s: %w", bus, rule, err)
+			}
+			if err != nil {
+				break
+			}
+			for _, target := range output.Targets {
+				targets = append(targets, aws.ToString(target.Id))
+			}
+			if output.NextToken == nil {
+				break
+			}
+			targetsInput.NextToken = output.NextToken
+		}
+
+		for start := 0; start < len(targets); start += eventBridgeMaxTargetsPerCall {
+			end := start + eventBridgeMaxTargetsPerCall
+			if end > len(targets) {
+				end = len(targets)
+			}
+			_, err := awsClient.RemoveTargets(context.TODO(), &eventbridge.RemoveTargetsInput{
+				Rule:         aws.String(rule),
+				EventBusName: aws.String(bus),
+				Ids:          targets[start:end],
+			})
+			if err != nil && !errors.As(err, &notFoundErr) {
+				return fmt.Errorf("failed removing EventBridge targets: %s/%s: %w", bus, rule, err)
+			}
+		}
+
+		_, err := awsClient.DeleteRule(context.TODO(), &eventbridge.DeleteRuleInput{Name: aws.String(rule), EventBusName: aws.String(bus)})
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting EventBridge rule: %s/%s: %w", bus, rule, err)
+		}
+		deleted.add(1)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Optional service cleanup", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		r             *AccountClaimReconciler
+		notifications chan string
+		errs          chan string
+		deleted       *cleanupCounter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWSClient = mock.NewMockClient(ctrl)
+		r = &AccountClaimReconciler{}
+		notifications, errs = make(chan string, 1), make(chan string, 1)
+		deleted = &cleanupCounter{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("only enables the steps set in the operator ConfigMap", func() {
+		r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				cleanupDynamoDBTablesKey: "true",
+				cleanupSQSQueuesKey:      "false",
+				cleanupSNSTopicsKey:      "not-a-bool",
+			},
+		}).Build()
+
+		policy := r.getCleanupPolicy(context.TODO(), nullLogger)
+		Expect(policy).To(Equal(cleanupPolicy{dynamoDBTables: true}))
+		Expect(r.optionalCleanUpSteps(policy)).To(HaveLen(1))
+	})
+
+	It("deletes DynamoDB tables from every page", func() {
+		mockAWSClient.EXPECT().ListTables(gomock.Any(), gomock.Any()).Return(&dynamodb.ListTablesOutput{
+			TableNames:             []string{"a"},
+			LastEvaluatedTableName: aws.String("a"),
+		}, nil)
+		mockAWSClient.EXPECT().ListTables(gomock.Any(), gomock.Any()).Return(&dynamodb.ListTablesOutput{
+			TableNames: []string{"b"},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteTable(gomock.Any(), gomock.Any()).Return(&dynamodb.DeleteTableOutput{}, nil).Times(2)
+
+		Expect(r.cleanUpAwsDynamoDBTables(nullLogger, mockAWSClient, notifications, errs, deleted)).To(Succeed())
+		Expect(<-notifications).To(ContainSubstring("DynamoDB"))
+		Expect(deleted.count()).To(Equal(2))
+	})
+
+	It("ignores SQS queues that are already gone", func() {
+		mockAWSClient.EXPECT().ListQueues(gomock.Any(), gomock.Any()).Return(&sqs.ListQueuesOutput{
+			QueueUrls: []string{"https://sqs.us-east-1.amazonaws.com/123456789012/q"},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteQueue(gomock.Any(), gomock.Any()).Return(nil, &sqstypes.QueueDoesNotExist{})
+
+		Expect(r.cleanUpAwsSQSQueues(nullLogger, mockAWSClient, notifications, errs, deleted)).To(Succeed())
+	})
+
+	It("reports SNS topics that can't be deleted", func() {
+		mockAWSClient.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&sns.ListTopicsOutput{
+			Topics: []snstypes.Topic{{TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:t")}},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteTopic(gomock.Any(), gomock.Any()).Return(nil, errors.New("denied"))
+
+		Expect(r.cleanUpAwsSNSTopics(nullLogger, mockAWSClient, notifications, errs, deleted)).NotTo(Succeed())
+		Expect(<-errs).To(ContainSubstring("arn:aws:sns:us-east-1:123456789012:t"))
+	})
+
+	It("deletes event source mappings before Lambda functions and layer versions", func() {
+		gomock.InOrder(
+			mockAWSClient.EXPECT().ListEventSourceMappings(gomock.Any(), gomock.Any()).Return(&lambda.ListEventSourceMappingsOutput{
+				EventSourceMappings: []lambdatypes.EventSourceMappingConfiguration{{UUID: aws.String("mapping")}},
+			}, nil),
+			mockAWSClient.EXPECT().DeleteEventSourceMapping(gomock.Any(), gomock.Any()).Return(&lambda.DeleteEventSourceMappingOutput{}, nil),
+			mockAWSClient.EXPECT().ListFunctions(gomock.Any(), gomock.Any()).Return(&lambda.ListFunctionsOutput{
+				Functions: []lambdatypes.FunctionConfiguration{{FunctionName: aws.String("function")}},
+			}, nil),
+			mockAWSClient.EXPECT().DeleteFunction(gomock.Any(), gomock.Any()).Return(nil, &lambdatypes.ResourceNotFoundException{}),
+			mockAWSClient.EXPECT().ListLayers(gomock.Any(), gomock.Any()).Return(&lambda.ListLayersOutput{
+				Layers: []lambdatypes.LayersListItem{{LayerName: aws.String("layer")}},
+			}, nil),
+			mockAWSClient.EXPECT().ListLayerVersions(gomock.Any(), gomock.Any()).Return(&lambda.ListLayerVersionsOutput{
+				LayerVersions: []lambdatypes.LayerVersionsListItem{{Version: 1}, {Version: 2}},
+			}, nil),
+		)
+		mockAWSClient.EXPECT().DeleteLayerVersion(gomock.Any(), gomock.Any()).Return(&lambda.DeleteLayerVersionOutput{}, nil).Times(2)
+
+		Expect(r.cleanUpAwsLambda(nullLogger, mockAWSClient, notifications, errs, deleted)).To(Succeed())
+		Expect(<-notifications).To(ContainSubstring("Lambda"))
+	})
+
+	It("deletes EventBridge rules and custom buses but keeps the default bus and managed rules", func() {
+		mockAWSClient.EXPECT().ListEventBuses(gomock.Any(), gomock.Any()).Return(&eventbridge.ListEventBusesOutput{
+			EventBuses: []eventbridgetypes.EventBus{{Name: aws.String("default")}, {Name: aws.String("custom")}},
+		}, nil)
+		mockAWSClient.EXPECT().ListRules(gomock.Any(), gomock.Any()).Return(&eventbridge.ListRulesOutput{
+			Rules: []eventbridgetypes.Rule{
+				{Name: aws.String("tenant-rule")},
+				{Name: aws.String("managed-rule"), ManagedBy: aws.String("events.amazonaws.com")},
+			},
+		}, nil).Times(2)
+		mockAWSClient.EXPECT().ListTargetsByRule(gomock.Any(), gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{
+			Targets: []eventbridgetypes.Target{{Id: aws.String("target")}},
+		}, nil).Times(2)
+		mockAWSClient.EXPECT().RemoveTargets(gomock.Any(), gomock.Any()).Return(&eventbridge.RemoveTargetsOutput{}, nil).Times(2)
+		mockAWSClient.EXPECT().DeleteRule(gomock.Any(), &eventbridge.DeleteRuleInput{Name: aws.String("tenant-rule"), EventBusName: aws.String("default")}).Return(&eventbridge.DeleteRuleOutput{}, nil)
+		mockAWSClient.EXPECT().DeleteRule(gomock.Any(), &eventbridge.DeleteRuleInput{Name: aws.String("tenant-rule"), EventBusName: aws.String("custom")}).Return(&eventbridge.DeleteRuleOutput{}, nil)
+		mockAWSClient.EXPECT().DeleteEventBus(gomock.Any(), &eventbridge.DeleteEventBusInput{Name: aws.String("custom")}).Return(&eventbridge.DeleteEventBusOutput{}, nil)
+
+		Expect(r.cleanUpAwsEventBridgeRules(nullLogger, mockAWSClient, notifications, errs, deleted)).To(Succeed())
+		Expect(<-notifications).To(ContainSubstring("EventBridge"))
+	})
+})
@@ -0,0 +1,114 @@
+package accountclaim
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+)
+
+const (
+	// cliConfigKey and cliCredentialsKey are the keys of the AWS CLI files in the delivered secrets of claims asking
+	// for them, to be used as AWS_CONFIG_FILE and AWS_SHARED_CREDENTIALS_FILE
+	cliConfigKey      = "config"
+	cliCredentialsKey = "credentials"
+
+	// cliCredentialsExpireAfterAnnotation is set on delivered role secrets with AWS CLI files, to how long the sessions
+	// assumed through their profile last
+	cliCredentialsExpireAfterAnnotation = "aws.managed.openshift.io/credentials-expire-after"
+
+	awsCredsSessionToken = "aws_session_token" // #nosec G101 -- This is a false positive
+	awsRoleARN           = "role_arn"
+
+	// roleSessionDuration is the longest session of the roles delivered to claims, which keep the default maximum
+	// session duration of IAM roles
+	roleSessionDuration = time.Hour
+)
+
+// cliRegion is the default region of the AWS CLI files of the claim, its first region
+func cliRegion(accountClaim *awsv1alpha1.AccountClaim) string {
+	if len(accountClaim.Spec.Aws.Regions) > 0 {
+		return accountClaim.Spec.Aws.Regions[0].Name
+	}
+	return config.GetDefaultRegion()
+}
+
+// validateCLIValue rejects the values which would break the INI files of the AWS CLI
+func validateCLIValue(name string, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("%s is empty", name)
+	}
+	if strings.ContainsAny(value, "\r\n[]") {
+		return fmt.Errorf("%s %q can't be written to an AWS CLI file", name, value)
+	}
+	return nil
+}
+
+// renderCLIFiles returns the AWS CLI config and credentials files of the profile, and of the default profile, for the
+// credentials of a delivered secret. A role secret only gets a config file, whose profile assumes the role with the
+// credentials of the default profile of the user.
+func renderCLIFiles(profile string, region string, data map[string][]byte) (map[string][]byte, error) {
+	if err := validateCLIValue("profile", profile); err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(profile, " \t") {
+		return nil, fmt.Errorf("profile %q can't contain spaces", profile)
+	}
+	if err := validateCLIValue("region", region); err != nil {
+		return nil, err
+	}
+
+	if roleARN, ok := data[awsRoleARN]; ok {
+		if err := validateCLIValue(awsRoleARN, string(roleARN)); err != nil {
+			return nil, err
+		}
+		cfg := fmt.Sprintf("[profile %s]\nrole_arn = %s\nsource_profile = default\nduration_seconds = %d\nregion = %s\noutput = json\n",
+			profile, roleARN, int(roleSessionDuration.Seconds()), region)
+		return map[string][]byte{cliConfigKey: []byte(cfg)}, nil
+	}
+
+	credentials := ""
+	for _, key := range []string{awsCredsAccessKeyID, awsCredsSecretAccessKey, awsCredsSessionToken} {
+		value, ok := data[key]
+		if !ok && key == awsCredsSessionToken {
+			continue
+		}
+		if err := validateCLIValue(key, string(value)); err != nil {
+			return nil, err
+		}
+		credentials += fmt.Sprintf("%s = %s\n", key, value)
+	}
+	return map[string][]byte{
+		cliConfigKey:      []byte(fmt.Sprintf("[default]\nregion = %[2]s\noutput = json\n\n[profile %[1]s]\nregion = %[2]s\noutput = json\n", profile, region)),
+		cliCredentialsKey: []byte(fmt.Sprintf("[default]\n%[2]s\n[%[1]s]\n%[2]s", profile, credentials)),
+	}, nil
+}
+
+// setCLIFiles adds the AWS CLI files of the claim to the delivered secret when the claim asks for them, with the
+// region as default region and a profile named after the claim. The files are left out when the credentials of the
+// secret can't be written to them.
+func setCLIFiles(accountClaim *awsv1alpha1.AccountClaim, secret *corev1.Secret, region string) error {
+	delete(secret.Data, cliConfigKey)
+	delete(secret.Data, cliCredentialsKey)
+	if !accountClaim.Spec.CLIConfig {
+		return nil
+	}
+	files, err := renderCLIFiles(accountClaim.Name, region, secret.Data)
+	if err != nil {
+		return fmt.Errorf("failed rendering the AWS CLI files of secret %s: %w", secret.Name, err)
+	}
+	for key, value := range files {
+		secret.Data[key] = value
+	}
+	if _, ok := secret.Data[awsRoleARN]; ok {
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[cliCredentialsExpireAfterAnnotation] = roleSessionDuration.String()
+	}
+	return nil
+}
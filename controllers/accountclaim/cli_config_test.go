@@ -0,0 +1,70 @@
+package accountclaim
+
+import (
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AWS CLI files", func() {
+	var accountClaim *awsv1alpha1.AccountClaim
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				Aws:       awsv1alpha1.Aws{Regions: []awsv1alpha1.AwsRegions{{Name: "eu-west-1"}, {Name: "us-east-1"}}},
+				CLIConfig: true,
+			},
+		}
+	})
+
+	It("renders the profiles of IAM user credentials", func() {
+		secret := newSecretforCR("aws", "secret-namespace", []byte("access-key"), []byte("secret-key"))
+		Expect(setCLIFiles(accountClaim, secret, cliRegion(accountClaim))).To(Succeed())
+
+		Expect(string(secret.Data[cliConfigKey])).To(Equal("[default]\nregion = eu-west-1\noutput = json\n\n" +
+			"[profile claim]\nregion = eu-west-1\noutput = json\n"))
+		Expect(string(secret.Data[cliCredentialsKey])).To(Equal("[default]\naws_access_key_id = access-key\naws_secret_access_key = secret-key\n\n" +
+			"[claim]\naws_access_key_id = access-key\naws_secret_access_key = secret-key\n"))
+		Expect(secret.Annotations).NotTo(HaveKey(cliCredentialsExpireAfterAnnotation))
+	})
+
+	It("renders a profile assuming delivered roles, with their expiry", func() {
+		secret := newStsSecretforCR("aws", "secret-namespace", []byte("arn:aws:iam::123456789012:role/fleet"))
+		Expect(setCLIFiles(accountClaim, secret, "us-east-1")).To(Succeed())
+
+		Expect(string(secret.Data[cliConfigKey])).To(Equal("[profile claim]\nrole_arn = arn:aws:iam::123456789012:role/fleet\n" +
+			"source_profile = default\nduration_seconds = 3600\nregion = us-east-1\noutput = json\n"))
+		Expect(secret.Data).NotTo(HaveKey(cliCredentialsKey))
+		Expect(secret.Annotations).To(HaveKeyWithValue(cliCredentialsExpireAfterAnnotation, "1h0m0s"))
+	})
+
+	It("leaves the files out of secrets of claims not asking for them", func() {
+		accountClaim.Spec.CLIConfig = false
+		secret := newSecretforCR("aws", "secret-namespace", []byte("access-key"), []byte("secret-key"))
+		secret.Data[cliConfigKey] = []byte("stale")
+		Expect(setCLIFiles(accountClaim, secret, "us-east-1")).To(Succeed())
+		Expect(secret.Data).NotTo(HaveKey(cliConfigKey))
+	})
+
+	It("rejects credentials breaking the files", func() {
+		secret := newSecretforCR("aws", "secret-namespace", []byte("access-key\n[other]"), []byte("secret-key"))
+		Expect(setCLIFiles(accountClaim, secret, "us-east-1")).To(MatchError(ContainSubstring("can't be written to an AWS CLI file")))
+		Expect(secret.Data).NotTo(HaveKey(cliCredentialsKey))
+
+		secret = &corev1.Secret{Data: map[string][]byte{awsCredsAccessKeyID: []byte("access-key")}}
+		Expect(setCLIFiles(accountClaim, secret, "us-east-1")).To(MatchError(ContainSubstring("aws_secret_access_key is empty")))
+	})
+
+	It("defaults the files of regional secrets to their region", func() {
+		credentials := newSecretforCR("aws", "secret-namespace", []byte("access-key"), []byte("secret-key"))
+		Expect(setCLIFiles(accountClaim, credentials, cliRegion(accountClaim))).To(Succeed())
+
+		regional := newRegionalSecret(accountClaim, credentials, "us-east-1")
+		Expect(string(regional.Data[cliConfigKey])).To(ContainSubstring("[profile claim]\nregion = us-east-1\n"))
+	})
+})
@@ -0,0 +1,47 @@
+package accountclaim
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// fleetManagerTrustedARNFeatureFlag enables the IAM role and secret of fleet manager claims
+const fleetManagerTrustedARNFeatureFlag = "feature.accountclaim_fleet_manager_trusted_arn"
+
+// gatingConfigMapKeys are the operator ConfigMap keys gating steps of claims, which are reconciled again when they change
+var gatingConfigMapKeys = []string{
+	fleetManagerTrustedARNFeatureFlag,
+	approvalFeatureFlag,
+	approvalTimeoutKey,
+	approvalWebhookKey,
+}
+
+// gatedByConfigMap returns whether the claim is in a state one of the gatingConfigMapKeys acts upon: a fleet manager
+// claim, or a claim waiting for approval
+func gatedByConfigMap(accountClaim *awsv1alpha1.AccountClaim) bool {
+	if accountClaim.DeletionTimestamp != nil {
+		return false
+	}
+	return accountClaim.Spec.FleetManagerConfig.TrustedARN != "" || accountClaim.Status.State == awsv1alpha1.ClaimStatusPendingApproval
+}
+
+// claimsForConfigMap reconciles the claims gated by the operator ConfigMap when their gating keys change, so flipping a
+// feature flag takes effect without waiting for the claims to be reconciled for another reason
+func (r *AccountClaimReconciler) claimsForConfigMap(obj client.Object) []reconcile.Request {
+	accountClaims := &awsv1alpha1.AccountClaimList{}
+	if err := r.List(context.TODO(), accountClaims); err != nil {
+		log.Error(err, "failed to list AccountClaims", "ConfigMap", obj.GetName())
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for i := range accountClaims.Items {
+		if gatedByConfigMap(&accountClaims.Items[i]) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&accountClaims.Items[i])})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,41 @@
+package accountclaim
+
+import (
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Operator ConfigMap watch", func() {
+	It("reconciles the fleet manager claims and the claims waiting for approval", func() {
+		fleetClaim := &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "ns"},
+			Spec:       v1alpha1.AccountClaimSpec{FleetManagerConfig: v1alpha1.FleetManagerConfig{TrustedARN: "arn:aws:iam::123456789012:role/fleet"}},
+		}
+		pendingClaim := &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "ns"},
+			Status:     v1alpha1.AccountClaimStatus{State: v1alpha1.ClaimStatusPendingApproval},
+		}
+		readyClaim := &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ns"},
+			Status:     v1alpha1.AccountClaimStatus{State: v1alpha1.ClaimStatusReady},
+		}
+		r := &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(fleetClaim, pendingClaim, readyClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+
+		requests := r.claimsForConfigMap(&corev1.ConfigMap{})
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "fleet", Namespace: "ns"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "pending", Namespace: "ns"}},
+		))
+	})
+})
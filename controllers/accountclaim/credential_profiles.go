@@ -0,0 +1,122 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/go-logr/logr"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// validateCredentialProfile denies claims asking for a credential profile that isn't defined, or for which there's
+// no IAM user to apply it to: CCS claims deliver the customer's credentials, and STS and fleet manager claims a role
+func (r *AccountClaimReconciler) validateCredentialProfile(ctx context.Context, accountClaim *awsv1alpha1.AccountClaim) error {
+	if accountClaim.Spec.CredentialProfile == "" {
+		return nil
+	}
+	if accountClaim.Spec.BYOC || accountClaim.Spec.ManualSTSMode || accountClaim.Spec.FleetManagerConfig.TrustedARN != "" {
+		return &admissionDeniedError{reasons: []string{
+			"credential profiles only apply to the IAM user credentials delivered for non-CCS, non-STS claims",
+		}}
+	}
+	_, err := r.operatorConfig().CredentialProfile(ctx, accountClaim.Spec.CredentialProfile)
+	if errors.Is(err, controllerutils.ErrUnknownCredentialProfile) {
+		return &admissionDeniedError{reasons: []string{err.Error()}}
+	}
+	return err
+}
+
+// credentialProfilePolicyArns returns the ARNs of the managed policies of the profile
+func credentialProfilePolicyArns(profile *controllerutils.CredentialProfile) []string {
+	arns := []string{}
+	for _, policy := range profile.ManagedPolicies {
+		if strings.HasPrefix(policy, "arn:") {
+			arns = append(arns, policy)
+			continue
+		}
+		arns = append(arns, config.GetIAMArn("aws", config.AwsResourceTypePolicy, policy))
+	}
+	return arns
+}
+
+// applyCredentialProfile attaches the policies of the credential profile of the claim to the IAM user of the account
+// whose credentials are delivered, and detaches any other, e.g. the ones of the profile of a previous claim of a
+// reused account. The profile applied is recorded in the claim status, so it's only applied once.
+func (r *AccountClaimReconciler) applyCredentialProfile(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) error {
+	profileName := controllerutils.CredentialProfileName(accountClaim.Spec.CredentialProfile)
+	if accountClaim.Status.CredentialProfile == profileName {
+		return nil
+	}
+	profile, err := r.operatorConfig().CredentialProfile(ctx, profileName)
+	if err != nil {
+		return err
+	}
+	iamUserNamePrefix, err := r.operatorConfig().IAMUserNamePrefix(ctx, account.Spec.AccountPool)
+	if err != nil {
+		return err
+	}
+	iamUserName := fmt.Sprintf("%s-%s", iamUserNamePrefix, account.Labels[awsv1alpha1.IAMUserIDLabel])
+
+	awsSetupClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		SecretName: controllerutils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  config.GetDefaultRegion(),
+	})
+	if err != nil {
+		reqLogger.Error(err, "failed building operator AWS client")
+		return err
+	}
+	awsClient, _, err := stsclient.HandleRoleAssumption(reqLogger, r.awsClientBuilder, account, r.Client, awsSetupClient, "", awsv1alpha1.AccountOperatorIAMRole, "")
+	if err != nil {
+		reqLogger.Error(err, "failed building AWS client from assume_role")
+		return err
+	}
+
+	reqLogger.Info(fmt.Sprintf("Applying credential profile %s to IAM user %s", profileName, iamUserName))
+	if err := ensureUserPolicies(awsClient, iamUserName, credentialProfilePolicyArns(profile)); err != nil {
+		return err
+	}
+
+	accountClaim.Status.CredentialProfile = profileName
+	return r.statusUpdate(reqLogger, accountClaim)
+}
+
+// ensureUserPolicies attaches policyArns to the IAM user and detaches the other managed policies attached to it
+func ensureUserPolicies(awsClient awsclient.Client, userName string, policyArns []string) error {
+	attached, err := awsClient.ListAttachedUserPolicies(context.TODO(), &iam.ListAttachedUserPoliciesInput{UserName: aws.String(userName)})
+	if err != nil {
+		return fmt.Errorf("unable to list the policies of IAM user %s: %w", userName, err)
+	}
+
+	current := map[string]bool{}
+	for _, policy := range attached.AttachedPolicies {
+		arn := aws.ToString(policy.PolicyArn)
+		current[arn] = true
+		if controllerutils.Contains(policyArns, arn) {
+			continue
+		}
+		_, err := awsClient.DetachUserPolicy(context.TODO(), &iam.DetachUserPolicyInput{UserName: aws.String(userName), PolicyArn: policy.PolicyArn})
+		if err != nil {
+			return fmt.Errorf("unable to detach policy %s from IAM user %s: %w", arn, userName, err)
+		}
+	}
+	for _, arn := range policyArns {
+		if current[arn] {
+			continue
+		}
+		_, err := awsClient.AttachUserPolicy(context.TODO(), &iam.AttachUserPolicyInput{UserName: aws.String(userName), PolicyArn: aws.String(arn)})
+		if err != nil {
+			return fmt.Errorf("unable to attach policy %s to IAM user %s: %w", arn, userName, err)
+		}
+	}
+	return nil
+}
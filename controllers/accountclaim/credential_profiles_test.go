@@ -0,0 +1,127 @@
+package accountclaim
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential profiles", func() {
+	var (
+		accountClaim *v1alpha1.AccountClaim
+		r            *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec:       v1alpha1.AccountClaimSpec{CredentialProfile: controllerutils.CredentialProfileReadOnly},
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultConfigMap, Namespace: v1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				"credential-profiles": `
+billing:
+  managedPolicies: [job-function/Billing]
+`,
+			},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	Context("validateCredentialProfile", func() {
+		It("admits default and configured profiles", func() {
+			Expect(r.validateCredentialProfile(context.TODO(), accountClaim)).To(Succeed())
+
+			accountClaim.Spec.CredentialProfile = "billing"
+			Expect(r.validateCredentialProfile(context.TODO(), accountClaim)).To(Succeed())
+		})
+
+		It("denies unknown profiles", func() {
+			accountClaim.Spec.CredentialProfile = "unknown"
+			err := r.validateCredentialProfile(context.TODO(), accountClaim)
+			Expect(isAdmissionDenied(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`unknown credential profile "unknown"`))
+		})
+
+		It("denies profiles for claims without an IAM user", func() {
+			accountClaim.Spec.BYOC = true
+			Expect(isAdmissionDenied(r.validateCredentialProfile(context.TODO(), accountClaim))).To(BeTrue())
+
+			accountClaim.Spec.BYOC = false
+			accountClaim.Spec.ManualSTSMode = true
+			Expect(isAdmissionDenied(r.validateCredentialProfile(context.TODO(), accountClaim))).To(BeTrue())
+		})
+	})
+
+	It("resolves managed policy names to ARNs", func() {
+		arns := credentialProfilePolicyArns(&controllerutils.CredentialProfile{
+			ManagedPolicies: []string{"ReadOnlyAccess", "arn:aws:iam::123456789012:policy/custom"},
+		})
+		Expect(arns).To(Equal([]string{
+			"arn:aws:iam::aws:policy/ReadOnlyAccess",
+			"arn:aws:iam::123456789012:policy/custom",
+		}))
+	})
+
+	It("skips claims whose profile was applied", func() {
+		accountClaim.Status.CredentialProfile = controllerutils.CredentialProfileReadOnly
+		err := r.applyCredentialProfile(context.TODO(), testutils.NewTestLogger().Logger(), accountClaim, &v1alpha1.Account{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("ensureUserPolicies", func() {
+		var (
+			ctrl          *gomock.Controller
+			mockAWSClient *mock.MockClient
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockAWSClient = mock.NewMockClient(ctrl)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("attaches the policies of the profile and detaches the others", func() {
+			mockAWSClient.EXPECT().ListAttachedUserPolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedUserPoliciesOutput{
+				AttachedPolicies: []iamtypes.AttachedPolicy{
+					{PolicyArn: aws.String("arn:aws:iam::aws:policy/AdministratorAccess")},
+					{PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess")},
+				},
+			}, nil)
+			mockAWSClient.EXPECT().DetachUserPolicy(gomock.Any(), gomock.Any()).Do(func(_ any, input *iam.DetachUserPolicyInput) {
+				Expect(*input.UserName).To(Equal("osdManagedAdmin-abcdef"))
+				Expect(*input.PolicyArn).To(Equal("arn:aws:iam::aws:policy/AdministratorAccess"))
+			}).Return(&iam.DetachUserPolicyOutput{}, nil)
+			mockAWSClient.EXPECT().AttachUserPolicy(gomock.Any(), gomock.Any()).Do(func(_ any, input *iam.AttachUserPolicyInput) {
+				Expect(*input.PolicyArn).To(Equal("arn:aws:iam::aws:policy/job-function/ViewOnlyAccess"))
+			}).Return(&iam.AttachUserPolicyOutput{}, nil)
+
+			err := ensureUserPolicies(mockAWSClient, "osdManagedAdmin-abcdef", []string{
+				"arn:aws:iam::aws:policy/ReadOnlyAccess",
+				"arn:aws:iam::aws:policy/job-function/ViewOnlyAccess",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,87 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+const (
+	// CredentialSecretSourceNamespacesAnnotation is set on a namespace to accept credential secrets from
+	// AccountClaims in other namespaces. It holds a comma separated list of claim namespaces, or "*".
+	CredentialSecretSourceNamespacesAnnotation = "aws.managed.openshift.io/credential-secret-source-namespaces"
+
+	credentialSecretNamespacePolicyKey = "accountclaim.credential_secret_namespace_policy"
+
+	// credentialSecretPolicyAny keeps the historical behavior of writing the secret wherever the claim asks
+	credentialSecretPolicyAny = "Any"
+	// credentialSecretPolicyExisting requires the target namespace to exist
+	credentialSecretPolicyExisting = "Existing"
+	// credentialSecretPolicyClaimNamespace additionally requires the target namespace to be the claim's own
+	// namespace, or to accept secrets from it through CredentialSecretSourceNamespacesAnnotation
+	credentialSecretPolicyClaimNamespace = "ClaimNamespace"
+)
+
+// credentialSecretNamespacePolicy reads the policy from the operator ConfigMap, defaulting to Any
+func (r *AccountClaimReconciler) credentialSecretNamespacePolicy(ctx context.Context, reqLogger logr.Logger) string {
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		reqLogger.Info("could not retrieve the operator configmap, not validating the credential secret namespace")
+		return credentialSecretPolicyAny
+	}
+	switch policy := cm.Data[credentialSecretNamespacePolicyKey]; policy {
+	case credentialSecretPolicyExisting, credentialSecretPolicyClaimNamespace:
+		return policy
+	case "", credentialSecretPolicyAny:
+		return credentialSecretPolicyAny
+	default:
+		reqLogger.Info("unknown credential secret namespace policy, not validating the credential secret namespace", "policy", policy)
+		return credentialSecretPolicyAny
+	}
+}
+
+// validateCredentialSecretNamespace checks that the claim is allowed to receive its credentials in
+// spec.awsCredentialSecret.namespace under the configured policy
+func (r *AccountClaimReconciler) validateCredentialSecretNamespace(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	policy := r.credentialSecretNamespacePolicy(ctx, reqLogger)
+	if policy == credentialSecretPolicyAny {
+		return nil
+	}
+
+	target := accountClaim.Spec.AwsCredentialSecret.Namespace
+	if target == "" {
+		return fmt.Errorf("awsCredentialSecret.namespace is not set")
+	}
+
+	namespace := &corev1.Namespace{}
+	err := r.Get(ctx, types.NamespacedName{Name: target}, namespace)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return fmt.Errorf("credential secret namespace %s does not exist", target)
+		}
+		return err
+	}
+
+	if policy == credentialSecretPolicyClaimNamespace && target != accountClaim.Namespace &&
+		!acceptsCredentialSecretsFrom(namespace, accountClaim.Namespace) {
+		return fmt.Errorf("namespace %s does not accept credential secrets from AccountClaims in namespace %s", target, accountClaim.Namespace)
+	}
+	return nil
+}
+
+func acceptsCredentialSecretsFrom(namespace *corev1.Namespace, claimNamespace string) bool {
+	for _, source := range strings.Split(namespace.Annotations[CredentialSecretSourceNamespacesAnnotation], ",") {
+		source = strings.TrimSpace(source)
+		if source == "*" || source == claimNamespace {
+			return true
+		}
+	}
+	return false
+}
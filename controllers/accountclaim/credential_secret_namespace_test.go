@@ -0,0 +1,84 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+
+	apis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential secret namespace validation", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *awsv1alpha1.AccountClaim
+		target       *corev1.Namespace
+	)
+
+	err := apis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding apis to scheme in credential secret namespace tests")
+	}
+
+	newReconciler := func(policy string, objects ...runtime.Object) *AccountClaimReconciler {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      awsv1alpha1.DefaultConfigMap,
+				Namespace: awsv1alpha1.AccountCrNamespace,
+			},
+			Data: map[string]string{credentialSecretNamespacePolicyKey: policy},
+		}
+		objects = append(objects, configMap)
+		return &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objects...).Build(),
+			Scheme: scheme.Scheme,
+		}
+	}
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-ns"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				AwsCredentialSecret: awsv1alpha1.SecretRef{Name: "aws", Namespace: "target-ns"},
+			},
+		}
+		target = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "target-ns"}}
+	})
+
+	It("accepts any namespace by default", func() {
+		r := newReconciler("")
+		Expect(r.validateCredentialSecretNamespace(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+	})
+
+	It("rejects a namespace that doesn't exist", func() {
+		r := newReconciler(credentialSecretPolicyExisting)
+		Expect(r.validateCredentialSecretNamespace(context.TODO(), nullLogger, accountClaim)).NotTo(Succeed())
+
+		r = newReconciler(credentialSecretPolicyExisting, target)
+		Expect(r.validateCredentialSecretNamespace(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+	})
+
+	It("requires the target namespace to accept secrets from the claim namespace", func() {
+		r := newReconciler(credentialSecretPolicyClaimNamespace, target)
+		Expect(r.validateCredentialSecretNamespace(context.TODO(), nullLogger, accountClaim)).NotTo(Succeed())
+
+		target.Annotations = map[string]string{CredentialSecretSourceNamespacesAnnotation: "other-ns, claim-ns"}
+		r = newReconciler(credentialSecretPolicyClaimNamespace, target)
+		Expect(r.validateCredentialSecretNamespace(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+	})
+
+	It("always accepts the claim's own namespace", func() {
+		accountClaim.Spec.AwsCredentialSecret.Namespace = "claim-ns"
+		r := newReconciler(credentialSecretPolicyClaimNamespace, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "claim-ns"}})
+		Expect(r.validateCredentialSecretNamespace(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+	})
+})
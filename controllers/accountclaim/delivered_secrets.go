@@ -0,0 +1,126 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// trackDeliveredSecrets records the secrets delivered for the claim in its status. Owner references can't cross
+// namespaces, so that list is how the secrets are found again when the claim is deleted. Secrets tracked in another
+// namespace than the current credentials secret, e.g. before spec.awsCredentialSecret was changed, are kept until
+// they're deleted.
+func (r *AccountClaimReconciler) trackDeliveredSecrets(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	delivered, err := controllerutils.SecretsForClaim(ctx, r.Client, accountClaim)
+	if err != nil {
+		return err
+	}
+
+	// Secrets delivered before the claim was linked to its account, or by older operator versions, don't carry the
+	// account label the account reset finds them with yet
+	if account, ok := controllerutils.ClaimSecretLabels(accountClaim)[controllerutils.AccountSecretLabel]; ok {
+		for i := range delivered {
+			if delivered[i].Labels[controllerutils.AccountSecretLabel] == account {
+				continue
+			}
+			delivered[i].Labels[controllerutils.AccountSecretLabel] = account
+			if err := r.Update(ctx, &delivered[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	tracked := []awsv1alpha1.SecretRef{}
+	for _, secret := range accountClaim.Status.DeliveredSecrets {
+		if secret.Namespace != accountClaim.Spec.AwsCredentialSecret.Namespace {
+			tracked = append(tracked, secret)
+		}
+	}
+	for _, secret := range delivered {
+		tracked = append(tracked, awsv1alpha1.SecretRef{Name: secret.Name, Namespace: secret.Namespace})
+	}
+	sortSecretRefs(tracked)
+
+	if equality.Semantic.DeepEqual(tracked, accountClaim.Status.DeliveredSecrets) {
+		return nil
+	}
+	accountClaim.Status.DeliveredSecrets = tracked
+	return r.statusUpdate(reqLogger, accountClaim)
+}
+
+// deleteDeliveredSecrets deletes the secrets delivered for the claim: the ones tracked in its status and the ones
+// labeled for it that weren't tracked yet. Secrets that aren't labeled for the claim weren't delivered by the operator
+// and are left alone.
+func (r *AccountClaimReconciler) deleteDeliveredSecrets(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	refs := append([]awsv1alpha1.SecretRef{}, accountClaim.Status.DeliveredSecrets...)
+	labeled, err := controllerutils.SecretsForClaim(ctx, r.Client, accountClaim)
+	if err != nil {
+		return err
+	}
+	for _, secret := range labeled {
+		refs = append(refs, awsv1alpha1.SecretRef{Name: secret.Name, Namespace: secret.Namespace})
+	}
+
+	deleted := map[awsv1alpha1.SecretRef]bool{}
+	for _, ref := range refs {
+		if deleted[ref] {
+			continue
+		}
+		deleted[ref] = true
+
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, secret)
+		if k8serr.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if secret.Labels[controllerutils.ClaimSecretLabel] != accountClaim.Name || secret.Labels[controllerutils.ClaimSecretNamespaceLabel] != accountClaim.Namespace {
+			reqLogger.Info(fmt.Sprintf("Secret %s/%s wasn't delivered for claim %s, leaving it", ref.Namespace, ref.Name, accountClaim.Name))
+			continue
+		}
+		reqLogger.Info(fmt.Sprintf("Deleting secret %s/%s delivered for claim %s", ref.Namespace, ref.Name, accountClaim.Name))
+		if err := r.Delete(ctx, secret); err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAccountDeliveredSecrets deletes the secrets delivered for any claim of the account when it's reset, so no
+// credentials of the account remain on the cluster once it's released, e.g. the secrets of earlier claims left behind
+// in a namespace their claim wasn't tracking anymore.
+func (r *AccountClaimReconciler) deleteAccountDeliveredSecrets(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account) error {
+	secrets, err := controllerutils.DeliveredSecretsForAccount(ctx, r.Client, account.Name)
+	if err != nil {
+		return err
+	}
+	for i := range secrets {
+		secret := &secrets[i]
+		reqLogger.Info(fmt.Sprintf("Deleting secret %s/%s delivered for account %s", secret.Namespace, secret.Name, account.Name),
+			"accountclaim", secret.Labels[controllerutils.ClaimSecretNamespaceLabel]+"/"+secret.Labels[controllerutils.ClaimSecretLabel])
+		if err := r.Delete(ctx, secret); err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortSecretRefs(refs []awsv1alpha1.SecretRef) {
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Namespace != refs[j].Namespace {
+			return refs[i].Namespace < refs[j].Namespace
+		}
+		return refs[i].Name < refs[j].Name
+	})
+}
@@ -0,0 +1,130 @@
+package accountclaim
+
+import (
+	"context"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Delivered secrets", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		r            *AccountClaimReconciler
+		accountClaim *awsv1alpha1.AccountClaim
+	)
+
+	deliveredSecret := func(name, namespace string) *corev1.Secret {
+		secret := newSecretforCR(name, namespace, []byte("access-key"), []byte("secret-key"))
+		secret.Labels = controllerutils.ClaimSecretLabels(accountClaim)
+		return secret
+	}
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				AwsCredentialSecret: awsv1alpha1.SecretRef{Name: "aws", Namespace: "secret-namespace"},
+			},
+		}
+	})
+
+	newReconciler := func(objs ...client.Object) {
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(append(objs, accountClaim)...).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+	}
+
+	It("tracks the secrets delivered for the claim in its status", func() {
+		accountClaim.Status.DeliveredSecrets = []awsv1alpha1.SecretRef{
+			{Name: "aws", Namespace: "old-namespace"},
+			{Name: "aws-us-west-2", Namespace: "secret-namespace"},
+		}
+		newReconciler(deliveredSecret("aws", "secret-namespace"), deliveredSecret("aws-us-east-1", "secret-namespace"))
+
+		Expect(r.trackDeliveredSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		updated := &awsv1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		Expect(updated.Status.DeliveredSecrets).To(Equal([]awsv1alpha1.SecretRef{
+			{Name: "aws", Namespace: "old-namespace"},
+			{Name: "aws", Namespace: "secret-namespace"},
+			{Name: "aws-us-east-1", Namespace: "secret-namespace"},
+		}))
+	})
+
+	It("deletes the tracked secrets across namespaces", func() {
+		accountClaim.Status.DeliveredSecrets = []awsv1alpha1.SecretRef{
+			{Name: "aws", Namespace: "old-namespace"},
+			{Name: "missing", Namespace: "secret-namespace"},
+		}
+		newReconciler(
+			deliveredSecret("aws", "old-namespace"),
+			deliveredSecret("aws", "secret-namespace"),
+			deliveredSecret("aws-us-east-1", "secret-namespace"),
+		)
+
+		Expect(r.deleteDeliveredSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		for _, key := range []client.ObjectKey{
+			{Name: "aws", Namespace: "old-namespace"},
+			{Name: "aws", Namespace: "secret-namespace"},
+			{Name: "aws-us-east-1", Namespace: "secret-namespace"},
+		} {
+			err := r.Get(context.TODO(), key, &corev1.Secret{})
+			Expect(k8serr.IsNotFound(err)).To(BeTrue(), key.String())
+		}
+	})
+
+	It("leaves secrets that weren't delivered for the claim", func() {
+		accountClaim.Status.DeliveredSecrets = []awsv1alpha1.SecretRef{{Name: "aws", Namespace: "other-namespace"}}
+		newReconciler(newSecretforCR("aws", "other-namespace", []byte("access-key"), []byte("secret-key")))
+
+		Expect(r.deleteDeliveredSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		Expect(r.Get(context.TODO(), client.ObjectKey{Name: "aws", Namespace: "other-namespace"}, &corev1.Secret{})).To(Succeed())
+	})
+
+	It("labels the secrets delivered for the claim with its account", func() {
+		newReconciler(deliveredSecret("aws", "secret-namespace"))
+		accountClaim.Spec.AccountLink = "osd-creds-mgmt-aaaaaa"
+
+		Expect(r.trackDeliveredSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(r.Get(context.TODO(), client.ObjectKey{Name: "aws", Namespace: "secret-namespace"}, secret)).To(Succeed())
+		Expect(secret.Labels).To(HaveKeyWithValue(controllerutils.AccountSecretLabel, "osd-creds-mgmt-aaaaaa"))
+	})
+
+	It("deletes the secrets delivered for every claim of the account at reset", func() {
+		accountClaim.Spec.AccountLink = "osd-creds-mgmt-aaaaaa"
+		earlier := deliveredSecret("aws", "earlier-namespace")
+		earlier.Labels[controllerutils.ClaimSecretLabel] = "earlier-claim"
+		otherAccount := deliveredSecret("aws", "other-namespace")
+		otherAccount.Labels[controllerutils.AccountSecretLabel] = "osd-creds-mgmt-bbbbbb"
+		newReconciler(deliveredSecret("aws", "secret-namespace"), earlier, otherAccount)
+		account := &awsv1alpha1.Account{ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace}}
+
+		Expect(r.deleteAccountDeliveredSecrets(context.TODO(), nullLogger, account)).To(Succeed())
+
+		for _, key := range []client.ObjectKey{
+			{Name: "aws", Namespace: "secret-namespace"},
+			{Name: "aws", Namespace: "earlier-namespace"},
+		} {
+			err := r.Get(context.TODO(), key, &corev1.Secret{})
+			Expect(k8serr.IsNotFound(err)).To(BeTrue(), key.String())
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKey{Name: "aws", Namespace: "other-namespace"}, &corev1.Secret{})).To(Succeed())
+	})
+})
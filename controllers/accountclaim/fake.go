@@ -8,6 +8,7 @@ import (
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
 	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -73,6 +74,7 @@ func (r *AccountClaimReconciler) processFake(reqLogger logr.Logger, accountClaim
 			controllerutils.UpdateConditionNever,
 			accountClaim.Spec.BYOCAWSAccountID != "")
 		accountClaim.Status.State = awsv1alpha1.ClaimStatusReady
+		markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseReady, metav1.Now())
 		reqLogger.Info(fmt.Sprintf("Fake Account %s condition status updated", accountClaim.Name))
 		err := r.statusUpdate(reqLogger, accountClaim)
 		if err != nil {
@@ -0,0 +1,242 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// fleetManagerTrustPolicy returns the trust policy of the fleet manager role, letting trustedARN assume it
+func fleetManagerTrustPolicy(trustedARN string) (string, error) {
+	type awsStatement struct {
+		Effect    string                 `json:"Effect"`
+		Action    []string               `json:"Action"`
+		Resource  []string               `json:"Resource,omitempty"`
+		Principal *awsv1alpha1.Principal `json:"Principal,omitempty"`
+	}
+
+	assumeRolePolicyDoc := struct {
+		Version   string
+		Statement []awsStatement
+	}{
+		Version: "2012-10-17",
+		Statement: []awsStatement{{
+			Effect: "Allow",
+			Action: []string{"sts:AssumeRole"},
+			Principal: &awsv1alpha1.Principal{
+				AWS: []string{trustedARN},
+			},
+		}},
+	}
+	jsonAssumeRolePolicyDoc, err := json.Marshal(assumeRolePolicyDoc)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonAssumeRolePolicyDoc), nil
+}
+
+// reconcileFleetManagerRole makes the fleet manager role trust trustedARN, hold the stsPolicyName inline policy only and
+// carry the ownership tags, and returns its ARN. The role is created when it's missing and updated in place otherwise,
+// writing only the policies and tags which differ, so the consumers assuming it aren't interrupted. Every step is idempotent: a reconcile interrupted
+// halfway, e.g. by a restart of the operator, is completed by the next one.
+func (r *AccountClaimReconciler) reconcileFleetManagerRole(reqLogger logr.Logger, awsClient awsclient.Client, roleName string, trustedARN string, ownership awsclient.IAMOwnership) (string, error) {
+	trustPolicy, err := fleetManagerTrustPolicy(trustedARN)
+	if err != nil {
+		return "", err
+	}
+
+	role, created, err := getOrCreateRole(reqLogger, awsClient, roleName, trustPolicy, ownership)
+	if err != nil {
+		return "", err
+	}
+	if !created && !samePolicyDocument(aws.ToString(role.AssumeRolePolicyDocument), trustPolicy) {
+		reqLogger.Info("Updating the trust policy of the role", "role", roleName)
+		_, err = awsClient.UpdateAssumeRolePolicy(context.TODO(), &iam.UpdateAssumeRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyDocument: aws.String(trustPolicy),
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	roleARN, err := arn.Parse(aws.ToString(role.Arn))
+	if err != nil {
+		return "", fmt.Errorf("failed parsing the ARN of role %s: %w", roleName, err)
+	}
+	policyDocument, err := generateInlinePolicy(roleARN.AccountID)
+	if err != nil {
+		return "", err
+	}
+	if err := putRolePolicyIfChanged(reqLogger, awsClient, roleName, stsPolicyName, policyDocument); err != nil {
+		return "", err
+	}
+	return roleARN.String(), nil
+}
+
+// reportRoleNameCollision sets the RoleNameCollision condition of the claim when err is a RoleCollisionError, and
+// resolves it once the role was reconciled
+func (r *AccountClaimReconciler) reportRoleNameCollision(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, err error) error {
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.RoleNameCollision)
+	status, reason, message := corev1.ConditionFalse, "RoleManaged", "The role is managed by the operator"
+	if awsclient.IsRoleCollision(err) {
+		status, reason, message = corev1.ConditionTrue, "UnmanagedRoleExists", err.Error()
+	} else if err != nil || existing == nil || existing.Status != corev1.ConditionTrue {
+		return nil
+	}
+	if existing != nil && existing.Status == status && existing.Message == message {
+		return nil
+	}
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.RoleNameCollision,
+		status,
+		reason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	return r.statusUpdate(reqLogger, accountClaim)
+}
+
+// getOrCreateRole returns the role, creating it with the trust policy and the ownership tags when it's missing. It
+// returns whether the role was created. A role created by an interrupted reconcile is picked up and its ownership tags
+// are brought up to date, while a role of the same name the operator didn't create is refused with a
+// RoleCollisionError.
+func getOrCreateRole(reqLogger logr.Logger, awsClient awsclient.Client, roleName string, trustPolicy string, ownership awsclient.IAMOwnership) (*iamtypes.Role, bool, error) {
+	getRoleOutput, err := awsClient.GetRole(context.TODO(), &iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err == nil {
+		return getRoleOutput.Role, false, adoptRole(awsClient, getRoleOutput.Role, ownership)
+	}
+	var noSuchEntity *iamtypes.NoSuchEntityException
+	if !errors.As(err, &noSuchEntity) {
+		return nil, false, err
+	}
+
+	reqLogger.Info(fmt.Sprintf("Creating role: %s", roleName))
+	createRoleOutput, err := awsClient.CreateRole(context.TODO(), &iam.CreateRoleInput{
+		RoleName:                 aws.String(roleName),
+		Description:              aws.String("Managed by AAO"),
+		AssumeRolePolicyDocument: aws.String(trustPolicy),
+		Tags:                     ownership.Tags(),
+	})
+	var entityExists *iamtypes.EntityAlreadyExistsException
+	if errors.As(err, &entityExists) {
+		getRoleOutput, err = awsClient.GetRole(context.TODO(), &iam.GetRoleInput{RoleName: aws.String(roleName)})
+		if err != nil {
+			return nil, false, err
+		}
+		return getRoleOutput.Role, false, adoptRole(awsClient, getRoleOutput.Role, ownership)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	reqLogger.Info(fmt.Sprintf("Role %s created", roleName))
+	return createRoleOutput.Role, true, nil
+}
+
+// adoptRole verifies the existing role was created by the operator and brings its ownership tags up to date
+func adoptRole(awsClient awsclient.Client, role *iamtypes.Role, ownership awsclient.IAMOwnership) error {
+	if err := awsclient.VerifyRoleOwnership(awsClient, role); err != nil {
+		return err
+	}
+	return awsclient.EnsureRoleOwnershipTags(awsClient, aws.ToString(role.RoleName), ownership)
+}
+
+// putRolePolicyIfChanged makes policyName the only inline policy of the role, writing it when it differs from
+// policyDocument
+func putRolePolicyIfChanged(reqLogger logr.Logger, awsClient awsclient.Client, roleName string, policyName string, policyDocument string) error {
+	listOutput, err := awsClient.ListRolePolicies(context.TODO(), &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, name := range listOutput.PolicyNames {
+		if name == policyName {
+			found = true
+			continue
+		}
+		reqLogger.Info("Deleting the unexpected inline policy of the role", "role", roleName, "policy", name)
+		_, err = awsClient.DeleteRolePolicy(context.TODO(), &iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(roleName),
+			PolicyName: aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if found {
+		getOutput, err := awsClient.GetRolePolicy(context.TODO(), &iam.GetRolePolicyInput{
+			RoleName:   aws.String(roleName),
+			PolicyName: aws.String(policyName),
+		})
+		if err != nil {
+			return err
+		}
+		if samePolicyDocument(aws.ToString(getOutput.PolicyDocument), policyDocument) {
+			return nil
+		}
+	}
+
+	reqLogger.Info("Writing the inline policy of the role", "role", roleName, "policy", policyName)
+	_, err = awsClient.PutRolePolicy(context.TODO(), &iam.PutRolePolicyInput{
+		PolicyName:     aws.String(policyName),
+		RoleName:       aws.String(roleName),
+		PolicyDocument: aws.String(policyDocument),
+	})
+	return err
+}
+
+// samePolicyDocument returns whether the policy document read from IAM, which is URL encoded, grants the same as
+// wanted. IAM reformats the documents it stores and turns single element lists into strings, so they are compared
+// once parsed.
+func samePolicyDocument(current string, wanted string) bool {
+	if unescaped, err := url.QueryUnescape(current); err == nil {
+		current = unescaped
+	}
+	var currentDoc, wantedDoc interface{}
+	if err := json.Unmarshal([]byte(current), &currentDoc); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(wanted), &wantedDoc); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(normalizePolicyDocument(currentDoc), normalizePolicyDocument(wantedDoc))
+}
+
+// normalizePolicyDocument replaces the single element lists of a parsed policy document with their element
+func normalizePolicyDocument(doc interface{}) interface{} {
+	switch value := doc.(type) {
+	case map[string]interface{}:
+		for key, element := range value {
+			value[key] = normalizePolicyDocument(element)
+		}
+		return value
+	case []interface{}:
+		if len(value) == 1 {
+			return normalizePolicyDocument(value[0])
+		}
+		for i, element := range value {
+			value[i] = normalizePolicyDocument(element)
+		}
+		return value
+	default:
+		return value
+	}
+}
@@ -0,0 +1,195 @@
+package accountclaim
+
+import (
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fleet manager role", func() {
+	const (
+		roleARN    = "arn:aws:iam::123456789012:role/managed-sts-role"
+		trustedARN = "arn:aws:iam::210987654321:role/fleet-manager"
+	)
+
+	ownership := awsclient.IAMOwnership{Pool: "fm-pool", Claim: "claim-namespace/fm-claim"}
+
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		ctrl       *gomock.Controller
+		mockAWS    *mock.MockClient
+		r          *AccountClaimReconciler
+		trust      string
+		inline     string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWS = mock.NewMockClient(ctrl)
+		r = &AccountClaimReconciler{}
+		var err error
+		trust, err = fleetManagerTrustPolicy(trustedARN)
+		Expect(err).NotTo(HaveOccurred())
+		inline, err = generateInlinePolicy("123456789012")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// existingRole is the role as IAM returns it: URL encoded, with single element lists turned into strings
+	existingRole := func(trustedARN string) *iam.GetRoleOutput {
+		document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"` + trustedARN + `"},"Action":"sts:AssumeRole"}]}`
+		return &iam.GetRoleOutput{Role: &iamtypes.Role{
+			RoleName:                 aws.String(stsRoleName),
+			Arn:                      aws.String(roleARN),
+			AssumeRolePolicyDocument: aws.String(url.QueryEscape(document)),
+		}}
+	}
+
+	// expectOwnershipTags lists the up to date ownership tags of the role, to verify and to resync them
+	expectOwnershipTags := func() {
+		mockAWS.EXPECT().ListRoleTags(gomock.Any(), gomock.Any()).Return(&iam.ListRoleTagsOutput{Tags: ownership.Tags()}, nil).Times(2)
+	}
+
+	It("creates a missing role and its policy", func() {
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{Message: aws.String("")})
+		mockAWS.EXPECT().CreateRole(gomock.Any(), &iam.CreateRoleInput{
+			RoleName:                 aws.String(stsRoleName),
+			Description:              aws.String("Managed by AAO"),
+			AssumeRolePolicyDocument: aws.String(trust),
+			Tags: []iamtypes.Tag{
+				{Key: aws.String("managed-by"), Value: aws.String("aws-account-operator")},
+				{Key: aws.String("aws.managed.openshift.io/pool"), Value: aws.String("fm-pool")},
+				{Key: aws.String("aws.managed.openshift.io/claim"), Value: aws.String("claim-namespace/fm-claim")},
+			},
+		}).Return(&iam.CreateRoleOutput{Role: &iamtypes.Role{RoleName: aws.String(stsRoleName), Arn: aws.String(roleARN)}}, nil)
+		mockAWS.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{}, nil)
+		mockAWS.EXPECT().PutRolePolicy(gomock.Any(), &iam.PutRolePolicyInput{
+			PolicyName:     aws.String(stsPolicyName),
+			RoleName:       aws.String(stsRoleName),
+			PolicyDocument: aws.String(inline),
+		}).Return(&iam.PutRolePolicyOutput{}, nil)
+
+		arn, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arn).To(Equal(roleARN))
+	})
+
+	It("leaves an up to date role alone", func() {
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(existingRole(trustedARN), nil)
+		expectOwnershipTags()
+		mockAWS.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{PolicyNames: []string{stsPolicyName}}, nil)
+		mockAWS.EXPECT().GetRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.GetRolePolicyOutput{PolicyDocument: aws.String(url.QueryEscape(inline))}, nil)
+
+		arn, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arn).To(Equal(roleARN))
+	})
+
+	It("updates the trust and inline policies which differ in place", func() {
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(existingRole("arn:aws:iam::210987654321:role/previous"), nil)
+		expectOwnershipTags()
+		mockAWS.EXPECT().UpdateAssumeRolePolicy(gomock.Any(), &iam.UpdateAssumeRolePolicyInput{
+			RoleName:       aws.String(stsRoleName),
+			PolicyDocument: aws.String(trust),
+		}).Return(&iam.UpdateAssumeRolePolicyOutput{}, nil)
+		mockAWS.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{PolicyNames: []string{"leftover", stsPolicyName}}, nil)
+		mockAWS.EXPECT().DeleteRolePolicy(gomock.Any(), &iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(stsRoleName),
+			PolicyName: aws.String("leftover"),
+		}).Return(&iam.DeleteRolePolicyOutput{}, nil)
+		mockAWS.EXPECT().GetRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.GetRolePolicyOutput{PolicyDocument: aws.String(url.QueryEscape(`{"Version":"2012-10-17","Statement":[]}`))}, nil)
+		mockAWS.EXPECT().PutRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.PutRolePolicyOutput{}, nil)
+		mockAWS.EXPECT().DeleteRole(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("completes a role created before the operator restarted", func() {
+		By("failing to write the policy of the created role")
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{Message: aws.String("")})
+		mockAWS.EXPECT().CreateRole(gomock.Any(), gomock.Any()).Return(&iam.CreateRoleOutput{Role: &iamtypes.Role{RoleName: aws.String(stsRoleName), Arn: aws.String(roleARN)}}, nil)
+		mockAWS.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{}, nil)
+		mockAWS.EXPECT().PutRolePolicy(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.ServiceFailureException{Message: aws.String("")})
+		mockAWS.EXPECT().DeleteRole(gomock.Any(), gomock.Any()).Times(0)
+		_, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(err).To(HaveOccurred())
+
+		By("writing the policy only on the next reconcile")
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(existingRole(trustedARN), nil)
+		expectOwnershipTags()
+		mockAWS.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{}, nil)
+		mockAWS.EXPECT().PutRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.PutRolePolicyOutput{}, nil)
+		arn, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arn).To(Equal(roleARN))
+	})
+
+	It("picks up a role created concurrently", func() {
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{Message: aws.String("")})
+		mockAWS.EXPECT().CreateRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.EntityAlreadyExistsException{Message: aws.String("")})
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(existingRole(trustedARN), nil)
+		expectOwnershipTags()
+		mockAWS.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{PolicyNames: []string{stsPolicyName}}, nil)
+		mockAWS.EXPECT().GetRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.GetRolePolicyOutput{PolicyDocument: aws.String(url.QueryEscape(inline))}, nil)
+
+		arn, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arn).To(Equal(roleARN))
+	})
+
+	It("adopts the roles created by older operator versions and tags them", func() {
+		legacy := existingRole(trustedARN)
+		legacy.Role.Description = aws.String("Managed by AAO")
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(legacy, nil)
+		mockAWS.EXPECT().ListRoleTags(gomock.Any(), gomock.Any()).Return(&iam.ListRoleTagsOutput{}, nil)
+		mockAWS.EXPECT().TagRole(gomock.Any(), &iam.TagRoleInput{
+			RoleName: aws.String(stsRoleName),
+			Tags:     ownership.Tags(),
+		}).Return(&iam.TagRoleOutput{}, nil)
+		mockAWS.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{PolicyNames: []string{stsPolicyName}}, nil)
+		mockAWS.EXPECT().GetRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.GetRolePolicyOutput{PolicyDocument: aws.String(url.QueryEscape(inline))}, nil)
+
+		_, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("refuses a role of the customer of the same name", func() {
+		accountClaim := &v1alpha1.AccountClaim{ObjectMeta: metav1.ObjectMeta{Name: "fm-claim", Namespace: "claim-namespace"}}
+		r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build()
+		mockAWS.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(existingRole(trustedARN), nil)
+		mockAWS.EXPECT().ListRoleTags(gomock.Any(), gomock.Any()).Return(&iam.ListRoleTagsOutput{
+			Tags: []iamtypes.Tag{{Key: aws.String("team"), Value: aws.String("customer")}},
+		}, nil)
+
+		_, err := r.reconcileFleetManagerRole(nullLogger, mockAWS, stsRoleName, trustedARN, ownership)
+		Expect(awsclient.IsRoleCollision(err)).To(BeTrue())
+		Expect(r.reportRoleNameCollision(nullLogger, accountClaim, err)).To(Succeed())
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, v1alpha1.RoleNameCollision)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("role managed-sts-role exists in the AWS account and isn't managed by the operator"))
+
+		By("resolving the condition once the role is reconciled")
+		Expect(r.reportRoleNameCollision(nullLogger, accountClaim, nil)).To(Succeed())
+		Expect(controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, v1alpha1.RoleNameCollision).Status).To(Equal(corev1.ConditionFalse))
+	})
+})
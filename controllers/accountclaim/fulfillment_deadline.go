@@ -0,0 +1,132 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// FulfillmentDeadlineAnnotation overrides the fulfillment deadline of a claim, as a duration since its creation
+	FulfillmentDeadlineAnnotation = "aws.managed.openshift.io/fulfillment-deadline"
+	// fulfillmentDeadlineKey is the operator ConfigMap key holding the fulfillment deadline of the claims, none when
+	// unset
+	fulfillmentDeadlineKey = "accountclaim-fulfillment-deadline"
+
+	deadlineExceededReason  = "FulfillmentDeadlineExceeded"
+	deadlineFulfilledReason = "Fulfilled"
+)
+
+// fulfillmentDeadline returns how long after its creation the claim has to be Ready, 0 when it has no deadline. The
+// annotation of the claim takes precedence over the operator ConfigMap.
+func (r *AccountClaimReconciler) fulfillmentDeadline(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (time.Duration, error) {
+	value, ok := accountClaim.Annotations[FulfillmentDeadlineAnnotation]
+	if !ok {
+		cm, err := r.operatorConfig().ConfigMap(ctx)
+		if k8serr.IsNotFound(err) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		value = cm.Data[fulfillmentDeadlineKey]
+	}
+	if value == "" {
+		return 0, nil
+	}
+	deadline, err := time.ParseDuration(value)
+	if err != nil || deadline <= 0 {
+		reqLogger.Error(err, "invalid fulfillment deadline, ignoring it", "deadline", value)
+		return 0, nil
+	}
+	return deadline, nil
+}
+
+// reconcileFulfillmentDeadline escalates the claims that aren't Ready within their fulfillment deadline: they get an
+// Escalated condition, are counted in aws_account_operator_account_claims_escalated_total and a claim-escalated
+// notification is published. The condition is resolved once the claim turns Ready. It returns how long until the
+// deadline of a claim that isn't escalated yet, 0 otherwise, so the claim is reconciled at its deadline even when
+// nothing else happens to it.
+func (r *AccountClaimReconciler) reconcileFulfillmentDeadline(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, now time.Time) (time.Duration, error) {
+	isCCS := accountClaim.Spec.BYOCAWSAccountID != ""
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.Escalated)
+	escalated := existing != nil && existing.Status == corev1.ConditionTrue
+
+	if accountClaim.Status.State == awsv1alpha1.ClaimStatusReady {
+		if !escalated {
+			return 0, nil
+		}
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.Escalated,
+			corev1.ConditionFalse,
+			deadlineFulfilledReason,
+			"The claim is Ready",
+			controllerutils.UpdateConditionAlways,
+			isCCS,
+		)
+		return 0, r.statusUpdate(reqLogger, accountClaim)
+	}
+	if escalated {
+		return 0, nil
+	}
+
+	deadline, err := r.fulfillmentDeadline(ctx, reqLogger, accountClaim)
+	if err != nil || deadline == 0 {
+		return 0, err
+	}
+	remaining := accountClaim.CreationTimestamp.Add(deadline).Sub(now)
+	if remaining > 0 {
+		return remaining, nil
+	}
+
+	message := fmt.Sprintf("The claim wasn't Ready within its fulfillment deadline of %s", deadline)
+	reqLogger.Info("escalating claim", "deadline", deadline.String(), "state", accountClaim.Status.State)
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.Escalated,
+		corev1.ConditionTrue,
+		deadlineExceededReason,
+		message,
+		controllerutils.UpdateConditionAlways,
+		isCCS,
+	)
+	if err := r.statusUpdate(reqLogger, accountClaim); err != nil {
+		return 0, err
+	}
+	if localmetrics.Collector != nil {
+		localmetrics.Collector.AddAccountClaimEscalated(isCCS)
+	}
+	state := string(accountClaim.Status.State)
+	if state == "" {
+		state = "new"
+	}
+	controllerutils.PublishNotification(ctx, r.operatorConfig(), reqLogger, controllerutils.Notification{
+		Event:     controllerutils.NotificationClaimEscalated,
+		Kind:      "AccountClaim",
+		Namespace: accountClaim.Namespace,
+		Name:      accountClaim.Name,
+		Message:   fmt.Sprintf("%s, it is %s", message, state),
+	})
+	return 0, nil
+}
+
+// requeueBefore returns the result of a reconcile requeued after d at the latest, unless it failed
+func requeueBefore(result reconcile.Result, err error, d time.Duration) reconcile.Result {
+	if err != nil || d <= 0 || (result.Requeue && result.RequeueAfter == 0) {
+		return result
+	}
+	if result.RequeueAfter == 0 || result.RequeueAfter > d {
+		result.RequeueAfter = d
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Claim fulfillment deadline", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		configMap    *corev1.ConfigMap
+		r            *AccountClaimReconciler
+		server       *httptest.Server
+		received     []controllerutils.Notification
+		created      time.Time
+	)
+
+	BeforeEach(func() {
+		localmetrics.Collector = localmetrics.NewMetricsCollector(nil)
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			notification := controllerutils.Notification{}
+			Expect(json.NewDecoder(req.Body).Decode(&notification)).To(Succeed())
+			received = append(received, notification)
+		}))
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultConfigMap, Namespace: v1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				"accountclaim-fulfillment-deadline": "2h",
+				"notifications":                     "sinks:\n- type: webhook\n  url: " + server.URL + "\n  events: [claim-escalated]\n",
+			},
+		}
+		created = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "slow-claim", Namespace: "claim-namespace", CreationTimestamp: metav1.NewTime(created)},
+			Status:     v1alpha1.AccountClaimStatus{State: v1alpha1.ClaimStatusPending},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newReconciler := func() {
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap, accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+	}
+
+	escalation := func() *v1alpha1.AccountClaimCondition {
+		current := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), current)).To(Succeed())
+		return controllerutils.FindAccountClaimCondition(current.Status.Conditions, v1alpha1.Escalated)
+	}
+
+	It("waits for the deadline", func() {
+		newReconciler()
+		untilDeadline, err := r.reconcileFulfillmentDeadline(context.TODO(), nullLogger, accountClaim, created.Add(90*time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(untilDeadline).To(Equal(30 * time.Minute))
+		Expect(escalation()).To(BeNil())
+	})
+
+	It("escalates claims that aren't Ready by the deadline once", func() {
+		newReconciler()
+		untilDeadline, err := r.reconcileFulfillmentDeadline(context.TODO(), nullLogger, accountClaim, created.Add(3*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(untilDeadline).To(BeZero())
+
+		condition := escalation()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("FulfillmentDeadlineExceeded"))
+		Expect(condition.Message).To(Equal("The claim wasn't Ready within its fulfillment deadline of 2h0m0s"))
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].Event).To(Equal(controllerutils.NotificationClaimEscalated))
+		Expect(received[0].Message).To(HaveSuffix("it is Pending"))
+
+		By("not escalating it again")
+		_, err = r.reconcileFulfillmentDeadline(context.TODO(), nullLogger, accountClaim, created.Add(4*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(HaveLen(1))
+
+		By("resolving the escalation once the claim is Ready")
+		accountClaim.Status.State = v1alpha1.ClaimStatusReady
+		_, err = r.reconcileFulfillmentDeadline(context.TODO(), nullLogger, accountClaim, created.Add(5*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(escalation().Status).To(Equal(corev1.ConditionFalse))
+	})
+
+	It("uses the deadline of the claim over the operator one", func() {
+		accountClaim.Annotations = map[string]string{FulfillmentDeadlineAnnotation: "6h"}
+		newReconciler()
+		untilDeadline, err := r.reconcileFulfillmentDeadline(context.TODO(), nullLogger, accountClaim, created.Add(3*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(untilDeadline).To(Equal(3 * time.Hour))
+		Expect(escalation()).To(BeNil())
+	})
+
+	It("does nothing without a deadline", func() {
+		delete(configMap.Data, "accountclaim-fulfillment-deadline")
+		newReconciler()
+		untilDeadline, err := r.reconcileFulfillmentDeadline(context.TODO(), nullLogger, accountClaim, created.Add(30*24*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(untilDeadline).To(BeZero())
+		Expect(escalation()).To(BeNil())
+	})
+
+	It("requeues before the deadline", func() {
+		Expect(requeueBefore(reconcile.Result{}, nil, time.Hour)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(requeueBefore(reconcile.Result{RequeueAfter: time.Minute}, nil, time.Hour)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(requeueBefore(reconcile.Result{Requeue: true}, nil, time.Hour)).To(Equal(reconcile.Result{Requeue: true}))
+		Expect(requeueBefore(reconcile.Result{}, context.Canceled, time.Hour)).To(Equal(reconcile.Result{}))
+	})
+})
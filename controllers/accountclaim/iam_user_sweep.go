@@ -0,0 +1,29 @@
+package accountclaim
+
+import (
+	"github.com/go-logr/logr"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/controllers/account"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+)
+
+// iamUserSweepBudget is the most IAM users swept per reconcile, so accounts with many users don't exceed the reconcile
+// deadline
+const iamUserSweepBudget int32 = 100
+
+// sweepIAMUsers deletes the IAM users of the account of a fleet manager claim a page at a time, persisting where the
+// next reconcile resumes in the claim status. It returns whether all the users were swept.
+func (r *AccountClaimReconciler) sweepIAMUsers(reqLogger logr.Logger, awsClient awsclient.Client, accountClaim *awsv1alpha1.AccountClaim, acct *awsv1alpha1.Account) (bool, error) {
+	cursor, err := account.DeleteIAMUsersPage(reqLogger, awsClient, acct, accountClaim.Status.IAMUserSweepCursor, iamUserSweepBudget)
+	if err != nil {
+		return false, err
+	}
+	if cursor != accountClaim.Status.IAMUserSweepCursor {
+		accountClaim.Status.IAMUserSweepCursor = cursor
+		if err := r.statusUpdate(reqLogger, accountClaim); err != nil {
+			return false, err
+		}
+	}
+	return cursor == "", nil
+}
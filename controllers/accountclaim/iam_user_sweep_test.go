@@ -0,0 +1,71 @@
+package accountclaim
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IAM user sweep", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		ctrl         *gomock.Controller
+		mockAWS      *mock.MockClient
+		accountClaim *v1alpha1.AccountClaim
+		account      *v1alpha1.Account
+		r            *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWS = mock.NewMockClient(ctrl)
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "fm-claim", Namespace: "claim-namespace"},
+		}
+		account = &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: v1alpha1.AccountCrNamespace},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("sweeps a page per call and persists where it resumes", func() {
+		mockAWS.EXPECT().ListUsers(gomock.Any(), &iam.ListUsersInput{MaxItems: aws.Int32(iamUserSweepBudget)}).Return(
+			&iam.ListUsersOutput{IsTruncated: true, Marker: aws.String("next")}, nil)
+
+		swept, err := r.sweepIAMUsers(nullLogger, mockAWS, accountClaim, account)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swept).To(BeFalse())
+		updated := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		Expect(updated.Status.IAMUserSweepCursor).To(Equal("next"))
+
+		By("finishing on the last page")
+		mockAWS.EXPECT().ListUsers(gomock.Any(), &iam.ListUsersInput{Marker: aws.String("next"), MaxItems: aws.Int32(iamUserSweepBudget)}).Return(
+			&iam.ListUsersOutput{Users: []iamtypes.User{}}, nil)
+		swept, err = r.sweepIAMUsers(nullLogger, mockAWS, updated, account)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swept).To(BeTrue())
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		Expect(updated.Status.IAMUserSweepCursor).To(BeEmpty())
+	})
+})
@@ -0,0 +1,140 @@
+package accountclaim
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// propagatedLabelsKey is the operator ConfigMap key listing the AccountClaim labels copied onto the claimed Account
+const propagatedLabelsKey = "propagated-claim-labels"
+
+// propagatedLabelKeys returns the label keys configured to be propagated, separated by commas or newlines
+func propagatedLabelKeys(cm *corev1.ConfigMap) []string {
+	keys := []string{}
+	for _, key := range strings.FieldsFunc(cm.Data[propagatedLabelsKey], func(r rune) bool { return r == ',' || r == '\n' }) {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// propagateClaimLabels keeps the configured labels of the claim in sync on the claimed Account and as Organizations
+// tags of its AWS account, so the fleet can be queried by cluster or team from either side. Labels removed from the
+// claim are removed from both as well.
+func (r *AccountClaimReconciler) propagateClaimLabels(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	if accountClaim.Spec.AccountLink == "" {
+		return nil
+	}
+	account, err := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return r.syncClaimLabels(ctx, reqLogger, account, accountClaim.Labels)
+}
+
+// releaseClaimLabels removes the labels propagated from the claim the account is released from
+func (r *AccountClaimReconciler) releaseClaimLabels(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account) error {
+	return r.syncClaimLabels(ctx, reqLogger, account, nil)
+}
+
+// syncClaimLabels sets the configured labels of claimLabels on the account and its Organizations tags, and removes the
+// configured labels claimLabels doesn't have
+func (r *AccountClaimReconciler) syncClaimLabels(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account, claimLabels map[string]string) error {
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	toSet := map[string]string{}
+	toRemove := []string{}
+	for _, key := range propagatedLabelKeys(cm) {
+		value, onClaim := claimLabels[key]
+		current, onAccount := account.Labels[key]
+		switch {
+		case onClaim && (!onAccount || current != value):
+			toSet[key] = value
+		case !onClaim && onAccount:
+			toRemove = append(toRemove, key)
+		}
+	}
+	if len(toSet) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+
+	// Tag first, the Account labels record what's been tagged
+	if !account.IsBYOC() && account.Spec.AwsAccountID != "" {
+		awsClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+			SecretName: controllerutils.AwsSecretName,
+			NameSpace:  awsv1alpha1.AccountCrNamespace,
+			AwsRegion:  config.GetDefaultRegion(),
+		})
+		if err != nil {
+			return err
+		}
+		err = syncOrganizationsTags(awsClient, account.Spec.AwsAccountID, toSet, toRemove)
+		if err != nil {
+			reqLogger.Error(err, "Failed to sync the claim labels as Organizations tags", "awsRequestID", controllerutils.AwsRequestID(err))
+			return err
+		}
+	}
+
+	if account.Labels == nil {
+		account.Labels = map[string]string{}
+	}
+	for key, value := range toSet {
+		account.Labels[key] = value
+	}
+	for _, key := range toRemove {
+		delete(account.Labels, key)
+	}
+	reqLogger.Info("Syncing claim labels on the account", "account", account.Name, "set", toSet, "removed", toRemove)
+	return r.Update(ctx, account)
+}
+
+// syncOrganizationsTags tags the AWS account with the labels to set and untags the removed ones
+func syncOrganizationsTags(awsClient awsclient.Client, accountID string, toSet map[string]string, toRemove []string) error {
+	if len(toSet) > 0 {
+		tags := make([]orgtypes.Tag, 0, len(toSet))
+		for key, value := range toSet {
+			tags = append(tags, orgtypes.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+		sort.Slice(tags, func(i, j int) bool { return *tags[i].Key < *tags[j].Key })
+		_, err := awsClient.TagResource(context.TODO(), &organizations.TagResourceInput{
+			ResourceId: aws.String(accountID),
+			Tags:       tags,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(toRemove) > 0 {
+		_, err := awsClient.UntagResource(context.TODO(), &organizations.UntagResourceInput{
+			ResourceId: aws.String(accountID),
+			TagKeys:    toRemove,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Claim label propagation", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		r             *AccountClaimReconciler
+		accountClaim  *awsv1alpha1.AccountClaim
+		account       *awsv1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "claim",
+				Namespace: "claim-namespace",
+				Labels:    map[string]string{"api.openshift.com/id": "cluster-id", "team": "sre", "unrelated": "value"},
+			},
+			Spec: awsv1alpha1.AccountClaimSpec{AccountLink: "osd-creds-mgmt-aaaaaa"},
+		}
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "osd-creds-mgmt-aaaaaa",
+				Namespace: awsv1alpha1.AccountCrNamespace,
+				Labels:    map[string]string{"team": "former-team", "environment": "staging"},
+			},
+			Spec: awsv1alpha1.AccountSpec{AwsAccountID: "123456789012"},
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string]string{propagatedLabelsKey: "api.openshift.com/id, team\nenvironment"},
+		}
+		r = &AccountClaimReconciler{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(account, configMap).Build(),
+			Scheme:           scheme.Scheme,
+			awsClientBuilder: &mock.Builder{MockController: ctrl},
+		}
+		mockAWSClient = mock.GetMockClient(r.awsClientBuilder)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	getAccount := func() *awsv1alpha1.Account {
+		updated := &awsv1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		return updated
+	}
+
+	It("syncs the configured labels to the account and its tags", func() {
+		mockAWSClient.EXPECT().TagResource(gomock.Any(), &organizations.TagResourceInput{
+			ResourceId: aws.String("123456789012"),
+			Tags: []orgtypes.Tag{
+				{Key: aws.String("api.openshift.com/id"), Value: aws.String("cluster-id")},
+				{Key: aws.String("team"), Value: aws.String("sre")},
+			},
+		}).Return(&organizations.TagResourceOutput{}, nil)
+		mockAWSClient.EXPECT().UntagResource(gomock.Any(), &organizations.UntagResourceInput{
+			ResourceId: aws.String("123456789012"),
+			TagKeys:    []string{"environment"},
+		}).Return(&organizations.UntagResourceOutput{}, nil)
+
+		Expect(r.propagateClaimLabels(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		Expect(getAccount().Labels).To(Equal(map[string]string{"api.openshift.com/id": "cluster-id", "team": "sre"}))
+
+		// Nothing to do once in sync
+		Expect(r.propagateClaimLabels(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+	})
+
+	It("leaves the account labels unchanged when tagging fails", func() {
+		mockAWSClient.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(nil, errors.New("throttled"))
+
+		Expect(r.propagateClaimLabels(context.TODO(), nullLogger, accountClaim)).NotTo(Succeed())
+		Expect(getAccount().Labels).To(Equal(account.Labels))
+	})
+
+	It("only labels BYOC accounts", func() {
+		account = getAccount()
+		account.Spec.BYOC = true
+		Expect(r.Update(context.TODO(), account)).To(Succeed())
+
+		Expect(r.propagateClaimLabels(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		Expect(getAccount().Labels).To(HaveKeyWithValue("team", "sre"))
+	})
+
+	It("removes the labels when the account is released", func() {
+		mockAWSClient.EXPECT().UntagResource(gomock.Any(), &organizations.UntagResourceInput{
+			ResourceId: aws.String("123456789012"),
+			TagKeys:    []string{"team", "environment"},
+		}).Return(&organizations.UntagResourceOutput{}, nil)
+
+		Expect(r.releaseClaimLabels(context.TODO(), nullLogger, getAccount())).To(Succeed())
+		Expect(getAccount().Labels).To(BeEmpty())
+	})
+})
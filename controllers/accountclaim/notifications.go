@@ -0,0 +1,60 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// notifyClaimState publishes a notification for claims that just turned Ready or Error
+func (r *AccountClaimReconciler) notifyClaimState(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) {
+	notification := controllerutils.Notification{
+		Kind:      "AccountClaim",
+		Namespace: accountClaim.Namespace,
+		Name:      accountClaim.Name,
+	}
+	switch accountClaim.Status.State {
+	case awsv1alpha1.ClaimStatusReady:
+		notification.Event = controllerutils.NotificationClaimReady
+		notification.Message = fmt.Sprintf("Claim is ready with account %s", accountClaim.Spec.AccountLink)
+	case awsv1alpha1.ClaimStatusError:
+		notification.Event = controllerutils.NotificationClaimFailed
+		notification.Message = "Claim failed"
+		if condition := lastTrueClaimCondition(accountClaim); condition != nil {
+			notification.Message = fmt.Sprintf("Claim failed with condition %s (%s): %s", condition.Type, condition.Reason, condition.Message)
+		}
+	default:
+		return
+	}
+	controllerutils.PublishNotification(context.TODO(), r.operatorConfig(), reqLogger, notification)
+}
+
+// notifyCleanupFailed publishes a notification for the failed cleanup of the account released by the claim
+func (r *AccountClaimReconciler) notifyCleanupFailed(reqLogger logr.Logger, account *awsv1alpha1.Account, accountClaim *awsv1alpha1.AccountClaim, err error) {
+	controllerutils.PublishNotification(context.TODO(), r.operatorConfig(), reqLogger, controllerutils.Notification{
+		Event:     controllerutils.NotificationCleanupFailed,
+		Kind:      "Account",
+		Namespace: account.Namespace,
+		Name:      account.Name,
+		Message:   fmt.Sprintf("Cleanup after claim %s/%s failed: %v", accountClaim.Namespace, accountClaim.Name, err),
+	})
+}
+
+// lastTrueClaimCondition returns the condition that turned true last, which tells why a claim failed
+func lastTrueClaimCondition(accountClaim *awsv1alpha1.AccountClaim) *awsv1alpha1.AccountClaimCondition {
+	var last *awsv1alpha1.AccountClaimCondition
+	for i, condition := range accountClaim.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		if last == nil || !condition.LastTransitionTime.Before(&last.LastTransitionTime) {
+			last = &accountClaim.Status.Conditions[i]
+		}
+	}
+	return last
+}
@@ -0,0 +1,76 @@
+package accountclaim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Claim notifications", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		r            *AccountClaimReconciler
+		server       *httptest.Server
+		received     []controllerutils.Notification
+	)
+
+	BeforeEach(func() {
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			notification := controllerutils.Notification{}
+			Expect(json.NewDecoder(req.Body).Decode(&notification)).To(Succeed())
+			received = append(received, notification)
+		}))
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultConfigMap, Namespace: v1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				"notifications": "sinks:\n- type: webhook\n  url: " + server.URL + "\n",
+			},
+		}
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "notified-claim", Namespace: "claim-namespace"},
+			Spec:       v1alpha1.AccountClaimSpec{AccountLink: "osd-creds-mgmt-aaaaaa"},
+			Status:     v1alpha1.AccountClaimStatus{State: v1alpha1.ClaimStatusPending},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap, accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("notifies when the claim turns Ready", func() {
+		accountClaim.Status.State = v1alpha1.ClaimStatusReady
+		Expect(r.statusUpdate(nullLogger, accountClaim)).To(Succeed())
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].Event).To(Equal(controllerutils.NotificationClaimReady))
+		Expect(received[0].Message).To(ContainSubstring("osd-creds-mgmt-aaaaaa"))
+
+		By("not notifying status updates that don't change the state")
+		Expect(r.statusUpdate(nullLogger, accountClaim)).To(Succeed())
+		Expect(received).To(HaveLen(1))
+	})
+
+	It("notifies why the claim failed", func() {
+		controllerutils.SetAccountClaimStatus(accountClaim, "Invalid AccountClaim", "regions aren't supported", v1alpha1.InvalidAccountClaim, v1alpha1.ClaimStatusError)
+		Expect(r.statusUpdate(nullLogger, accountClaim)).To(Succeed())
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].Event).To(Equal(controllerutils.NotificationClaimFailed))
+		Expect(received[0].Message).To(Equal("Claim failed with condition InvalidAccountClaim (regions aren't supported): Invalid AccountClaim"))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
@@ -11,9 +12,20 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
 	awsclient "github.com/openshift/aws-account-operator/pkg/awsclient"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// requiredOURequeue is how often a claim waiting for its account to be in its required OU checks it again. The
+	// parent of an account isn't always listed right after it was moved.
+	requiredOURequeue = 30 * time.Second
+
+	requiredOUPendingReason  = "MovingToRequiredOU"
+	requiredOUVerifiedReason = "InRequiredOU"
 )
 
 // MoveAccountToOU takes care of all the logic surrounding moving an account into an OU
@@ -79,6 +91,105 @@ func MoveAccountToOU(r *AccountClaimReconciler, reqLogger logr.Logger, awsClient
 	return r.specUpdate(reqLogger, accountClaim)
 }
 
+// validateRequiredOU denies claims of CCS accounts requiring an OU, as they aren't part of the organization
+func validateRequiredOU(accountClaim *awsv1alpha1.AccountClaim) error {
+	if accountClaim.Spec.RequiredOU != "" && accountClaim.Spec.BYOC {
+		return &admissionDeniedError{reasons: []string{"a required OU only applies to accounts of the organization, not CCS accounts"}}
+	}
+	return nil
+}
+
+// accountParentID returns the ID of the OU or root the AWS account is in
+func accountParentID(client awsclient.Client, awsAccountID string) (string, error) {
+	output, err := client.ListParents(context.TODO(), &organizations.ListParentsInput{ChildId: &awsAccountID})
+	if err != nil {
+		return "", err
+	}
+	if len(output.Parents) != 1 || output.Parents[0].Id == nil {
+		return "", fmt.Errorf("expected a single parent of AWS account %s, got %d", awsAccountID, len(output.Parents))
+	}
+	return *output.Parents[0].Id, nil
+}
+
+// moveAccountToRequiredOU moves the account into the required OU of the claim unless it's already in it, and reports
+// whether the account is listed in it afterwards
+func moveAccountToRequiredOU(reqLogger logr.Logger, client awsclient.Client, accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) (bool, error) {
+	requiredOU := accountClaim.Spec.RequiredOU
+	parentID, err := accountParentID(client, account.Spec.AwsAccountID)
+	if err != nil {
+		return false, err
+	}
+	if parentID == requiredOU {
+		return true, nil
+	}
+
+	reqLogger.Info(fmt.Sprintf("OU: Moving account %s from %s to the required OU %s", account.Name, parentID, requiredOU))
+	err = MoveAccount(reqLogger, client, account, requiredOU, parentID)
+	if err != nil && err != awsv1alpha1.ErrAccAlreadyInOU {
+		if err == awsv1alpha1.ErrAccMoveRaceCondition {
+			return false, nil
+		}
+		return false, err
+	}
+
+	parentID, err = accountParentID(client, account.Spec.AwsAccountID)
+	if err != nil {
+		return false, err
+	}
+	return parentID == requiredOU, nil
+}
+
+// waitForRequiredOU moves the account of the claim into the required OU of the claim, and reports whether the claim
+// has to wait for the account to be verified in it. While it waits, the claim has an OUPending condition and the
+// returned result requeues it. Once verified, the required OU is recorded as the OU of the claim.
+func (r *AccountClaimReconciler) waitForRequiredOU(reqLogger logr.Logger, awsClient awsclient.Client, accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) (bool, reconcile.Result, error) {
+	verified, err := moveAccountToRequiredOU(reqLogger, awsClient, accountClaim, account)
+	if err != nil {
+		return false, reconcile.Result{}, err
+	}
+	isCCS := accountClaim.Spec.BYOCAWSAccountID != ""
+	condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.OUPending)
+
+	if !verified {
+		message := fmt.Sprintf("Waiting for account %s to be in the required OU %s", account.Name, accountClaim.Spec.RequiredOU)
+		reqLogger.Info(message)
+		result := reconcile.Result{RequeueAfter: requiredOURequeue}
+		if condition != nil && condition.Status == corev1.ConditionTrue && condition.Message == message {
+			return true, result, nil
+		}
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.OUPending,
+			corev1.ConditionTrue,
+			requiredOUPendingReason,
+			message,
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+			isCCS,
+		)
+		return true, result, r.statusUpdate(reqLogger, accountClaim)
+	}
+
+	reqLogger.Info(fmt.Sprintf("OU: Account %s is in the required OU %s", account.Name, accountClaim.Spec.RequiredOU))
+	accountClaim.Spec.AccountOU = accountClaim.Spec.RequiredOU
+	err = r.specUpdate(reqLogger, accountClaim)
+	if err != nil {
+		return false, reconcile.Result{}, err
+	}
+	if condition == nil || condition.Status != corev1.ConditionTrue {
+		return false, reconcile.Result{}, nil
+	}
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.OUPending,
+		corev1.ConditionFalse,
+		requiredOUVerifiedReason,
+		fmt.Sprintf("Account %s is in the required OU %s", account.Name, accountClaim.Spec.RequiredOU),
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		isCCS,
+	)
+	return false, reconcile.Result{}, r.statusUpdate(reqLogger, accountClaim)
+}
+
 // CreateOrFindOU will create or find an existing OU and return its ID
 func CreateOrFindOU(reqLogger logr.Logger, client awsclient.Client, ouName string, baseID string) (string, error) {
 	// Create/Find account OU
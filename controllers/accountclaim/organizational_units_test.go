@@ -278,6 +278,73 @@ var _ = Describe("Organizational Unit", func() {
 		})
 	})
 
+	When("Moving an Account to the required OU of its claim", func() {
+		var requiredClaim *awsv1alpha1.AccountClaim
+		requiredOU := "ou-abcd-efghijkl"
+		parents := func(id string) *organizations.ListParentsOutput {
+			return &organizations.ListParentsOutput{Parents: []organizationstypes.Parent{{Id: aws.String(id)}}}
+		}
+
+		BeforeEach(func() {
+			requiredClaim = &awsv1alpha1.AccountClaim{
+				ObjectMeta: v1.ObjectMeta{Name: "required-ou", Namespace: "test-namespace"},
+				Spec:       awsv1alpha1.AccountClaimSpec{RequiredOU: requiredOU},
+			}
+			r = AccountClaimReconciler{
+				Scheme: scheme.Scheme,
+				Client: fake.NewClientBuilder().WithRuntimeObjects(requiredClaim).Build(),
+			}
+		})
+
+		It("Should not move an Account already in the required OU", func() {
+			mockAWSClient.EXPECT().ListParents(gomock.Any(), gomock.Any()).Return(parents(requiredOU), nil)
+
+			waiting, _, err := r.waitForRequiredOU(nullLogger, mockAWSClient, requiredClaim, &account)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiting).To(BeFalse())
+			Expect(requiredClaim.Spec.AccountOU).To(Equal(requiredOU))
+		})
+
+		It("Should move the Account from its parent and verify it", func() {
+			gomock.InOrder(
+				mockAWSClient.EXPECT().ListParents(gomock.Any(), gomock.Any()).Return(parents(parentID), nil),
+				mockAWSClient.EXPECT().MoveAccount(gomock.Any(), &organizations.MoveAccountInput{
+					AccountId:           &awsAccountID,
+					DestinationParentId: &requiredOU,
+					SourceParentId:      &parentID,
+				}).Return(nil, nil),
+				mockAWSClient.EXPECT().ListParents(gomock.Any(), gomock.Any()).Return(parents(requiredOU), nil),
+			)
+
+			waiting, _, err := r.waitForRequiredOU(nullLogger, mockAWSClient, requiredClaim, &account)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiting).To(BeFalse())
+			Expect(requiredClaim.Spec.AccountOU).To(Equal(requiredOU))
+		})
+
+		It("Should wait until the Account is listed in the required OU", func() {
+			gomock.InOrder(
+				mockAWSClient.EXPECT().ListParents(gomock.Any(), gomock.Any()).Return(parents(parentID), nil),
+				mockAWSClient.EXPECT().MoveAccount(gomock.Any(), gomock.Any()).Return(nil, nil),
+				mockAWSClient.EXPECT().ListParents(gomock.Any(), gomock.Any()).Return(parents(parentID), nil),
+			)
+
+			waiting, result, err := r.waitForRequiredOU(nullLogger, mockAWSClient, requiredClaim, &account)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiting).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(requiredOURequeue))
+			Expect(requiredClaim.Spec.AccountOU).To(BeEmpty())
+			Expect(requiredClaim.Status.Conditions).To(HaveLen(1))
+			Expect(requiredClaim.Status.Conditions[0].Type).To(Equal(awsv1alpha1.OUPending))
+			Expect(requiredClaim.Status.Conditions[0].Status).To(Equal(corev1.ConditionTrue))
+		})
+
+		It("Should deny CCS claims requiring an OU", func() {
+			requiredClaim.Spec.BYOC = true
+			Expect(isAdmissionDenied(validateRequiredOU(requiredClaim))).To(BeTrue())
+		})
+	})
+
 	When("Moving Account", func() {
 		It("Should move successfully", func() {
 			mockAWSClient.EXPECT().MoveAccount(gomock.Any(), &organizations.MoveAccountInput{
@@ -0,0 +1,77 @@
+package accountclaim
+
+import (
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// supportedInPartitionReason is the reason of the UnsupportedInPartition condition once the claim only requests
+// capabilities of the partition
+const supportedInPartitionReason = "SupportedInPartition"
+
+// reconcilePartitionSupport fails the claims requesting capabilities the AWS partition of the operator doesn't have,
+// e.g. opt-in regions in GovCloud, before an account is selected for them. They get an UnsupportedInPartition
+// condition, whose reason is the first unsupported capability, and are counted in
+// aws_account_operator_account_claims_unsupported_in_partition_total. Returns whether the claim is unsupported, in
+// which case it isn't reconciled further until its spec changes.
+func (r *AccountClaimReconciler) reconcilePartitionSupport(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	partition := config.GetPartition()
+	regions := []string{}
+	for _, region := range accountClaim.Spec.Aws.Regions {
+		regions = append(regions, region.Name)
+	}
+	mismatches := controllerutils.UnsupportedInPartition(partition, regions, accountClaim.Spec.BYOC)
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.UnsupportedInPartition)
+	isCCS := accountClaim.Spec.BYOCAWSAccountID != ""
+
+	if len(mismatches) == 0 {
+		if existing == nil || existing.Status != corev1.ConditionTrue {
+			return false, nil
+		}
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.UnsupportedInPartition,
+			corev1.ConditionFalse,
+			supportedInPartitionReason,
+			"The claim only requests capabilities of partition "+partition,
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+			isCCS,
+		)
+		accountClaim.Status.State = awsv1alpha1.ClaimStatusPending
+		return false, r.statusUpdate(reqLogger, accountClaim)
+	}
+
+	messages := []string{}
+	for _, mismatch := range mismatches {
+		messages = append(messages, mismatch.Message)
+	}
+	message := strings.Join(messages, "; ")
+	if existing != nil && existing.Status == corev1.ConditionTrue && existing.Message == message {
+		return true, nil
+	}
+
+	reqLogger.Info("claim requests capabilities the partition doesn't have", "partition", partition, "reasons", message)
+	if localmetrics.Collector != nil {
+		for _, mismatch := range mismatches {
+			localmetrics.Collector.AddAccountClaimUnsupportedInPartition(partition, string(mismatch.Capability))
+		}
+	}
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.UnsupportedInPartition,
+		corev1.ConditionTrue,
+		string(mismatches[0].Capability),
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		isCCS,
+	)
+	accountClaim.Status.State = awsv1alpha1.ClaimStatusError
+	return true, r.statusUpdate(reqLogger, accountClaim)
+}
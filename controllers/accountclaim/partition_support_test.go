@@ -0,0 +1,88 @@
+package accountclaim
+
+import (
+	"context"
+
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Partition support", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		r            *AccountClaimReconciler
+	)
+
+	setFedramp := func(fedramp string) {
+		Expect(config.SetIsFedramp(&corev1.ConfigMap{Data: map[string]string{"fedramp": fedramp}})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: v1alpha1.AccountClaimSpec{
+				BYOC: true,
+				Aws:  v1alpha1.Aws{Regions: []v1alpha1.AwsRegions{{Name: "us-gov-west-1"}, {Name: "af-south-1"}}},
+			},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+		setFedramp("true")
+	})
+
+	AfterEach(func() {
+		setFedramp("false")
+	})
+
+	getClaim := func() *v1alpha1.AccountClaim {
+		updated := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		accountClaim = updated
+		return updated
+	}
+
+	It("fails a claim of an opt-in region in GovCloud fast", func() {
+		unsupported, err := r.reconcilePartitionSupport(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unsupported).To(BeTrue())
+
+		claim := getClaim()
+		Expect(claim.Status.State).To(Equal(v1alpha1.ClaimStatusError))
+		condition := controllerutils.FindAccountClaimCondition(claim.Status.Conditions, v1alpha1.UnsupportedInPartition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(string(controllerutils.CapabilityOptInRegions)))
+		Expect(condition.Message).To(Equal("region af-south-1 is an opt-in region, partition aws-us-gov has no opt-in regions"))
+
+		By("resolving the condition once the claim only requests capabilities of the partition")
+		claim.Spec.Aws.Regions = []v1alpha1.AwsRegions{{Name: "us-gov-west-1"}}
+		unsupported, err = r.reconcilePartitionSupport(nullLogger, claim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unsupported).To(BeFalse())
+		claim = getClaim()
+		Expect(claim.Status.State).To(Equal(v1alpha1.ClaimStatusPending))
+		Expect(controllerutils.FindAccountClaimCondition(claim.Status.Conditions, v1alpha1.UnsupportedInPartition).Status).To(Equal(corev1.ConditionFalse))
+	})
+
+	It("leaves the claims of the commercial partition alone", func() {
+		setFedramp("false")
+		accountClaim.Spec.Aws.Regions = []v1alpha1.AwsRegions{{Name: "af-south-1"}}
+		unsupported, err := r.reconcilePartitionSupport(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unsupported).To(BeFalse())
+		Expect(accountClaim.Status.Conditions).To(BeEmpty())
+	})
+})
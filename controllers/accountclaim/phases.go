@@ -0,0 +1,50 @@
+package accountclaim
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// markClaimPhase records the phase as completed at now in the claim status and updates its progress. Ready completes
+// the phases before it that weren't recorded, e.g. for claims that were Ready before phases were tracked. Returns
+// whether the status changed.
+func markClaimPhase(accountClaim *awsv1alpha1.AccountClaim, phase awsv1alpha1.ClaimPhase, now metav1.Time) bool {
+	completed := map[awsv1alpha1.ClaimPhase]metav1.Time{}
+	for _, p := range accountClaim.Status.Phases {
+		completed[p.Name] = p.CompletionTime
+	}
+	if _, ok := completed[phase]; ok {
+		return false
+	}
+
+	completed[phase] = now
+	if phase == awsv1alpha1.ClaimPhaseReady {
+		for _, p := range awsv1alpha1.ClaimPhases {
+			if _, ok := completed[p]; !ok {
+				completed[p] = now
+			}
+		}
+	}
+
+	phases := []awsv1alpha1.AccountClaimPhase{}
+	for _, p := range awsv1alpha1.ClaimPhases {
+		if completionTime, ok := completed[p]; ok {
+			phases = append(phases, awsv1alpha1.AccountClaimPhase{Name: p, CompletionTime: completionTime})
+		}
+	}
+	accountClaim.Status.Phases = phases
+	accountClaim.Status.Progress = 100 * len(phases) / len(awsv1alpha1.ClaimPhases)
+	return true
+}
+
+// recordClaimPhase records the phase in the claim status, updating the status only when the phase is new
+func (r *AccountClaimReconciler) recordClaimPhase(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, phase awsv1alpha1.ClaimPhase) error {
+	if !markClaimPhase(accountClaim, phase, metav1.Now()) {
+		return nil
+	}
+	reqLogger.Info(fmt.Sprintf("Claim %s completed phase %s", accountClaim.Name, phase), "progress", accountClaim.Status.Progress)
+	return r.statusUpdate(reqLogger, accountClaim)
+}
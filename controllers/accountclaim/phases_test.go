@@ -0,0 +1,83 @@
+package accountclaim
+
+import (
+	"context"
+	"time"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Claim phases", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *awsv1alpha1.AccountClaim
+		selectedAt   = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		readyAt      = metav1.NewTime(time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC))
+	)
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "namespace"},
+		}
+	})
+
+	phaseNames := func() []awsv1alpha1.ClaimPhase {
+		names := []awsv1alpha1.ClaimPhase{}
+		for _, phase := range accountClaim.Status.Phases {
+			names = append(names, phase.Name)
+		}
+		return names
+	}
+
+	It("records phases in order and updates the progress", func() {
+		Expect(markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseRolesConfigured, selectedAt)).To(BeTrue())
+		Expect(markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseSelected, selectedAt)).To(BeTrue())
+
+		Expect(phaseNames()).To(Equal([]awsv1alpha1.ClaimPhase{awsv1alpha1.ClaimPhaseSelected, awsv1alpha1.ClaimPhaseRolesConfigured}))
+		Expect(accountClaim.Status.Progress).To(Equal(50))
+	})
+
+	It("doesn't change phases already recorded", func() {
+		Expect(markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseSelected, selectedAt)).To(BeTrue())
+		Expect(markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseSelected, readyAt)).To(BeFalse())
+
+		Expect(accountClaim.Status.Phases).To(HaveLen(1))
+		Expect(accountClaim.Status.Phases[0].CompletionTime).To(Equal(selectedAt))
+		Expect(accountClaim.Status.Progress).To(Equal(25))
+	})
+
+	It("completes the missing phases when the claim is Ready", func() {
+		Expect(markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseSelected, selectedAt)).To(BeTrue())
+		Expect(markClaimPhase(accountClaim, awsv1alpha1.ClaimPhaseReady, readyAt)).To(BeTrue())
+
+		Expect(phaseNames()).To(Equal(awsv1alpha1.ClaimPhases))
+		Expect(accountClaim.Status.Phases[0].CompletionTime).To(Equal(selectedAt))
+		Expect(accountClaim.Status.Phases[3].CompletionTime).To(Equal(readyAt))
+		Expect(accountClaim.Status.Progress).To(Equal(100))
+	})
+
+	It("persists new phases in the claim status", func() {
+		r := &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+
+		Expect(r.recordClaimPhase(nullLogger, accountClaim, awsv1alpha1.ClaimPhaseSelected)).To(Succeed())
+		Expect(r.recordClaimPhase(nullLogger, accountClaim, awsv1alpha1.ClaimPhaseSelected)).To(Succeed())
+
+		stored := &awsv1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), stored)).To(Succeed())
+		Expect(stored.Status.Phases).To(HaveLen(1))
+		Expect(stored.Status.Phases[0].Name).To(Equal(awsv1alpha1.ClaimPhaseSelected))
+		Expect(stored.Status.Progress).To(Equal(25))
+	})
+})
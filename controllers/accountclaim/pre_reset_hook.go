@@ -0,0 +1,405 @@
+package accountclaim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// PreResetHookAnnotation holds the URL of a webhook invoked before an AccountClaim's account is cleaned up
+	PreResetHookAnnotation = "aws.managed.openshift.io/pre-reset-hook-url"
+	// PreResetHookJobTemplateAnnotation holds the name of a ConfigMap in the operator namespace, whose job.yaml key
+	// holds a Job run in the namespace of an AccountClaim before the claim's account is cleaned up
+	PreResetHookJobTemplateAnnotation = "aws.managed.openshift.io/pre-reset-hook-job-template"
+	// PreResetHookTimeoutAnnotation optionally overrides how long cleanup waits for the pre-reset hook to succeed, up
+	// to the maximum of the operator configuration
+	PreResetHookTimeoutAnnotation = "aws.managed.openshift.io/pre-reset-hook-timeout"
+	// preResetHookCompletedAnnotation records that the pre-reset hook ran, so it is only invoked once
+	preResetHookCompletedAnnotation = "aws.managed.openshift.io/pre-reset-hook-completed"
+
+	// preResetHookAllowedURLsKey is the operator ConfigMap key listing the URL prefixes pre-reset webhooks may point
+	// to, separated by commas or newlines. Webhooks are only invoked when their URL matches one of them.
+	preResetHookAllowedURLsKey = "pre-reset-hook-allowed-urls"
+	// preResetHookMaxTimeoutKey is the operator ConfigMap key capping PreResetHookTimeoutAnnotation, it defaults to
+	// defaultPreResetHookTimeout
+	preResetHookMaxTimeoutKey = "pre-reset-hook-max-timeout"
+	// preResetHookJobsFeatureFlag lets claims run a pre-reset hook Job, from a template of the operator namespace, in
+	// their namespace
+	preResetHookJobsFeatureFlag = "feature.pre_reset_hook_jobs"
+	// preResetHookJobTemplateKey is the key of the Job in the ConfigMap named by PreResetHookJobTemplateAnnotation
+	preResetHookJobTemplateKey = "job.yaml"
+
+	defaultPreResetHookTimeout = 10 * time.Minute
+	preResetHookRetryInterval  = 30 * time.Second
+)
+
+// errPreResetHookNotAllowed is returned for the hooks the operator configuration doesn't allow. They're skipped
+// instead of being waited for.
+var errPreResetHookNotAllowed = errors.New("pre-reset hook is not allowed by the operator configuration")
+
+var preResetHookHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+	// A redirect could lead the webhook anywhere the allow-list doesn't
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// preResetHookPayload is the body POSTed to the pre-reset hook
+type preResetHookPayload struct {
+	AccountClaim string `json:"accountClaim"`
+	Namespace    string `json:"namespace"`
+	AccountLink  string `json:"accountLink,omitempty"`
+	AWSAccountID string `json:"awsAccountID,omitempty"`
+}
+
+// handlePreResetHook runs the pre-reset hooks of a deleted AccountClaim, if any are configured: the Job first, then
+// the webhook. It returns true while cleanup has to wait for the hooks. Once they succeeded, or their timeout
+// (measured from the deletion timestamp) has passed, cleanup is allowed to continue.
+func (r *AccountClaimReconciler) handlePreResetHook(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	hookURL := accountClaim.Annotations[PreResetHookAnnotation]
+	jobTemplate := accountClaim.Annotations[PreResetHookJobTemplateAnnotation]
+	if hookURL == "" && jobTemplate == "" {
+		return false, nil
+	}
+	if _, done := accountClaim.Annotations[preResetHookCompletedAnnotation]; done {
+		return false, nil
+	}
+	if !controllerutils.Contains(accountClaim.GetFinalizers(), accountClaimFinalizer) {
+		return false, nil
+	}
+
+	cm, err := r.operatorConfig().ConfigMap(context.TODO())
+	if err != nil {
+		if !k8serr.IsNotFound(err) {
+			return false, err
+		}
+		cm = &corev1.ConfigMap{}
+	}
+	timeout := preResetHookTimeout(reqLogger, cm, accountClaim)
+
+	var hookErr error
+	if jobTemplate != "" {
+		hookErr = r.runPreResetHookJob(reqLogger, cm, accountClaim, jobTemplate, timeout)
+	}
+	if hookErr == nil && hookURL != "" {
+		hookErr = r.invokePreResetHook(cm, accountClaim, hookURL)
+	}
+	if errors.Is(hookErr, errPreResetHookNotAllowed) {
+		reqLogger.Error(hookErr, "skipping the pre-reset hook", "url", hookURL, "jobTemplate", jobTemplate)
+		return false, r.completePreResetHook(reqLogger, accountClaim, jobTemplate, "NotAllowed")
+	}
+	if hookErr != nil {
+		if accountClaim.DeletionTimestamp != nil && time.Since(accountClaim.DeletionTimestamp.Time) > timeout {
+			reqLogger.Error(hookErr, "pre-reset hook did not succeed in time, continuing with cleanup", "timeout", timeout)
+			return false, r.completePreResetHook(reqLogger, accountClaim, jobTemplate, "TimedOut")
+		}
+		reqLogger.Info("pre-reset hook has not succeeded yet, waiting before cleanup", "error", hookErr.Error())
+		return true, nil
+	}
+
+	reqLogger.Info("pre-reset hook succeeded")
+	return false, r.completePreResetHook(reqLogger, accountClaim, jobTemplate, "Succeeded")
+}
+
+// preResetHookTimeout returns how long cleanup waits for the pre-reset hooks of the claim: the timeout annotation of
+// the claim, capped at the maximum of the operator configuration, or the default. Invalid values are reported and
+// ignored.
+func preResetHookTimeout(reqLogger logr.Logger, cm *corev1.ConfigMap, accountClaim *awsv1alpha1.AccountClaim) time.Duration {
+	maxTimeout := defaultPreResetHookTimeout
+	if value, ok := cm.Data[preResetHookMaxTimeoutKey]; ok {
+		parsed, err := time.ParseDuration(value)
+		switch {
+		case err != nil:
+			reqLogger.Error(err, "invalid pre-reset hook maximum timeout, using default", "maxTimeout", value)
+		case parsed <= 0:
+			reqLogger.Info("pre-reset hook maximum timeout isn't positive, using default", "maxTimeout", value)
+		default:
+			maxTimeout = parsed
+		}
+	}
+
+	timeout := defaultPreResetHookTimeout
+	if value, ok := accountClaim.Annotations[PreResetHookTimeoutAnnotation]; ok {
+		parsed, err := time.ParseDuration(value)
+		switch {
+		case err != nil:
+			reqLogger.Error(err, "invalid pre-reset hook timeout, using default", "timeout", value)
+		case parsed <= 0:
+			reqLogger.Info("pre-reset hook timeout isn't positive, using default", "timeout", value)
+		default:
+			timeout = parsed
+		}
+	}
+	if timeout > maxTimeout {
+		reqLogger.Info("pre-reset hook timeout is over the maximum, using the maximum", "timeout", timeout, "maxTimeout", maxTimeout)
+		timeout = maxTimeout
+	}
+	return timeout
+}
+
+func (r *AccountClaimReconciler) preResetHookPayload(accountClaim *awsv1alpha1.AccountClaim) preResetHookPayload {
+	payload := preResetHookPayload{
+		AccountClaim: accountClaim.Name,
+		Namespace:    accountClaim.Namespace,
+		AccountLink:  accountClaim.Spec.AccountLink,
+		AWSAccountID: accountClaim.Spec.BYOCAWSAccountID,
+	}
+	if accountClaim.Spec.AccountLink != "" {
+		claimedAccount, err := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
+		if err == nil {
+			payload.AWSAccountID = claimedAccount.Spec.AwsAccountID
+		}
+	}
+	return payload
+}
+
+func (r *AccountClaimReconciler) invokePreResetHook(cm *corev1.ConfigMap, accountClaim *awsv1alpha1.AccountClaim, hookURL string) error {
+	if !preResetHookURLAllowed(hookURL, preResetHookAllowedURLs(cm)) {
+		return errPreResetHookNotAllowed
+	}
+	if controllerutils.IsDryRun() {
+		controllerutils.LogDryRunCall("pre-reset hook", "url", hookURL, "accountClaim", accountClaim.Name, "namespace", accountClaim.Namespace)
+		return nil
+	}
+
+	body, err := json.Marshal(r.preResetHookPayload(accountClaim))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, hookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := preResetHookHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("pre-reset hook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// preResetHookAllowedURLs returns the URL prefixes configured for pre-reset webhooks
+func preResetHookAllowedURLs(cm *corev1.ConfigMap) []string {
+	prefixes := []string{}
+	for _, prefix := range strings.FieldsFunc(cm.Data[preResetHookAllowedURLsKey], func(r rune) bool { return r == ',' || r == '\n' }) {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// preResetHookURLAllowed returns whether the hook URL has the scheme and host of one of the allowed prefixes, and a
+// path under its path. Nothing is allowed without prefixes.
+func preResetHookURLAllowed(hookURL string, allowed []string) bool {
+	hook, err := url.Parse(hookURL)
+	if err != nil || hook.User != nil {
+		return false
+	}
+	for _, segment := range strings.Split(hook.Path, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	for _, prefix := range allowed {
+		allowedURL, err := url.Parse(prefix)
+		if err != nil {
+			continue
+		}
+		if !strings.EqualFold(hook.Scheme, allowedURL.Scheme) || !strings.EqualFold(hook.Host, allowedURL.Host) {
+			continue
+		}
+		allowedPath := strings.TrimSuffix(allowedURL.Path, "/")
+		if hook.Path == allowedPath || strings.HasPrefix(hook.Path, allowedPath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// runPreResetHookJob creates the pre-reset hook Job of the claim from its template, once, and returns nil once it
+// succeeded. The Job is named after the claim and runs in its namespace, with the claim and its account in its
+// environment. Templates are only read from the operator namespace, so claims can't make the operator create the pods
+// of their choosing.
+func (r *AccountClaimReconciler) runPreResetHookJob(reqLogger logr.Logger, cm *corev1.ConfigMap, accountClaim *awsv1alpha1.AccountClaim, templateName string, timeout time.Duration) error {
+	enabled, err := controllerutils.GetFeatureFlagValue(cm, preResetHookJobsFeatureFlag)
+	if err != nil || !enabled {
+		return errPreResetHookNotAllowed
+	}
+
+	job := &batchv1.Job{}
+	err = r.Get(context.TODO(), types.NamespacedName{Name: preResetHookJobName(accountClaim), Namespace: accountClaim.Namespace}, job)
+	if k8serr.IsNotFound(err) {
+		job, err = r.newPreResetHookJob(accountClaim, templateName, timeout)
+		if err != nil {
+			return err
+		}
+		reqLogger.Info("creating the pre-reset hook job", "job", job.Name)
+		if err := r.Create(context.TODO(), job); err != nil {
+			return err
+		}
+		return fmt.Errorf("pre-reset hook job %s created", job.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if job.Status.Succeeded > 0 {
+		return nil
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return fmt.Errorf("pre-reset hook job %s failed: %s", job.Name, condition.Message)
+		}
+	}
+	return fmt.Errorf("pre-reset hook job %s is still running", job.Name)
+}
+
+// newPreResetHookJob returns the pre-reset hook Job of the claim, read from the job.yaml key of the template
+// ConfigMap in the operator namespace. It's labeled so the operator caches it, and stopped when the hook times out.
+func (r *AccountClaimReconciler) newPreResetHookJob(accountClaim *awsv1alpha1.AccountClaim, templateName string, timeout time.Duration) (*batchv1.Job, error) {
+	template := &corev1.ConfigMap{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Name: templateName, Namespace: awsv1alpha1.AccountCrNamespace}, template); err != nil {
+		return nil, err
+	}
+	job := &batchv1.Job{}
+	if err := yaml.UnmarshalStrict([]byte(template.Data[preResetHookJobTemplateKey]), job); err != nil {
+		return nil, fmt.Errorf("invalid pre-reset hook job template %s: %w", templateName, err)
+	}
+	if reason := privilegedPodSpecReason(&job.Spec.Template.Spec); reason != "" {
+		return nil, fmt.Errorf("%w: job template %s %s", errPreResetHookNotAllowed, templateName, reason)
+	}
+
+	labels := job.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[controllerutils.ManagedLabel] = "true"
+	job.ObjectMeta = metav1.ObjectMeta{
+		Name:      preResetHookJobName(accountClaim),
+		Namespace: accountClaim.Namespace,
+		Labels:    labels,
+	}
+	if job.Spec.ActiveDeadlineSeconds == nil {
+		deadline := int64(timeout / time.Second)
+		job.Spec.ActiveDeadlineSeconds = &deadline
+	}
+
+	payload := r.preResetHookPayload(accountClaim)
+	env := []corev1.EnvVar{
+		{Name: "ACCOUNT_CLAIM", Value: payload.AccountClaim},
+		{Name: "ACCOUNT_CLAIM_NAMESPACE", Value: payload.Namespace},
+		{Name: "ACCOUNT_LINK", Value: payload.AccountLink},
+		{Name: "AWS_ACCOUNT_ID", Value: payload.AWSAccountID},
+	}
+	for i := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, env...)
+	}
+	return job, nil
+}
+
+// privilegedPodSpecReason returns why the pod spec of a pre-reset hook Job isn't allowed, empty when it is. The pods
+// run with the default service account of the claim's namespace, without host namespaces, privileged containers or
+// host paths.
+func privilegedPodSpecReason(spec *corev1.PodSpec) string {
+	if spec.ServiceAccountName != "" || spec.DeprecatedServiceAccount != "" {
+		return "sets a service account"
+	}
+	if spec.HostNetwork || spec.HostPID || spec.HostIPC {
+		return "uses a host namespace"
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			return fmt.Sprintf("mounts the host path volume %s", volume.Name)
+		}
+	}
+	privileged := func(securityContext *corev1.SecurityContext) bool {
+		return securityContext != nil && securityContext.Privileged != nil && *securityContext.Privileged
+	}
+	for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for _, container := range containers {
+			if privileged(container.SecurityContext) {
+				return fmt.Sprintf("runs the privileged container %s", container.Name)
+			}
+		}
+	}
+	for _, container := range spec.EphemeralContainers {
+		if privileged(container.SecurityContext) {
+			return fmt.Sprintf("runs the privileged container %s", container.Name)
+		}
+	}
+	return ""
+}
+
+// preResetHookJobName returns the name of the pre-reset hook Job of the claim, short enough for the job-name label
+// of its pods
+func preResetHookJobName(accountClaim *awsv1alpha1.AccountClaim) string {
+	const suffix = "-pre-reset"
+	name := accountClaim.Name
+	if len(name)+len(suffix) > 63 {
+		name = strings.TrimRight(name[:63-len(suffix)], "-.")
+	}
+	return name + suffix
+}
+
+// completePreResetHook records the outcome of the pre-reset hooks, then deletes the hook Job, if the claim has one, so
+// a Job isn't left behind for every deleted claim. The Job is only deleted once the outcome is recorded, it would be
+// created again otherwise.
+func (r *AccountClaimReconciler) completePreResetHook(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, jobTemplate string, result string) error {
+	if err := r.markPreResetHookCompleted(accountClaim, result); err != nil {
+		return err
+	}
+	if jobTemplate == "" {
+		return nil
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      preResetHookJobName(accountClaim),
+			Namespace: accountClaim.Namespace,
+		},
+	}
+	reqLogger.Info("deleting the pre-reset hook job", "job", job.Name)
+	err := r.Delete(context.TODO(), job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !k8serr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *AccountClaimReconciler) markPreResetHookCompleted(accountClaim *awsv1alpha1.AccountClaim, result string) error {
+	annotations := accountClaim.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[preResetHookCompletedAnnotation] = result
+	accountClaim.SetAnnotations(annotations)
+	return r.Update(context.TODO(), accountClaim)
+}
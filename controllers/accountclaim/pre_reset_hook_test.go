@@ -0,0 +1,306 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	apis "github.com/openshift/aws-account-operator/api"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccountClaim pre-reset hook", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		account      *v1alpha1.Account
+		configMap    *corev1.ConfigMap
+		r            *AccountClaimReconciler
+		server       *httptest.Server
+		statusCode   int
+		received     []preResetHookPayload
+	)
+
+	err := apis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding apis to scheme in pre-reset hook tests")
+	}
+
+	BeforeEach(func() {
+		statusCode = http.StatusOK
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			payload := preResetHookPayload{}
+			Expect(json.NewDecoder(req.Body).Decode(&payload)).To(Succeed())
+			received = append(received, payload)
+			w.WriteHeader(statusCode)
+		}))
+
+		now := metav1.Now()
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "testAccountClaim",
+				Namespace:         "myAccountClaimNamespace",
+				Finalizers:        []string{accountClaimFinalizer},
+				DeletionTimestamp: &now,
+				Annotations: map[string]string{
+					PreResetHookAnnotation: server.URL,
+				},
+			},
+			Spec: v1alpha1.AccountClaimSpec{
+				AccountLink: "osd-creds-mgmt-aaabbb",
+			},
+		}
+		account = &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "osd-creds-mgmt-aaabbb",
+				Namespace: v1alpha1.AccountCrNamespace,
+			},
+			Spec: v1alpha1.AccountSpec{
+				AwsAccountID: "123456789012",
+			},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1alpha1.DefaultConfigMap,
+				Namespace: v1alpha1.AccountCrNamespace,
+			},
+			Data: map[string]string{
+				preResetHookAllowedURLsKey: "https://example.com/hooks, " + server.URL,
+			},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{accountClaim, account, configMap}...).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("does nothing without the annotation", func() {
+		delete(accountClaim.Annotations, PreResetHookAnnotation)
+		wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(received).To(BeEmpty())
+	})
+
+	It("invokes the hook once and lets cleanup continue on success", func() {
+		wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].AWSAccountID).To(Equal("123456789012"))
+		Expect(received[0].AccountLink).To(Equal("osd-creds-mgmt-aaabbb"))
+
+		updated := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		Expect(updated.Annotations).To(HaveKeyWithValue(preResetHookCompletedAnnotation, "Succeeded"))
+
+		wait, err = r.handlePreResetHook(nullLogger, updated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(received).To(HaveLen(1))
+	})
+
+	It("doesn't invoke the hook in dry-run mode", func() {
+		controllerutils.SetDryRun(true)
+		defer controllerutils.SetDryRun(false)
+
+		wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(received).To(BeEmpty())
+	})
+
+	It("waits while the hook fails within the timeout", func() {
+		statusCode = http.StatusServiceUnavailable
+		wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeTrue())
+	})
+
+	It("continues with cleanup once the timeout has passed", func() {
+		statusCode = http.StatusServiceUnavailable
+		accountClaim.Annotations[PreResetHookTimeoutAnnotation] = "1m"
+		past := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		accountClaim.DeletionTimestamp = &past
+
+		wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(accountClaim.Annotations).To(HaveKeyWithValue(preResetHookCompletedAnnotation, "TimedOut"))
+	})
+
+	It("caps the timeout at the maximum of the operator configuration", func() {
+		accountClaim.Annotations[PreResetHookTimeoutAnnotation] = "8760h"
+		Expect(preResetHookTimeout(nullLogger, configMap, accountClaim)).To(Equal(defaultPreResetHookTimeout))
+
+		configMap.Data[preResetHookMaxTimeoutKey] = "1h"
+		Expect(preResetHookTimeout(nullLogger, configMap, accountClaim)).To(Equal(time.Hour))
+
+		accountClaim.Annotations[PreResetHookTimeoutAnnotation] = "30m"
+		Expect(preResetHookTimeout(nullLogger, configMap, accountClaim)).To(Equal(30 * time.Minute))
+	})
+
+	It("uses the default timeout for values that aren't positive", func() {
+		accountClaim.Annotations[PreResetHookTimeoutAnnotation] = "0s"
+		Expect(preResetHookTimeout(nullLogger, configMap, accountClaim)).To(Equal(defaultPreResetHookTimeout))
+
+		accountClaim.Annotations[PreResetHookTimeoutAnnotation] = "-5m"
+		Expect(preResetHookTimeout(nullLogger, configMap, accountClaim)).To(Equal(defaultPreResetHookTimeout))
+	})
+
+	It("skips webhooks outside the allowed URLs without invoking them", func() {
+		configMap.Data[preResetHookAllowedURLsKey] = "https://example.com/hooks"
+		Expect(r.Update(context.TODO(), configMap)).To(Succeed())
+
+		wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(received).To(BeEmpty())
+		Expect(accountClaim.Annotations).To(HaveKeyWithValue(preResetHookCompletedAnnotation, "NotAllowed"))
+	})
+
+	When("The claim has a job template hook", func() {
+		BeforeEach(func() {
+			delete(accountClaim.Annotations, PreResetHookAnnotation)
+			accountClaim.Annotations[PreResetHookJobTemplateAnnotation] = "pre-reset"
+			template := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "pre-reset", Namespace: v1alpha1.AccountCrNamespace},
+				Data: map[string]string{
+					preResetHookJobTemplateKey: "spec:\n  template:\n    spec:\n      restartPolicy: Never\n      containers:\n      - name: archive\n        image: archiver\n",
+				},
+			}
+			Expect(r.Create(context.TODO(), template)).To(Succeed())
+		})
+
+		It("runs the job and waits for it to succeed", func() {
+			configMap.Data[preResetHookJobsFeatureFlag] = "true"
+			Expect(r.Update(context.TODO(), configMap)).To(Succeed())
+
+			wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+
+			job := &batchv1.Job{}
+			Expect(r.Get(context.TODO(), client.ObjectKey{Name: "testAccountClaim-pre-reset", Namespace: accountClaim.Namespace}, job)).To(Succeed())
+			Expect(job.Labels).To(HaveKeyWithValue(controllerutils.ManagedLabel, "true"))
+			Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "AWS_ACCOUNT_ID", Value: "123456789012"}))
+
+			wait, err = r.handlePreResetHook(nullLogger, accountClaim)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+
+			job.Status.Succeeded = 1
+			Expect(r.Status().Update(context.TODO(), job)).To(Succeed())
+			wait, err = r.handlePreResetHook(nullLogger, accountClaim)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(accountClaim.Annotations).To(HaveKeyWithValue(preResetHookCompletedAnnotation, "Succeeded"))
+
+			err = r.Get(context.TODO(), client.ObjectKeyFromObject(job), &batchv1.Job{})
+			Expect(k8serr.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("doesn't read templates from the claim's namespace", func() {
+			configMap.Data[preResetHookJobsFeatureFlag] = "true"
+			Expect(r.Update(context.TODO(), configMap)).To(Succeed())
+			template := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "claim-pre-reset", Namespace: accountClaim.Namespace},
+				Data: map[string]string{
+					preResetHookJobTemplateKey: "spec:\n  template:\n    spec:\n      restartPolicy: Never\n      containers:\n      - name: archive\n        image: archiver\n",
+				},
+			}
+			Expect(r.Create(context.TODO(), template)).To(Succeed())
+			accountClaim.Annotations[PreResetHookJobTemplateAnnotation] = "claim-pre-reset"
+
+			wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(k8serr.IsNotFound(r.Get(context.TODO(), client.ObjectKey{Name: "testAccountClaim-pre-reset", Namespace: accountClaim.Namespace}, &batchv1.Job{}))).To(BeTrue())
+		})
+
+		It("skips templates with a privileged pod spec without creating the job", func() {
+			configMap.Data[preResetHookJobsFeatureFlag] = "true"
+			Expect(r.Update(context.TODO(), configMap)).To(Succeed())
+			template := &corev1.ConfigMap{}
+			Expect(r.Get(context.TODO(), client.ObjectKey{Name: "pre-reset", Namespace: v1alpha1.AccountCrNamespace}, template)).To(Succeed())
+			template.Data[preResetHookJobTemplateKey] = "spec:\n  template:\n    spec:\n      serviceAccountName: admin\n      restartPolicy: Never\n      containers:\n      - name: archive\n        image: archiver\n"
+			Expect(r.Update(context.TODO(), template)).To(Succeed())
+
+			wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(accountClaim.Annotations).To(HaveKeyWithValue(preResetHookCompletedAnnotation, "NotAllowed"))
+			Expect(k8serr.IsNotFound(r.Get(context.TODO(), client.ObjectKey{Name: "testAccountClaim-pre-reset", Namespace: accountClaim.Namespace}, &batchv1.Job{}))).To(BeTrue())
+		})
+
+		It("skips the job unless the operator allows job hooks", func() {
+			wait, err := r.handlePreResetHook(nullLogger, accountClaim)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(accountClaim.Annotations).To(HaveKeyWithValue(preResetHookCompletedAnnotation, "NotAllowed"))
+			Expect(k8serr.IsNotFound(r.Get(context.TODO(), client.ObjectKey{Name: "testAccountClaim-pre-reset", Namespace: accountClaim.Namespace}, &batchv1.Job{}))).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Pre-reset hook job pod spec", func() {
+	privileged := true
+
+	DescribeTable("rejects the pod specs escalating the privileges of the job",
+		func(spec corev1.PodSpec, rejected bool) {
+			Expect(privilegedPodSpecReason(&spec) != "").To(Equal(rejected))
+		},
+		Entry("plain containers", corev1.PodSpec{Containers: []corev1.Container{{Name: "archive"}}}, false),
+		Entry("service account", corev1.PodSpec{ServiceAccountName: "admin"}, true),
+		Entry("host network", corev1.PodSpec{HostNetwork: true}, true),
+		Entry("host PID", corev1.PodSpec{HostPID: true}, true),
+		Entry("host path volume", corev1.PodSpec{Volumes: []corev1.Volume{{Name: "root", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}}}}}, true),
+		Entry("privileged container", corev1.PodSpec{Containers: []corev1.Container{{Name: "archive", SecurityContext: &corev1.SecurityContext{Privileged: &privileged}}}}, true),
+		Entry("privileged init container", corev1.PodSpec{InitContainers: []corev1.Container{{Name: "setup", SecurityContext: &corev1.SecurityContext{Privileged: &privileged}}}}, true),
+	)
+})
+
+var _ = Describe("Pre-reset hook URL allow-list", func() {
+	allowed := []string{"https://hooks.example.com/pre-reset/", "http://archiver.svc:8080"}
+
+	DescribeTable("matches the scheme, host and path of the allowed prefixes",
+		func(hookURL string, expected bool) {
+			Expect(preResetHookURLAllowed(hookURL, allowed)).To(Equal(expected))
+		},
+		Entry("path under a prefix", "https://hooks.example.com/pre-reset/team-a", true),
+		Entry("prefix itself", "https://hooks.example.com/pre-reset", true),
+		Entry("host without path", "http://archiver.svc:8080/archive", true),
+		Entry("other path", "https://hooks.example.com/admin", false),
+		Entry("path sharing the prefix", "https://hooks.example.com/pre-reset-other", false),
+		Entry("escaping the prefix", "https://hooks.example.com/pre-reset/../admin", false),
+		Entry("other scheme", "http://hooks.example.com/pre-reset/team-a", false),
+		Entry("other host", "https://169.254.169.254/pre-reset/", false),
+		Entry("credentials", "https://user@hooks.example.com/pre-reset/", false),
+	)
+
+	It("allows nothing without prefixes", func() {
+		Expect(preResetHookURLAllowed("https://hooks.example.com/pre-reset/", nil)).To(BeFalse())
+	})
+})
@@ -0,0 +1,199 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// regionReportKey is the key of the region report in its ConfigMap
+	regionReportKey = "report.json"
+
+	regionEnabled      = "enabled"
+	regionOptInPending = "opt-in-pending"
+)
+
+// regionReport tells installers which regions of the claimed account they can place resources in
+type regionReport struct {
+	AccountID string             `json:"accountID"`
+	Regions   []regionCapability `json:"regions"`
+}
+
+// regionCapability is a region of the report. AvailabilityZones is the number of available default availability
+// zones of enabled regions.
+type regionCapability struct {
+	Name              string `json:"name"`
+	Status            string `json:"status"`
+	AvailabilityZones int    `json:"availabilityZones,omitempty"`
+}
+
+// pendingRegions returns the regions of the report still waiting to be opted in
+func (report *regionReport) pendingRegions() []string {
+	pending := []string{}
+	for _, region := range report.Regions {
+		if region.Status == regionOptInPending {
+			pending = append(pending, region.Name)
+		}
+	}
+	return pending
+}
+
+// reconcileRegionReport writes the region report of the claimed account to the ConfigMap named by
+// Spec.RegionReportConfigMap. The report is written once, and again when a region it lists as pending was opted in.
+func (r *AccountClaimReconciler) reconcileRegionReport(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	if accountClaim.Spec.RegionReportConfigMap == "" || accountClaim.Status.State != awsv1alpha1.ClaimStatusReady {
+		return nil
+	}
+
+	claimedAccount, err := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
+	if err != nil {
+		return err
+	}
+
+	current := &corev1.ConfigMap{}
+	err = r.Get(ctx, client.ObjectKey{Name: accountClaim.Spec.RegionReportConfigMap, Namespace: accountClaim.Namespace}, current)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+	if exists {
+		if !metav1.IsControlledBy(current, accountClaim) {
+			reqLogger.Info(fmt.Sprintf("ConfigMap %s wasn't created for claim %s, not writing the region report", current.Name, accountClaim.Name))
+			return nil
+		}
+		if !regionReportOutdated(current, claimedAccount) {
+			return nil
+		}
+	}
+
+	report, err := r.buildRegionReport(reqLogger, claimedAccount)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		current.Data = map[string]string{regionReportKey: string(data)}
+		reqLogger.Info(fmt.Sprintf("Updating region report %s of claim %s", current.Name, accountClaim.Name))
+		return r.Update(ctx, current)
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      accountClaim.Spec.RegionReportConfigMap,
+			Namespace: accountClaim.Namespace,
+			Labels:    map[string]string{controllerutils.ManagedLabel: "true"},
+		},
+		Data: map[string]string{regionReportKey: string(data)},
+	}
+	if err := controllerutil.SetControllerReference(accountClaim, configMap, r.Scheme); err != nil {
+		return err
+	}
+	reqLogger.Info(fmt.Sprintf("Creating region report %s of claim %s", configMap.Name, accountClaim.Name))
+	return r.Create(ctx, configMap)
+}
+
+// regionReportOutdated returns whether a region the report lists as pending was opted in since, or the report can't
+// be read
+func regionReportOutdated(configMap *corev1.ConfigMap, account *awsv1alpha1.Account) bool {
+	report := &regionReport{}
+	if err := json.Unmarshal([]byte(configMap.Data[regionReportKey]), report); err != nil {
+		return true
+	}
+	for _, region := range report.pendingRegions() {
+		if optIn, ok := account.Status.OptInRegions[region]; !ok || optIn.Status == awsv1alpha1.OptInRequestEnabled {
+			return true
+		}
+	}
+	return false
+}
+
+// buildRegionReport lists the enabled regions of the account with their number of availability zones, and the
+// regions whose opt-in is still pending
+func (r *AccountClaimReconciler) buildRegionReport(reqLogger logr.Logger, account *awsv1alpha1.Account) (*regionReport, error) {
+	awsSetupClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		SecretName: controllerutils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  config.GetDefaultRegion(),
+	})
+	if err != nil {
+		reqLogger.Error(err, "failed building operator AWS client")
+		return nil, err
+	}
+	awsClient, creds, err := stsclient.HandleRoleAssumption(reqLogger, r.awsClientBuilder, account, r.Client, awsSetupClient, "", awsv1alpha1.AccountOperatorIAMRole, "")
+	if err != nil {
+		reqLogger.Error(err, "failed building AWS client from assume_role")
+		return nil, err
+	}
+
+	output, err := awsClient.DescribeRegions(context.TODO(), &ec2.DescribeRegionsInput{AllRegions: aws.Bool(false)})
+	if err != nil {
+		return nil, fmt.Errorf("failed describing the regions of account %s: %w", account.Name, err)
+	}
+
+	report := &regionReport{AccountID: account.Spec.AwsAccountID, Regions: []regionCapability{}}
+	enabled := map[string]bool{}
+	for _, region := range output.Regions {
+		name := aws.ToString(region.RegionName)
+		enabled[name] = true
+		regionalClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+			AwsCredsSecretIDKey:     *creds.Credentials.AccessKeyId,
+			AwsCredsSecretAccessKey: *creds.Credentials.SecretAccessKey,
+			AwsToken:                *creds.Credentials.SessionToken,
+			AwsRegion:               name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get AWS client for region %s: %w", name, err)
+		}
+		zones, err := countAvailabilityZones(regionalClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed describing the availability zones of region %s: %w", name, err)
+		}
+		report.Regions = append(report.Regions, regionCapability{Name: name, Status: regionEnabled, AvailabilityZones: zones})
+	}
+	for name, optIn := range account.Status.OptInRegions {
+		if !enabled[name] && optIn.Status != awsv1alpha1.OptInRequestEnabled {
+			report.Regions = append(report.Regions, regionCapability{Name: name, Status: regionOptInPending})
+		}
+	}
+	sort.Slice(report.Regions, func(i, j int) bool { return report.Regions[i].Name < report.Regions[j].Name })
+	return report, nil
+}
+
+// countAvailabilityZones returns the number of available default availability zones of the region of the client,
+// which leaves out local and wavelength zones
+func countAvailabilityZones(awsClient awsclient.Client) (int, error) {
+	output, err := awsClient.DescribeAvailabilityZones(context.TODO(), &ec2.DescribeAvailabilityZonesInput{
+		Filters: []ec2types.Filter{{Name: aws.String("zone-type"), Values: []string{"availability-zone"}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, zone := range output.AvailabilityZones {
+		if zone.State == ec2types.AvailabilityZoneStateAvailable {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,134 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Region report", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		accountClaim  *v1alpha1.AccountClaim
+		account       *v1alpha1.Account
+		r             *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace", UID: "claim-uid"},
+			Spec: v1alpha1.AccountClaimSpec{
+				AccountLink:           "osd-creds-mgmt-aaaaaa",
+				RegionReportConfigMap: "region-report",
+			},
+			Status: v1alpha1.AccountClaimStatus{State: v1alpha1.ClaimStatusReady},
+		}
+		account = &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: v1alpha1.AccountCrNamespace},
+			Spec:       v1alpha1.AccountSpec{AwsAccountID: "123456789012"},
+			Status: v1alpha1.AccountStatus{
+				OptInRegions: v1alpha1.OptInRegions{"ap-east-1": &v1alpha1.OptInRegionStatus{Status: v1alpha1.OptInRequestEnabling}},
+			},
+		}
+		r = &AccountClaimReconciler{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim, account).Build(),
+			Scheme:           scheme.Scheme,
+			awsClientBuilder: &mock.Builder{MockController: ctrl},
+		}
+		mockAWSClient = mock.GetMockClient(r.awsClientBuilder)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectRegions := func() {
+		mockAWSClient.EXPECT().AssumeRole(gomock.Any(), gomock.Any()).Return(&sts.AssumeRoleOutput{
+			AssumedRoleUser: &ststypes.AssumedRoleUser{
+				Arn:           aws.String("aws:::OrganizationAccountAccessRole/awsAccountOperator"),
+				AssumedRoleId: aws.String("OrganizationAccountAccessRole/awsAccountOperator"),
+			},
+			Credentials: &ststypes.Credentials{
+				AccessKeyId:     aws.String("ACCESS_KEY"),
+				SecretAccessKey: aws.String("SECRET_KEY"),
+				SessionToken:    aws.String("SESSION_TOKEN"),
+			},
+		}, nil)
+		mockAWSClient.EXPECT().DescribeRegions(gomock.Any(), gomock.Any()).Return(&ec2.DescribeRegionsOutput{
+			Regions: []ec2types.Region{{RegionName: aws.String("us-east-1")}},
+		}, nil)
+		mockAWSClient.EXPECT().DescribeAvailabilityZones(gomock.Any(), gomock.Any()).Return(&ec2.DescribeAvailabilityZonesOutput{
+			AvailabilityZones: []ec2types.AvailabilityZone{
+				{ZoneName: aws.String("us-east-1a"), State: ec2types.AvailabilityZoneStateAvailable},
+				{ZoneName: aws.String("us-east-1b"), State: ec2types.AvailabilityZoneStateAvailable},
+				{ZoneName: aws.String("us-east-1c"), State: ec2types.AvailabilityZoneStateImpaired},
+			},
+		}, nil)
+	}
+
+	getReport := func() (*corev1.ConfigMap, *regionReport) {
+		configMap := &corev1.ConfigMap{}
+		Expect(r.Get(context.TODO(), client.ObjectKey{Name: "region-report", Namespace: accountClaim.Namespace}, configMap)).To(Succeed())
+		report := &regionReport{}
+		Expect(json.Unmarshal([]byte(configMap.Data[regionReportKey]), report)).To(Succeed())
+		return configMap, report
+	}
+
+	It("writes the enabled and pending regions of the account", func() {
+		expectRegions()
+		Expect(r.reconcileRegionReport(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		configMap, report := getReport()
+		Expect(metav1.IsControlledBy(configMap, accountClaim)).To(BeTrue())
+		Expect(report).To(Equal(&regionReport{
+			AccountID: "123456789012",
+			Regions: []regionCapability{
+				{Name: "ap-east-1", Status: regionOptInPending},
+				{Name: "us-east-1", Status: regionEnabled, AvailabilityZones: 2},
+			},
+		}))
+
+		By("not describing the account again while the pending regions are pending")
+		Expect(r.reconcileRegionReport(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		By("writing the report again once a pending region is opted in")
+		account.Status.OptInRegions["ap-east-1"].Status = v1alpha1.OptInRequestEnabled
+		Expect(r.Status().Update(context.TODO(), account)).To(Succeed())
+		expectRegions()
+		Expect(r.reconcileRegionReport(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		_, report = getReport()
+		Expect(report.pendingRegions()).To(BeEmpty())
+	})
+
+	It("leaves ConfigMaps it didn't create", func() {
+		Expect(r.Create(context.TODO(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "region-report", Namespace: accountClaim.Namespace},
+		})).To(Succeed())
+		Expect(r.reconcileRegionReport(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+	})
+
+	It("doesn't report for claims that don't ask for it", func() {
+		accountClaim.Spec.RegionReportConfigMap = ""
+		Expect(r.reconcileRegionReport(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+	})
+})
@@ -0,0 +1,130 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// regionalSecretRegionLabel is the region of a regional credentials secret
+	regionalSecretRegionLabel = "aws.managed.openshift.io/region"
+
+	regionalSecretRegionKey      = "aws_region"
+	regionalSecretSTSEndpointKey = "sts_regional_endpoint"
+)
+
+// regionalSecretName is the name of the credentials secret of the claim for the region
+func regionalSecretName(secretName string, region string) string {
+	return fmt.Sprintf("%s-%s", secretName, region)
+}
+
+// stsRegionalEndpoint is the STS endpoint of the region
+func stsRegionalEndpoint(region string) string {
+	if strings.HasPrefix(region, "cn-") {
+		return fmt.Sprintf("https://sts.%s.amazonaws.com.cn", region)
+	}
+	return fmt.Sprintf("https://sts.%s.amazonaws.com", region)
+}
+
+// newRegionalSecret returns the credentials secret of the claim for the region, a copy of the credentials secret with
+// the region and its STS regional endpoint added, and its AWS CLI files defaulting to the region
+func newRegionalSecret(accountClaim *awsv1alpha1.AccountClaim, credentials *corev1.Secret, region string) *corev1.Secret {
+	labels := controllerutils.ClaimSecretLabels(accountClaim)
+	labels[regionalSecretRegionLabel] = region
+
+	data := map[string][]byte{}
+	for key, value := range credentials.Data {
+		data[key] = value
+	}
+	data[regionalSecretRegionKey] = []byte(region)
+	data[regionalSecretSTSEndpointKey] = []byte(stsRegionalEndpoint(region))
+
+	secret := &corev1.Secret{
+		Type: credentials.Type,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      regionalSecretName(credentials.Name, region),
+			Namespace: credentials.Namespace,
+			Labels:    labels,
+		},
+		Data: data,
+	}
+	// The AWS CLI files default to the region of the secret. Credentials which can't be written to them were already
+	// reported when the credentials secret was delivered.
+	_ = setCLIFiles(accountClaim, secret, region)
+	return secret
+}
+
+// reconcileRegionalSecrets delivers a credentials secret for each region of a claim asking for regional credentials
+// secrets, keeping them in sync with its credentials secret, and deletes the ones of regions no longer requested
+func (r *AccountClaimReconciler) reconcileRegionalSecrets(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	desired := map[string]*corev1.Secret{}
+	if accountClaim.Spec.RegionalCredentialSecrets && accountClaim.DeletionTimestamp == nil {
+		credentials := &corev1.Secret{}
+		err := r.Get(ctx, client.ObjectKey{Name: accountClaim.Spec.AwsCredentialSecret.Name, Namespace: accountClaim.Spec.AwsCredentialSecret.Namespace}, credentials)
+		if err != nil {
+			if k8serr.IsNotFound(err) {
+				// Delivered once the credentials secret exists
+				return nil
+			}
+			return err
+		}
+		for _, region := range accountClaim.Spec.Aws.Regions {
+			secret := newRegionalSecret(accountClaim, credentials, region.Name)
+			desired[secret.Name] = secret
+		}
+	}
+
+	for _, secret := range desired {
+		current := &corev1.Secret{}
+		err := r.Get(ctx, client.ObjectKeyFromObject(secret), current)
+		if k8serr.IsNotFound(err) {
+			reqLogger.Info(fmt.Sprintf("Creating regional credentials secret %s for claim %s", secret.Name, accountClaim.Name))
+			if err := r.Create(ctx, secret); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if current.Labels[controllerutils.ClaimSecretLabel] != accountClaim.Name || current.Labels[controllerutils.ClaimSecretNamespaceLabel] != accountClaim.Namespace {
+			reqLogger.Info(fmt.Sprintf("Secret %s wasn't delivered for claim %s, leaving it", secret.Name, accountClaim.Name))
+			continue
+		}
+		if reflect.DeepEqual(current.Data, secret.Data) {
+			continue
+		}
+		current.Data = secret.Data
+		reqLogger.Info(fmt.Sprintf("Updating regional credentials secret %s for claim %s", secret.Name, accountClaim.Name))
+		if err := r.Update(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	delivered, err := controllerutils.SecretsForClaim(ctx, r.Client, accountClaim, client.HasLabels{regionalSecretRegionLabel})
+	if err != nil {
+		return err
+	}
+	for i := range delivered {
+		secret := &delivered[i]
+		if _, ok := desired[secret.Name]; ok {
+			continue
+		}
+		reqLogger.Info(fmt.Sprintf("Deleting regional credentials secret %s of claim %s", secret.Name, accountClaim.Name))
+		if err := r.Delete(ctx, secret); err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
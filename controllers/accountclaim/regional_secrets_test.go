@@ -0,0 +1,120 @@
+package accountclaim
+
+import (
+	"context"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regional credentials secrets", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		r            *AccountClaimReconciler
+		accountClaim *awsv1alpha1.AccountClaim
+		credentials  *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				AwsCredentialSecret:       awsv1alpha1.SecretRef{Name: "aws", Namespace: "secret-namespace"},
+				Aws:                       awsv1alpha1.Aws{Regions: []awsv1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "cn-north-1"}}},
+				RegionalCredentialSecrets: true,
+			},
+		}
+		credentials = newSecretforCR("aws", "secret-namespace", []byte("access-key"), []byte("secret-key"))
+	})
+
+	newReconciler := func(objs ...client.Object) {
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...).Build(),
+			Scheme: scheme.Scheme,
+		}
+	}
+
+	getSecret := func(name string) (*corev1.Secret, error) {
+		secret := &corev1.Secret{}
+		err := r.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: "secret-namespace"}, secret)
+		return secret, err
+	}
+
+	It("delivers a secret per region with its STS regional endpoint", func() {
+		newReconciler(credentials)
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		secret, err := getSecret("aws-us-east-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(secret.Data["aws_access_key_id"])).To(Equal("access-key"))
+		Expect(string(secret.Data[regionalSecretRegionKey])).To(Equal("us-east-1"))
+		Expect(string(secret.Data[regionalSecretSTSEndpointKey])).To(Equal("https://sts.us-east-1.amazonaws.com"))
+		Expect(secret.Labels).To(HaveKeyWithValue(controllerutils.ClaimSecretLabel, "claim"))
+
+		secret, err = getSecret("aws-cn-north-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(secret.Data[regionalSecretSTSEndpointKey])).To(Equal("https://sts.cn-north-1.amazonaws.com.cn"))
+	})
+
+	It("keeps the secrets in sync with the credentials secret", func() {
+		newReconciler(credentials)
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		credentials, err := getSecret("aws")
+		Expect(err).NotTo(HaveOccurred())
+		credentials.Data["aws_secret_access_key"] = []byte("rotated")
+		Expect(r.Update(context.TODO(), credentials)).To(Succeed())
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		secret, err := getSecret("aws-us-east-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(secret.Data["aws_secret_access_key"])).To(Equal("rotated"))
+	})
+
+	It("deletes the secrets of regions no longer requested", func() {
+		newReconciler(credentials)
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		accountClaim.Spec.Aws.Regions = []awsv1alpha1.AwsRegions{{Name: "us-east-1"}}
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		_, err := getSecret("aws-us-east-1")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = getSecret("aws-cn-north-1")
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("deletes all secrets when the claim is deleted", func() {
+		newReconciler(credentials)
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		now := metav1.Now()
+		accountClaim.DeletionTimestamp = &now
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		secrets := &corev1.SecretList{}
+		Expect(r.List(context.TODO(), secrets, client.InNamespace("secret-namespace"))).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(1))
+		Expect(secrets.Items[0].Name).To(Equal("aws"))
+	})
+
+	It("leaves secrets it didn't deliver", func() {
+		existing := newSecretforCR("aws-us-east-1", "secret-namespace", []byte("other"), []byte("other"))
+		newReconciler(credentials, existing)
+		Expect(r.reconcileRegionalSecrets(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		secret, err := getSecret("aws-us-east-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(secret.Data["aws_access_key_id"])).To(Equal("other"))
+	})
+})
@@ -0,0 +1,140 @@
+package accountclaim
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// requiredQuotasRequeue is how often a claim waiting for its required quotas checks its account again. Quota
+	// changes of the account don't trigger a reconcile of the claim.
+	requiredQuotasRequeue = 5 * time.Minute
+
+	requiredQuotasPendingReason  = "WaitingForQuotas"
+	requiredQuotasApprovedReason = "QuotasApproved"
+
+	// accountSelectedReason is the reason of the event recording which account was selected for a claim with required
+	// quotas
+	accountSelectedReason = "AccountSelected"
+)
+
+// validateRequiredQuotas denies claims requiring quotas that can't be approved: CCS accounts don't get quota
+// requests, and a quota needs a code and a value
+func validateRequiredQuotas(accountClaim *awsv1alpha1.AccountClaim) error {
+	if len(accountClaim.Spec.RequiredQuotas) == 0 {
+		return nil
+	}
+	if accountClaim.Spec.BYOC {
+		return &admissionDeniedError{reasons: []string{"required quotas only apply to accounts of the organization, not CCS accounts"}}
+	}
+	reasons := []string{}
+	for _, quota := range accountClaim.Spec.RequiredQuotas {
+		if quota.QuotaCode == "" || quota.Value < 1 {
+			reasons = append(reasons, fmt.Sprintf("required quota %q needs a quota code and a value of at least 1", quota.QuotaCode))
+		}
+	}
+	if len(reasons) > 0 {
+		return &admissionDeniedError{reasons: reasons}
+	}
+	return nil
+}
+
+// unmetRequiredQuotas returns the required quotas of the claim not approved in the account, as "code>=value in
+// region". A quota is approved when the account requested at least the value and AWS completed the request.
+func unmetRequiredQuotas(accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) []string {
+	unmet := []string{}
+	for _, quota := range accountClaim.Spec.RequiredQuotas {
+		regions := []string{quota.Region}
+		if quota.Region == "" {
+			regions = []string{}
+			for _, region := range accountClaim.Spec.Aws.Regions {
+				regions = append(regions, region.Name)
+			}
+		}
+		for _, region := range regions {
+			status := account.Status.RegionalServiceQuotas[region][quota.QuotaCode]
+			if status == nil || status.Status != awsv1alpha1.ServiceRequestCompleted || status.Value < quota.Value {
+				unmet = append(unmet, fmt.Sprintf("%s>=%d in %s", quota.QuotaCode, quota.Value, region))
+			}
+		}
+	}
+	sort.Strings(unmet)
+	return unmet
+}
+
+// selectAccountForRequiredQuotas returns the candidate account whose approved quotas best satisfy the required quotas
+// of the claim, i.e. with the fewest unmet ones, and the unmet quotas of that account. Reused accounts are preferred
+// among the accounts satisfying them as well, and the order of the candidates decides otherwise.
+func selectAccountForRequiredQuotas(accountClaim *awsv1alpha1.AccountClaim, candidates []*awsv1alpha1.Account) (*awsv1alpha1.Account, []string) {
+	var selected *awsv1alpha1.Account
+	var selectedUnmet []string
+	for _, candidate := range candidates {
+		unmet := unmetRequiredQuotas(accountClaim, candidate)
+		if selected == nil || len(unmet) < len(selectedUnmet) ||
+			(len(unmet) == len(selectedUnmet) && candidate.Status.Reused && !selected.Status.Reused) {
+			selected, selectedUnmet = candidate, unmet
+		}
+	}
+	return selected, selectedUnmet
+}
+
+// recordAccountSelection records the account selected for a claim with required quotas in an event of the claim
+func (r *AccountClaimReconciler) recordAccountSelection(accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account, unmet []string, candidates int) {
+	if r.recorder == nil {
+		return
+	}
+	message := fmt.Sprintf("Selected account %s out of %d candidates, its quotas satisfy the required quotas", account.Name, candidates)
+	if len(unmet) > 0 {
+		message = fmt.Sprintf("Selected account %s out of %d candidates, with the fewest unmet required quotas: %s", account.Name, candidates, strings.Join(unmet, ", "))
+	}
+	r.recorder.Event(accountClaim, corev1.EventTypeNormal, accountSelectedReason, message)
+}
+
+// waitForRequiredQuotas reports whether the claim has to wait for its required quotas to be approved in its account
+// before it's Ready. While it waits, the claim has a QuotasPending condition listing them and the returned result
+// requeues it.
+func (r *AccountClaimReconciler) waitForRequiredQuotas(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, account *awsv1alpha1.Account) (bool, reconcile.Result, error) {
+	condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.QuotasPending)
+	unmet := unmetRequiredQuotas(accountClaim, account)
+	if len(unmet) == 0 {
+		if condition == nil || condition.Status != corev1.ConditionTrue {
+			return false, reconcile.Result{}, nil
+		}
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.QuotasPending,
+			corev1.ConditionFalse,
+			requiredQuotasApprovedReason,
+			fmt.Sprintf("The required quotas are approved in account %s", account.Name),
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+			accountClaim.Spec.BYOCAWSAccountID != "",
+		)
+		return false, reconcile.Result{}, r.statusUpdate(reqLogger, accountClaim)
+	}
+
+	message := fmt.Sprintf("Waiting for quotas %s to be approved in account %s", strings.Join(unmet, ", "), account.Name)
+	reqLogger.Info(message)
+	result := reconcile.Result{RequeueAfter: requiredQuotasRequeue}
+	if condition != nil && condition.Status == corev1.ConditionTrue && condition.Message == message {
+		return true, result, nil
+	}
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.QuotasPending,
+		corev1.ConditionTrue,
+		requiredQuotasPendingReason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	return true, result, r.statusUpdate(reqLogger, accountClaim)
+}
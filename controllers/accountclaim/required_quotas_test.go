@@ -0,0 +1,165 @@
+package accountclaim
+
+import (
+	"context"
+
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Required quotas", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		account      *v1alpha1.Account
+		r            *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: v1alpha1.AccountClaimSpec{
+				Aws: v1alpha1.Aws{Regions: []v1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "us-west-2"}}},
+				RequiredQuotas: []v1alpha1.RequiredQuota{
+					{QuotaCode: v1alpha1.RunningStandardInstances, Value: 750},
+				},
+			},
+		}
+		account = &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: v1alpha1.AccountCrNamespace},
+			Status: v1alpha1.AccountStatus{
+				RegionalServiceQuotas: v1alpha1.RegionalServiceQuotas{
+					"us-east-1": {v1alpha1.RunningStandardInstances: {Value: 750, Status: v1alpha1.ServiceRequestCompleted}},
+					"us-west-2": {v1alpha1.RunningStandardInstances: {Value: 750, Status: v1alpha1.ServiceRequestInProgress}},
+				},
+			},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+	})
+
+	getCondition := func() *v1alpha1.AccountClaimCondition {
+		updated := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		accountClaim = updated
+		return controllerutils.FindAccountClaimCondition(updated.Status.Conditions, v1alpha1.QuotasPending)
+	}
+
+	Context("validateRequiredQuotas", func() {
+		It("admits quotas with a code and a value", func() {
+			Expect(validateRequiredQuotas(accountClaim)).To(Succeed())
+		})
+
+		It("denies incomplete quotas", func() {
+			accountClaim.Spec.RequiredQuotas = append(accountClaim.Spec.RequiredQuotas, v1alpha1.RequiredQuota{QuotaCode: v1alpha1.NLBPerRegion})
+			Expect(isAdmissionDenied(validateRequiredQuotas(accountClaim))).To(BeTrue())
+		})
+
+		It("denies quotas for CCS claims", func() {
+			accountClaim.Spec.BYOC = true
+			Expect(isAdmissionDenied(validateRequiredQuotas(accountClaim))).To(BeTrue())
+		})
+	})
+
+	Context("unmetRequiredQuotas", func() {
+		It("checks every region of the claim by default", func() {
+			Expect(unmetRequiredQuotas(accountClaim, account)).To(Equal([]string{"L-1216C47A>=750 in us-west-2"}))
+		})
+
+		It("checks the region of the quota", func() {
+			accountClaim.Spec.RequiredQuotas[0].Region = "us-east-1"
+			Expect(unmetRequiredQuotas(accountClaim, account)).To(BeEmpty())
+		})
+
+		It("requires the approved value to be high enough", func() {
+			accountClaim.Spec.RequiredQuotas[0].Region = "us-east-1"
+			accountClaim.Spec.RequiredQuotas[0].Value = 1000
+			Expect(unmetRequiredQuotas(accountClaim, account)).To(Equal([]string{"L-1216C47A>=1000 in us-east-1"}))
+		})
+
+		It("treats quotas the account didn't request as unmet", func() {
+			accountClaim.Spec.RequiredQuotas[0].Region = "eu-west-1"
+			Expect(unmetRequiredQuotas(accountClaim, account)).To(HaveLen(1))
+		})
+	})
+
+	Context("waitForRequiredQuotas", func() {
+		It("waits until the quotas are approved", func() {
+			waiting, result, err := r.waitForRequiredQuotas(nullLogger, accountClaim, account)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiting).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(requiredQuotasRequeue))
+			condition := getCondition()
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("L-1216C47A>=750 in us-west-2"))
+
+			By("clearing the condition once they are")
+			account.Status.RegionalServiceQuotas["us-west-2"][v1alpha1.RunningStandardInstances].Status = v1alpha1.ServiceRequestCompleted
+			waiting, _, err = r.waitForRequiredQuotas(nullLogger, accountClaim, account)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiting).To(BeFalse())
+			condition = getCondition()
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(requiredQuotasApprovedReason))
+		})
+
+		It("doesn't wait for claims without required quotas", func() {
+			accountClaim.Spec.RequiredQuotas = nil
+			waiting, _, err := r.waitForRequiredQuotas(nullLogger, accountClaim, account)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiting).To(BeFalse())
+			Expect(getCondition()).To(BeNil())
+		})
+	})
+
+	Context("selectAccountForRequiredQuotas", func() {
+		approved := func(name string, reused bool, regions ...string) *v1alpha1.Account {
+			quotas := v1alpha1.RegionalServiceQuotas{}
+			for _, region := range regions {
+				quotas[region] = v1alpha1.AccountServiceQuota{v1alpha1.RunningStandardInstances: {Value: 750, Status: v1alpha1.ServiceRequestCompleted}}
+			}
+			return &v1alpha1.Account{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: v1alpha1.AccountCrNamespace},
+				Status:     v1alpha1.AccountStatus{Reused: reused, RegionalServiceQuotas: quotas},
+			}
+		}
+
+		It("prefers the account with the fewest unmet quotas over a reused one", func() {
+			selected, unmet := selectAccountForRequiredQuotas(accountClaim, []*v1alpha1.Account{
+				approved("reused", true, "us-east-1"),
+				approved("satisfying", false, "us-east-1", "us-west-2"),
+				approved("empty", false),
+			})
+			Expect(selected.Name).To(Equal("satisfying"))
+			Expect(unmet).To(BeEmpty())
+		})
+
+		It("prefers reused accounts among the ones satisfying the quotas as well", func() {
+			selected, _ := selectAccountForRequiredQuotas(accountClaim, []*v1alpha1.Account{
+				approved("unused", false, "us-east-1"),
+				approved("reused", true, "us-east-1"),
+			})
+			Expect(selected.Name).To(Equal("reused"))
+		})
+
+		It("records the selection in an event", func() {
+			recorder := record.NewFakeRecorder(1)
+			r.recorder = recorder
+			selected, unmet := selectAccountForRequiredQuotas(accountClaim, []*v1alpha1.Account{approved("partial", false, "us-east-1")})
+			r.recordAccountSelection(accountClaim, selected, unmet, 1)
+			Expect(recorder.Events).To(Receive(Equal("Normal AccountSelected Selected account partial out of 1 candidates, with the fewest unmet required quotas: L-1216C47A>=750 in us-west-2")))
+		})
+	})
+})
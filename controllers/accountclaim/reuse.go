@@ -20,9 +20,11 @@ import (
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-logr/logr"
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/controllers/account"
 	"github.com/openshift/aws-account-operator/pkg/awsclient"
 	"github.com/openshift/aws-account-operator/pkg/localmetrics"
 	"github.com/openshift/aws-account-operator/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -32,7 +34,7 @@ const (
 	AccountFailed = "Failed"
 )
 
-func (r *AccountClaimReconciler) finalizeAccountClaim(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+func (r *AccountClaimReconciler) finalizeAccountClaim(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
 
 	// Get account claimed by deleted accountclaim
 	reusedAccount, err := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
@@ -56,7 +58,12 @@ func (r *AccountClaimReconciler) finalizeAccountClaim(reqLogger logr.Logger, acc
 
 	// If the reused account is STS, then we don't have to clean up
 	if reusedAccount.Spec.ManualSTSMode {
-		err := r.Delete(context.TODO(), reusedAccount)
+		err := r.deleteAccountDeliveredSecrets(ctx, reqLogger, reusedAccount)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete the secrets delivered for the STS account")
+			return err
+		}
+		err = r.Delete(context.TODO(), reusedAccount)
 		if err != nil {
 			reqLogger.Error(err, "Failed to delete STS account from accountclaim cleanup")
 			return err
@@ -99,7 +106,7 @@ func (r *AccountClaimReconciler) finalizeAccountClaim(reqLogger logr.Logger, acc
 
 		// This can not be the default region us-east-1 when cleaning up S3 buckets that live in other regions (if the cluster is not in us-east-1):
 		// e.g. https://github.com/parallelworks/interactive_session/pull/65
-		awsClient, _, err = stsclient.HandleRoleAssumption(reqLogger, r.awsClientBuilder, reusedAccount, r.Client, awsSetupClient, clusterAwsRegion, awsv1alpha1.AccountOperatorIAMRole, "")
+		awsClient, _, err = stsclient.HandleCachedRoleAssumption(reqLogger, r.awsClientBuilder, reusedAccount, r.Client, awsSetupClient, clusterAwsRegion, awsv1alpha1.AccountOperatorIAMRole)
 		if err != nil {
 			connErr := fmt.Sprintf("Unable to create aws client for region %s", clusterAwsRegion)
 			reqLogger.Error(err, connErr)
@@ -108,7 +115,13 @@ func (r *AccountClaimReconciler) finalizeAccountClaim(reqLogger logr.Logger, acc
 	}
 
 	if reusedAccount.IsBYOC() {
-		err := r.Delete(context.TODO(), reusedAccount)
+		// Deleting the Account cleans up the customer account, make sure it's the one of the claim
+		err := r.verifyBYOCOwnership(ctx, reqLogger, awsClient, accountClaim, reusedAccount)
+		if err != nil {
+			return err
+		}
+
+		err = r.Delete(context.TODO(), reusedAccount)
 		if err != nil {
 			reqLogger.Error(err, "Failed to delete BYOC account from accountclaim cleanup")
 			return err
@@ -142,18 +155,52 @@ func (r *AccountClaimReconciler) finalizeAccountClaim(reqLogger logr.Logger, acc
 		return fmt.Errorf("cannot clean up payer account %s - protected by blocklist", reusedAccount.Spec.AwsAccountID)
 	}
 
+	// Only list what the cleanup would delete while the claim asks for a dry run
+	if hasCleanupDryRunAnnotation(accountClaim) {
+		return r.cleanUpDryRun(reqLogger, awsClient, accountClaim)
+	}
+
+	// Don't fail the cleanup during an outage of the region, retry it once the region recovered instead
 	before := time.Now()
-	err = r.cleanUpAwsAccount(reqLogger, awsClient)
+	if allowed, retryAfter := utils.RegionCircuits.Allow(clusterAwsRegion, before); !allowed {
+		reqLogger.Info("Deferring the cleanup during an outage of the region", "region", clusterAwsRegion, "retryAfter", retryAfter)
+		return &regionOutageError{region: clusterAwsRegion, retryAfter: retryAfter}
+	}
+	report, err := r.cleanUpAwsAccount(ctx, reqLogger, awsClient)
+	r.recordCleanupReport(reqLogger, reusedAccount, report)
+	// A cleanup stopped by a write skipped in dry-run mode neither failed nor says anything about the region
+	if awsclient.IsDryRunError(err) {
+		return err
+	}
+	utils.RegionCircuits.Record(clusterAwsRegion, err != nil, time.Now())
 	if err != nil {
 		localmetrics.Collector.AddAccountReuseCleanupFailure()
 		reqLogger.Error(err, "Failed to clean up AWS account")
+		r.notifyCleanupFailed(reqLogger, reusedAccount, accountClaim, err)
 		return err
 	}
 	localmetrics.Collector.SetAccountReusedCleanupDuration(time.Since(before).Seconds())
 
-	err = r.resetAccountSpecStatus(reqLogger, reusedAccount, accountClaim, awsv1alpha1.AccountReused, "Ready")
+	err = r.releaseClaimLabels(ctx, reqLogger, reusedAccount)
+	if err != nil {
+		reqLogger.Error(err, "Failed to remove the claim labels from the account")
+		return err
+	}
+
+	err = r.releaseClaimTags(ctx, reqLogger, reusedAccount)
+	if err != nil {
+		reqLogger.Error(err, "Failed to remove the claim tags from the account")
+		return err
+	}
+
+	err = r.deleteAccountDeliveredSecrets(ctx, reqLogger, reusedAccount)
+	if err != nil {
+		reqLogger.Error(err, "Failed to delete the secrets delivered for the account")
+		return err
+	}
+
+	err = r.releaseAccount(ctx, reqLogger, reusedAccount, accountClaim)
 	if err != nil {
-		reqLogger.Error(err, "Failed to reset account entity")
 		return err
 	}
 
@@ -161,6 +208,47 @@ func (r *AccountClaimReconciler) finalizeAccountClaim(reqLogger logr.Logger, acc
 	return nil
 }
 
+// releaseAccount records the temporary quotas of the claim for rollback, then retires the cleaned up account or makes
+// it available again. The quotas are recorded for retired accounts too, as their AWS accounts stay in the
+// organization with the raised quotas.
+func (r *AccountClaimReconciler) releaseAccount(ctx context.Context, reqLogger logr.Logger, reusedAccount *awsv1alpha1.Account, accountClaim *awsv1alpha1.AccountClaim) error {
+	err := r.releaseTemporaryQuotas(ctx, reqLogger, reusedAccount, accountClaim)
+	if err != nil {
+		reqLogger.Error(err, "Failed to record the temporary quotas of the claim for rollback")
+		return err
+	}
+
+	retired, err := r.retireReleasedAccount(ctx, reqLogger, reusedAccount)
+	if err != nil {
+		reqLogger.Error(err, "Failed to retire the account")
+		return err
+	}
+	if retired {
+		return nil
+	}
+
+	err = r.resetAccountSpecStatus(reqLogger, reusedAccount, accountClaim, awsv1alpha1.AccountReused, "Ready")
+	if err != nil {
+		reqLogger.Error(err, "Failed to reset account entity")
+		return err
+	}
+	return nil
+}
+
+// retireReleasedAccount retires the account released by the claim instead of making it available again when it
+// reached the MaxReuseCount or MaxAccountAge of its pool, and returns whether it did
+func (r *AccountClaimReconciler) retireReleasedAccount(ctx context.Context, reqLogger logr.Logger, reusedAccount *awsv1alpha1.Account) (bool, error) {
+	pool, err := account.GetAccountPool(ctx, reqLogger, r.Client, reusedAccount)
+	if err != nil {
+		return false, err
+	}
+	reason := account.RetirementReason(pool, reusedAccount.Status.ReuseCount+1, reusedAccount.CreationTimestamp.Time, time.Now())
+	if reason == "" {
+		return false, nil
+	}
+	return true, account.RetireAccount(ctx, reqLogger, r.Client, reusedAccount, reason)
+}
+
 func (r *AccountClaimReconciler) resetAccountSpecStatus(reqLogger logr.Logger, reusedAccount *awsv1alpha1.Account, deletedAccountClaim *awsv1alpha1.AccountClaim, accountState awsv1alpha1.AccountConditionType, conditionStatus string) error {
 
 	// Reset claimlink and carry over legal entity from deleted claim
@@ -189,6 +277,15 @@ func (r *AccountClaimReconciler) resetAccountSpecStatus(reqLogger logr.Logger, r
 	reusedAccount.Status.State = conditionStatus
 	reusedAccount.Status.Claimed = false
 	reusedAccount.Status.Reused = true
+	if accountState == awsv1alpha1.AccountReused {
+		reusedAccount.Status.ReuseCount++
+	}
+	// A failed cleanup leaves the account unusable, anything else clears a previous failure
+	if accountState == awsv1alpha1.AccountFailed {
+		reusedAccount.Status.FailureReason = awsv1alpha1.FailureReasonClientError
+	} else {
+		reusedAccount.Status.FailureReason = ""
+	}
 	conditionMsg := fmt.Sprintf("Account Reuse - %s", conditionStatus)
 	utils.SetAccountStatus(reusedAccount, conditionMsg, accountState, conditionStatus)
 	err = r.accountStatusUpdate(reqLogger, reusedAccount)
@@ -200,57 +297,58 @@ func (r *AccountClaimReconciler) resetAccountSpecStatus(reqLogger logr.Logger, r
 	return nil
 }
 
-func (r *AccountClaimReconciler) cleanUpAwsAccount(reqLogger logr.Logger, awsClient awsclient.Client) error {
-	// Clean up status, used to store an error if any of the cleanup functions received one
-	cleanUpStatusFailed := false
-
-	// Channels to track clean up functions
-	awsNotifications, awsErrors := make(chan string), make(chan string)
-
-	defer close(awsNotifications)
-	defer close(awsErrors)
-
-	// Declare un array of cleanup functions
-	cleanUpFunctions := []func(logr.Logger, awsclient.Client, chan string, chan string) error{
-		r.cleanUpAwsAccountSnapshots,
-		r.cleanUpAwsAccountEbsVolumes,
-		r.cleanUpAwsAccountS3,
-		r.CleanUpAwsAccountVpcEndpointServiceConfigurations,
-		r.cleanUpAwsRoute53,
-	}
-
-	// Call the clean up functions in parallel
-	for _, cleanUpFunc := range cleanUpFunctions {
-		//nolint:errcheck // Not checking return value of goroutine
-		go cleanUpFunc(reqLogger, awsClient, awsNotifications, awsErrors)
-	}
-
-	var err error
-	// Wait for clean up functions to end
-	for i := 0; i < len(cleanUpFunctions); i++ {
-		select {
-		case msg := <-awsNotifications:
-			reqLogger.Info(msg)
-		case errMsg := <-awsErrors:
-			err = errors.New(errMsg)
-			reqLogger.Error(err, errMsg)
-			cleanUpStatusFailed = true
+// cleanUpAwsAccount runs the cleanup steps and reports what they deleted
+func (r *AccountClaimReconciler) cleanUpAwsAccount(ctx context.Context, reqLogger logr.Logger, awsClient awsclient.Client) (*awsv1alpha1.AccountCleanupReport, error) {
+	report := &awsv1alpha1.AccountCleanupReport{StartTime: metav1.Now()}
+	policy := r.getCleanupPolicy(ctx, reqLogger)
+
+	// Stop the spending first, the remaining steps can take a long time to enumerate everything
+	if spendFreezeSteps := r.spendFreezeCleanUpSteps(policy); len(spendFreezeSteps) > 0 {
+		before := time.Now()
+		results, err := runCleanUpSteps(reqLogger, awsClient, spendFreezeSteps)
+		report.Steps = append(report.Steps, results...)
+		if err != nil {
+			reqLogger.Error(err, "failed to freeze the spending of the AWS account")
+			report.CompletionTime = metav1.Now()
+			return report, err
 		}
+		localmetrics.Collector.SetAccountReuseSpendFreezeDuration(time.Since(before).Seconds())
+		reqLogger.Info("AWS account spend freeze completed")
+	}
+
+	// Declare un array of cleanup steps
+	cleanUpSteps := []cleanUpStep{
+		{"Snapshots", r.cleanUpAwsAccountSnapshots},
+		{"EBSVolumes", r.cleanUpAwsAccountEbsVolumes},
+		{"S3Buckets", r.cleanUpAwsAccountS3},
+		{"VPCEndpointServices", r.cleanUpAwsAccountVpcEndpointServiceConfigurations},
+		{"Route53HostedZones", r.cleanUpAwsRoute53},
 	}
+	cleanUpSteps = append(cleanUpSteps, r.optionalCleanUpSteps(policy)...)
 
-	// Return an error if we saw any errors on the awsErrors channel so we can make the reused account as failed
-	if cleanUpStatusFailed {
+	results, err := runCleanUpSteps(reqLogger, awsClient, cleanUpSteps)
+	report.Steps = append(report.Steps, results...)
+	report.CompletionTime = metav1.Now()
+	if err != nil {
 		cleanUpStatusFailedMsg := "failed to clean up AWS account"
 		reqLogger.Error(err, cleanUpStatusFailedMsg)
-		return err
+		return report, err
 	}
 
+	report.Succeeded = true
 	reqLogger.Info("AWS account cleanup completed")
 
-	return nil
+	return report, nil
 }
 
-func (r *AccountClaimReconciler) cleanUpAwsAccountSnapshots(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string) error {
+func (r *AccountClaimReconciler) cleanUpAwsAccountSnapshots(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+
+	// AMIs have to be deregistered before the snapshots backing them can be deleted
+	err := r.cleanUpAwsAccountImages(awsClient, deleted)
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
 
 	// Filter only for snapshots owned by the account
 	selfOwnerFilter := ec2types.Filter{
@@ -273,6 +371,13 @@ func (r *AccountClaimReconciler) cleanUpAwsAccountSnapshots(reqLogger logr.Logge
 
 	for _, snapshot := range ebsSnapshots.Snapshots {
 
+		err = revokeSnapshotSharing(awsClient, *snapshot.SnapshotId)
+		if err != nil {
+			revokeError := fmt.Errorf("failed revoking EBS snapshot sharing: %s: %w", *snapshot.SnapshotId, err).Error()
+			awsErrors <- revokeError
+			return err
+		}
+
 		deleteSnapshotInput := ec2.DeleteSnapshotInput{
 			SnapshotId: snapshot.SnapshotId,
 		}
@@ -283,6 +388,7 @@ func (r *AccountClaimReconciler) cleanUpAwsAccountSnapshots(reqLogger logr.Logge
 			awsErrors <- delError
 			return err
 		}
+		deleted.add(1)
 	}
 
 	successMsg := "Snapshot cleanup finished successfully"
@@ -290,7 +396,84 @@ func (r *AccountClaimReconciler) cleanUpAwsAccountSnapshots(reqLogger logr.Logge
 	return nil
 }
 
+// cleanUpAwsAccountImages revokes the launch permissions of all AMIs owned by the account and deregisters them
+func (r *AccountClaimReconciler) cleanUpAwsAccountImages(awsClient awsclient.Client, deleted *cleanupCounter) error {
+	describeImagesInput := ec2.DescribeImagesInput{
+		Owners: []string{"self"},
+	}
+	images, err := awsClient.DescribeImages(context.TODO(), &describeImagesInput)
+	if err != nil {
+		return fmt.Errorf("failed describing AMIs: %w", err)
+	}
+
+	for _, image := range images.Images {
+		err = revokeImageSharing(awsClient, *image.ImageId)
+		if err != nil {
+			return fmt.Errorf("failed revoking AMI sharing: %s: %w", *image.ImageId, err)
+		}
+
+		_, err = awsClient.DeregisterImage(context.TODO(), &ec2.DeregisterImageInput{
+			ImageId: image.ImageId,
+		})
+		if err != nil {
+			return fmt.Errorf("failed deregistering AMI: %s: %w", *image.ImageId, err)
+		}
+		deleted.add(1)
+	}
+
+	return nil
+}
+
+// revokeImageSharing removes every launch permission granted on the AMI to other accounts, groups or organizations
+func revokeImageSharing(awsClient awsclient.Client, imageID string) error {
+	attribute, err := awsClient.DescribeImageAttribute(context.TODO(), &ec2.DescribeImageAttributeInput{
+		ImageId:   aws.String(imageID),
+		Attribute: ec2types.ImageAttributeNameLaunchPermission,
+	})
+	if err != nil {
+		return err
+	}
+	if len(attribute.LaunchPermissions) == 0 {
+		return nil
+	}
+
+	_, err = awsClient.ModifyImageAttribute(context.TODO(), &ec2.ModifyImageAttributeInput{
+		ImageId: aws.String(imageID),
+		LaunchPermission: &ec2types.LaunchPermissionModifications{
+			Remove: attribute.LaunchPermissions,
+		},
+	})
+	return err
+}
+
+// revokeSnapshotSharing removes every create volume permission granted on the snapshot to other accounts or groups
+func revokeSnapshotSharing(awsClient awsclient.Client, snapshotID string) error {
+	attribute, err := awsClient.DescribeSnapshotAttribute(context.TODO(), &ec2.DescribeSnapshotAttributeInput{
+		SnapshotId: aws.String(snapshotID),
+		Attribute:  ec2types.SnapshotAttributeNameCreateVolumePermission,
+	})
+	if err != nil {
+		return err
+	}
+	if len(attribute.CreateVolumePermissions) == 0 {
+		return nil
+	}
+
+	_, err = awsClient.ModifySnapshotAttribute(context.TODO(), &ec2.ModifySnapshotAttributeInput{
+		SnapshotId: aws.String(snapshotID),
+		Attribute:  ec2types.SnapshotAttributeNameCreateVolumePermission,
+		CreateVolumePermission: &ec2types.CreateVolumePermissionModifications{
+			Remove: attribute.CreateVolumePermissions,
+		},
+	})
+	return err
+}
+
 func (r *AccountClaimReconciler) CleanUpAwsAccountVpcEndpointServiceConfigurations(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string) error {
+	return r.cleanUpAwsAccountVpcEndpointServiceConfigurations(reqLogger, awsClient, awsNotifications, awsErrors, nil)
+}
+
+func (r *AccountClaimReconciler) cleanUpAwsAccountVpcEndpointServiceConfigurations(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
 	describeVpcEndpointServiceConfigurationsInput := ec2.DescribeVpcEndpointServiceConfigurationsInput{}
 	vpcEndpointServiceConfigurations, err := awsClient.DescribeVpcEndpointServiceConfigurations(context.TODO(), &describeVpcEndpointServiceConfigurationsInput)
 	if vpcEndpointServiceConfigurations == nil || err != nil {
@@ -328,12 +511,13 @@ func (r *AccountClaimReconciler) CleanUpAwsAccountVpcEndpointServiceConfiguratio
 		awsErrors <- delError
 		return err
 	}
+	deleted.add(len(serviceIds) - len(output.Unsuccessful))
 
 	awsNotifications <- successMsg
 	return nil
 }
 
-func (r *AccountClaimReconciler) cleanUpAwsAccountEbsVolumes(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string) error {
+func (r *AccountClaimReconciler) cleanUpAwsAccountEbsVolumes(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
 
 	describeVolumesInput := ec2.DescribeVolumesInput{}
 	ebsVolumes, err := awsClient.DescribeVolumes(context.TODO(), &describeVolumesInput)
@@ -356,6 +540,7 @@ func (r *AccountClaimReconciler) cleanUpAwsAccountEbsVolumes(reqLogger logr.Logg
 			awsErrors <- delError
 			return err
 		}
+		deleted.add(1)
 
 	}
 
@@ -364,7 +549,7 @@ func (r *AccountClaimReconciler) cleanUpAwsAccountEbsVolumes(reqLogger logr.Logg
 	return nil
 }
 
-func (r *AccountClaimReconciler) cleanUpAwsAccountS3(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string) error {
+func (r *AccountClaimReconciler) cleanUpAwsAccountS3(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
 	listBucketsInput := s3.ListBucketsInput{}
 	s3Buckets, err := awsClient.ListBuckets(context.TODO(), &listBucketsInput)
 	if err != nil {
@@ -403,7 +588,9 @@ func (r *AccountClaimReconciler) cleanUpAwsAccountS3(reqLogger logr.Logger, awsC
 				return err
 			}
 			// NoSuchBucket - ignore this error
+			continue
 		}
+		deleted.add(1)
 
 	}
 
@@ -412,7 +599,7 @@ func (r *AccountClaimReconciler) cleanUpAwsAccountS3(reqLogger logr.Logger, awsC
 	return nil
 }
 
-func (r *AccountClaimReconciler) cleanUpAwsRoute53(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string) error {
+func (r *AccountClaimReconciler) cleanUpAwsRoute53(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
 
 	var nextZoneMarker *string
 
@@ -475,6 +662,7 @@ func (r *AccountClaimReconciler) cleanUpAwsRoute53(reqLogger logr.Logger, awsCli
 				awsErrors <- zoneDelErr
 				return deleteError
 			}
+			deleted.add(1)
 		}
 
 		if hostedZonesOutput.IsTruncated {
@@ -489,24 +677,10 @@ func (r *AccountClaimReconciler) cleanUpAwsRoute53(reqLogger logr.Logger, awsCli
 	return nil
 }
 
-// DeleteBucketContent deletes any content in a bucket if it is not empty
-func DeleteBucketContent(awsClient awsclient.Client, bucketName string) error {
-	// check if objects exits
-	objects, err := awsClient.ListObjectsV2(context.TODO(), &s3.ListObjectsV2Input{
-		Bucket: aws.String(bucketName),
-	})
-	if err != nil {
-		return err
-	}
-	if len((*objects).Contents) == 0 {
-		return nil
-	}
-
-	err = awsClient.BatchDeleteBucketObjects(context.TODO(), aws.String(bucketName))
-	if err != nil {
-		return err
-	}
-	return nil
+// DeleteBucketContent deletes any content in a bucket, including the noncurrent versions and delete markers of
+// versioned buckets, which a listing of its current objects doesn't show
+func DeleteBucketContent(awsClient awsclient.S3Client, bucketName string) error {
+	return awsClient.BatchDeleteBucketObjects(context.TODO(), aws.String(bucketName))
 }
 
 func (r *AccountClaimReconciler) accountStatusUpdate(reqLogger logr.Logger, account *awsv1alpha1.Account) error {
@@ -516,3 +690,18 @@ func (r *AccountClaimReconciler) accountStatusUpdate(reqLogger logr.Logger, acco
 	}
 	return err
 }
+
+// regionOutageError defers the cleanup of an account while the region of its claim is in an outage
+type regionOutageError struct {
+	region     string
+	retryAfter time.Time
+}
+
+func (e *regionOutageError) Error() string {
+	return fmt.Sprintf("cleanup deferred during an outage of region %s until %s", e.region, e.retryAfter.Format(time.RFC3339))
+}
+
+func isRegionOutage(err error) bool {
+	_, ok := err.(*regionOutageError)
+	return ok
+}
@@ -0,0 +1,69 @@
+package accountclaim
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AMI and snapshot sharing cleanup", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		r             *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWSClient = mock.NewMockClient(ctrl)
+		r = &AccountClaimReconciler{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("revokes launch permissions before deregistering shared AMIs", func() {
+		sharedWith := []ec2types.LaunchPermission{{UserId: aws.String("111122223333")}}
+		mockAWSClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{ImageId: aws.String("ami-123")}},
+		}, nil)
+		mockAWSClient.EXPECT().DescribeImageAttribute(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImageAttributeOutput{
+			LaunchPermissions: sharedWith,
+		}, nil)
+		modify := mockAWSClient.EXPECT().ModifyImageAttribute(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.ModifyImageAttributeInput) {
+			Expect(input.LaunchPermission.Remove).To(Equal(sharedWith))
+		}).Return(&ec2.ModifyImageAttributeOutput{}, nil)
+		mockAWSClient.EXPECT().DeregisterImage(gomock.Any(), gomock.Any()).Return(&ec2.DeregisterImageOutput{}, nil).After(modify)
+
+		Expect(r.cleanUpAwsAccountImages(mockAWSClient, &cleanupCounter{})).To(Succeed())
+	})
+
+	It("skips ModifyImageAttribute for AMIs that are not shared", func() {
+		mockAWSClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{ImageId: aws.String("ami-123")}},
+		}, nil)
+		mockAWSClient.EXPECT().DescribeImageAttribute(gomock.Any(), gomock.Any()).Return(&ec2.DescribeImageAttributeOutput{}, nil)
+		mockAWSClient.EXPECT().DeregisterImage(gomock.Any(), gomock.Any()).Return(&ec2.DeregisterImageOutput{}, nil)
+
+		Expect(r.cleanUpAwsAccountImages(mockAWSClient, &cleanupCounter{})).To(Succeed())
+	})
+
+	It("removes create volume permissions from shared snapshots", func() {
+		sharedWith := []ec2types.CreateVolumePermission{{Group: ec2types.PermissionGroupAll}}
+		mockAWSClient.EXPECT().DescribeSnapshotAttribute(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSnapshotAttributeOutput{
+			CreateVolumePermissions: sharedWith,
+		}, nil)
+		mockAWSClient.EXPECT().ModifySnapshotAttribute(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.ModifySnapshotAttributeInput) {
+			Expect(input.Attribute).To(Equal(ec2types.SnapshotAttributeNameCreateVolumePermission))
+			Expect(input.CreateVolumePermission.Remove).To(Equal(sharedWith))
+		}).Return(&ec2.ModifySnapshotAttributeOutput{}, nil)
+
+		Expect(revokeSnapshotSharing(mockAWSClient, "snap-123")).To(Succeed())
+	})
+})
@@ -0,0 +1,168 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;create;update;delete
+
+// secretReaderName is the name of the Role and RoleBinding granting read access to the secrets delivered for a claim
+func secretReaderName(accountClaim *awsv1alpha1.AccountClaim) string {
+	return fmt.Sprintf("%s-reader", accountClaim.Spec.AwsCredentialSecret.Name)
+}
+
+// newSecretReaderRole returns the Role allowing to read the named secrets, and nothing else
+func newSecretReaderRole(accountClaim *awsv1alpha1.AccountClaim, secretNames []string) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretReaderName(accountClaim),
+			Namespace: accountClaim.Spec.AwsCredentialSecret.Namespace,
+			Labels:    controllerutils.ClaimSecretLabels(accountClaim),
+		},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups:     []string{""},
+			Resources:     []string{"secrets"},
+			ResourceNames: secretNames,
+			Verbs:         []string{"get", "watch"},
+		}},
+	}
+}
+
+// newSecretReaderRoleBinding returns the RoleBinding granting the Role to the ServiceAccount of the claim
+func newSecretReaderRoleBinding(accountClaim *awsv1alpha1.AccountClaim) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretReaderName(accountClaim),
+			Namespace: accountClaim.Spec.AwsCredentialSecret.Namespace,
+			Labels:    controllerutils.ClaimSecretLabels(accountClaim),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     secretReaderName(accountClaim),
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      accountClaim.Spec.CredentialSecretReader,
+			Namespace: accountClaim.Spec.AwsCredentialSecret.Namespace,
+		}},
+	}
+}
+
+// reconcileSecretReaderRBAC grants the ServiceAccount named by spec.credentialSecretReader read access to the secrets
+// delivered for the claim, by name, so consumers don't need to read every secret of the namespace. The Role and
+// RoleBinding follow the delivered secrets, and are deleted along with the claim or once the field is cleared.
+func (r *AccountClaimReconciler) reconcileSecretReaderRBAC(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	var role *rbacv1.Role
+	var roleBinding *rbacv1.RoleBinding
+	if accountClaim.Spec.CredentialSecretReader != "" && accountClaim.DeletionTimestamp == nil {
+		delivered, err := controllerutils.SecretsForClaim(ctx, r.Client, accountClaim)
+		if err != nil {
+			return err
+		}
+		secretNames := []string{accountClaim.Spec.AwsCredentialSecret.Name}
+		for _, secret := range delivered {
+			if !controllerutils.Contains(secretNames, secret.Name) {
+				secretNames = append(secretNames, secret.Name)
+			}
+		}
+		sort.Strings(secretNames)
+		role = newSecretReaderRole(accountClaim, secretNames)
+		roleBinding = newSecretReaderRoleBinding(accountClaim)
+	}
+
+	if err := r.deleteStaleSecretReaderRBAC(ctx, reqLogger, accountClaim, role); err != nil {
+		return err
+	}
+	if role == nil {
+		return nil
+	}
+
+	currentRole := &rbacv1.Role{}
+	err := r.Get(ctx, client.ObjectKeyFromObject(role), currentRole)
+	switch {
+	case k8serr.IsNotFound(err):
+		reqLogger.Info(fmt.Sprintf("Creating Role %s/%s reading the secrets of claim %s", role.Namespace, role.Name, accountClaim.Name))
+		if err := r.Create(ctx, role); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case !equality.Semantic.DeepEqual(currentRole.Rules, role.Rules):
+		currentRole.Rules = role.Rules
+		reqLogger.Info(fmt.Sprintf("Updating Role %s/%s reading the secrets of claim %s", role.Namespace, role.Name, accountClaim.Name))
+		if err := r.Update(ctx, currentRole); err != nil {
+			return err
+		}
+	}
+
+	currentRoleBinding := &rbacv1.RoleBinding{}
+	err = r.Get(ctx, client.ObjectKeyFromObject(roleBinding), currentRoleBinding)
+	switch {
+	case k8serr.IsNotFound(err):
+		reqLogger.Info(fmt.Sprintf("Creating RoleBinding %s/%s for ServiceAccount %s of claim %s", roleBinding.Namespace, roleBinding.Name, accountClaim.Spec.CredentialSecretReader, accountClaim.Name))
+		return r.Create(ctx, roleBinding)
+	case err != nil:
+		return err
+	case !equality.Semantic.DeepEqual(currentRoleBinding.Subjects, roleBinding.Subjects):
+		currentRoleBinding.Subjects = roleBinding.Subjects
+		reqLogger.Info(fmt.Sprintf("Updating RoleBinding %s/%s for ServiceAccount %s of claim %s", roleBinding.Namespace, roleBinding.Name, accountClaim.Spec.CredentialSecretReader, accountClaim.Name))
+		return r.Update(ctx, currentRoleBinding)
+	}
+	return nil
+}
+
+// deleteStaleSecretReaderRBAC deletes the Roles and RoleBindings created for the claim other than the desired one,
+// e.g. the ones of a previous credentials secret, or all of them when desired is nil
+func (r *AccountClaimReconciler) deleteStaleSecretReaderRBAC(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, desired *rbacv1.Role) error {
+	isDesired := func(obj client.Object) bool {
+		return desired != nil && obj.GetNamespace() == desired.Namespace && obj.GetName() == desired.Name
+	}
+	claimLabels := client.MatchingLabels{
+		controllerutils.ClaimSecretLabel:          accountClaim.Name,
+		controllerutils.ClaimSecretNamespaceLabel: accountClaim.Namespace,
+	}
+
+	roleBindings := &rbacv1.RoleBindingList{}
+	if err := r.List(ctx, roleBindings, claimLabels); err != nil {
+		return err
+	}
+	for i := range roleBindings.Items {
+		roleBinding := &roleBindings.Items[i]
+		if isDesired(roleBinding) {
+			continue
+		}
+		reqLogger.Info(fmt.Sprintf("Deleting RoleBinding %s/%s of claim %s", roleBinding.Namespace, roleBinding.Name, accountClaim.Name))
+		if err := r.Delete(ctx, roleBinding); err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+
+	roles := &rbacv1.RoleList{}
+	if err := r.List(ctx, roles, claimLabels); err != nil {
+		return err
+	}
+	for i := range roles.Items {
+		role := &roles.Items[i]
+		if isDesired(role) {
+			continue
+		}
+		reqLogger.Info(fmt.Sprintf("Deleting Role %s/%s of claim %s", role.Namespace, role.Name, accountClaim.Name))
+		if err := r.Delete(ctx, role); err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
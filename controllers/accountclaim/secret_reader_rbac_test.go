@@ -0,0 +1,130 @@
+package accountclaim
+
+import (
+	"context"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret reader RBAC", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		r            *AccountClaimReconciler
+		accountClaim *awsv1alpha1.AccountClaim
+		credentials  *corev1.Secret
+		regional     *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				AwsCredentialSecret:    awsv1alpha1.SecretRef{Name: "aws", Namespace: "secret-namespace"},
+				CredentialSecretReader: "installer",
+			},
+		}
+		credentials = newSecretforCR("aws", "secret-namespace", []byte("access-key"), []byte("secret-key"))
+		credentials.Labels = controllerutils.ClaimSecretLabels(accountClaim)
+		regional = newSecretforCR("aws-us-east-1", "secret-namespace", []byte("access-key"), []byte("secret-key"))
+		regional.Labels = controllerutils.ClaimSecretLabels(accountClaim)
+	})
+
+	newReconciler := func(objs ...client.Object) {
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...).Build(),
+			Scheme: scheme.Scheme,
+		}
+	}
+
+	getRBAC := func(namespace string) (*rbacv1.Role, *rbacv1.RoleBinding, error) {
+		role := &rbacv1.Role{}
+		if err := r.Get(context.TODO(), client.ObjectKey{Name: "aws-reader", Namespace: namespace}, role); err != nil {
+			return nil, nil, err
+		}
+		roleBinding := &rbacv1.RoleBinding{}
+		err := r.Get(context.TODO(), client.ObjectKey{Name: "aws-reader", Namespace: namespace}, roleBinding)
+		return role, roleBinding, err
+	}
+
+	It("grants the ServiceAccount read access to the delivered secrets only", func() {
+		other := newSecretforCR("other", "secret-namespace", []byte("access-key"), []byte("secret-key"))
+		newReconciler(credentials, regional, other)
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		role, roleBinding, err := getRBAC("secret-namespace")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role.Labels).To(HaveKeyWithValue(controllerutils.ClaimSecretLabel, "claim"))
+		Expect(role.Rules).To(HaveLen(1))
+		Expect(role.Rules[0].Resources).To(Equal([]string{"secrets"}))
+		Expect(role.Rules[0].ResourceNames).To(Equal([]string{"aws", "aws-us-east-1"}))
+		Expect(role.Rules[0].Verbs).To(Equal([]string{"get", "watch"}))
+		Expect(roleBinding.RoleRef.Name).To(Equal("aws-reader"))
+		Expect(roleBinding.Subjects).To(Equal([]rbacv1.Subject{{Kind: "ServiceAccount", Name: "installer", Namespace: "secret-namespace"}}))
+	})
+
+	It("follows the delivered secrets and the ServiceAccount", func() {
+		newReconciler(credentials)
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		Expect(r.Create(context.TODO(), regional)).To(Succeed())
+		accountClaim.Spec.CredentialSecretReader = "deployer"
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		role, roleBinding, err := getRBAC("secret-namespace")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role.Rules[0].ResourceNames).To(Equal([]string{"aws", "aws-us-east-1"}))
+		Expect(roleBinding.Subjects[0].Name).To(Equal("deployer"))
+	})
+
+	It("moves with the credentials secret", func() {
+		newReconciler(credentials)
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		accountClaim.Spec.AwsCredentialSecret.Namespace = "new-namespace"
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		_, _, err := getRBAC("secret-namespace")
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+		_, _, err = getRBAC("new-namespace")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("deletes the Role and RoleBinding once the field is cleared or the claim deleted", func() {
+		newReconciler(credentials)
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+
+		accountClaim.Spec.CredentialSecretReader = ""
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		_, _, err := getRBAC("secret-namespace")
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+
+		accountClaim.Spec.CredentialSecretReader = "installer"
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		now := metav1.Now()
+		accountClaim.DeletionTimestamp = &now
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		_, _, err = getRBAC("secret-namespace")
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("does nothing without a ServiceAccount", func() {
+		accountClaim.Spec.CredentialSecretReader = ""
+		newReconciler(credentials)
+		Expect(r.reconcileSecretReaderRBAC(context.TODO(), nullLogger, accountClaim)).To(Succeed())
+		roles := &rbacv1.RoleList{}
+		Expect(r.List(context.TODO(), roles)).To(Succeed())
+		Expect(roles.Items).To(BeEmpty())
+	})
+})
@@ -0,0 +1,117 @@
+package accountclaim
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const specDriftReason = "ImmutableFieldsChanged"
+
+// appliedClaimSpec returns the fields of the spec of the claim that can't be reconciled once the claim is Ready
+func appliedClaimSpec(accountClaim *awsv1alpha1.AccountClaim) *awsv1alpha1.AppliedClaimSpec {
+	applied := &awsv1alpha1.AppliedClaimSpec{
+		BYOC:             accountClaim.Spec.BYOC,
+		BYOCAWSAccountID: accountClaim.Spec.BYOCAWSAccountID,
+		ManualSTSMode:    accountClaim.Spec.ManualSTSMode,
+		LegalEntityID:    accountClaim.Spec.LegalEntity.ID,
+		AccountPool:      accountClaim.Spec.AccountPool,
+	}
+	for _, region := range accountClaim.Spec.Aws.Regions {
+		applied.Regions = append(applied.Regions, region.Name)
+	}
+	return applied
+}
+
+// specDrift lists the fields of the current spec that differ from the applied one, with the value they were applied
+// with
+func specDrift(applied *awsv1alpha1.AppliedClaimSpec, current *awsv1alpha1.AppliedClaimSpec) []string {
+	drift := []string{}
+	if !reflect.DeepEqual(applied.Regions, current.Regions) {
+		drift = append(drift, fmt.Sprintf("aws.regions (applied: %v)", applied.Regions))
+	}
+	if applied.BYOC != current.BYOC {
+		drift = append(drift, fmt.Sprintf("byoc (applied: %t)", applied.BYOC))
+	}
+	if applied.BYOCAWSAccountID != current.BYOCAWSAccountID {
+		drift = append(drift, fmt.Sprintf("byocAWSAccountID (applied: %q)", applied.BYOCAWSAccountID))
+	}
+	if applied.ManualSTSMode != current.ManualSTSMode {
+		drift = append(drift, fmt.Sprintf("manualSTSMode (applied: %t)", applied.ManualSTSMode))
+	}
+	if applied.LegalEntityID != current.LegalEntityID {
+		drift = append(drift, fmt.Sprintf("legalEntity.id (applied: %q)", applied.LegalEntityID))
+	}
+	if applied.AccountPool != current.AccountPool {
+		drift = append(drift, fmt.Sprintf("accountPool (applied: %q)", applied.AccountPool))
+	}
+	return drift
+}
+
+// reconcileSpecDrift records the spec a claim turned Ready with, and detects in-place edits of the fields that can't be
+// reconciled afterwards, like its regions or CCS account. Rather than applying part of such an edit, the claim is left
+// as it was provisioned and marked with the SpecDriftUnsupported condition telling how to remediate, it returns true in
+// that case. The condition is resolved once the fields are reverted.
+func (r *AccountClaimReconciler) reconcileSpecDrift(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	if accountClaim.Status.State != awsv1alpha1.ClaimStatusReady {
+		return false, nil
+	}
+
+	current := appliedClaimSpec(accountClaim)
+	if accountClaim.Status.AppliedSpec == nil {
+		accountClaim.Status.AppliedSpec = current
+		return false, r.statusUpdate(reqLogger, accountClaim)
+	}
+
+	drift := specDrift(accountClaim.Status.AppliedSpec, current)
+	if len(drift) == 0 {
+		return false, r.clearSpecDrift(reqLogger, accountClaim)
+	}
+
+	message := fmt.Sprintf("Changing %s of a Ready claim isn't supported, the claim keeps the account it was provisioned with. "+
+		"Revert the changes, or delete the claim and create a new one with the new values", strings.Join(drift, ", "))
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.SpecDriftUnsupported)
+	if existing != nil && existing.Status == corev1.ConditionTrue && existing.Message == message {
+		return true, nil
+	}
+
+	reqLogger.Info("unsupported changes to the spec of a Ready claim", "fields", drift)
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.SpecDriftUnsupported,
+		corev1.ConditionTrue,
+		specDriftReason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	if r.recorder != nil {
+		r.recorder.Event(accountClaim, corev1.EventTypeWarning, string(awsv1alpha1.SpecDriftUnsupported), message)
+	}
+	return true, r.statusUpdate(reqLogger, accountClaim)
+}
+
+// clearSpecDrift resolves the SpecDriftUnsupported condition of a claim whose spec matches the applied one again
+func (r *AccountClaimReconciler) clearSpecDrift(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) error {
+	existing := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.SpecDriftUnsupported)
+	if existing == nil || existing.Status != corev1.ConditionTrue {
+		return nil
+	}
+
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.SpecDriftUnsupported,
+		corev1.ConditionFalse,
+		"SpecDriftReverted",
+		"The spec matches the one the claim was provisioned with",
+		controllerutils.UpdateConditionAlways,
+		accountClaim.Spec.BYOCAWSAccountID != "",
+	)
+	return r.statusUpdate(reqLogger, accountClaim)
+}
@@ -0,0 +1,90 @@
+package accountclaim
+
+import (
+	"context"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spec drift", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *awsv1alpha1.AccountClaim
+		r            *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				AccountLink:      "osd-creds-mgmt-aaaaaa",
+				BYOCAWSAccountID: "123456789012",
+				Aws:              awsv1alpha1.Aws{Regions: []awsv1alpha1.AwsRegions{{Name: "us-east-1"}}},
+			},
+			Status: awsv1alpha1.AccountClaimStatus{State: awsv1alpha1.ClaimStatusReady},
+		}
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+	})
+
+	It("records the spec the claim turned Ready with", func() {
+		drifted, err := r.reconcileSpecDrift(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifted).To(BeFalse())
+
+		updated := &awsv1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), updated)).To(Succeed())
+		Expect(updated.Status.AppliedSpec).To(Equal(&awsv1alpha1.AppliedClaimSpec{
+			Regions:          []string{"us-east-1"},
+			BYOCAWSAccountID: "123456789012",
+		}))
+	})
+
+	It("leaves claims that aren't Ready alone", func() {
+		accountClaim.Status.State = awsv1alpha1.ClaimStatusPending
+
+		drifted, err := r.reconcileSpecDrift(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifted).To(BeFalse())
+		Expect(accountClaim.Status.AppliedSpec).To(BeNil())
+	})
+
+	It("blocks unsupported changes until they are reverted", func() {
+		_, err := r.reconcileSpecDrift(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+
+		accountClaim.Spec.Aws.Regions = []awsv1alpha1.AwsRegions{{Name: "eu-west-1"}}
+		accountClaim.Spec.BYOCAWSAccountID = "210987654321"
+		drifted, err := r.reconcileSpecDrift(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifted).To(BeTrue())
+		Expect(accountClaim.Status.State).To(Equal(awsv1alpha1.ClaimStatusReady))
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.SpecDriftUnsupported)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring(`aws.regions (applied: [us-east-1]), byocAWSAccountID (applied: "123456789012")`))
+		Expect(condition.Message).To(ContainSubstring("delete the claim and create a new one"))
+
+		By("resolving the condition once the changes are reverted")
+		accountClaim.Spec.Aws.Regions = []awsv1alpha1.AwsRegions{{Name: "us-east-1"}}
+		accountClaim.Spec.BYOCAWSAccountID = "123456789012"
+		drifted, err = r.reconcileSpecDrift(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifted).To(BeFalse())
+		condition = controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.SpecDriftUnsupported)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	})
+})
@@ -0,0 +1,154 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+)
+
+// spendFreezeCleanUpSteps returns the steps deleting the resources that keep billing a reused account the most.
+// They run to completion before the rest of the cleanup starts, so a slow enumeration of e.g. S3 objects doesn't
+// keep instances running.
+func (r *AccountClaimReconciler) spendFreezeCleanUpSteps(policy cleanupPolicy) []cleanUpStep {
+	if !policy.spendFreeze {
+		return []cleanUpStep{}
+	}
+	steps := []cleanUpStep{
+		{"EC2Instances", r.cleanUpAwsEC2Instances},
+		{"NATGateways", r.cleanUpAwsNatGateways},
+		{"LoadBalancers", r.cleanUpAwsLoadBalancers},
+	}
+	if policy.rds {
+		steps = append(steps, cleanUpStep{"RDS", r.cleanUpAwsRDS})
+	}
+	return steps
+}
+
+func (r *AccountClaimReconciler) cleanUpAwsEC2Instances(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("instance-state-name"),
+			Values: []string{"pending", "running", "stopping", "stopped"},
+		}},
+	}
+	for {
+		output, err := awsClient.DescribeInstances(context.TODO(), input)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed describing EC2 instances: %w", err).Error()
+			return err
+		}
+
+		instanceIDs := []string{}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				instanceIDs = append(instanceIDs, aws.ToString(instance.InstanceId))
+			}
+		}
+		if len(instanceIDs) > 0 {
+			_, err = awsClient.TerminateInstances(context.TODO(), &ec2.TerminateInstancesInput{InstanceIds: instanceIDs})
+			if err != nil && !isAPIErrorCode(err, "InvalidInstanceID.NotFound") {
+				awsErrors <- fmt.Errorf("failed terminating EC2 instances: %v: %w", instanceIDs, err).Error()
+				return err
+			}
+			deleted.add(len(instanceIDs))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	awsNotifications <- "EC2 instance cleanup finished successfully"
+	return nil
+}
+
+func (r *AccountClaimReconciler) cleanUpAwsNatGateways(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	natGateways := []string{}
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{{
+			Name:   aws.String("state"),
+			Values: []string{"pending", "available"},
+		}},
+	}
+	for {
+		output, err := awsClient.DescribeNatGateways(context.TODO(), input)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed describing NAT gateways: %w", err).Error()
+			return err
+		}
+		for _, natGateway := range output.NatGateways {
+			natGateways = append(natGateways, aws.ToString(natGateway.NatGatewayId))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	err := deleteConcurrently(natGateways, deleted, func(natGateway string) error {
+		_, err := awsClient.DeleteNatGateway(context.TODO(), &ec2.DeleteNatGatewayInput{NatGatewayId: aws.String(natGateway)})
+		if err != nil && !isAPIErrorCode(err, "NatGatewayNotFound") {
+			return fmt.Errorf("failed deleting NAT gateway: %s: %w", natGateway, err)
+		}
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	awsNotifications <- "NAT gateway cleanup finished successfully"
+	return nil
+}
+
+func (r *AccountClaimReconciler) cleanUpAwsLoadBalancers(reqLogger logr.Logger, awsClient awsclient.Client, awsNotifications chan string, awsErrors chan string, deleted *cleanupCounter) error {
+	loadBalancers := []string{}
+	input := &elasticloadbalancingv2.DescribeLoadBalancersInput{}
+	for {
+		output, err := awsClient.DescribeLoadBalancers(context.TODO(), input)
+		if err != nil {
+			awsErrors <- fmt.Errorf("failed describing load balancers: %w", err).Error()
+			return err
+		}
+		for _, loadBalancer := range output.LoadBalancers {
+			loadBalancers = append(loadBalancers, aws.ToString(loadBalancer.LoadBalancerArn))
+		}
+		if output.NextMarker == nil {
+			break
+		}
+		input.Marker = output.NextMarker
+	}
+
+	err := deleteConcurrently(loadBalancers, deleted, func(loadBalancerARN string) error {
+		_, err := awsClient.DeleteLoadBalancer(context.TODO(), &elasticloadbalancingv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(loadBalancerARN)})
+		var notFoundErr *elbtypes.LoadBalancerNotFoundException
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed deleting load balancer: %s: %w", loadBalancerARN, err)
+		}
+		return nil
+	})
+	if err != nil {
+		awsErrors <- err.Error()
+		return err
+	}
+
+	awsNotifications <- "Load balancer cleanup finished successfully"
+	return nil
+}
+
+// isAPIErrorCode is used for the EC2 API, which doesn't model its errors as types
+func isAPIErrorCode(err error, code string) bool {
+	var aerr smithy.APIError
+	return errors.As(err, &aerr) && aerr.ErrorCode() == code
+}
@@ -0,0 +1,104 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/smithy-go"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spend freeze", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		r             *AccountClaimReconciler
+		notifications chan string
+		errs          chan string
+		deleted       *cleanupCounter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWSClient = mock.NewMockClient(ctrl)
+		r = &AccountClaimReconciler{}
+		notifications, errs = make(chan string, 1), make(chan string, 1)
+		deleted = &cleanupCounter{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("is disabled by default", func() {
+		Expect(r.spendFreezeCleanUpSteps(cleanupPolicy{})).To(BeEmpty())
+		Expect(r.spendFreezeCleanUpSteps(cleanupPolicy{spendFreeze: true})).To(HaveLen(3))
+	})
+
+	It("stops the cleanup before the slower steps when it fails", func() {
+		r.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string]string{cleanupSpendFreezeKey: "true"},
+		}).Build()
+		mockAWSClient.EXPECT().DescribeInstances(gomock.Any(), gomock.Any()).Return(nil, errors.New("denied"))
+		mockAWSClient.EXPECT().DescribeNatGateways(gomock.Any(), gomock.Any()).Return(&ec2.DescribeNatGatewaysOutput{}, nil)
+		mockAWSClient.EXPECT().DescribeLoadBalancers(gomock.Any(), gomock.Any()).Return(&elasticloadbalancingv2.DescribeLoadBalancersOutput{}, nil)
+
+		report, err := r.cleanUpAwsAccount(context.TODO(), nullLogger, mockAWSClient)
+		Expect(err).To(HaveOccurred())
+		Expect(report.Succeeded).To(BeFalse())
+		Expect(report.Steps).To(HaveLen(3))
+	})
+
+	It("terminates the EC2 instances of every page", func() {
+		mockAWSClient.EXPECT().DescribeInstances(gomock.Any(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2types.Reservation{{Instances: []ec2types.Instance{{InstanceId: aws.String("i-1")}, {InstanceId: aws.String("i-2")}}}},
+			NextToken:    aws.String("next"),
+		}, nil)
+		mockAWSClient.EXPECT().TerminateInstances(gomock.Any(), &ec2.TerminateInstancesInput{InstanceIds: []string{"i-1", "i-2"}}).Return(&ec2.TerminateInstancesOutput{}, nil)
+		mockAWSClient.EXPECT().DescribeInstances(gomock.Any(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2types.Reservation{{Instances: []ec2types.Instance{{InstanceId: aws.String("i-3")}}}},
+		}, nil)
+		mockAWSClient.EXPECT().TerminateInstances(gomock.Any(), &ec2.TerminateInstancesInput{InstanceIds: []string{"i-3"}}).Return(&ec2.TerminateInstancesOutput{}, nil)
+
+		Expect(r.cleanUpAwsEC2Instances(nullLogger, mockAWSClient, notifications, errs, deleted)).To(Succeed())
+		Expect(<-notifications).To(ContainSubstring("EC2"))
+		Expect(deleted.count()).To(Equal(3))
+	})
+
+	It("ignores NAT gateways that are already gone", func() {
+		mockAWSClient.EXPECT().DescribeNatGateways(gomock.Any(), gomock.Any()).Return(&ec2.DescribeNatGatewaysOutput{
+			NatGateways: []ec2types.NatGateway{{NatGatewayId: aws.String("nat-1")}},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteNatGateway(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "NatGatewayNotFound"})
+
+		Expect(r.cleanUpAwsNatGateways(nullLogger, mockAWSClient, notifications, errs, deleted)).To(Succeed())
+		Expect(deleted.count()).To(Equal(1))
+	})
+
+	It("reports load balancers that can't be deleted", func() {
+		mockAWSClient.EXPECT().DescribeLoadBalancers(gomock.Any(), gomock.Any()).Return(&elasticloadbalancingv2.DescribeLoadBalancersOutput{
+			LoadBalancers: []elbtypes.LoadBalancer{{LoadBalancerArn: aws.String("arn:lb")}},
+		}, nil)
+		mockAWSClient.EXPECT().DeleteLoadBalancer(gomock.Any(), gomock.Any()).Return(nil, &elbtypes.OperationNotPermittedException{})
+
+		Expect(r.cleanUpAwsLoadBalancers(nullLogger, mockAWSClient, notifications, errs, deleted)).NotTo(Succeed())
+		Expect(<-errs).To(ContainSubstring("arn:lb"))
+	})
+})
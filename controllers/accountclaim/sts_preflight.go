@@ -0,0 +1,134 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// stsPreflightSessionName identifies the preflight AssumeRole calls in the customer's CloudTrail
+	stsPreflightSessionName = "RH-STS-Preflight"
+	// stsPreflightRetryInterval is how long a claim whose role doesn't trust the operator waits for the customer
+	// to fix the trust policy
+	stsPreflightRetryInterval = 5 * time.Minute
+)
+
+// verifySTSRoleTrust checks that the customer's STSRoleARN can be assumed from the STS jump role, the same way the
+// account controller will once it initializes the account. It returns the reason the role can't be assumed, or an
+// empty string when it can.
+func (r *AccountClaimReconciler) verifySTSRoleTrust(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (string, error) {
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		reqLogger.Error(err, "failed getting the operator configmap to get the STS jump role")
+		return "", err
+	}
+	jumpRoleARN, err := stsclient.JumpRoles.Select(stsclient.JumpRolesFromConfigMap(cm))
+	if err != nil {
+		reqLogger.Error(awsv1alpha1.ErrInvalidConfigMap, "configmap key missing", "keyName", stsclient.JumpRoleKey)
+		return "", err
+	}
+
+	awsRegion := config.GetDefaultRegion()
+	operatorClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		SecretName: controllerutils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  awsRegion,
+	})
+	if err != nil {
+		reqLogger.Error(err, "failed building operator AWS client")
+		return "", err
+	}
+
+	// Failing to assume our own jump role isn't the customer's problem, the claim is retried with an error
+	jumpRoleCreds, err := operatorClient.AssumeRole(ctx, &sts.AssumeRoleInput{
+		RoleArn:         aws.String(jumpRoleARN),
+		RoleSessionName: aws.String(stsPreflightSessionName),
+		DurationSeconds: aws.Int32(900),
+	})
+	if err != nil {
+		stsclient.JumpRoles.ReportFailure(jumpRoleARN)
+		return "", fmt.Errorf("failed assuming the STS jump role %s: %w", jumpRoleARN, err)
+	}
+	stsclient.JumpRoles.ReportSuccess(jumpRoleARN)
+
+	jumpRoleClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		AwsCredsSecretIDKey:     aws.ToString(jumpRoleCreds.Credentials.AccessKeyId),
+		AwsCredsSecretAccessKey: aws.ToString(jumpRoleCreds.Credentials.SecretAccessKey),
+		AwsToken:                aws.ToString(jumpRoleCreds.Credentials.SessionToken),
+		AwsRegion:               awsRegion,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	input := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(accountClaim.Spec.STSRoleARN),
+		RoleSessionName: aws.String(stsPreflightSessionName),
+		DurationSeconds: aws.Int32(900),
+	}
+	if accountClaim.Spec.STSExternalID != "" {
+		input.ExternalId = aws.String(accountClaim.Spec.STSExternalID)
+	}
+	_, err = jumpRoleClient.AssumeRole(ctx, input)
+	if err != nil {
+		// AWS doesn't tell a missing role from one whose trust policy or external ID doesn't match
+		if isAPIErrorCode(err, "AccessDenied") {
+			return fmt.Sprintf("The trust policy of %s doesn't allow %s to assume it, or the external ID doesn't match", accountClaim.Spec.STSRoleARN, jumpRoleARN), nil
+		}
+		return "", fmt.Errorf("failed assuming the STS role %s: %w", accountClaim.Spec.STSRoleARN, err)
+	}
+
+	return "", nil
+}
+
+// reconcileSTSRoleTrust runs the STS preflight for a claim that isn't linked to an account yet. It returns true when
+// the claim has to wait for the customer to fix the role's trust policy.
+func (r *AccountClaimReconciler) reconcileSTSRoleTrust(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	reason, err := r.verifySTSRoleTrust(ctx, reqLogger, accountClaim)
+	if err != nil {
+		return false, err
+	}
+
+	if reason != "" {
+		reqLogger.Info("STS role can't be assumed by the operator", "roleARN", accountClaim.Spec.STSRoleARN, "reason", reason)
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.STSRoleTrustFailed,
+			corev1.ConditionTrue,
+			"TrustPolicyMismatch",
+			reason,
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+			true,
+		)
+		accountClaim.Status.State = awsv1alpha1.ClaimStatusError
+		return true, r.statusUpdate(reqLogger, accountClaim)
+	}
+
+	if condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.STSRoleTrustFailed); condition != nil && condition.Status == corev1.ConditionTrue {
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+			accountClaim.Status.Conditions,
+			awsv1alpha1.STSRoleTrustFailed,
+			corev1.ConditionFalse,
+			"TrustPolicyVerified",
+			"The operator can assume the STS role",
+			controllerutils.UpdateConditionAlways,
+			true,
+		)
+		accountClaim.Status.State = awsv1alpha1.ClaimStatusPending
+		return false, r.statusUpdate(reqLogger, accountClaim)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,121 @@
+package accountclaim
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/aws/smithy-go"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccountClaim STS preflight", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+		r             *AccountClaimReconciler
+		accountClaim  *awsv1alpha1.AccountClaim
+		jumpRoleARN   = "arn:aws:iam::111111111111:role/jump"
+		customerRole  = "arn:aws:iam::222222222222:role/installer"
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "sts-claim", Namespace: "sts-claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				BYOC:          true,
+				ManualSTSMode: true,
+				STSRoleARN:    customerRole,
+				STSExternalID: "external-id",
+			},
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string]string{stsclient.JumpRoleKey: jumpRoleARN},
+		}
+		r = &AccountClaimReconciler{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim, configMap).Build(),
+			Scheme:           scheme.Scheme,
+			awsClientBuilder: &mock.Builder{MockController: ctrl},
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+		mockAWSClient = mock.GetMockClient(r.awsClientBuilder)
+
+		mockAWSClient.EXPECT().AssumeRole(gomock.Any(), &sts.AssumeRoleInput{
+			RoleArn:         aws.String(jumpRoleARN),
+			RoleSessionName: aws.String(stsPreflightSessionName),
+			DurationSeconds: aws.Int32(900),
+		}).Return(&sts.AssumeRoleOutput{Credentials: &ststypes.Credentials{
+			AccessKeyId:     aws.String("ACCESS_KEY"),
+			SecretAccessKey: aws.String("SECRET_KEY"),
+			SessionToken:    aws.String("SESSION_TOKEN"),
+		}}, nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectCustomerRoleAssumption := func(err error) {
+		mockAWSClient.EXPECT().AssumeRole(gomock.Any(), &sts.AssumeRoleInput{
+			RoleArn:         aws.String(customerRole),
+			RoleSessionName: aws.String(stsPreflightSessionName),
+			DurationSeconds: aws.Int32(900),
+			ExternalId:      aws.String("external-id"),
+		}).Return(&sts.AssumeRoleOutput{}, err)
+	}
+
+	It("sets a condition when the role doesn't trust the jump role", func() {
+		expectCustomerRoleAssumption(&smithy.GenericAPIError{Code: "AccessDenied"})
+
+		wait, err := r.reconcileSTSRoleTrust(context.TODO(), nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeTrue())
+
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+		Expect(accountClaim.Status.State).To(Equal(awsv1alpha1.ClaimStatusError))
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.STSRoleTrustFailed)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring(customerRole))
+	})
+
+	It("clears the condition once the role can be assumed", func() {
+		accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(nil, awsv1alpha1.STSRoleTrustFailed,
+			corev1.ConditionTrue, "TrustPolicyMismatch", "mismatch", controllerutils.UpdateConditionNever, true)
+		expectCustomerRoleAssumption(nil)
+
+		wait, err := r.reconcileSTSRoleTrust(context.TODO(), nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		condition := controllerutils.FindAccountClaimCondition(accountClaim.Status.Conditions, awsv1alpha1.STSRoleTrustFailed)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(accountClaim.Status.State).To(Equal(awsv1alpha1.ClaimStatusPending))
+	})
+
+	It("returns other errors without blaming the customer's role", func() {
+		expectCustomerRoleAssumption(errors.New("throttled"))
+
+		wait, err := r.reconcileSTSRoleTrust(context.TODO(), nullLogger, accountClaim)
+		Expect(err).To(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(accountClaim.Status.Conditions).To(BeEmpty())
+	})
+})
@@ -0,0 +1,85 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// unsupportedClaimRegions returns the supported regions of the pool of the claim and the regions of the claim they
+// don't include. CCS claims bring their own account and have no restrictions.
+func (r *AccountClaimReconciler) unsupportedClaimRegions(ctx context.Context, accountClaim *awsv1alpha1.AccountClaim) (*controllerutils.SupportedRegions, []string, error) {
+	if accountClaim.Spec.BYOC {
+		return nil, nil, nil
+	}
+	supported, err := r.operatorConfig().SupportedRegions(ctx, accountClaim.Spec.AccountPool)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if supported == nil {
+		return nil, nil, nil
+	}
+	regions := []string{}
+	for _, region := range accountClaim.Spec.Aws.Regions {
+		regions = append(regions, region.Name)
+	}
+	return supported, supported.Unsupported(regions), nil
+}
+
+// validateSupportedRegions denies claims requesting regions their pool doesn't support, unless the pool trims them
+func (r *AccountClaimReconciler) validateSupportedRegions(ctx context.Context, accountClaim *awsv1alpha1.AccountClaim) error {
+	supported, unsupported, err := r.unsupportedClaimRegions(ctx, accountClaim)
+	if err != nil || len(unsupported) == 0 || supported.Trim() {
+		return err
+	}
+	return &admissionDeniedError{reasons: []string{
+		fmt.Sprintf("account pool doesn't support regions %s, supported regions are %s", strings.Join(unsupported, ", "), strings.Join(supported.Regions, ", ")),
+	}}
+}
+
+// trimUnsupportedRegions removes the regions the pool doesn't support from claims of pools trimming them, before an
+// account is selected, and records the removed regions in a RegionsTrimmed condition. Returns whether the claim was
+// updated.
+func (r *AccountClaimReconciler) trimUnsupportedRegions(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	if accountClaim.Spec.AccountLink != "" {
+		return false, nil
+	}
+	supported, unsupported, err := r.unsupportedClaimRegions(ctx, accountClaim)
+	if err != nil || len(unsupported) == 0 || !supported.Trim() {
+		return false, err
+	}
+
+	regions := []awsv1alpha1.AwsRegions{}
+	for _, region := range accountClaim.Spec.Aws.Regions {
+		if !controllerutils.Contains(unsupported, region.Name) {
+			regions = append(regions, region)
+		}
+	}
+	message := fmt.Sprintf("Removed regions %s the account pool doesn't support", strings.Join(unsupported, ", "))
+	reqLogger.Info(message)
+	accountClaim.Spec.Aws.Regions = regions
+	if err := r.specUpdate(reqLogger, accountClaim); err != nil {
+		return false, err
+	}
+
+	accountClaim.Status.Conditions = controllerutils.SetAccountClaimCondition(
+		accountClaim.Status.Conditions,
+		awsv1alpha1.RegionsTrimmed,
+		corev1.ConditionTrue,
+		"UnsupportedRegions",
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+		false,
+	)
+	return true, r.statusUpdate(reqLogger, accountClaim)
+}
@@ -0,0 +1,93 @@
+package accountclaim
+
+import (
+	"context"
+
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Supported regions of pools", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		r            *AccountClaimReconciler
+		accountClaim *v1alpha1.AccountClaim
+		configMap    *corev1.ConfigMap
+	)
+
+	setPolicy := func(policy string) {
+		configMap.Data["accountpool"] = `fm-pool:
+  supportedRegions:
+    regions: [us-east-1, us-west-2]
+    policy: ` + policy + "\n"
+		r = &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap, accountClaim).Build(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), accountClaim)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "uhc-production-abc"},
+			Spec: v1alpha1.AccountClaimSpec{
+				AccountPool: "fm-pool",
+				Aws:         v1alpha1.Aws{Regions: []v1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "af-south-1"}}},
+			},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DefaultConfigMap, Namespace: v1alpha1.AccountCrNamespace},
+			Data:       map[string]string{},
+		}
+	})
+
+	It("denies claims requesting unsupported regions", func() {
+		setPolicy("reject")
+		err := r.admitClaim(context.TODO(), nullLogger, accountClaim)
+		Expect(isAdmissionDenied(err)).To(BeTrue())
+		Expect(err.Error()).To(Equal("account pool doesn't support regions af-south-1, supported regions are us-east-1, us-west-2"))
+
+		trimmed, err := r.trimUnsupportedRegions(context.TODO(), nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(trimmed).To(BeFalse())
+	})
+
+	It("removes unsupported regions from claims of pools trimming them", func() {
+		setPolicy("trim")
+		trimmed, err := r.trimUnsupportedRegions(context.TODO(), nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(trimmed).To(BeTrue())
+
+		stored := &v1alpha1.AccountClaim{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(accountClaim), stored)).To(Succeed())
+		Expect(stored.Spec.Aws.Regions).To(Equal([]v1alpha1.AwsRegions{{Name: "us-east-1"}}))
+		condition := controllerutils.FindAccountClaimCondition(stored.Status.Conditions, v1alpha1.RegionsTrimmed)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Message).To(ContainSubstring("af-south-1"))
+
+		Expect(r.admitClaim(context.TODO(), nullLogger, stored)).To(Succeed())
+	})
+
+	It("doesn't trim claims already linked to an account", func() {
+		accountClaim.Spec.AccountLink = "osd-creds-mgmt-aaaaaa"
+		setPolicy("trim")
+		trimmed, err := r.trimUnsupportedRegions(context.TODO(), nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(trimmed).To(BeFalse())
+	})
+
+	It("doesn't restrict CCS claims", func() {
+		accountClaim.Spec.BYOC = true
+		setPolicy("reject")
+		Expect(r.validateSupportedRegions(context.TODO(), accountClaim)).To(Succeed())
+	})
+})
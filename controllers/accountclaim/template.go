@@ -0,0 +1,72 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+func (r *AccountClaimReconciler) getAccountClaimTemplate(name string) (*awsv1alpha1.AccountClaimTemplate, error) {
+	template := &awsv1alpha1.AccountClaimTemplate{}
+	err := r.Get(context.TODO(), types.NamespacedName{Name: name}, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting AccountClaimTemplate %s: %w", name, err)
+	}
+	return template, nil
+}
+
+// applyAccountClaimTemplate fills the fields left empty on the claim from the AccountClaimTemplate it references.
+// It returns true if the claim was changed and has to be updated.
+func (r *AccountClaimReconciler) applyAccountClaimTemplate(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (bool, error) {
+	if accountClaim.Spec.TemplateRef == "" {
+		return false, nil
+	}
+
+	template, err := r.getAccountClaimTemplate(accountClaim.Spec.TemplateRef)
+	if err != nil {
+		reqLogger.Error(err, "could not apply AccountClaimTemplate", "template", accountClaim.Spec.TemplateRef)
+		return false, err
+	}
+
+	changed := mergeAccountClaimTemplate(accountClaim, template)
+	if changed {
+		reqLogger.Info("applied AccountClaimTemplate", "template", template.Name)
+	}
+	return changed, nil
+}
+
+// mergeAccountClaimTemplate copies template values into empty claim fields. Values set on the claim always win.
+func mergeAccountClaimTemplate(accountClaim *awsv1alpha1.AccountClaim, template *awsv1alpha1.AccountClaimTemplate) bool {
+	changed := false
+
+	if len(accountClaim.Spec.Aws.Regions) == 0 && len(template.Spec.Regions) > 0 {
+		accountClaim.Spec.Aws.Regions = append([]awsv1alpha1.AwsRegions{}, template.Spec.Regions...)
+		changed = true
+	}
+	if accountClaim.Spec.AccountPool == "" && template.Spec.AccountPool != "" {
+		accountClaim.Spec.AccountPool = template.Spec.AccountPool
+		changed = true
+	}
+	if accountClaim.Spec.AwsCredentialSecret.Name == "" && template.Spec.AwsCredentialSecretName != "" {
+		accountClaim.Spec.AwsCredentialSecret.Name = template.Spec.AwsCredentialSecretName
+		changed = true
+	}
+	if accountClaim.Spec.AwsCredentialSecret.Namespace == "" && accountClaim.Spec.AwsCredentialSecret.Name != "" {
+		accountClaim.Spec.AwsCredentialSecret.Namespace = accountClaim.Namespace
+		changed = true
+	}
+	if accountClaim.Spec.CustomTags == "" && template.Spec.CustomTags != "" {
+		accountClaim.Spec.CustomTags = template.Spec.CustomTags
+		changed = true
+	}
+	if accountClaim.Spec.KmsKeyId == "" && template.Spec.KmsKeyId != "" {
+		accountClaim.Spec.KmsKeyId = template.Spec.KmsKeyId
+		changed = true
+	}
+
+	return changed
+}
@@ -0,0 +1,77 @@
+package accountclaim
+
+import (
+	"fmt"
+
+	apis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccountClaimTemplate", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		template     *awsv1alpha1.AccountClaimTemplate
+		accountClaim *awsv1alpha1.AccountClaim
+	)
+
+	err := apis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding apis to scheme in AccountClaimTemplate tests")
+	}
+
+	BeforeEach(func() {
+		template = &awsv1alpha1.AccountClaimTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "standard"},
+			Spec: awsv1alpha1.AccountClaimTemplateSpec{
+				Regions:                 []awsv1alpha1.AwsRegions{{Name: "us-east-1"}},
+				AccountPool:             "hypershift",
+				AwsCredentialSecretName: "aws",
+				CustomTags:              "team=sre",
+			},
+		}
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-ns"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				TemplateRef: "standard",
+				AccountPool: "explicit",
+			},
+		}
+	})
+
+	It("fills empty claim fields and keeps the ones set on the claim", func() {
+		Expect(mergeAccountClaimTemplate(accountClaim, template)).To(BeTrue())
+		Expect(accountClaim.Spec.Aws.Regions).To(Equal([]awsv1alpha1.AwsRegions{{Name: "us-east-1"}}))
+		Expect(accountClaim.Spec.AccountPool).To(Equal("explicit"))
+		Expect(accountClaim.Spec.AwsCredentialSecret).To(Equal(awsv1alpha1.SecretRef{Name: "aws", Namespace: "claim-ns"}))
+		Expect(accountClaim.Spec.CustomTags).To(Equal("team=sre"))
+
+		Expect(mergeAccountClaimTemplate(accountClaim, template)).To(BeFalse())
+	})
+
+	It("fails when the referenced template doesn't exist", func() {
+		r := &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			Scheme: scheme.Scheme,
+		}
+		_, err := r.applyAccountClaimTemplate(nullLogger, accountClaim)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("applies the template found in the cluster", func() {
+		r := &AccountClaimReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(template).Build(),
+			Scheme: scheme.Scheme,
+		}
+		changed, err := r.applyAccountClaimTemplate(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(accountClaim.Spec.CustomTags).To(Equal("team=sre"))
+	})
+})
@@ -0,0 +1,304 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/controllers/account"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// temporaryQuotasRequeue is how often a claim checks the requests of its temporary quotas while one of them is in
+	// progress
+	temporaryQuotasRequeue = 5 * time.Minute
+
+	// temporaryQuotaDeniedReason is the reason of the event recorded when AWS denies a temporary quota of a claim
+	temporaryQuotaDeniedReason = "TemporaryQuotaDenied"
+	// quotaRollbackReason is the reason of the event recorded on an account released with quotas raised for its claim
+	quotaRollbackReason = "QuotaRollbackRequired"
+)
+
+// quotaRollback is a quota raised for a former claim of an account, recorded in the QuotaRollbackAnnotation of the
+// account until it's lowered back
+type quotaRollback struct {
+	QuotaCode     awsv1alpha1.SupportedServiceQuotas `json:"quotaCode"`
+	Region        string                             `json:"region"`
+	Value         int                                `json:"value"`
+	PreviousValue int                                `json:"previousValue"`
+	Claim         string                             `json:"claim"`
+}
+
+// validateTemporaryQuotas denies claims with temporary quotas that can't be requested: CCS accounts don't get quota
+// requests, and a quota needs a code the operator knows the service of and a value
+func validateTemporaryQuotas(accountClaim *awsv1alpha1.AccountClaim) error {
+	if len(accountClaim.Spec.TemporaryQuotas) == 0 {
+		return nil
+	}
+	if accountClaim.Spec.BYOC {
+		return &admissionDeniedError{reasons: []string{"temporary quotas only apply to accounts of the organization, not CCS accounts"}}
+	}
+	reasons := []string{}
+	for _, quota := range accountClaim.Spec.TemporaryQuotas {
+		if _, ok := account.GetServiceCode(quota.QuotaCode); !ok || quota.Value < 1 {
+			reasons = append(reasons, fmt.Sprintf("temporary quota %q needs a supported quota code and a value of at least 1", quota.QuotaCode))
+		}
+	}
+	if len(reasons) > 0 {
+		return &admissionDeniedError{reasons: reasons}
+	}
+	return nil
+}
+
+// desiredTemporaryQuotas returns the temporary quotas of the claim by region, a quota without a region applies to every
+// region of the claim. The requests have no status yet.
+func desiredTemporaryQuotas(accountClaim *awsv1alpha1.AccountClaim) []awsv1alpha1.TemporaryQuotaStatus {
+	desired := []awsv1alpha1.TemporaryQuotaStatus{}
+	for _, quota := range accountClaim.Spec.TemporaryQuotas {
+		regions := []string{quota.Region}
+		if quota.Region == "" {
+			regions = []string{}
+			for _, region := range accountClaim.Spec.Aws.Regions {
+				regions = append(regions, region.Name)
+			}
+		}
+		for _, region := range regions {
+			desired = append(desired, awsv1alpha1.TemporaryQuotaStatus{QuotaCode: quota.QuotaCode, Region: region, Value: quota.Value})
+		}
+	}
+	return desired
+}
+
+// mergeTemporaryQuotas returns the requests of the claim once the desired quotas are added to the current ones. A quota
+// raised above its current request is requested again, keeping the value it had before the claim. Quotas lowered or
+// removed from the claim keep their request, as the account keeps the raised value.
+func mergeTemporaryQuotas(current []awsv1alpha1.TemporaryQuotaStatus, desired []awsv1alpha1.TemporaryQuotaStatus) []awsv1alpha1.TemporaryQuotaStatus {
+	merged := make([]awsv1alpha1.TemporaryQuotaStatus, len(current))
+	copy(merged, current)
+	for _, want := range desired {
+		found := false
+		for i := range merged {
+			if merged[i].QuotaCode != want.QuotaCode || merged[i].Region != want.Region {
+				continue
+			}
+			found = true
+			if want.Value > merged[i].Value {
+				merged[i].Value = want.Value
+				if merged[i].Status != "" {
+					merged[i].Status = awsv1alpha1.ServiceRequestTodo
+				}
+			}
+			break
+		}
+		if !found {
+			merged = append(merged, want)
+		}
+	}
+	return merged
+}
+
+// isPendingTemporaryQuota returns whether the request of the temporary quota still needs to be submitted or followed
+func isPendingTemporaryQuota(status awsv1alpha1.TemporaryQuotaStatus) bool {
+	return status.Status == "" || status.Status == awsv1alpha1.ServiceRequestTodo || status.Status == awsv1alpha1.ServiceRequestInProgress
+}
+
+// reconcileTemporaryQuotas requests the temporary quotas of a Ready claim in its account, and follows their requests in
+// the status of the claim. The value of a quota before the claim raised it is recorded first, so the account can be
+// rolled back once the claim is deleted. Quotas already at their value aren't requested. Denied requests are reported
+// by an event and don't fail the claim.
+func (r *AccountClaimReconciler) reconcileTemporaryQuotas(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (reconcile.Result, error) {
+	if len(accountClaim.Spec.TemporaryQuotas) == 0 || accountClaim.Spec.BYOC || accountClaim.Spec.AccountLink == "" {
+		return reconcile.Result{}, nil
+	}
+	if validateTemporaryQuotas(accountClaim) != nil {
+		return reconcile.Result{}, nil
+	}
+	statuses := mergeTemporaryQuotas(accountClaim.Status.TemporaryQuotas, desiredTemporaryQuotas(accountClaim))
+
+	pending := false
+	for _, status := range statuses {
+		pending = pending || isPendingTemporaryQuota(status)
+	}
+	var requestErr error
+	if pending {
+		requestErr = r.requestTemporaryQuotas(ctx, reqLogger, accountClaim, statuses)
+	}
+
+	if !reflect.DeepEqual(statuses, accountClaim.Status.TemporaryQuotas) {
+		accountClaim.Status.TemporaryQuotas = statuses
+		if err := r.statusUpdate(reqLogger, accountClaim); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if requestErr != nil {
+		return reconcile.Result{}, requestErr
+	}
+	for _, status := range statuses {
+		if isPendingTemporaryQuota(status) {
+			return reconcile.Result{RequeueAfter: temporaryQuotasRequeue}, nil
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// requestTemporaryQuotas submits or follows the pending requests of statuses in the account of the claim. It stops at
+// the first failure, leaving the requests handled so far updated.
+func (r *AccountClaimReconciler) requestTemporaryQuotas(ctx context.Context, reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim, statuses []awsv1alpha1.TemporaryQuotaStatus) error {
+	claimedAccount, err := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	awsSetupClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		SecretName: controllerutils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  config.GetDefaultRegion(),
+	})
+	if err != nil {
+		return err
+	}
+
+	regionClients := map[string]awsclient.Client{}
+	for i := range statuses {
+		status := &statuses[i]
+		if !isPendingTemporaryQuota(*status) {
+			continue
+		}
+		regionLogger := reqLogger.WithValues("Region", status.Region, "QuotaCode", status.QuotaCode)
+		awsClient, ok := regionClients[status.Region]
+		if !ok {
+			awsClient, _, err = stsclient.HandleRoleAssumption(reqLogger, r.awsClientBuilder, claimedAccount, r.Client, awsSetupClient, status.Region, awsv1alpha1.AccountOperatorIAMRole, "")
+			if err != nil {
+				reqLogger.Error(err, "failed building AWS client from assume_role")
+				return err
+			}
+			regionClients[status.Region] = awsClient
+		}
+
+		if status.Status == "" {
+			previous, err := currentQuotaValue(ctx, awsClient, status.QuotaCode)
+			var noSuchResource *servicequotastypes.NoSuchResourceException
+			if errors.As(err, &noSuchResource) {
+				regionLogger.Info("The temporary quota doesn't exist in the region, it needs manual action")
+				status.Status = awsv1alpha1.ServiceRequestUnknown
+				continue
+			}
+			if err != nil {
+				regionLogger.Error(err, "failed reading the current value of the temporary quota", "awsRequestID", controllerutils.AwsRequestID(err))
+				return err
+			}
+			status.PreviousValue = previous
+			status.Status = awsv1alpha1.ServiceRequestTodo
+		}
+
+		quotaStatus := &awsv1alpha1.ServiceQuotaStatus{Value: status.Value, Status: status.Status}
+		err = account.HandleServiceQuotaRequests(regionLogger, awsClient, status.QuotaCode, quotaStatus)
+		if err != nil {
+			return err
+		}
+		if quotaStatus.Status == awsv1alpha1.ServiceRequestDenied && status.Status != awsv1alpha1.ServiceRequestDenied && r.recorder != nil {
+			r.recorder.Event(accountClaim, corev1.EventTypeWarning, temporaryQuotaDeniedReason,
+				fmt.Sprintf("AWS denied raising quota %s to %d in %s", status.QuotaCode, status.Value, status.Region))
+		}
+		status.Status = quotaStatus.Status
+	}
+	return nil
+}
+
+// currentQuotaValue returns the value of the quota in the region of awsClient
+func currentQuotaValue(ctx context.Context, awsClient awsclient.Client, quotaCode awsv1alpha1.SupportedServiceQuotas) (int, error) {
+	serviceCode, _ := account.GetServiceCode(quotaCode)
+	output, err := awsClient.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(string(quotaCode)),
+		ServiceCode: aws.String(serviceCode),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if output.Quota == nil || output.Quota.Value == nil {
+		return 0, fmt.Errorf("quota %s has no value", quotaCode)
+	}
+	return int(*output.Quota.Value), nil
+}
+
+// releaseTemporaryQuotas records the quotas raised for the claim the account is released from in the
+// QuotaRollbackAnnotation of the account, and reports them by an event of the account. Service Quotas can't lower a
+// quota, they have to be lowered back through a support case of the account, so reused accounts don't accumulate
+// ever growing quotas.
+func (r *AccountClaimReconciler) releaseTemporaryQuotas(ctx context.Context, reqLogger logr.Logger, claimedAccount *awsv1alpha1.Account, accountClaim *awsv1alpha1.AccountClaim) error {
+	claim := accountClaim.Namespace + "/" + accountClaim.Name
+	rollbacks := []quotaRollback{}
+	raised := []string{}
+	for _, status := range accountClaim.Status.TemporaryQuotas {
+		if status.Value <= status.PreviousValue ||
+			(status.Status != awsv1alpha1.ServiceRequestCompleted && status.Status != awsv1alpha1.ServiceRequestInProgress) {
+			continue
+		}
+		rollbacks = append(rollbacks, quotaRollback{
+			QuotaCode:     status.QuotaCode,
+			Region:        status.Region,
+			Value:         status.Value,
+			PreviousValue: status.PreviousValue,
+			Claim:         claim,
+		})
+		raised = append(raised, fmt.Sprintf("%s %d->%d in %s", status.QuotaCode, status.PreviousValue, status.Value, status.Region))
+	}
+	if len(rollbacks) == 0 {
+		return nil
+	}
+
+	existing := []quotaRollback{}
+	if value := claimedAccount.Annotations[awsv1alpha1.QuotaRollbackAnnotation]; value != "" {
+		if err := json.Unmarshal([]byte(value), &existing); err != nil {
+			reqLogger.Error(err, "Ignoring the invalid quota rollback annotation of the account", "account", claimedAccount.Name)
+			existing = []quotaRollback{}
+		}
+	}
+	// The rollbacks may already be recorded by a release that failed afterwards
+	for _, rollback := range rollbacks {
+		recorded := false
+		for _, existingRollback := range existing {
+			recorded = recorded || existingRollback == rollback
+		}
+		if !recorded {
+			existing = append(existing, rollback)
+		}
+	}
+	encoded, err := json.Marshal(existing)
+	if err != nil {
+		return err
+	}
+	if claimedAccount.Annotations == nil {
+		claimedAccount.Annotations = map[string]string{}
+	}
+	claimedAccount.Annotations[awsv1alpha1.QuotaRollbackAnnotation] = string(encoded)
+
+	message := fmt.Sprintf("Quotas raised for claim %s have to be lowered back: %s", claim, strings.Join(raised, ", "))
+	reqLogger.Info(message, "account", claimedAccount.Name)
+	if err := r.Update(ctx, claimedAccount); err != nil {
+		return err
+	}
+	if r.recorder != nil {
+		r.recorder.Event(claimedAccount, corev1.EventTypeWarning, quotaRollbackReason, message)
+	}
+	return nil
+}
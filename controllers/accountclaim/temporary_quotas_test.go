@@ -0,0 +1,153 @@
+package accountclaim
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Temporary quotas", func() {
+	var (
+		nullLogger   = testutils.NewTestLogger().Logger()
+		accountClaim *v1alpha1.AccountClaim
+		account      *v1alpha1.Account
+		recorder     *record.FakeRecorder
+		r            *AccountClaimReconciler
+	)
+
+	BeforeEach(func() {
+		accountClaim = &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: v1alpha1.AccountClaimSpec{
+				AccountLink: "osd-creds-mgmt-aaaaaa",
+				Aws:         v1alpha1.Aws{Regions: []v1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "us-west-2"}}},
+				TemporaryQuotas: []v1alpha1.RequiredQuota{
+					{QuotaCode: v1alpha1.RunningStandardInstances, Value: 1500},
+				},
+			},
+		}
+		account = &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: v1alpha1.AccountCrNamespace},
+		}
+		recorder = record.NewFakeRecorder(2)
+		r = &AccountClaimReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim, account).Build(),
+			Scheme:   scheme.Scheme,
+			recorder: recorder,
+		}
+	})
+
+	getRollbacks := func() []quotaRollback {
+		updated := &v1alpha1.Account{}
+		Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), updated)).To(Succeed())
+		account = updated
+		rollbacks := []quotaRollback{}
+		Expect(json.Unmarshal([]byte(updated.Annotations[v1alpha1.QuotaRollbackAnnotation]), &rollbacks)).To(Succeed())
+		return rollbacks
+	}
+
+	Context("validateTemporaryQuotas", func() {
+		It("admits supported quotas with a value", func() {
+			Expect(validateTemporaryQuotas(accountClaim)).To(Succeed())
+		})
+
+		It("denies unsupported quota codes", func() {
+			accountClaim.Spec.TemporaryQuotas[0].QuotaCode = "L-00000000"
+			Expect(isAdmissionDenied(validateTemporaryQuotas(accountClaim))).To(BeTrue())
+		})
+
+		It("denies quotas without a value", func() {
+			accountClaim.Spec.TemporaryQuotas[0].Value = 0
+			Expect(isAdmissionDenied(validateTemporaryQuotas(accountClaim))).To(BeTrue())
+		})
+
+		It("denies quotas for CCS claims", func() {
+			accountClaim.Spec.BYOC = true
+			Expect(isAdmissionDenied(validateTemporaryQuotas(accountClaim))).To(BeTrue())
+		})
+	})
+
+	Context("mergeTemporaryQuotas", func() {
+		It("requests the quota in every region of the claim by default", func() {
+			merged := mergeTemporaryQuotas(nil, desiredTemporaryQuotas(accountClaim))
+			Expect(merged).To(Equal([]v1alpha1.TemporaryQuotaStatus{
+				{QuotaCode: v1alpha1.RunningStandardInstances, Region: "us-east-1", Value: 1500},
+				{QuotaCode: v1alpha1.RunningStandardInstances, Region: "us-west-2", Value: 1500},
+			}))
+		})
+
+		It("requests a raised quota again, keeping its previous value", func() {
+			accountClaim.Spec.TemporaryQuotas[0].Region = "us-east-1"
+			merged := mergeTemporaryQuotas([]v1alpha1.TemporaryQuotaStatus{
+				{QuotaCode: v1alpha1.RunningStandardInstances, Region: "us-east-1", Value: 1000, PreviousValue: 750, Status: v1alpha1.ServiceRequestCompleted},
+			}, desiredTemporaryQuotas(accountClaim))
+			Expect(merged).To(Equal([]v1alpha1.TemporaryQuotaStatus{
+				{QuotaCode: v1alpha1.RunningStandardInstances, Region: "us-east-1", Value: 1500, PreviousValue: 750, Status: v1alpha1.ServiceRequestTodo},
+			}))
+		})
+
+		It("keeps the requests of quotas removed from the claim", func() {
+			current := []v1alpha1.TemporaryQuotaStatus{
+				{QuotaCode: v1alpha1.NLBPerRegion, Region: "us-east-1", Value: 100, PreviousValue: 50, Status: v1alpha1.ServiceRequestCompleted},
+			}
+			accountClaim.Spec.TemporaryQuotas = nil
+			Expect(mergeTemporaryQuotas(current, desiredTemporaryQuotas(accountClaim))).To(Equal(current))
+		})
+	})
+
+	Context("releaseTemporaryQuotas", func() {
+		BeforeEach(func() {
+			accountClaim.Status.TemporaryQuotas = []v1alpha1.TemporaryQuotaStatus{
+				{QuotaCode: v1alpha1.RunningStandardInstances, Region: "us-east-1", Value: 1500, PreviousValue: 750, Status: v1alpha1.ServiceRequestCompleted},
+				{QuotaCode: v1alpha1.RunningStandardInstances, Region: "us-west-2", Value: 1500, PreviousValue: 750, Status: v1alpha1.ServiceRequestDenied},
+			}
+		})
+
+		It("records the raised quotas in the account for rollback", func() {
+			Expect(r.releaseTemporaryQuotas(context.TODO(), nullLogger, account, accountClaim)).To(Succeed())
+			Expect(getRollbacks()).To(Equal([]quotaRollback{
+				{QuotaCode: v1alpha1.RunningStandardInstances, Region: "us-east-1", Value: 1500, PreviousValue: 750, Claim: "claim-namespace/claim"},
+			}))
+			Expect(recorder.Events).To(Receive(ContainSubstring("QuotaRollbackRequired Quotas raised for claim claim-namespace/claim have to be lowered back: L-1216C47A 750->1500 in us-east-1")))
+
+			By("not recording them twice when the release is retried")
+			Expect(r.releaseTemporaryQuotas(context.TODO(), nullLogger, account, accountClaim)).To(Succeed())
+			Expect(getRollbacks()).To(HaveLen(1))
+		})
+
+		It("leaves accounts without raised quotas alone", func() {
+			accountClaim.Status.TemporaryQuotas[0].Status = v1alpha1.ServiceRequestDenied
+			Expect(r.releaseTemporaryQuotas(context.TODO(), nullLogger, account, accountClaim)).To(Succeed())
+			Expect(account.Annotations).NotTo(HaveKey(v1alpha1.QuotaRollbackAnnotation))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("records the raised quotas of an account retired by its pool", func() {
+			pool := &v1alpha1.AccountPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "retiring-pool", Namespace: v1alpha1.AccountCrNamespace},
+				Spec:       v1alpha1.AccountPoolSpec{MaxReuseCount: 1},
+			}
+			Expect(r.Create(context.TODO(), pool)).To(Succeed())
+			Expect(r.Get(context.TODO(), client.ObjectKeyFromObject(account), account)).To(Succeed())
+			account.Spec.AccountPool = pool.Name
+			account.Status.ReuseCount = 1
+			Expect(r.Update(context.TODO(), account)).To(Succeed())
+
+			Expect(r.releaseAccount(context.TODO(), nullLogger, account, accountClaim)).To(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring("QuotaRollbackRequired")))
+			err := r.Get(context.TODO(), client.ObjectKeyFromObject(account), &v1alpha1.Account{})
+			Expect(k8serr.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,160 @@
+package accountclaim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	controllerutils "github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// vpcEndpointServicePermissionsInterval is how often the permissions of the VPC endpoint services are reconciled,
+// services created in the account aren't watched
+const vpcEndpointServicePermissionsInterval = 10 * time.Minute
+
+// reconcileVpcEndpointServicePermissions makes the allowed principals of every VPC endpoint service in the regions
+// of the claimed account match Spec.VpcEndpointServiceAllowedPrincipals, and requeues the claim to catch up with the
+// services created since. Claims that don't declare any principals are left alone.
+func (r *AccountClaimReconciler) reconcileVpcEndpointServicePermissions(reqLogger logr.Logger, accountClaim *awsv1alpha1.AccountClaim) (reconcile.Result, error) {
+	if len(accountClaim.Spec.VpcEndpointServiceAllowedPrincipals) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	claimedAccount, err := r.getClaimedAccount(accountClaim.Spec.AccountLink, awsv1alpha1.AccountCrNamespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	awsSetupClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		SecretName: controllerutils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  config.GetDefaultRegion(),
+	})
+	if err != nil {
+		reqLogger.Error(err, "failed building operator AWS client")
+		return reconcile.Result{}, err
+	}
+
+	for _, region := range vpcEndpointServiceRegions(accountClaim) {
+		awsClient, _, err := stsclient.HandleRoleAssumption(reqLogger, r.awsClientBuilder, claimedAccount, r.Client, awsSetupClient, region, awsv1alpha1.AccountOperatorIAMRole, "")
+		if err != nil {
+			reqLogger.Error(err, "failed building AWS client from assume_role", "region", region)
+			return reconcile.Result{}, err
+		}
+
+		serviceIDs, err := listVpcEndpointServices(awsClient)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("region %s: %w", region, err)
+		}
+
+		for _, serviceID := range serviceIDs {
+			err = syncVpcEndpointServicePermissions(reqLogger, awsClient, serviceID, accountClaim.Spec.VpcEndpointServiceAllowedPrincipals)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("region %s: %w", region, err)
+			}
+		}
+	}
+	return reconcile.Result{RequeueAfter: vpcEndpointServicePermissionsInterval}, nil
+}
+
+// vpcEndpointServiceRegions returns the regions of the claim, or the default region for claims without regions
+func vpcEndpointServiceRegions(accountClaim *awsv1alpha1.AccountClaim) []string {
+	regions := []string{}
+	for _, region := range accountClaim.Spec.Aws.Regions {
+		regions = append(regions, region.Name)
+	}
+	if len(regions) == 0 {
+		regions = append(regions, config.GetDefaultRegion())
+	}
+	return regions
+}
+
+func listVpcEndpointServices(awsClient awsclient.Client) ([]string, error) {
+	serviceIDs := []string{}
+	var nextToken *string
+	for {
+		output, err := awsClient.DescribeVpcEndpointServiceConfigurations(context.TODO(), &ec2.DescribeVpcEndpointServiceConfigurationsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed describing VPC endpoint services: %w", err)
+		}
+		for _, service := range output.ServiceConfigurations {
+			if service.ServiceId != nil {
+				serviceIDs = append(serviceIDs, *service.ServiceId)
+			}
+		}
+		if output.NextToken == nil || *output.NextToken == "" {
+			return serviceIDs, nil
+		}
+		nextToken = output.NextToken
+	}
+}
+
+// syncVpcEndpointServicePermissions adds the missing principals to the service and removes the ones not desired
+func syncVpcEndpointServicePermissions(reqLogger logr.Logger, awsClient awsclient.Client, serviceID string, desired []string) error {
+	current := map[string]bool{}
+	var nextToken *string
+	for {
+		output, err := awsClient.DescribeVpcEndpointServicePermissions(context.TODO(), &ec2.DescribeVpcEndpointServicePermissionsInput{
+			ServiceId: aws.String(serviceID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed describing permissions of VPC endpoint service %s: %w", serviceID, err)
+		}
+		for _, principal := range output.AllowedPrincipals {
+			if principal.Principal != nil {
+				current[*principal.Principal] = true
+			}
+		}
+		if output.NextToken == nil || *output.NextToken == "" {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	toAdd := []string{}
+	wanted := map[string]bool{}
+	for _, principal := range desired {
+		wanted[principal] = true
+		if !current[principal] {
+			toAdd = append(toAdd, principal)
+		}
+	}
+	toRemove := []string{}
+	for principal := range current {
+		if !wanted[principal] {
+			toRemove = append(toRemove, principal)
+		}
+	}
+
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+
+	input := &ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(serviceID),
+	}
+	if len(toAdd) > 0 {
+		input.AddAllowedPrincipals = toAdd
+	}
+	if len(toRemove) > 0 {
+		input.RemoveAllowedPrincipals = toRemove
+	}
+	_, err := awsClient.ModifyVpcEndpointServicePermissions(context.TODO(), input)
+	if err != nil {
+		return fmt.Errorf("failed modifying permissions of VPC endpoint service %s: %w", serviceID, err)
+	}
+	reqLogger.Info("updated VPC endpoint service permissions", "service", serviceID, "added", toAdd, "removed", toRemove)
+	return nil
+}
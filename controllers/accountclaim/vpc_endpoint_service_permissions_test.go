@@ -0,0 +1,139 @@
+package accountclaim
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VPC endpoint service permissions", func() {
+	var (
+		nullLogger    = testutils.NewTestLogger().Logger()
+		ctrl          *gomock.Controller
+		mockAWSClient *mock.MockClient
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAWSClient = mock.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("lists services across pages", func() {
+		gomock.InOrder(
+			mockAWSClient.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcEndpointServiceConfigurationsOutput{
+				ServiceConfigurations: []ec2types.ServiceConfiguration{{ServiceId: aws.String("vpce-svc-1")}},
+				NextToken:             aws.String("next"),
+			}, nil),
+			mockAWSClient.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcEndpointServiceConfigurationsOutput{
+				ServiceConfigurations: []ec2types.ServiceConfiguration{{ServiceId: aws.String("vpce-svc-2")}},
+			}, nil),
+		)
+
+		serviceIDs, err := listVpcEndpointServices(mockAWSClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceIDs).To(Equal([]string{"vpce-svc-1", "vpce-svc-2"}))
+	})
+
+	It("adds missing principals and removes undeclared ones", func() {
+		mockAWSClient.EXPECT().DescribeVpcEndpointServicePermissions(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcEndpointServicePermissionsOutput{
+			AllowedPrincipals: []ec2types.AllowedPrincipal{
+				{Principal: aws.String("arn:aws:iam::111111111111:root")},
+				{Principal: aws.String("arn:aws:iam::222222222222:root")},
+			},
+		}, nil)
+		mockAWSClient.EXPECT().ModifyVpcEndpointServicePermissions(gomock.Any(), gomock.Any()).Do(func(_ any, input *ec2.ModifyVpcEndpointServicePermissionsInput) {
+			Expect(*input.ServiceId).To(Equal("vpce-svc-1"))
+			Expect(input.AddAllowedPrincipals).To(Equal([]string{"arn:aws:iam::333333333333:root"}))
+			Expect(input.RemoveAllowedPrincipals).To(Equal([]string{"arn:aws:iam::222222222222:root"}))
+		}).Return(&ec2.ModifyVpcEndpointServicePermissionsOutput{}, nil)
+
+		err := syncVpcEndpointServicePermissions(nullLogger, mockAWSClient, "vpce-svc-1", []string{
+			"arn:aws:iam::111111111111:root",
+			"arn:aws:iam::333333333333:root",
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reconciles the services of every region of the claim and requeues", func() {
+		stsclient.Credentials = stsclient.NewCredentialCache()
+		defer func() { stsclient.Credentials = stsclient.NewCredentialCache() }()
+		accountClaim := &v1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: v1alpha1.AccountClaimSpec{
+				AccountLink:                         "osd-creds-mgmt-aaaaaa",
+				Aws:                                 v1alpha1.Aws{Regions: []v1alpha1.AwsRegions{{Name: "us-east-1"}, {Name: "eu-west-1"}}},
+				VpcEndpointServiceAllowedPrincipals: []string{"arn:aws:iam::111111111111:root"},
+			},
+		}
+		account := &v1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: v1alpha1.AccountCrNamespace},
+			Spec:       v1alpha1.AccountSpec{AwsAccountID: "123456789012"},
+		}
+		mockBuilder := mock.NewMockIBuilder(ctrl)
+		r := &AccountClaimReconciler{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(accountClaim, account).Build(),
+			Scheme:           scheme.Scheme,
+			awsClientBuilder: mockBuilder,
+		}
+		clientRegions := []string{}
+		mockBuilder.EXPECT().GetClient(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ string, _ client.Client, input awsclient.NewAwsClientInput) (awsclient.Client, error) {
+				if input.AwsToken != "" {
+					clientRegions = append(clientRegions, input.AwsRegion)
+				}
+				return mockAWSClient, nil
+			}).AnyTimes()
+		mockAWSClient.EXPECT().AssumeRole(gomock.Any(), gomock.Any()).Return(&sts.AssumeRoleOutput{
+			AssumedRoleUser: &ststypes.AssumedRoleUser{AssumedRoleId: aws.String("role")},
+			Credentials: &ststypes.Credentials{
+				AccessKeyId:     aws.String("ACCESS_KEY"),
+				Expiration:      aws.Time(time.Now().Add(time.Hour)),
+				SecretAccessKey: aws.String("SECRET_KEY"),
+				SessionToken:    aws.String("SESSION_TOKEN"),
+			},
+		}, nil).AnyTimes()
+		mockAWSClient.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcEndpointServiceConfigurationsOutput{}, nil).Times(2)
+
+		result, err := r.reconcileVpcEndpointServicePermissions(nullLogger, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(vpcEndpointServicePermissionsInterval))
+		Expect(clientRegions).To(Equal([]string{"us-east-1", "eu-west-1"}))
+	})
+
+	It("requeues satisfied claims by their earliest periodic reconcile", func() {
+		Expect(earliestRequeue(reconcile.Result{}, reconcile.Result{RequeueAfter: time.Hour}, reconcile.Result{RequeueAfter: time.Minute})).
+			To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(earliestRequeue(reconcile.Result{}, reconcile.Result{})).To(Equal(reconcile.Result{}))
+	})
+
+	It("does not modify a service that is already in sync", func() {
+		mockAWSClient.EXPECT().DescribeVpcEndpointServicePermissions(gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcEndpointServicePermissionsOutput{
+			AllowedPrincipals: []ec2types.AllowedPrincipal{{Principal: aws.String("arn:aws:iam::111111111111:root")}},
+		}, nil)
+
+		err := syncVpcEndpointServicePermissions(nullLogger, mockAWSClient, "vpce-svc-1", []string{"arn:aws:iam::111111111111:root"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
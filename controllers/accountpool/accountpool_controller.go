@@ -3,6 +3,9 @@ package accountpool
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +20,8 @@ import (
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
 	"github.com/openshift/aws-account-operator/config"
 	"github.com/openshift/aws-account-operator/controllers/account"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
 	"github.com/openshift/aws-account-operator/pkg/totalaccountwatcher"
 	"github.com/openshift/aws-account-operator/pkg/utils"
 )
@@ -30,8 +35,14 @@ var log = logf.Log.WithName("controller_accountpool")
 // AccountPoolReconciler reconciles a AccountPool object
 type AccountPoolReconciler struct {
 	client.Client
-	Scheme         *runtime.Scheme
-	accountWatcher totalaccountwatcher.AccountWatcherIface
+	Scheme           *runtime.Scheme
+	accountWatcher   totalaccountwatcher.AccountWatcherIface
+	awsClientBuilder awsclient.IBuilder
+}
+
+// operatorConfig returns the operator configuration, read with the client of the reconciler
+func (r *AccountPoolReconciler) operatorConfig() *utils.OperatorConfig {
+	return utils.NewOperatorConfig(r.Client)
 }
 
 //+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=accountpools,verbs=get;list;watch;create;update;patch;delete
@@ -53,12 +64,22 @@ func (r *AccountPoolReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			poolClaimHistory.forget(request.Name)
+			if localmetrics.Collector != nil {
+				localmetrics.Collector.DeleteAccountPoolForecast(awsv1alpha1.AccountCrNamespace, request.Name)
+			}
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
 
+	// Retire the unclaimed accounts past the limits of the pool, they are replaced below once they are gone
+	err = r.retireAccounts(ctx, reqLogger, currentAccountPool)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Calculate unclaimed accounts vs claimed accounts
 	calculatedStatus, err := r.calculateAccountPoolStatus(reqLogger, currentAccountPool.Name)
 	if err != nil {
@@ -66,37 +87,49 @@ func (r *AccountPoolReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 	}
 	// Update the pool size after we calculate all other values
 	calculatedStatus.PoolSize = currentAccountPool.Spec.PoolSize
+	calculatedStatus.ObservedGeneration = currentAccountPool.Generation
+	// The rollout to the canary accounts is recorded by the validation controller
+	calculatedStatus.Canary = currentAccountPool.Status.Canary
+	calculatedStatus.Bootstrap, err = r.bootstrapStatus(ctx, currentAccountPool, time.Now())
+	if err != nil {
+		return reconcile.Result{}, err
+	}
 
 	if shouldUpdateAccountPoolStatus(currentAccountPool, calculatedStatus) {
+		previousStatus := currentAccountPool.Status
 		currentAccountPool.Status = calculatedStatus
 		err = r.Client.Status().Update(context.TODO(), currentAccountPool)
 		if err != nil {
 			return reconcile.Result{}, err
 		}
+		r.notifyPoolLow(ctx, reqLogger, currentAccountPool, previousStatus, calculatedStatus)
 	}
 
+	reportForecast(currentAccountPool, calculatedStatus, time.Now())
+
 	// Get the number of desired unclaimed AWS accounts in the pool
 	poolSizeCount := currentAccountPool.Spec.PoolSize
 	unclaimedAccountCount := calculatedStatus.UnclaimedAccounts
 
 	reqLogger.Info(fmt.Sprintf("AccountPool Calculations Completed: %+v", calculatedStatus))
 
-	if unclaimedAccountCount >= poolSizeCount {
-		reqLogger.Info(fmt.Sprintf("unclaimed account pool satisfied, unclaimedAccounts %d >= poolSize %d", unclaimedAccountCount, poolSizeCount))
-		return reconcile.Result{}, nil
+	// Start support enrollment and quota requests of progressing accounts early
+	if calculatedStatus.AccountsProgressing > 0 {
+		r.warmUpAccounts(ctx, reqLogger, currentAccountPool.Name)
 	}
 
-	// Create Account CR
-	newAccount := account.GenerateAccountCR(awsv1alpha1.AccountCrNamespace)
-	newAccount.Spec.AccountPool = currentAccountPool.Name
-	utils.AddFinalizer(newAccount, awsv1alpha1.AccountFinalizer)
+	// The bootstrap creates the accounts of the pool until it completed
+	if calculatedStatus.Bootstrap != nil && calculatedStatus.Bootstrap.Phase != awsv1alpha1.BootstrapPhaseCompleted {
+		return r.bootstrapAccounts(ctx, reqLogger, currentAccountPool)
+	}
 
-	// Set AccountPool instance as the owner and controller
-	if err := controllerutil.SetControllerReference(currentAccountPool, newAccount, r.Scheme); err != nil {
-		return reconcile.Result{}, err
+	if unclaimedAccountCount >= poolSizeCount {
+		reqLogger.Info(fmt.Sprintf("unclaimed account pool satisfied, unclaimedAccounts %d >= poolSize %d", unclaimedAccountCount, poolSizeCount))
+		return reconcile.Result{}, nil
 	}
 
-	if err = r.handleServiceQuotas(reqLogger, newAccount); err != nil {
+	newAccount, err := r.newPoolAccount(ctx, reqLogger, currentAccountPool)
+	if err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -109,8 +142,26 @@ func (r *AccountPoolReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 	return reconcile.Result{}, nil
 }
 
-func (r *AccountPoolReconciler) handleServiceQuotas(reqLogger logr.Logger, account *awsv1alpha1.Account) error {
-	parsedRegionalServiceQuotas, err := utils.GetServiceQuotasFromAccountPool(reqLogger, account.Spec.AccountPool, r.Client)
+// newPoolAccount returns a new Account CR of the pool, owned by it
+func (r *AccountPoolReconciler) newPoolAccount(ctx context.Context, reqLogger logr.Logger, pool *awsv1alpha1.AccountPool) (*awsv1alpha1.Account, error) {
+	newAccount := account.GenerateAccountCR(awsv1alpha1.AccountCrNamespace)
+	newAccount.Spec.AccountPool = pool.Name
+	account.SetAWSAccountIdentity(newAccount)
+	utils.AddFinalizer(newAccount, awsv1alpha1.AccountFinalizer)
+
+	// Set AccountPool instance as the owner and controller
+	if err := controllerutil.SetControllerReference(pool, newAccount, r.Scheme); err != nil {
+		return nil, err
+	}
+
+	if err := r.handleServiceQuotas(ctx, reqLogger, newAccount); err != nil {
+		return nil, err
+	}
+	return newAccount, nil
+}
+
+func (r *AccountPoolReconciler) handleServiceQuotas(ctx context.Context, reqLogger logr.Logger, account *awsv1alpha1.Account) error {
+	parsedRegionalServiceQuotas, err := r.operatorConfig().ServiceQuotas(ctx, reqLogger, account.Spec.AccountPool)
 	if err != nil {
 		return err
 	}
@@ -128,6 +179,9 @@ func (r *AccountPoolReconciler) calculateAccountPoolStatus(reqLogger logr.Logger
 	claimedAccountCount := 0
 	availableAccounts := 0
 	accountsProgressing := 0
+	highestReuseCount := 0
+	oldestAccountAgeDays := 0
+	now := time.Now()
 
 	//Get the number of actual unclaimed AWS accounts in the pool
 	accountList := &awsv1alpha1.AccountList{}
@@ -140,29 +194,12 @@ func (r *AccountPoolReconciler) calculateAccountPoolStatus(reqLogger logr.Logger
 	}
 
 	for _, account := range accountList.Items {
-		// if the account is not owned by the accountpool, skip it
-		if !account.IsOwnedByAccountPool() {
-			continue
+		inPool, err := r.isInPool(reqLogger, &account, poolName)
+		if err != nil {
+			return awsv1alpha1.AccountPoolStatus{}, err
 		}
-
-		// Special intermediary case until all account crs have had their account.Spec.AccountPool set appropriately.
-		// If account.Spec.AccountPool is empty, we count it as if it's from the default accountpool.
-		if account.Spec.AccountPool == "" {
-			defaultPoolName, err := config.GetDefaultAccountPoolName(reqLogger, r.Client)
-
-			if err != nil {
-				reqLogger.Error(err, "error getting default accountpool name")
-				return awsv1alpha1.AccountPoolStatus{}, err
-			}
-
-			if poolName != defaultPoolName {
-				continue
-			}
-		} else {
-			// If an accountpool name is specified, we want to count ONLY that pool
-			if account.Spec.AccountPool != poolName {
-				continue
-			}
+		if !inPool {
+			continue
 		}
 
 		// count unclaimed accounts
@@ -177,6 +214,9 @@ func (r *AccountPoolReconciler) calculateAccountPoolStatus(reqLogger logr.Logger
 			claimedAccountCount++
 		}
 
+		// remember the claims for the forecast of the pool
+		poolClaimHistory.observe(poolName, &account, now)
+
 		// count available accounts
 		if account.HasNeverBeenClaimed() && account.IsReady() {
 			availableAccounts++
@@ -186,19 +226,88 @@ func (r *AccountPoolReconciler) calculateAccountPoolStatus(reqLogger logr.Logger
 		if account.IsProgressing() {
 			accountsProgressing++
 		}
+
+		// summarize the reuse counts and ages of the accounts
+		if account.Status.ReuseCount > highestReuseCount {
+			highestReuseCount = account.Status.ReuseCount
+		}
+		if !account.CreationTimestamp.IsZero() {
+			ageDays := int(now.Sub(account.CreationTimestamp.Time) / (24 * time.Hour))
+			if ageDays > oldestAccountAgeDays {
+				oldestAccountAgeDays = ageDays
+			}
+		}
 	}
 
 	accountDelta := r.calculateAccountDelta()
 
 	return awsv1alpha1.AccountPoolStatus{
-		UnclaimedAccounts:   unclaimedAccountCount,
-		ClaimedAccounts:     claimedAccountCount,
-		AvailableAccounts:   availableAccounts,
-		AccountsProgressing: accountsProgressing,
-		AWSLimitDelta:       accountDelta,
+		UnclaimedAccounts:    unclaimedAccountCount,
+		ClaimedAccounts:      claimedAccountCount,
+		AvailableAccounts:    availableAccounts,
+		AccountsProgressing:  accountsProgressing,
+		AWSLimitDelta:        accountDelta,
+		HighestReuseCount:    highestReuseCount,
+		OldestAccountAgeDays: oldestAccountAgeDays,
 	}, nil
 }
 
+// isInPool returns whether the account belongs to the pool poolName
+func (r *AccountPoolReconciler) isInPool(reqLogger logr.Logger, account *awsv1alpha1.Account, poolName string) (bool, error) {
+	// if the account is not owned by the accountpool, skip it
+	if !account.IsOwnedByAccountPool() {
+		return false, nil
+	}
+
+	// Special intermediary case until all account crs have had their account.Spec.AccountPool set appropriately.
+	// If account.Spec.AccountPool is empty, we count it as if it's from the default accountpool.
+	if account.Spec.AccountPool == "" {
+		defaultPoolName, err := config.GetDefaultAccountPoolName(reqLogger, r.Client)
+		if err != nil {
+			reqLogger.Error(err, "error getting default accountpool name")
+			return false, err
+		}
+		return poolName == defaultPoolName, nil
+	}
+	// If an accountpool name is specified, we want to count ONLY that pool
+	return account.Spec.AccountPool == poolName, nil
+}
+
+// retireAccounts retires the Ready accounts of the pool which aren't claimed and reached its MaxReuseCount or
+// MaxAccountAge. Claimed accounts are retired by the AccountClaim controller once they are released.
+func (r *AccountPoolReconciler) retireAccounts(ctx context.Context, reqLogger logr.Logger, pool *awsv1alpha1.AccountPool) error {
+	if pool.Spec.MaxReuseCount == 0 && pool.Spec.MaxAccountAge == nil {
+		return nil
+	}
+	accountList := &awsv1alpha1.AccountList{}
+	if err := r.List(ctx, accountList, client.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		return err
+	}
+	now := time.Now()
+	for i := range accountList.Items {
+		acct := &accountList.Items[i]
+		if acct.IsBYOC() || !acct.IsReady() || acct.IsClaimed() || acct.HasClaimLink() || acct.IsPendingDeletion() {
+			continue
+		}
+		inPool, err := r.isInPool(reqLogger, acct, pool.Name)
+		if err != nil {
+			return err
+		}
+		if !inPool {
+			continue
+		}
+		reason := account.RetirementReason(pool, acct.Status.ReuseCount, acct.CreationTimestamp.Time, now)
+		if reason == "" {
+			continue
+		}
+		err = account.RetireAccount(ctx, reqLogger, r.Client, acct, reason)
+		if err != nil && !errors.IsNotFound(err) && !errors.IsConflict(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *AccountPoolReconciler) calculateAccountDelta() int {
 	accounts := r.accountWatcher.GetAccountCount()
 	limit := r.accountWatcher.GetLimit()
@@ -208,12 +317,14 @@ func (r *AccountPoolReconciler) calculateAccountDelta() int {
 
 // We only want to update the account pool status if something in the status has changed
 func shouldUpdateAccountPoolStatus(currentAccountPool *awsv1alpha1.AccountPool, calculatedStatus awsv1alpha1.AccountPoolStatus) bool {
-	return currentAccountPool.Status != calculatedStatus
+	return !equality.Semantic.DeepEqual(currentAccountPool.Status, calculatedStatus)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AccountPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = utils.NewSecretAuditClient(r.Client, controllerName)
 	r.accountWatcher = totalaccountwatcher.TotalAccountWatcher
+	r.awsClientBuilder = &awsclient.Builder{}
 	maxReconciles, err := utils.GetControllerMaxReconciles(controllerName)
 	if err != nil {
 		log.Error(err, "missing max reconciles for controller", "controller", controllerName)
@@ -221,8 +332,8 @@ func (r *AccountPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&awsv1alpha1.AccountPool{}).
-		Owns(&awsv1alpha1.Account{}).
+		For(&awsv1alpha1.AccountPool{}, utils.WithReconcilePredicates(controllerName, nil)).
+		Owns(&awsv1alpha1.Account{}, utils.WithReconcilePredicates(controllerName, utils.AccountStatusTrigger)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}).Complete(rwm)
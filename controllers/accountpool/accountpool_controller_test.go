@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -301,6 +302,11 @@ func verifyAccountCreated(c client.Client, expected *awsv1alpha1.AccountPool) bo
 
 	unclaimedAccountCount := 0
 	for _, account := range al.Items {
+		// Accounts created by the pool have the name and email of their AWS account set
+		if account.Spec.AccountPool == expected.Name && !account.HasState() &&
+			(account.Spec.AccountName != account.Name || account.Spec.Email == "") {
+			return false
+		}
 		// We don't want to count reused accounts here, filter by LegalEntity.ID
 		if account.Status.Claimed == false && account.Spec.LegalEntity.ID == "" {
 			if account.Status.State != "Failed" {
@@ -378,3 +384,48 @@ func TestUpdateAccountPoolStatus(t *testing.T) {
 		t.Error("AccountPool status doesn't need updating, but function returns true")
 	}
 }
+
+func TestRetireAccounts(t *testing.T) {
+	err := awsaccountapis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding to scheme in accountpoot_controller_test.go")
+	}
+
+	localmetrics.Collector = localmetrics.NewMetricsCollector(nil)
+	pool := &awsv1alpha1.AccountPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: awsv1alpha1.AccountCrNamespace},
+		Spec: awsv1alpha1.AccountPoolSpec{
+			PoolSize:      1,
+			MaxReuseCount: 2,
+			MaxAccountAge: &metav1.Duration{Duration: 365 * 24 * time.Hour},
+		},
+	}
+	poolAccount := func(name string, reuseCount int, age time.Duration, claimLink string) *awsv1alpha1.Account {
+		account := createAccountMock(name, "Ready", unclaimed)
+		account.Spec.AccountPool = pool.Name
+		account.Spec.ClaimLink = claimLink
+		account.Status.ReuseCount = reuseCount
+		account.CreationTimestamp = metav1.NewTime(time.Now().Add(-age))
+		return account
+	}
+
+	mocks := setupDefaultMocks(t, []runtime.Object{
+		pool,
+		poolAccount("within-limits", 2, 24*time.Hour, ""),
+		poolAccount("reused-too-often", 3, 24*time.Hour, ""),
+		poolAccount("too-old", 0, 400*24*time.Hour, ""),
+		poolAccount("claimed-too-old", 0, 400*24*time.Hour, "claim"),
+	})
+	defer mocks.mockCtrl.Finish()
+	r := AccountPoolReconciler{Client: mocks.fakeKubeClient, Scheme: scheme.Scheme}
+
+	assert.NoError(t, r.retireAccounts(context.TODO(), log, pool))
+
+	accounts := &awsv1alpha1.AccountList{}
+	assert.NoError(t, mocks.fakeKubeClient.List(context.TODO(), accounts))
+	remaining := []string{}
+	for _, account := range accounts.Items {
+		remaining = append(remaining, account.Name)
+	}
+	assert.ElementsMatch(t, []string{"within-limits", "claimed-too-old"}, remaining)
+}
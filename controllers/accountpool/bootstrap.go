@@ -0,0 +1,139 @@
+package accountpool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+const (
+	defaultBootstrapInterval    = time.Minute
+	defaultBootstrapMaxFailures = 3
+)
+
+func bootstrapInterval(bootstrap *awsv1alpha1.AccountPoolBootstrap) time.Duration {
+	if bootstrap.Interval == nil || bootstrap.Interval.Duration <= 0 {
+		return defaultBootstrapInterval
+	}
+	return bootstrap.Interval.Duration
+}
+
+func bootstrapMaxFailures(bootstrap *awsv1alpha1.AccountPoolBootstrap) int {
+	if bootstrap.MaxFailures <= 0 {
+		return defaultBootstrapMaxFailures
+	}
+	return bootstrap.MaxFailures
+}
+
+// bootstrapStatus returns the progress of the bootstrap of the pool, counted from the accounts labeled with its ID, or
+// nil when the pool has no bootstrap. A new bootstrap gets the generation of the pool as ID. Stopped and completed
+// bootstraps stay so, while accounts keep being counted.
+func (r *AccountPoolReconciler) bootstrapStatus(ctx context.Context, pool *awsv1alpha1.AccountPool, now time.Time) (*awsv1alpha1.AccountPoolBootstrapStatus, error) {
+	bootstrap := pool.Spec.Bootstrap
+	if bootstrap == nil {
+		return nil, nil
+	}
+	status := pool.Status.Bootstrap.DeepCopy()
+	if status == nil {
+		status = &awsv1alpha1.AccountPoolBootstrapStatus{
+			ID:        strconv.FormatInt(pool.Generation, 10),
+			Phase:     awsv1alpha1.BootstrapPhaseInProgress,
+			StartTime: metav1.NewTime(now),
+		}
+	}
+
+	accountList := &awsv1alpha1.AccountList{}
+	err := r.List(ctx, accountList, client.InNamespace(awsv1alpha1.AccountCrNamespace),
+		client.MatchingLabels{awsv1alpha1.AccountPoolBootstrapLabel: status.ID})
+	if err != nil {
+		return nil, err
+	}
+	listed, verifying, ready, failed := 0, 0, 0, 0
+	for i := range accountList.Items {
+		acct := &accountList.Items[i]
+		if acct.Spec.AccountPool != pool.Name {
+			continue
+		}
+		listed++
+		switch {
+		case acct.IsFailed():
+			failed++
+		case acct.IsReady() || acct.HasBeenClaimedAtLeastOnce():
+			ready++
+		default:
+			verifying++
+		}
+	}
+	// The accounts just created may not be listed yet
+	if listed > status.Created {
+		status.Created = listed
+	}
+	status.Verifying = verifying
+	status.Ready = ready
+	status.Failed = failed
+
+	if status.Phase == awsv1alpha1.BootstrapPhaseInProgress {
+		switch {
+		case failed >= bootstrapMaxFailures(bootstrap):
+			status.Phase = awsv1alpha1.BootstrapPhaseStopped
+			status.Message = fmt.Sprintf("%d accounts failed, no more accounts are created until the bootstrap is removed", failed)
+		case ready >= bootstrap.Accounts:
+			status.Phase = awsv1alpha1.BootstrapPhaseCompleted
+			status.Message = fmt.Sprintf("%d accounts are ready", ready)
+		}
+	}
+	return status, nil
+}
+
+// bootstrapAccounts creates the next account of the bootstrap of the pool once the interval since the last one passed.
+// The creation is recorded in the status of the pool before the account is created, so an account whose creation
+// isn't cached yet isn't created twice.
+func (r *AccountPoolReconciler) bootstrapAccounts(ctx context.Context, reqLogger logr.Logger, pool *awsv1alpha1.AccountPool) (reconcile.Result, error) {
+	bootstrap := pool.Spec.Bootstrap
+	status := pool.Status.Bootstrap
+	if status.Phase != awsv1alpha1.BootstrapPhaseInProgress || status.Created >= bootstrap.Accounts {
+		return reconcile.Result{}, nil
+	}
+	interval := bootstrapInterval(bootstrap)
+	if status.LastCreationTime != nil {
+		if wait := time.Until(status.LastCreationTime.Add(interval)); wait > 0 {
+			return reconcile.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	newAccount, err := r.newPoolAccount(ctx, reqLogger, pool)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if newAccount.Labels == nil {
+		newAccount.Labels = map[string]string{}
+	}
+	newAccount.Labels[awsv1alpha1.AccountPoolBootstrapLabel] = status.ID
+
+	now := metav1.Now()
+	status.Created++
+	status.LastCreationTime = &now
+	err = r.Status().Update(ctx, pool)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	reqLogger.Info(fmt.Sprintf("Creating account %s %d/%d of the bootstrap of the accountpool", newAccount.Name, status.Created, bootstrap.Accounts))
+	err = r.Create(ctx, newAccount)
+	if err != nil {
+		status.Created--
+		if updateErr := r.Status().Update(ctx, pool); updateErr != nil {
+			reqLogger.Error(updateErr, "failed to roll back the bootstrap account count")
+		}
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
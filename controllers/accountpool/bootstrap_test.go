@@ -0,0 +1,179 @@
+package accountpool
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsaccountapis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+func bootstrapPool(accounts int, status *awsv1alpha1.AccountPoolBootstrapStatus) *awsv1alpha1.AccountPool {
+	return &awsv1alpha1.AccountPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: awsv1alpha1.AccountCrNamespace, Generation: 2},
+		Spec: awsv1alpha1.AccountPoolSpec{
+			PoolSize:  1,
+			Bootstrap: &awsv1alpha1.AccountPoolBootstrap{Accounts: accounts, MaxFailures: 2},
+		},
+		Status: awsv1alpha1.AccountPoolStatus{Bootstrap: status},
+	}
+}
+
+func bootstrapAccount(name string, state string, id string) *awsv1alpha1.Account {
+	account := createAccountMock(name, state, unclaimed)
+	account.Spec.AccountPool = "test"
+	account.Spec.ClaimLink = ""
+	account.Labels = map[string]string{awsv1alpha1.AccountPoolBootstrapLabel: id}
+	return account
+}
+
+func TestBootstrapStatus(t *testing.T) {
+	err := awsaccountapis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding to scheme in bootstrap_test.go")
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		pool     *awsv1alpha1.AccountPool
+		accounts []runtime.Object
+		expected *awsv1alpha1.AccountPoolBootstrapStatus
+	}{
+		{
+			name:     "pool without bootstrap",
+			pool:     &awsv1alpha1.AccountPool{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			expected: nil,
+		},
+		{
+			name: "new bootstrap",
+			pool: bootstrapPool(3, nil),
+			expected: &awsv1alpha1.AccountPoolBootstrapStatus{
+				ID: "2", Phase: awsv1alpha1.BootstrapPhaseInProgress, StartTime: metav1.NewTime(now),
+			},
+		},
+		{
+			name: "bootstrap in progress",
+			pool: bootstrapPool(3, &awsv1alpha1.AccountPoolBootstrapStatus{ID: "1", Phase: awsv1alpha1.BootstrapPhaseInProgress, Created: 4}),
+			accounts: []runtime.Object{
+				bootstrapAccount("ready", "Ready", "1"),
+				bootstrapAccount("creating", "Creating", "1"),
+				bootstrapAccount("failed", "Failed", "1"),
+				bootstrapAccount("earlier-bootstrap", "Ready", "0"),
+			},
+			expected: &awsv1alpha1.AccountPoolBootstrapStatus{
+				ID: "1", Phase: awsv1alpha1.BootstrapPhaseInProgress, Created: 4, Verifying: 1, Ready: 1, Failed: 1,
+			},
+		},
+		{
+			name: "bootstrap completed",
+			pool: bootstrapPool(1, &awsv1alpha1.AccountPoolBootstrapStatus{ID: "1", Phase: awsv1alpha1.BootstrapPhaseInProgress, Created: 1}),
+			accounts: []runtime.Object{
+				bootstrapAccount("ready", "Ready", "1"),
+			},
+			expected: &awsv1alpha1.AccountPoolBootstrapStatus{
+				ID: "1", Phase: awsv1alpha1.BootstrapPhaseCompleted, Created: 1, Ready: 1, Message: "1 accounts are ready",
+			},
+		},
+		{
+			name: "bootstrap stopped by failures",
+			pool: bootstrapPool(3, &awsv1alpha1.AccountPoolBootstrapStatus{ID: "1", Phase: awsv1alpha1.BootstrapPhaseInProgress, Created: 2}),
+			accounts: []runtime.Object{
+				bootstrapAccount("failed-1", "Failed", "1"),
+				bootstrapAccount("failed-2", "Failed", "1"),
+			},
+			expected: &awsv1alpha1.AccountPoolBootstrapStatus{
+				ID: "1", Phase: awsv1alpha1.BootstrapPhaseStopped, Created: 2, Failed: 2,
+				Message: "2 accounts failed, no more accounts are created until the bootstrap is removed",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mocks := setupDefaultMocks(t, test.accounts)
+			defer mocks.mockCtrl.Finish()
+			r := AccountPoolReconciler{Client: mocks.fakeKubeClient, Scheme: scheme.Scheme}
+
+			status, err := r.bootstrapStatus(context.TODO(), test.pool, now)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, status)
+		})
+	}
+}
+
+func TestBootstrapAccounts(t *testing.T) {
+	err := awsaccountapis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding to scheme in bootstrap_test.go")
+	}
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+		Data:       map[string]string{"accountpool": "test: {\"default\": true}"},
+	}
+	bootstrapAccounts := func(t *testing.T, mocks *mocks) []awsv1alpha1.Account {
+		accounts := &awsv1alpha1.AccountList{}
+		assert.NoError(t, mocks.fakeKubeClient.List(context.TODO(), accounts, client.HasLabels{awsv1alpha1.AccountPoolBootstrapLabel}))
+		return accounts.Items
+	}
+
+	t.Run("creates the next account of the bootstrap", func(t *testing.T) {
+		pool := bootstrapPool(2, &awsv1alpha1.AccountPoolBootstrapStatus{ID: "1", Phase: awsv1alpha1.BootstrapPhaseInProgress})
+		mocks := setupDefaultMocks(t, []runtime.Object{pool, configmap})
+		defer mocks.mockCtrl.Finish()
+		r := AccountPoolReconciler{Client: mocks.fakeKubeClient, Scheme: scheme.Scheme}
+		assert.NoError(t, mocks.fakeKubeClient.Get(context.TODO(), client.ObjectKeyFromObject(pool), pool))
+
+		result, err := r.bootstrapAccounts(context.TODO(), log, pool)
+		assert.NoError(t, err)
+		assert.Equal(t, defaultBootstrapInterval, result.RequeueAfter)
+
+		accounts := bootstrapAccounts(t, mocks)
+		assert.Len(t, accounts, 1)
+		assert.Equal(t, "1", accounts[0].Labels[awsv1alpha1.AccountPoolBootstrapLabel])
+		assert.Equal(t, "test", accounts[0].Spec.AccountPool)
+
+		updated := &awsv1alpha1.AccountPool{}
+		assert.NoError(t, mocks.fakeKubeClient.Get(context.TODO(), client.ObjectKeyFromObject(pool), updated))
+		assert.Equal(t, 1, updated.Status.Bootstrap.Created)
+		assert.NotNil(t, updated.Status.Bootstrap.LastCreationTime)
+	})
+
+	t.Run("waits for the interval since the last creation", func(t *testing.T) {
+		lastCreation := metav1.NewTime(time.Now().Add(-30 * time.Second))
+		pool := bootstrapPool(2, &awsv1alpha1.AccountPoolBootstrapStatus{ID: "1", Phase: awsv1alpha1.BootstrapPhaseInProgress, Created: 1, LastCreationTime: &lastCreation})
+		mocks := setupDefaultMocks(t, []runtime.Object{pool, configmap})
+		defer mocks.mockCtrl.Finish()
+		r := AccountPoolReconciler{Client: mocks.fakeKubeClient, Scheme: scheme.Scheme}
+
+		result, err := r.bootstrapAccounts(context.TODO(), log, pool)
+		assert.NoError(t, err)
+		assert.InDelta(t, 30*time.Second, result.RequeueAfter, float64(5*time.Second))
+		assert.Empty(t, bootstrapAccounts(t, mocks))
+	})
+
+	t.Run("doesn't create accounts once all were created or the bootstrap stopped", func(t *testing.T) {
+		for _, status := range []*awsv1alpha1.AccountPoolBootstrapStatus{
+			{ID: "1", Phase: awsv1alpha1.BootstrapPhaseInProgress, Created: 2},
+			{ID: "1", Phase: awsv1alpha1.BootstrapPhaseStopped, Created: 1, Failed: 1},
+		} {
+			pool := bootstrapPool(2, status)
+			mocks := setupDefaultMocks(t, []runtime.Object{pool, configmap})
+			r := AccountPoolReconciler{Client: mocks.fakeKubeClient, Scheme: scheme.Scheme}
+
+			result, err := r.bootstrapAccounts(context.TODO(), log, pool)
+			assert.NoError(t, err)
+			assert.Zero(t, result.RequeueAfter)
+			assert.Empty(t, bootstrapAccounts(t, mocks))
+			mocks.mockCtrl.Finish()
+		}
+	})
+}
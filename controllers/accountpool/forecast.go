@@ -0,0 +1,90 @@
+package accountpool
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+// claimArrivalWindow is the history the claim rate of a pool is computed over
+const claimArrivalWindow = 24 * time.Hour
+
+// poolClaimHistory remembers the claims of the accounts of every pool over claimArrivalWindow. The claims are read
+// from the AccountIsClaimed condition of the accounts, so the history survives restarts of the operator, and are
+// remembered once the account is deleted.
+var poolClaimHistory = newClaimHistory()
+
+// claimHistory holds the claim times of the accounts of the pools, by pool and by account and claim time so the
+// claims of a reused account count separately
+type claimHistory struct {
+	mu       sync.Mutex
+	arrivals map[string]map[string]time.Time
+}
+
+func newClaimHistory() *claimHistory {
+	return &claimHistory{arrivals: map[string]map[string]time.Time{}}
+}
+
+// observe records the claim of the account, when it's still within the window
+func (h *claimHistory) observe(poolName string, account *awsv1alpha1.Account, now time.Time) {
+	claimed := account.GetCondition(awsv1alpha1.AccountConditionType(awsv1alpha1.AccountIsClaimed))
+	if claimed == nil || claimed.Status != corev1.ConditionTrue || claimed.LastTransitionTime.IsZero() {
+		return
+	}
+	claimedAt := claimed.LastTransitionTime.Time
+	if now.Sub(claimedAt) > claimArrivalWindow {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.arrivals[poolName] == nil {
+		h.arrivals[poolName] = map[string]time.Time{}
+	}
+	h.arrivals[poolName][fmt.Sprintf("%s@%d", account.Name, claimedAt.Unix())] = claimedAt
+}
+
+// claimsPerHour returns the rate at which the accounts of the pool were claimed over the window, forgetting the
+// claims older than that
+func (h *claimHistory) claimsPerHour(poolName string, now time.Time) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, claimedAt := range h.arrivals[poolName] {
+		if now.Sub(claimedAt) > claimArrivalWindow {
+			delete(h.arrivals[poolName], key)
+		}
+	}
+	return float64(len(h.arrivals[poolName])) / claimArrivalWindow.Hours()
+}
+
+// forget drops the history of a deleted pool
+func (h *claimHistory) forget(poolName string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.arrivals, poolName)
+}
+
+// forecastExhaustion returns when the available accounts of the pool run out at the claim rate, nil when the accounts
+// of the pool aren't claimed
+func forecastExhaustion(available int, claimsPerHour float64, now time.Time) *time.Time {
+	if claimsPerHour <= 0 {
+		return nil
+	}
+	exhaustion := now.Add(time.Duration(float64(available) / claimsPerHour * float64(time.Hour)))
+	return &exhaustion
+}
+
+// reportForecast exposes the claim rate of the pool and when its available accounts run out at that rate
+func reportForecast(accountPool *awsv1alpha1.AccountPool, status awsv1alpha1.AccountPoolStatus, now time.Time) {
+	if localmetrics.Collector == nil {
+		return
+	}
+	claimsPerHour := poolClaimHistory.claimsPerHour(accountPool.Name, now)
+	localmetrics.Collector.SetAccountPoolForecast(accountPool.Namespace, accountPool.Name, claimsPerHour,
+		forecastExhaustion(status.AvailableAccounts, claimsPerHour, now))
+}
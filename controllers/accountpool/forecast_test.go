@@ -0,0 +1,57 @@
+package accountpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+func claimedAccount(name string, claimedAt time.Time) *awsv1alpha1.Account {
+	return &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: awsv1alpha1.AccountStatus{
+			Claimed: true,
+			Conditions: []awsv1alpha1.AccountCondition{{
+				Type:               awsv1alpha1.AccountConditionType(awsv1alpha1.AccountIsClaimed),
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(claimedAt),
+			}},
+		},
+	}
+}
+
+func TestClaimHistory(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	history := newClaimHistory()
+
+	history.observe("pool", claimedAccount("a", now.Add(-time.Hour)), now)
+	history.observe("pool", claimedAccount("a", now.Add(-time.Hour)), now)
+	history.observe("pool", claimedAccount("b", now.Add(-2*time.Hour)), now)
+	// Reused accounts count once per claim
+	history.observe("pool", claimedAccount("b", now.Add(-3*time.Hour)), now)
+	history.observe("pool", claimedAccount("c", now.Add(-25*time.Hour)), now)
+	history.observe("pool", &awsv1alpha1.Account{ObjectMeta: metav1.ObjectMeta{Name: "unclaimed"}}, now)
+	history.observe("other", claimedAccount("d", now.Add(-time.Hour)), now)
+
+	assert.InDelta(t, 3.0/24, history.claimsPerHour("pool", now), 1e-9)
+	assert.InDelta(t, 1.0/24, history.claimsPerHour("other", now), 1e-9)
+
+	// Claims are remembered until they leave the window, whether the account is still there or not
+	assert.InDelta(t, 1.0/24, history.claimsPerHour("pool", now.Add(22*time.Hour+30*time.Minute)), 1e-9)
+
+	history.forget("pool")
+	assert.Zero(t, history.claimsPerHour("pool", now))
+}
+
+func TestForecastExhaustion(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, forecastExhaustion(10, 0, now), "no forecast without claims")
+	assert.Equal(t, now.Add(5*time.Hour), *forecastExhaustion(10, 2, now))
+	assert.Equal(t, now, *forecastExhaustion(0, 2, now))
+}
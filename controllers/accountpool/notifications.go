@@ -0,0 +1,32 @@
+package accountpool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// notifyPoolLow publishes a notification when the available accounts of the pool drop under the pool low threshold
+func (r *AccountPoolReconciler) notifyPoolLow(ctx context.Context, reqLogger logr.Logger, accountPool *awsv1alpha1.AccountPool, previous awsv1alpha1.AccountPoolStatus, current awsv1alpha1.AccountPoolStatus) {
+	if accountPool.Spec.PoolSize == 0 || current.AvailableAccounts >= previous.AvailableAccounts {
+		return
+	}
+	threshold, err := r.operatorConfig().PoolLowThreshold(ctx)
+	if err != nil {
+		reqLogger.Error(err, "failed reading the pool low threshold")
+		return
+	}
+	if current.AvailableAccounts >= threshold || previous.AvailableAccounts < threshold {
+		return
+	}
+	utils.PublishNotification(ctx, r.operatorConfig(), reqLogger, utils.Notification{
+		Event:   utils.NotificationPoolLow,
+		Kind:    "AccountPool",
+		Name:    accountPool.Name,
+		Message: fmt.Sprintf("%d accounts available out of a pool size of %d, %d progressing", current.AvailableAccounts, accountPool.Spec.PoolSize, current.AccountsProgressing),
+	})
+}
@@ -0,0 +1,72 @@
+package accountpool
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+func TestNotifyPoolLow(t *testing.T) {
+	tests := []struct {
+		name              string
+		poolSize          int
+		previousAvailable int
+		currentAvailable  int
+		expected          bool
+	}{
+		{name: "drops under the threshold", poolSize: 5, previousAvailable: 2, currentAvailable: 1, expected: true},
+		{name: "stays above the threshold", poolSize: 5, previousAvailable: 4, currentAvailable: 3},
+		{name: "already under the threshold", poolSize: 5, previousAvailable: 1, currentAvailable: 0},
+		{name: "grows", poolSize: 5, previousAvailable: 0, currentAvailable: 1},
+		{name: "empty pool", poolSize: 0, previousAvailable: 2, currentAvailable: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received := []utils.Notification{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				notification := utils.Notification{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&notification))
+				received = append(received, notification)
+			}))
+			defer server.Close()
+
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+				Data: map[string]string{
+					"notifications": "poolLowThreshold: 2\nsinks:\n- type: webhook\n  url: " + server.URL + "\n",
+				},
+			}
+			pool := &awsv1alpha1.AccountPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: awsv1alpha1.AccountCrNamespace},
+				Spec:       awsv1alpha1.AccountPoolSpec{PoolSize: test.poolSize},
+			}
+			r := &AccountPoolReconciler{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()}
+
+			r.notifyPoolLow(context.TODO(), logr.Discard(), pool,
+				awsv1alpha1.AccountPoolStatus{AvailableAccounts: test.previousAvailable},
+				awsv1alpha1.AccountPoolStatus{AvailableAccounts: test.currentAvailable},
+			)
+
+			if !test.expected {
+				assert.Empty(t, received)
+				return
+			}
+			assert.Len(t, received, 1)
+			assert.Equal(t, utils.NotificationPoolLow, received[0].Event)
+			assert.Equal(t, pool.Name, received[0].Name)
+		})
+	}
+}
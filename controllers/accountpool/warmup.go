@@ -0,0 +1,194 @@
+package accountpool
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/controllers/account"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	warmUpFeatureFlag        = "feature.accountpool_warmup"
+	warmUpConcurrencyKey     = "accountpool.warmup_concurrency"
+	defaultWarmUpConcurrency = 5
+)
+
+// warmUpPhase is a step of the pool warm-up pipeline. Phases run in order; within a phase the eligible
+// accounts are processed in parallel.
+type warmUpPhase struct {
+	name string
+	// eligible returns true if the account still needs the phase and its prerequisites are met
+	eligible func(acct *awsv1alpha1.Account) bool
+	// run does the AWS work and records the result on the account status. It is called with a
+	// freshly read account and may be retried on update conflicts.
+	run func(r *AccountPoolReconciler, reqLogger logr.Logger, awsSetupClient awsclient.Client, acct *awsv1alpha1.Account) error
+}
+
+// warmUpPhases front-load the slow parts of the account workflow: the Enterprise Support case and the
+// service quota requests are opened while the account is still opting into and initializing regions,
+// instead of only once it reaches PendingVerification.
+var warmUpPhases = []warmUpPhase{
+	{
+		name: "SupportEnrollment",
+		eligible: func(acct *awsv1alpha1.Account) bool {
+			return isWarmingUp(acct) && !acct.HasSupportCaseID()
+		},
+		run: func(r *AccountPoolReconciler, reqLogger logr.Logger, awsSetupClient awsclient.Client, acct *awsv1alpha1.Account) error {
+			caseID, err := account.CreateCase(reqLogger, acct, awsSetupClient)
+			if err != nil {
+				return err
+			}
+			return r.updateAccountStatus(acct, func(latest *awsv1alpha1.Account) error {
+				if !latest.HasSupportCaseID() {
+					latest.Status.SupportCaseID = caseID
+				}
+				return nil
+			})
+		},
+	},
+	{
+		name: "ServiceQuotas",
+		eligible: func(acct *awsv1alpha1.Account) bool {
+			// Quotas are set per enabled region, so wait until the opt-in regions are enabled
+			return isWarmingUp(acct) && acct.HasSupportCaseID() &&
+				acct.Spec.RegionalServiceQuotas != nil && len(acct.Status.RegionalServiceQuotas) == 0 &&
+				(acct.Status.State == string(awsv1alpha1.AccountInitializingRegions) || acct.Status.State == string(awsv1alpha1.AccountOptInRegionEnabled))
+		},
+		run: func(r *AccountPoolReconciler, reqLogger logr.Logger, awsSetupClient awsclient.Client, acct *awsv1alpha1.Account) error {
+			return r.updateAccountStatus(acct, func(latest *awsv1alpha1.Account) error {
+				if len(latest.Status.RegionalServiceQuotas) > 0 {
+					return nil
+				}
+				return account.SetCurrentAccountServiceQuotas(reqLogger, r.awsClientBuilder, awsSetupClient, latest, r.Client)
+			})
+		},
+	},
+}
+
+// isWarmingUp returns true for pool accounts that exist in AWS but haven't reached PendingVerification yet
+func isWarmingUp(acct *awsv1alpha1.Account) bool {
+	if acct.IsBYOC() || !acct.HasAwsAccountID() || acct.IsClaimed() || acct.DeletionTimestamp != nil {
+		return false
+	}
+	switch awsv1alpha1.AccountConditionType(acct.Status.State) {
+	case awsv1alpha1.AccountCreating, awsv1alpha1.AccountOptingInRegions, awsv1alpha1.AccountOptInRegionEnabled, awsv1alpha1.AccountInitializingRegions:
+		return true
+	}
+	return false
+}
+
+func warmUpSettings(cm *corev1.ConfigMap) (bool, int) {
+	enabled, err := utils.GetFeatureFlagValue(cm, warmUpFeatureFlag)
+	if err != nil || !enabled {
+		return false, 0
+	}
+	concurrency := defaultWarmUpConcurrency
+	if value, ok := cm.Data[warmUpConcurrencyKey]; ok {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			concurrency = parsed
+		}
+	}
+	return true, concurrency
+}
+
+// warmUpAccounts runs the warm-up pipeline for the pool's accounts when enabled in the operator ConfigMap.
+// Failures are logged and retried on the next reconcile, the account controller falls back to its own
+// workflow for anything the pipeline didn't get to.
+func (r *AccountPoolReconciler) warmUpAccounts(ctx context.Context, reqLogger logr.Logger, poolName string) {
+	if utils.DetectDevMode != utils.DevModeProduction {
+		return
+	}
+	cm, err := r.operatorConfig().ConfigMap(ctx)
+	if err != nil {
+		reqLogger.Error(err, "could not retrieve the operator configmap, skipping account warm-up")
+		return
+	}
+	enabled, concurrency := warmUpSettings(cm)
+	if !enabled {
+		return
+	}
+
+	accountList := &awsv1alpha1.AccountList{}
+	if err := r.List(context.TODO(), accountList, client.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		reqLogger.Error(err, "failed listing accounts for warm-up")
+		return
+	}
+	poolAccounts := []awsv1alpha1.Account{}
+	for _, acct := range accountList.Items {
+		if acct.IsOwnedByAccountPool() && acct.Spec.AccountPool == poolName && isWarmingUp(&acct) {
+			poolAccounts = append(poolAccounts, acct)
+		}
+	}
+	if len(poolAccounts) == 0 {
+		return
+	}
+
+	awsSetupClient, err := r.awsClientBuilder.GetClient(controllerName, r.Client, awsclient.NewAwsClientInput{
+		SecretName: utils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+		AwsRegion:  config.GetDefaultRegion(),
+	})
+	if err != nil {
+		reqLogger.Error(err, "failed building operator AWS client for warm-up")
+		return
+	}
+
+	for _, phase := range warmUpPhases {
+		r.runWarmUpPhase(reqLogger.WithValues("phase", phase.name), phase, awsSetupClient, poolAccounts, concurrency)
+	}
+}
+
+func (r *AccountPoolReconciler) runWarmUpPhase(reqLogger logr.Logger, phase warmUpPhase, awsSetupClient awsclient.Client, accounts []awsv1alpha1.Account, concurrency int) {
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range accounts {
+		acct := &accounts[i]
+		if !phase.eligible(acct) {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			err := phase.run(r, reqLogger, awsSetupClient, acct)
+			if err != nil {
+				reqLogger.Error(err, "account warm-up phase failed", "account", acct.Name)
+				return
+			}
+			reqLogger.Info("account warm-up phase done", "account", acct.Name)
+		}()
+	}
+	wg.Wait()
+}
+
+// updateAccountStatus applies mutate to the latest version of the account and updates its status, retrying
+// on conflicts with the account controller. acct is refreshed with the stored result.
+func (r *AccountPoolReconciler) updateAccountStatus(acct *awsv1alpha1.Account, mutate func(latest *awsv1alpha1.Account) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &awsv1alpha1.Account{}
+		err := r.Get(context.TODO(), types.NamespacedName{Name: acct.Name, Namespace: acct.Namespace}, latest)
+		if err != nil {
+			return err
+		}
+		if err := mutate(latest); err != nil {
+			return err
+		}
+		if err := r.Status().Update(context.TODO(), latest); err != nil {
+			return err
+		}
+		latest.DeepCopyInto(acct)
+		return nil
+	})
+}
@@ -0,0 +1,95 @@
+package accountpool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/support"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsaccountapis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+)
+
+func TestIsWarmingUp(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(*awsv1alpha1.Account)
+		expected bool
+	}{
+		{name: "opting in", mutate: func(a *awsv1alpha1.Account) {}, expected: true},
+		{name: "initializing regions", mutate: func(a *awsv1alpha1.Account) { a.Status.State = awsv1alpha1.AccountInitializingRegions }, expected: true},
+		{name: "pending verification", mutate: func(a *awsv1alpha1.Account) { a.Status.State = string(awsv1alpha1.AccountPendingVerification) }, expected: false},
+		{name: "no AWS account yet", mutate: func(a *awsv1alpha1.Account) { a.Spec.AwsAccountID = "" }, expected: false},
+		{name: "claimed", mutate: func(a *awsv1alpha1.Account) { a.Status.Claimed = true }, expected: false},
+		{name: "BYOC", mutate: func(a *awsv1alpha1.Account) { a.Spec.BYOC = true }, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			acct := createAccountMock("test", string(awsv1alpha1.AccountOptingInRegions), false)
+			test.mutate(acct)
+			assert.Equal(t, test.expected, isWarmingUp(acct))
+		})
+	}
+}
+
+func TestWarmUpSettings(t *testing.T) {
+	tests := []struct {
+		name                string
+		data                map[string]string
+		expectedEnabled     bool
+		expectedConcurrency int
+	}{
+		{name: "flag missing", data: map[string]string{}, expectedEnabled: false},
+		{name: "flag disabled", data: map[string]string{warmUpFeatureFlag: "false"}, expectedEnabled: false},
+		{name: "default concurrency", data: map[string]string{warmUpFeatureFlag: "true"}, expectedEnabled: true, expectedConcurrency: defaultWarmUpConcurrency},
+		{name: "custom concurrency", data: map[string]string{warmUpFeatureFlag: "true", warmUpConcurrencyKey: "2"}, expectedEnabled: true, expectedConcurrency: 2},
+		{name: "invalid concurrency", data: map[string]string{warmUpFeatureFlag: "true", warmUpConcurrencyKey: "-1"}, expectedEnabled: true, expectedConcurrency: defaultWarmUpConcurrency},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enabled, concurrency := warmUpSettings(&corev1.ConfigMap{Data: test.data})
+			assert.Equal(t, test.expectedEnabled, enabled)
+			assert.Equal(t, test.expectedConcurrency, concurrency)
+		})
+	}
+}
+
+func TestSupportEnrollmentPhase(t *testing.T) {
+	err := awsaccountapis.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	acct := createAccountMock("warming", string(awsv1alpha1.AccountOptingInRegions), false)
+	acct.Status.SupportCaseID = ""
+	r := &AccountPoolReconciler{
+		Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(acct).Build(),
+		Scheme:           scheme.Scheme,
+		awsClientBuilder: &mock.Builder{MockController: ctrl},
+	}
+	mockAWSClient := mock.GetMockClient(r.awsClientBuilder)
+	mockAWSClient.EXPECT().DescribeCases(gomock.Any(), gomock.Any()).Return(&support.DescribeCasesOutput{}, nil).Times(1)
+	mockAWSClient.EXPECT().CreateCase(gomock.Any(), gomock.Any()).Return(&support.CreateCaseOutput{CaseId: aws.String("case-1")}, nil).Times(1)
+
+	phase := warmUpPhases[0]
+	assert.True(t, phase.eligible(acct))
+	r.runWarmUpPhase(logr.Discard(), phase, mockAWSClient, []awsv1alpha1.Account{*acct}, 1)
+
+	stored := &awsv1alpha1.Account{}
+	err = r.Get(context.TODO(), types.NamespacedName{Name: acct.Name, Namespace: acct.Namespace}, stored)
+	assert.NoError(t, err)
+	assert.Equal(t, "case-1", stored.Status.SupportCaseID)
+	assert.False(t, phase.eligible(stored))
+}
@@ -158,6 +158,10 @@ func (r *AWSFederatedAccountAccessReconciler) Reconcile(_ context.Context, reque
 		}
 
 		if err = r.syncIAMPolicy(currentFAA, requestedRole, awsClient, reqLogger); err != nil {
+			if awsclient.IsDryRunError(err) {
+				reqLogger.Info("dry-run: the IAM policy would be updated", "skipped", err.Error())
+				return reconcile.Result{}, err
+			}
 			reqLogger.Error(err, fmt.Sprintf("Failed to validate IAM policy for account access %s/%s", currentFAA.Namespace, currentFAA.Name))
 			currentFAA.Status.State = awsv1alpha1.AWSFederatedAccountStateFailed
 			SetStatuswithCondition(currentFAA, "Failed to update policy", awsv1alpha1.AWSFederatedAccountFailed, awsv1alpha1.AWSFederatedAccountStateFailed)
@@ -229,6 +233,10 @@ func (r *AWSFederatedAccountAccessReconciler) Reconcile(_ context.Context, reque
 
 	// Here create the custom policy in the cluster account
 	err = r.createOrUpdateIAMPolicy(awsClient, *requestedRole, *currentFAA)
+	if awsclient.IsDryRunError(err) {
+		reqLogger.Info("dry-run: the custom IAM policy would be created", "skipped", err.Error())
+		return reconcile.Result{}, err
+	}
 	if err != nil {
 		// if we were unable to create the policy fail this CR.
 		SetStatuswithCondition(currentFAA, "Failed to create custom policy", awsv1alpha1.AWSFederatedAccountFailed, awsv1alpha1.AWSFederatedAccountStateFailed)
@@ -245,7 +253,10 @@ func (r *AWSFederatedAccountAccessReconciler) Reconcile(_ context.Context, reque
 
 	// Create role and apply custom policies and awsmanagedpolicies
 	role, err := r.createOrUpdateIAMRole(awsClient, *requestedRole, *currentFAA, reqLogger)
-
+	if awsclient.IsDryRunError(err) {
+		reqLogger.Info("dry-run: the IAM role would be created", "skipped", err.Error())
+		return reconcile.Result{}, err
+	}
 	if err != nil {
 		SetStatuswithCondition(currentFAA, "Failed to create role", awsv1alpha1.AWSFederatedAccountFailed, awsv1alpha1.AWSFederatedAccountStateFailed)
 		reqLogger.Error(ErrFederatedAccessRoleFailedCreate, fmt.Sprintf("Unable to create role requested by '%s'", currentFAA.Name), "AWS ERROR: ", err)
@@ -273,6 +284,10 @@ func (r *AWSFederatedAccountAccessReconciler) Reconcile(_ context.Context, reque
 
 	// Attach the requested policy to the newly created role
 	err = r.attachIAMPolices(awsClient, currentFAA.Spec.AWSFederatedRole.Name+"-"+uidLabel, policyArns)
+	if awsclient.IsDryRunError(err) {
+		reqLogger.Info("dry-run: the policies would be attached to the IAM role", "skipped", err.Error())
+		return reconcile.Result{}, err
+	}
 	if err != nil {
 		//TODO() role should be deleted here so that we leave nothing behind.
 
@@ -326,6 +341,11 @@ func (r *AWSFederatedAccountAccessReconciler) syncIAMPolicy(currentFAA *awsv1alp
 	}
 	roleName := fmt.Sprintf("%s-%s", requestedRole.Name, uid)
 	policyName := fmt.Sprintf("%s-%s", requestedRole.Spec.AWSCustomPolicy.Name, uid)
+	ownership := awsclient.ObjectIAMOwnership(currentFAA)
+	if err := awsclient.EnsureRoleOwnershipTags(awsClient, roleName, ownership); err != nil {
+		reqLogger.Error(err, fmt.Sprintf("Failed to update the ownership tags of role %s", roleName))
+		return err
+	}
 	awsRolePolicies, err := awsClient.ListAttachedRolePolicies(context.TODO(), &iam.ListAttachedRolePoliciesInput{RoleName: &roleName})
 	if err != nil {
 		reqLogger.Error(err, fmt.Sprintf("Failed to list policies for role %s from AWS", roleName))
@@ -334,6 +354,10 @@ func (r *AWSFederatedAccountAccessReconciler) syncIAMPolicy(currentFAA *awsv1alp
 
 	for _, awsAttachedPolicy := range awsRolePolicies.AttachedPolicies {
 		if *awsAttachedPolicy.PolicyName == policyName {
+			if err := awsclient.EnsurePolicyOwnershipTags(awsClient, aws.ToString(awsAttachedPolicy.PolicyArn), ownership); err != nil {
+				reqLogger.Error(err, fmt.Sprintf("Failed to update the ownership tags of policy %s", policyName))
+				return err
+			}
 			awsPolicy, err := awsClient.GetPolicy(context.TODO(), &iam.GetPolicyInput{PolicyArn: awsAttachedPolicy.PolicyArn})
 			if err != nil {
 				reqLogger.Error(err, fmt.Sprintf("Failed to get policy %s for role %s from AWS", *awsAttachedPolicy.PolicyName, roleName))
@@ -420,6 +444,7 @@ func (r *AWSFederatedAccountAccessReconciler) createIAMPolicy(awsClient awsclien
 		PolicyName:     aws.String(policyName),
 		Description:    aws.String(afr.Spec.AWSCustomPolicy.Description),
 		PolicyDocument: aws.String(string(jsonPolicyDoc)),
+		Tags:           awsclient.ObjectIAMOwnership(&afaa).Tags(),
 	})
 	if err != nil {
 		return nil, err
@@ -469,6 +494,7 @@ func (r *AWSFederatedAccountAccessReconciler) createIAMRole(awsClient awsclient.
 		RoleName:                 aws.String(roleName),
 		Description:              aws.String(afr.Spec.RoleDescription),
 		AssumeRolePolicyDocument: aws.String(string(jsonAssumeRolePolicyDoc)),
+		Tags:                     awsclient.ObjectIAMOwnership(&afaa).Tags(),
 	})
 	if err != nil {
 		return nil, err
@@ -836,8 +862,27 @@ func getPolicyNameWithUID(crPolicyName string, uidLabel string) string {
 	return crPolicyName
 }
 
+// RecordPanic records a panic of the reconcile of the AWSFederatedAccountAccess in its Failed condition, see
+// controllerutils.PanicRecorder
+func (r *AWSFederatedAccountAccessReconciler) RecordPanic(ctx context.Context, request ctrl.Request, message string) error {
+	currentFAA := &awsv1alpha1.AWSFederatedAccountAccess{}
+	if err := r.Get(ctx, request.NamespacedName, currentFAA); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	currentFAA.Status.Conditions = controllerutils.SetAWSFederatedAccountAccessCondition(
+		currentFAA.Status.Conditions,
+		awsv1alpha1.AWSFederatedAccountFailed,
+		corev1.ConditionTrue,
+		controllerutils.ReconcilePanicReason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+	)
+	return r.Status().Update(ctx, currentFAA)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AWSFederatedAccountAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = controllerutils.NewSecretAuditClient(r.Client, controllerName)
 	r.awsClientBuilder = &awsclient.Builder{}
 	maxReconciles, err := controllerutils.GetControllerMaxReconciles(controllerName)
 	if err != nil {
@@ -846,7 +891,7 @@ func (r *AWSFederatedAccountAccessReconciler) SetupWithManager(mgr ctrl.Manager)
 
 	rwm := controllerutils.NewReconcilerWithMetrics(r, controllerName)
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&awsv1alpha1.AWSFederatedAccountAccess{}).
+		For(&awsv1alpha1.AWSFederatedAccountAccess{}, controllerutils.WithReconcilePredicates(controllerName, controllerutils.AWSFederatedAccountAccessStatusTrigger)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}).Complete(rwm)
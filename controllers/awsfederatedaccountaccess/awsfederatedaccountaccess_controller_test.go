@@ -13,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
 	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
 	"github.com/openshift/aws-account-operator/pkg/testutils"
 	"github.com/openshift/aws-account-operator/pkg/utils"
@@ -351,6 +352,7 @@ func TestCreateOrUpdateIAMPolicy(t *testing.T) {
 		PolicyName:     aws.String(policyName),
 		Description:    aws.String(afr.Spec.AWSCustomPolicy.Description),
 		PolicyDocument: aws.String(string(jsonPolicyDoc)),
+		Tags:           awsclient.ObjectIAMOwnership(&afaa).Tags(),
 	}).Return(
 		nil,
 		&iamtypes.EntityAlreadyExistsException{Message: aws.String("")},
@@ -366,6 +368,7 @@ func TestCreateOrUpdateIAMPolicy(t *testing.T) {
 		PolicyName:     aws.String(policyName),
 		Description:    aws.String(afr.Spec.AWSCustomPolicy.Description),
 		PolicyDocument: aws.String(string(jsonPolicyDoc)),
+		Tags:           awsclient.ObjectIAMOwnership(&afaa).Tags(),
 	}).Return(
 		&iam.CreatePolicyOutput{
 			Policy: &iamtypes.Policy{},
@@ -434,6 +437,7 @@ func TestCreateOrUpdateIAMRole(t *testing.T) {
 		RoleName:                 aws.String(roleName),
 		Description:              aws.String(afr.Spec.RoleDescription),
 		AssumeRolePolicyDocument: aws.String(string(jsonAssumeRolePolicyDoc)),
+		Tags:                     awsclient.ObjectIAMOwnership(&afaa).Tags(),
 	}).Return(
 		nil,
 		&iamtypes.EntityAlreadyExistsException{Message: aws.String("")},
@@ -449,6 +453,7 @@ func TestCreateOrUpdateIAMRole(t *testing.T) {
 		RoleName:                 aws.String(roleName),
 		Description:              aws.String(afr.Spec.RoleDescription),
 		AssumeRolePolicyDocument: aws.String(string(jsonAssumeRolePolicyDoc)),
+		Tags:                     awsclient.ObjectIAMOwnership(&afaa).Tags(),
 	}).Return(
 		createRoleOutput,
 		nil,
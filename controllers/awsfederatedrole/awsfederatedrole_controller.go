@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -144,6 +145,8 @@ func (r *AWSFederatedRoleReconciler) Reconcile(_ context.Context, request ctrl.R
 		Description:    &instance.Spec.AWSCustomPolicy.Description,
 		PolicyName:     &instance.Spec.AWSCustomPolicy.Name,
 		PolicyDocument: &jsonPolicy,
+		// Tagged so a policy left behind by a failed delete is swept like the other resources of the operator
+		Tags: awsclient.ObjectIAMOwnership(instance).Tags(),
 	})
 	if err != nil {
 		// Check for specific IAM exception types
@@ -308,8 +311,26 @@ func policyInSlice(policy string, policyList []string) bool {
 	return false
 }
 
+// RecordPanic records a panic of the reconcile of the role in its Failed condition, see utils.PanicRecorder
+func (r *AWSFederatedRoleReconciler) RecordPanic(ctx context.Context, request ctrl.Request, message string) error {
+	federatedRole := &awsv1alpha1.AWSFederatedRole{}
+	if err := r.Get(ctx, request.NamespacedName, federatedRole); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	federatedRole.Status.Conditions = utils.SetAWSFederatedRoleCondition(
+		federatedRole.Status.Conditions,
+		awsv1alpha1.AWSFederatedRoleFailed,
+		corev1.ConditionTrue,
+		utils.ReconcilePanicReason,
+		message,
+		utils.UpdateConditionIfReasonOrMessageChange,
+	)
+	return r.Status().Update(ctx, federatedRole)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AWSFederatedRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = utils.NewSecretAuditClient(r.Client, controllerName)
 	r.awsClientBuilder = &awsclient.Builder{}
 	maxReconciles, err := utils.GetControllerMaxReconciles(controllerName)
 	if err != nil {
@@ -318,7 +339,7 @@ func (r *AWSFederatedRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&awsv1alpha1.AWSFederatedRole{}).
+		For(&awsv1alpha1.AWSFederatedRole{}, utils.WithReconcilePredicates(controllerName, utils.AWSFederatedRoleStatusTrigger)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}).Complete(rwm)
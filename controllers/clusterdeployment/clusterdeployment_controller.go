@@ -0,0 +1,214 @@
+package clusterdeployment
+
+import (
+	"context"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	controllerName = "clusterdeployment"
+
+	// ClaimLabel opts a Hive ClusterDeployment into getting an AccountClaim when set to "true"
+	ClaimLabel = "aws.managed.openshift.io/account-claim"
+	// AccountPoolAnnotation names the account pool of the claim of a ClusterDeployment. It defaults to the default pool.
+	AccountPoolAnnotation = "aws.managed.openshift.io/account-pool"
+	// LegalEntityIDAnnotation is the legal entity ID of the claim of a ClusterDeployment. It defaults to the namespace of
+	// the ClusterDeployment.
+	LegalEntityIDAnnotation = "aws.managed.openshift.io/legal-entity-id"
+	// LegalEntityNameAnnotation is the legal entity name of the claim of a ClusterDeployment. It defaults to the cluster
+	// name of the ClusterDeployment.
+	LegalEntityNameAnnotation = "aws.managed.openshift.io/legal-entity-name"
+	// ClusterDeploymentLabel is set on the claims created for a ClusterDeployment, to its name
+	ClusterDeploymentLabel = "aws.managed.openshift.io/cluster-deployment"
+
+	// defaultCredentialsSecretSuffix names the credentials secret of claims of ClusterDeployments without AWS
+	// credentials secret, after the ClusterDeployment
+	defaultCredentialsSecretSuffix = "-aws-creds"
+)
+
+var log = logf.Log.WithName("controller_clusterdeployment")
+
+// ClusterDeploymentGVK is the kind of the Hive ClusterDeployments, read as unstructured objects so the operator
+// doesn't depend on the Hive API
+var ClusterDeploymentGVK = schema.GroupVersionKind{Group: "hive.openshift.io", Version: "v1", Kind: "ClusterDeployment"}
+
+// ClusterDeploymentReconciler creates an AccountClaim for every Hive ClusterDeployment with the ClaimLabel, and deletes
+// it when the label is removed. The claims of deleted ClusterDeployments are garbage collected once the
+// ClusterDeployment is gone, so its deprovision still has the credentials of the account.
+type ClusterDeploymentReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=hive.openshift.io,resources=clusterdeployments,verbs=get;list;watch
+
+// Reconcile creates the claim of a labeled ClusterDeployment, or deletes the claim of a ClusterDeployment which isn't
+// labeled anymore
+func (r *ClusterDeploymentReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
+
+	clusterDeployment := newClusterDeployment()
+	err := r.Get(ctx, request.NamespacedName, clusterDeployment)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	accountClaim := &awsv1alpha1.AccountClaim{}
+	err = r.Get(ctx, request.NamespacedName, accountClaim)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+	claimExists := err == nil
+	if claimExists && !metav1.IsControlledBy(accountClaim, clusterDeployment) {
+		reqLogger.Info("an AccountClaim not created for the ClusterDeployment has its name, leaving it alone")
+		return reconcile.Result{}, nil
+	}
+
+	if !hasClaimLabel(clusterDeployment) {
+		if !claimExists || accountClaim.DeletionTimestamp != nil {
+			return reconcile.Result{}, nil
+		}
+		reqLogger.Info(fmt.Sprintf("Deleting AccountClaim %s, the ClusterDeployment isn't labeled %s anymore", accountClaim.Name, ClaimLabel))
+		return reconcile.Result{}, client.IgnoreNotFound(r.Delete(ctx, accountClaim))
+	}
+	if claimExists || clusterDeployment.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	accountClaim, err = newAccountClaim(clusterDeployment)
+	if err != nil {
+		// The ClusterDeployment is reconciled again once it's updated
+		reqLogger.Error(err, "can't create the AccountClaim of the ClusterDeployment")
+		return reconcile.Result{}, nil
+	}
+	err = controllerutil.SetControllerReference(clusterDeployment, accountClaim, r.Scheme)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	reqLogger.Info(fmt.Sprintf("Creating AccountClaim %s for the ClusterDeployment", accountClaim.Name))
+	return reconcile.Result{}, client.IgnoreAlreadyExists(r.Create(ctx, accountClaim))
+}
+
+func newClusterDeployment() *unstructured.Unstructured {
+	clusterDeployment := &unstructured.Unstructured{}
+	clusterDeployment.SetGroupVersionKind(ClusterDeploymentGVK)
+	return clusterDeployment
+}
+
+func hasClaimLabel(obj client.Object) bool {
+	return obj.GetLabels()[ClaimLabel] == "true"
+}
+
+// newAccountClaim returns the claim of the ClusterDeployment: named after it, in its namespace, for its AWS region,
+// delivering the credentials to the AWS credentials secret it references, with the pool and legal entity of its
+// annotations
+func newAccountClaim(clusterDeployment *unstructured.Unstructured) (*awsv1alpha1.AccountClaim, error) {
+	region, _, err := unstructured.NestedString(clusterDeployment.Object, "spec", "platform", "aws", "region")
+	if err != nil {
+		return nil, err
+	}
+	if region == "" {
+		return nil, fmt.Errorf("ClusterDeployment %s/%s has no AWS region", clusterDeployment.GetNamespace(), clusterDeployment.GetName())
+	}
+	secretName, _, err := unstructured.NestedString(clusterDeployment.Object, "spec", "platform", "aws", "credentialsSecretRef", "name")
+	if err != nil {
+		return nil, err
+	}
+	if secretName == "" {
+		secretName = clusterDeployment.GetName() + defaultCredentialsSecretSuffix
+	}
+	clusterName, _, err := unstructured.NestedString(clusterDeployment.Object, "spec", "clusterName")
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := clusterDeployment.GetAnnotations()
+	legalEntity := awsv1alpha1.LegalEntity{
+		ID:   annotations[LegalEntityIDAnnotation],
+		Name: annotations[LegalEntityNameAnnotation],
+	}
+	if legalEntity.ID == "" {
+		legalEntity.ID = clusterDeployment.GetNamespace()
+	}
+	if legalEntity.Name == "" {
+		legalEntity.Name = clusterName
+	}
+	if legalEntity.Name == "" {
+		legalEntity.Name = clusterDeployment.GetName()
+	}
+
+	return &awsv1alpha1.AccountClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterDeployment.GetName(),
+			Namespace: clusterDeployment.GetNamespace(),
+			Labels:    map[string]string{ClusterDeploymentLabel: clusterDeployment.GetName()},
+		},
+		Spec: awsv1alpha1.AccountClaimSpec{
+			LegalEntity: legalEntity,
+			AwsCredentialSecret: awsv1alpha1.SecretRef{
+				Name:      secretName,
+				Namespace: clusterDeployment.GetNamespace(),
+			},
+			Aws:         awsv1alpha1.Aws{Regions: []awsv1alpha1.AwsRegions{{Name: region}}},
+			AccountPool: annotations[AccountPoolAnnotation],
+		},
+	}, nil
+}
+
+// claimLabelPredicate only lets through the events of labeled ClusterDeployments, and the updates removing the label
+var claimLabelPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return hasClaimLabel(e.Object)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return hasClaimLabel(e.ObjectOld) || hasClaimLabel(e.ObjectNew)
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return hasClaimLabel(e.Object)
+	},
+}
+
+// SetupWithManager sets up the controller with the Manager. The controller isn't started when Hive isn't installed.
+func (r *ClusterDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	_, err := mgr.GetRESTMapper().RESTMapping(ClusterDeploymentGVK.GroupKind(), ClusterDeploymentGVK.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			log.Info("Hive ClusterDeployments aren't served by the cluster, not starting the controller", "controller", controllerName)
+			return nil
+		}
+		return err
+	}
+
+	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newClusterDeployment(), builder.WithPredicates(claimLabelPredicate)).
+		Owns(&awsv1alpha1.AccountClaim{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).Complete(rwm)
+}
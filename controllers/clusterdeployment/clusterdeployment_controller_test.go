@@ -0,0 +1,131 @@
+package clusterdeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsaccountapis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+func newReconciler(t *testing.T, objs ...runtime.Object) *ClusterDeploymentReconciler {
+	if err := awsaccountapis.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatalf("failed adding apis to scheme: %v", err)
+	}
+	// The fake client needs the ClusterDeployments registered, which the operator reads as unstructured objects
+	scheme.Scheme.AddKnownTypeWithName(ClusterDeploymentGVK, &unstructured.Unstructured{})
+	scheme.Scheme.AddKnownTypeWithName(ClusterDeploymentGVK.GroupVersion().WithKind("ClusterDeploymentList"), &unstructured.UnstructuredList{})
+	return &ClusterDeploymentReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objs...).Build(),
+		Scheme: scheme.Scheme,
+	}
+}
+
+func testClusterDeployment(labeled bool, annotations map[string]string) *unstructured.Unstructured {
+	clusterDeployment := newClusterDeployment()
+	clusterDeployment.SetName("cluster")
+	clusterDeployment.SetNamespace("uhc-cluster")
+	clusterDeployment.SetUID("cluster-uid")
+	if labeled {
+		clusterDeployment.SetLabels(map[string]string{ClaimLabel: "true"})
+	}
+	clusterDeployment.SetAnnotations(annotations)
+	clusterDeployment.Object["spec"] = map[string]interface{}{
+		"clusterName": "my-cluster",
+		"platform": map[string]interface{}{
+			"aws": map[string]interface{}{
+				"region":               "us-east-1",
+				"credentialsSecretRef": map[string]interface{}{"name": "cluster-aws"},
+			},
+		},
+	}
+	return clusterDeployment
+}
+
+func reconcileClusterDeployment(t *testing.T, r *ClusterDeploymentReconciler) {
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "uhc-cluster", Name: "cluster"}})
+	assert.NoError(t, err)
+}
+
+func getClaim(r *ClusterDeploymentReconciler) (*awsv1alpha1.AccountClaim, error) {
+	accountClaim := &awsv1alpha1.AccountClaim{}
+	err := r.Get(context.TODO(), types.NamespacedName{Namespace: "uhc-cluster", Name: "cluster"}, accountClaim)
+	return accountClaim, err
+}
+
+func TestReconcileCreatesClaim(t *testing.T) {
+	r := newReconciler(t, testClusterDeployment(true, map[string]string{
+		AccountPoolAnnotation:   "hive-pool",
+		LegalEntityIDAnnotation: "entity-id",
+	}))
+	reconcileClusterDeployment(t, r)
+
+	accountClaim, err := getClaim(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "hive-pool", accountClaim.Spec.AccountPool)
+	assert.Equal(t, awsv1alpha1.LegalEntity{ID: "entity-id", Name: "my-cluster"}, accountClaim.Spec.LegalEntity)
+	assert.Equal(t, awsv1alpha1.SecretRef{Name: "cluster-aws", Namespace: "uhc-cluster"}, accountClaim.Spec.AwsCredentialSecret)
+	assert.Equal(t, []awsv1alpha1.AwsRegions{{Name: "us-east-1"}}, accountClaim.Spec.Aws.Regions)
+	assert.Equal(t, "cluster", accountClaim.Labels[ClusterDeploymentLabel])
+	owner := metav1.GetControllerOf(accountClaim)
+	if assert.NotNil(t, owner) {
+		assert.Equal(t, "ClusterDeployment", owner.Kind)
+		assert.Equal(t, types.UID("cluster-uid"), owner.UID)
+	}
+}
+
+func TestReconcileIgnoresUnlabeledClusterDeployments(t *testing.T) {
+	r := newReconciler(t, testClusterDeployment(false, nil))
+	reconcileClusterDeployment(t, r)
+
+	_, err := getClaim(r)
+	assert.True(t, k8serr.IsNotFound(err))
+}
+
+func TestReconcileDeletesClaimWhenUnlabeled(t *testing.T) {
+	clusterDeployment := testClusterDeployment(false, nil)
+	accountClaim, err := newAccountClaim(clusterDeployment)
+	assert.NoError(t, err)
+	assert.NoError(t, controllerutil.SetControllerReference(clusterDeployment, accountClaim, scheme.Scheme))
+	r := newReconciler(t, clusterDeployment, accountClaim)
+	reconcileClusterDeployment(t, r)
+
+	_, err = getClaim(r)
+	assert.True(t, k8serr.IsNotFound(err))
+}
+
+func TestReconcileLeavesClaimsOfOthersAlone(t *testing.T) {
+	accountClaim := &awsv1alpha1.AccountClaim{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "uhc-cluster"}}
+	r := newReconciler(t, testClusterDeployment(false, nil), accountClaim)
+	reconcileClusterDeployment(t, r)
+
+	_, err := getClaim(r)
+	assert.NoError(t, err)
+}
+
+func TestNewAccountClaimDefaults(t *testing.T) {
+	clusterDeployment := testClusterDeployment(true, nil)
+	clusterDeployment.Object["spec"] = map[string]interface{}{
+		"platform": map[string]interface{}{"aws": map[string]interface{}{"region": "eu-west-1"}},
+	}
+	accountClaim, err := newAccountClaim(clusterDeployment)
+	assert.NoError(t, err)
+	assert.Equal(t, awsv1alpha1.LegalEntity{ID: "uhc-cluster", Name: "cluster"}, accountClaim.Spec.LegalEntity)
+	assert.Equal(t, "cluster-aws-creds", accountClaim.Spec.AwsCredentialSecret.Name)
+	assert.Empty(t, accountClaim.Spec.AccountPool)
+
+	delete(clusterDeployment.Object, "spec")
+	_, err = newAccountClaim(clusterDeployment)
+	assert.EqualError(t, err, "ClusterDeployment uhc-cluster/cluster has no AWS region")
+}
@@ -0,0 +1,244 @@
+package fleetfederatedaccess
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	controllerName = "fleetfederatedaccess"
+)
+
+var log = logf.Log.WithName("controller_fleetfederatedaccess")
+
+// FleetFederatedAccessReconciler rolls out an AWSFederatedAccountAccess to every Account selected by a
+// FleetFederatedAccess. The AWSFederatedAccountAccesses are owned by the FleetFederatedAccess, so deleting it
+// revokes the access from every Account.
+type FleetFederatedAccessReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=fleetfederatedaccesses,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=fleetfederatedaccesses/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=awsfederatedaccountaccesses,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates an AWSFederatedAccountAccess for every selected Account that's Ready, deletes the ones of the
+// Accounts that aren't selected anymore and reports their state on the FleetFederatedAccess.
+func (r *FleetFederatedAccessReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
+
+	fleetAccess := &awsv1alpha1.FleetFederatedAccess{}
+	err := r.Get(ctx, request.NamespacedName, fleetAccess)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if fleetAccess.DeletionTimestamp != nil {
+		// The AWSFederatedAccountAccesses are garbage collected, their finalizer cleans up the roles
+		return reconcile.Result{}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&fleetAccess.Spec.AccountSelector)
+	if err != nil {
+		reqLogger.Error(err, "invalid account selector")
+		status := awsv1alpha1.FleetFederatedAccessStatus{State: awsv1alpha1.AWSFederatedAccountStateFailed}
+		return reconcile.Result{}, r.updateStatus(ctx, fleetAccess, status)
+	}
+
+	accounts := &awsv1alpha1.AccountList{}
+	err = r.List(ctx, accounts, client.InNamespace(awsv1alpha1.AccountCrNamespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	accountAccesses := &awsv1alpha1.AWSFederatedAccountAccessList{}
+	err = r.List(ctx, accountAccesses, client.InNamespace(fleetAccess.Namespace), client.MatchingLabels{awsv1alpha1.FleetFederatedAccessLabel: fleetAccess.Name})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	existing := map[string]*awsv1alpha1.AWSFederatedAccountAccess{}
+	for i := range accountAccesses.Items {
+		existing[accountAccesses.Items[i].Name] = &accountAccesses.Items[i]
+	}
+
+	status := awsv1alpha1.FleetFederatedAccessStatus{}
+	for i := range accounts.Items {
+		account := &accounts.Items[i]
+		desired := newAccountAccess(fleetAccess, account)
+		accountAccess, ok := existing[desired.Name]
+		delete(existing, desired.Name)
+
+		switch {
+		case ok && !equality.Semantic.DeepEqual(accountAccess.Spec, desired.Spec):
+			// The role or ARN changed, the access is replaced so its finalizer revokes the old one
+			reqLogger.Info("Replacing outdated AWSFederatedAccountAccess", "AccountAccess", accountAccess.Name)
+			if err := r.Delete(ctx, accountAccess); err != nil && !k8serr.IsNotFound(err) {
+				return reconcile.Result{}, err
+			}
+			status.Accounts = append(status.Accounts, accountStatus(account, desired.Name, awsv1alpha1.AWSFederatedAccountAccessStateInProgress, "Replacing the access"))
+		case ok:
+			status.Accounts = append(status.Accounts, accountAccessStatus(account, accountAccess))
+		case account.Status.State != string(awsv1alpha1.AccountReady) || account.Spec.IAMUserSecret == "":
+			status.Accounts = append(status.Accounts, accountStatus(account, "", awsv1alpha1.AWSFederatedAccountAccessStateInProgress, "Waiting for the Account to be Ready"))
+		default:
+			err := r.createAccountAccess(ctx, reqLogger, fleetAccess, desired)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			status.Accounts = append(status.Accounts, accountStatus(account, desired.Name, awsv1alpha1.AWSFederatedAccountAccessStateInProgress, ""))
+		}
+	}
+
+	// Whatever is left belongs to Accounts that aren't selected anymore
+	for _, accountAccess := range existing {
+		if accountAccess.DeletionTimestamp != nil {
+			continue
+		}
+		reqLogger.Info("Revoking access from unselected Account", "AccountAccess", accountAccess.Name)
+		if err := r.Delete(ctx, accountAccess); err != nil && !k8serr.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+	}
+
+	summarize(&status)
+	return reconcile.Result{}, r.updateStatus(ctx, fleetAccess, status)
+}
+
+// newAccountAccess returns the AWSFederatedAccountAccess granting the access to the Account
+func newAccountAccess(fleetAccess *awsv1alpha1.FleetFederatedAccess, account *awsv1alpha1.Account) *awsv1alpha1.AWSFederatedAccountAccess {
+	return &awsv1alpha1.AWSFederatedAccountAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", fleetAccess.Name, account.Name),
+			Namespace: fleetAccess.Namespace,
+			Labels:    map[string]string{awsv1alpha1.FleetFederatedAccessLabel: fleetAccess.Name},
+		},
+		Spec: awsv1alpha1.AWSFederatedAccountAccessSpec{
+			ExternalCustomerAWSIAMARN: fleetAccess.Spec.ExternalCustomerAWSIAMARN,
+			AWSCustomerCredentialSecret: awsv1alpha1.AWSSecretReference{
+				Name:      account.Spec.IAMUserSecret,
+				Namespace: account.Namespace,
+			},
+			AWSFederatedRole: fleetAccess.Spec.AWSFederatedRole,
+		},
+	}
+}
+
+func (r *FleetFederatedAccessReconciler) createAccountAccess(ctx context.Context, reqLogger logr.Logger, fleetAccess *awsv1alpha1.FleetFederatedAccess, accountAccess *awsv1alpha1.AWSFederatedAccountAccess) error {
+	err := controllerutil.SetControllerReference(fleetAccess, accountAccess, r.Scheme)
+	if err != nil {
+		return err
+	}
+	reqLogger.Info("Granting access to Account", "AccountAccess", accountAccess.Name)
+	err = r.Create(ctx, accountAccess)
+	if k8serr.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func accountStatus(account *awsv1alpha1.Account, accountAccess string, state awsv1alpha1.AWSFederatedAccountAccessState, message string) awsv1alpha1.FleetFederatedAccessAccountStatus {
+	return awsv1alpha1.FleetFederatedAccessAccountStatus{
+		Account:       account.Name,
+		AccountAccess: accountAccess,
+		State:         state,
+		Message:       message,
+	}
+}
+
+// accountAccessStatus reports the state of the AWSFederatedAccountAccess of the Account
+func accountAccessStatus(account *awsv1alpha1.Account, accountAccess *awsv1alpha1.AWSFederatedAccountAccess) awsv1alpha1.FleetFederatedAccessAccountStatus {
+	state := accountAccess.Status.State
+	if state == "" {
+		state = awsv1alpha1.AWSFederatedAccountAccessStateInProgress
+	}
+	message := ""
+	if state == awsv1alpha1.AWSFederatedAccountStateFailed {
+		for _, condition := range accountAccess.Status.Conditions {
+			if condition.Type == awsv1alpha1.AWSFederatedAccountFailed {
+				message = condition.Message
+			}
+		}
+	}
+	return accountStatus(account, accountAccess.Name, state, message)
+}
+
+// summarize counts the Accounts by state and sets the overall state
+func summarize(status *awsv1alpha1.FleetFederatedAccessStatus) {
+	sort.Slice(status.Accounts, func(i, j int) bool { return status.Accounts[i].Account < status.Accounts[j].Account })
+	for _, account := range status.Accounts {
+		switch account.State {
+		case awsv1alpha1.AWSFederatedAccountStateReady:
+			status.Ready++
+		case awsv1alpha1.AWSFederatedAccountStateFailed:
+			status.Failed++
+		default:
+			status.InProgress++
+		}
+	}
+
+	switch {
+	case status.Failed > 0:
+		status.State = awsv1alpha1.AWSFederatedAccountStateFailed
+	case status.InProgress > 0:
+		status.State = awsv1alpha1.AWSFederatedAccountAccessStateInProgress
+	default:
+		status.State = awsv1alpha1.AWSFederatedAccountStateReady
+	}
+}
+
+func (r *FleetFederatedAccessReconciler) updateStatus(ctx context.Context, fleetAccess *awsv1alpha1.FleetFederatedAccess, status awsv1alpha1.FleetFederatedAccessStatus) error {
+	if equality.Semantic.DeepEqual(fleetAccess.Status, status) {
+		return nil
+	}
+	fleetAccess.Status = status
+	return r.Status().Update(ctx, fleetAccess)
+}
+
+// fleetAccessesForAccount enqueues every FleetFederatedAccess, as any of them may select the Account
+func (r *FleetFederatedAccessReconciler) fleetAccessesForAccount(obj client.Object) []reconcile.Request {
+	fleetAccesses := &awsv1alpha1.FleetFederatedAccessList{}
+	if err := r.List(context.TODO(), fleetAccesses); err != nil {
+		log.Error(err, "failed to list FleetFederatedAccesses", "Account", obj.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(fleetAccesses.Items))
+	for i := range fleetAccesses.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&fleetAccesses.Items[i])})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FleetFederatedAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = utils.NewSecretAuditClient(r.Client, controllerName)
+	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&awsv1alpha1.FleetFederatedAccess{}, utils.WithReconcilePredicates(controllerName, nil)).
+		Owns(&awsv1alpha1.AWSFederatedAccountAccess{}, utils.WithReconcilePredicates(controllerName, utils.AWSFederatedAccountAccessStatusTrigger)).
+		Watches(&source.Kind{Type: &awsv1alpha1.Account{}}, handler.EnqueueRequestsFromMapFunc(r.fleetAccessesForAccount),
+			utils.WithReconcilePredicates(controllerName, utils.AccountStatusTrigger)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).Complete(rwm)
+}
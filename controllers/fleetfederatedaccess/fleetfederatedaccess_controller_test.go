@@ -0,0 +1,152 @@
+package fleetfederatedaccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsaccountapis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+const fleetNamespace = "fleet-access"
+
+func newReconciler(t *testing.T, objs ...runtime.Object) *FleetFederatedAccessReconciler {
+	if err := awsaccountapis.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatalf("failed adding apis to scheme: %v", err)
+	}
+	return &FleetFederatedAccessReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objs...).Build(),
+		Scheme: scheme.Scheme,
+	}
+}
+
+func fleetAccess() *awsv1alpha1.FleetFederatedAccess {
+	return &awsv1alpha1.FleetFederatedAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "sre", Namespace: fleetNamespace},
+		Spec: awsv1alpha1.FleetFederatedAccessSpec{
+			AccountSelector:           metav1.LabelSelector{MatchLabels: map[string]string{"fleet": "prod"}},
+			ExternalCustomerAWSIAMARN: "arn:aws:iam::123456789012:user/sre",
+			AWSFederatedRole:          awsv1alpha1.AWSFederatedRoleRef{Name: "read-only", Namespace: awsv1alpha1.AccountCrNamespace},
+		},
+	}
+}
+
+func account(name, fleet string, state awsv1alpha1.AccountConditionType) *awsv1alpha1.Account {
+	return &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: awsv1alpha1.AccountCrNamespace, Labels: map[string]string{"fleet": fleet}},
+		Spec:       awsv1alpha1.AccountSpec{IAMUserSecret: name + "-secret"},
+		Status:     awsv1alpha1.AccountStatus{State: string(state)},
+	}
+}
+
+func reconcileFleetAccess(t *testing.T, r *FleetFederatedAccessReconciler) *awsv1alpha1.FleetFederatedAccess {
+	request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(fleetAccess())}
+	_, err := r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+
+	updated := &awsv1alpha1.FleetFederatedAccess{}
+	assert.NoError(t, r.Get(context.TODO(), request.NamespacedName, updated))
+	return updated
+}
+
+func accountAccesses(t *testing.T, r *FleetFederatedAccessReconciler) map[string]awsv1alpha1.AWSFederatedAccountAccess {
+	list := &awsv1alpha1.AWSFederatedAccountAccessList{}
+	assert.NoError(t, r.List(context.TODO(), list, client.InNamespace(fleetNamespace)))
+	accesses := map[string]awsv1alpha1.AWSFederatedAccountAccess{}
+	for _, accountAccess := range list.Items {
+		accesses[accountAccess.Name] = accountAccess
+	}
+	return accesses
+}
+
+func TestGrantsAccessToSelectedAccounts(t *testing.T) {
+	r := newReconciler(t,
+		fleetAccess(),
+		account("account-a", "prod", awsv1alpha1.AccountReady),
+		account("account-b", "prod", awsv1alpha1.AccountCreating),
+		account("account-c", "staging", awsv1alpha1.AccountReady),
+	)
+
+	updated := reconcileFleetAccess(t, r)
+
+	accesses := accountAccesses(t, r)
+	assert.Len(t, accesses, 1)
+	accountAccess, ok := accesses["sre-account-a"]
+	assert.True(t, ok)
+	assert.Equal(t, "account-a-secret", accountAccess.Spec.AWSCustomerCredentialSecret.Name)
+	assert.Equal(t, awsv1alpha1.AccountCrNamespace, accountAccess.Spec.AWSCustomerCredentialSecret.Namespace)
+	assert.Equal(t, "sre", accountAccess.Labels[awsv1alpha1.FleetFederatedAccessLabel])
+	assert.Equal(t, "sre", accountAccess.OwnerReferences[0].Name)
+
+	assert.Equal(t, awsv1alpha1.AWSFederatedAccountAccessStateInProgress, updated.Status.State)
+	assert.Equal(t, 2, updated.Status.InProgress)
+	assert.Len(t, updated.Status.Accounts, 2)
+	assert.Equal(t, "Waiting for the Account to be Ready", updated.Status.Accounts[1].Message)
+}
+
+func TestReportsRolloutStatus(t *testing.T) {
+	fleet := fleetAccess()
+	ready := newAccountAccess(fleet, account("account-a", "prod", awsv1alpha1.AccountReady))
+	ready.Status.State = awsv1alpha1.AWSFederatedAccountStateReady
+	failed := newAccountAccess(fleet, account("account-b", "prod", awsv1alpha1.AccountReady))
+	failed.Status.State = awsv1alpha1.AWSFederatedAccountStateFailed
+	failed.Status.Conditions = []awsv1alpha1.AWSFederatedAccountAccessCondition{
+		{Type: awsv1alpha1.AWSFederatedAccountFailed, Message: "Requested role does not exist"},
+	}
+	r := newReconciler(t,
+		fleet, ready, failed,
+		account("account-a", "prod", awsv1alpha1.AccountReady),
+		account("account-b", "prod", awsv1alpha1.AccountReady),
+	)
+
+	updated := reconcileFleetAccess(t, r)
+
+	assert.Equal(t, awsv1alpha1.AWSFederatedAccountStateFailed, updated.Status.State)
+	assert.Equal(t, 1, updated.Status.Ready)
+	assert.Equal(t, 1, updated.Status.Failed)
+	assert.Equal(t, "Requested role does not exist", updated.Status.Accounts[1].Message)
+}
+
+func TestRevokesAccessFromUnselectedAccounts(t *testing.T) {
+	fleet := fleetAccess()
+	revoked := newAccountAccess(fleet, account("account-a", "staging", awsv1alpha1.AccountReady))
+	r := newReconciler(t, fleet, revoked, account("account-a", "staging", awsv1alpha1.AccountReady))
+
+	updated := reconcileFleetAccess(t, r)
+
+	assert.Empty(t, accountAccesses(t, r))
+	assert.Equal(t, awsv1alpha1.AWSFederatedAccountStateReady, updated.Status.State)
+	assert.Empty(t, updated.Status.Accounts)
+}
+
+func TestReplacesOutdatedAccess(t *testing.T) {
+	fleet := fleetAccess()
+	outdated := newAccountAccess(fleet, account("account-a", "prod", awsv1alpha1.AccountReady))
+	outdated.Spec.ExternalCustomerAWSIAMARN = "arn:aws:iam::123456789012:user/former-sre"
+	r := newReconciler(t, fleet, outdated, account("account-a", "prod", awsv1alpha1.AccountReady))
+
+	reconcileFleetAccess(t, r)
+	assert.Empty(t, accountAccesses(t, r))
+
+	reconcileFleetAccess(t, r)
+	accesses := accountAccesses(t, r)
+	assert.Equal(t, "arn:aws:iam::123456789012:user/sre", accesses["sre-account-a"].Spec.ExternalCustomerAWSIAMARN)
+}
+
+func TestRejectsInvalidSelector(t *testing.T) {
+	fleet := fleetAccess()
+	fleet.Spec.AccountSelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "fleet", Operator: "Near"}}}
+	r := newReconciler(t, fleet)
+
+	updated := reconcileFleetAccess(t, r)
+
+	assert.Equal(t, awsv1alpha1.AWSFederatedAccountStateFailed, updated.Status.State)
+}
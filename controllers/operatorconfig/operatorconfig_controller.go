@@ -0,0 +1,239 @@
+package operatorconfig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	controllerName = "operatorconfig"
+
+	featureFlagPrefix             = "feature."
+	maxConcurrentReconcilesPrefix = "MaxConcurrentReconciles."
+	quotaPrefix                   = "quota."
+)
+
+var log = logf.Log.WithName("controller_operatorconfig")
+
+// OperatorConfigReconciler writes the OperatorConfig to the operator ConfigMap the controllers read their
+// configuration from. Keys of the ConfigMap the OperatorConfig doesn't set are kept, so settings can be moved to the
+// OperatorConfig one at a time.
+type OperatorConfigReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// organizationsAccess is reported in the OrganizationsAccessDenied condition, utils.OrganizationsAccess when nil
+	organizationsAccess *utils.OrganizationsAccessBreaker
+}
+
+//+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=operatorconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=aws.managed.openshift.io,resources=operatorconfigs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
+
+// Reconcile sets the keys of the operator ConfigMap from the OperatorConfig, reverting edits of those keys made to the
+// ConfigMap directly, and lists the keys only set in the ConfigMap in the OperatorConfig status. The status also reports
+// whether the operator is denied Organizations access.
+func (r *OperatorConfigReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
+
+	operatorConfig := &awsv1alpha1.OperatorConfig{}
+	err := r.Get(ctx, request.NamespacedName, operatorConfig)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Without an OperatorConfig, the operator ConfigMap is used as it is
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	configMap, err := utils.NewOperatorConfig(r.Client).ConfigMap(ctx)
+	if err != nil && !errors.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+	create := errors.IsNotFound(err)
+	if create {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      awsv1alpha1.DefaultConfigMap,
+				Namespace: awsv1alpha1.AccountCrNamespace,
+				Labels:    map[string]string{utils.ManagedLabel: "true"},
+			},
+		}
+	}
+
+	desired := ConfigMapData(operatorConfig.Spec)
+	changed := false
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	for key, value := range desired {
+		if current, ok := configMap.Data[key]; !ok || current != value {
+			configMap.Data[key] = value
+			changed = true
+		}
+	}
+
+	switch {
+	case create:
+		reqLogger.Info("creating the operator ConfigMap from the OperatorConfig")
+		err = r.Create(ctx, configMap)
+	case changed:
+		reqLogger.Info("updating the operator ConfigMap from the OperatorConfig")
+		err = r.Update(ctx, configMap)
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	legacyKeys := []string{}
+	for key := range configMap.Data {
+		if _, ok := desired[key]; !ok {
+			legacyKeys = append(legacyKeys, key)
+		}
+	}
+	sort.Strings(legacyKeys)
+	warnDeprecatedFeatureFlags(reqLogger, legacyKeys)
+
+	status := operatorConfig.Status.DeepCopy()
+	status.ObservedGeneration = operatorConfig.Generation
+	status.LegacyKeys = legacyKeys
+	if create || changed || status.LastSyncTime == nil {
+		now := metav1.Now()
+		status.LastSyncTime = &now
+	}
+	r.setOrganizationsAccessCondition(status, operatorConfig.Generation)
+	if reflect.DeepEqual(*status, operatorConfig.Status) {
+		return reconcile.Result{}, nil
+	}
+	operatorConfig.Status = *status
+	return reconcile.Result{}, r.Status().Update(ctx, operatorConfig)
+}
+
+// setOrganizationsAccessCondition reports in the status whether the account state transitions are frozen because the
+// operator is denied Organizations access
+func (r *OperatorConfigReconciler) setOrganizationsAccessCondition(status *awsv1alpha1.OperatorConfigStatus, generation int64) {
+	breaker := r.organizationsAccess
+	if breaker == nil {
+		breaker = utils.OrganizationsAccess
+	}
+	condition := metav1.Condition{
+		Type:               awsv1alpha1.OperatorConfigOrganizationsAccessDenied,
+		Status:             metav1.ConditionFalse,
+		Reason:             "AccessGranted",
+		Message:            "Organizations calls are allowed",
+		ObservedGeneration: generation,
+	}
+	if frozen, since, lastError := breaker.Frozen(); frozen {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "AccessDenied"
+		condition.Message = fmt.Sprintf("Account state transitions are frozen since %s, Organizations denied access: %s", since.UTC().Format(time.RFC3339), lastError)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// ConfigMapData returns the operator ConfigMap keys set by the OperatorConfig
+func ConfigMapData(spec awsv1alpha1.OperatorConfigSpec) map[string]string {
+	data := map[string]string{}
+	for name, enabled := range spec.FeatureFlags {
+		data[featureFlagPrefix+name] = strconv.FormatBool(enabled)
+	}
+	if spec.AccountLimit != nil {
+		data["account-limit"] = strconv.Itoa(*spec.AccountLimit)
+	}
+	for name, maxReconciles := range spec.MaxConcurrentReconciles {
+		data[maxConcurrentReconcilesPrefix+name] = strconv.Itoa(maxReconciles)
+	}
+	if spec.OrganizationalUnits != nil {
+		data["root"] = spec.OrganizationalUnits.Root
+		data["base"] = spec.OrganizationalUnits.Base
+	}
+	if len(spec.STSJumpRoles) > 0 {
+		data[stsclient.JumpRolesKey] = strings.Join(spec.STSJumpRoles, ",")
+	}
+	if spec.SupportJumpRole != "" {
+		data["support-jump-role"] = spec.SupportJumpRole
+	}
+	if len(spec.OptInRegions) > 0 {
+		data["opt-in-regions"] = strings.Join(spec.OptInRegions, ",")
+	}
+	for name, quota := range spec.Quotas {
+		data[quotaPrefix+name] = strconv.Itoa(quota)
+	}
+	return data
+}
+
+// warnDeprecatedFeatureFlags logs the feature flags still only set in the operator ConfigMap
+func warnDeprecatedFeatureFlags(reqLogger logr.Logger, legacyKeys []string) {
+	flags := []string{}
+	for _, key := range legacyKeys {
+		if strings.HasPrefix(key, featureFlagPrefix) {
+			flags = append(flags, key)
+		}
+	}
+	if len(flags) > 0 {
+		reqLogger.Info("feature flags set in the operator ConfigMap are deprecated, set them in the OperatorConfig featureFlags", "keys", flags)
+	}
+}
+
+// isOperatorConfig filters events down to the OperatorConfig the operator reads
+func isOperatorConfig(obj client.Object) bool {
+	return obj.GetNamespace() == awsv1alpha1.AccountCrNamespace && obj.GetName() == awsv1alpha1.OperatorConfigName
+}
+
+// operatorConfigForConfigMap reconciles the OperatorConfig when the operator ConfigMap, or the Organizations access of
+// the operator, changes
+func operatorConfigForConfigMap(client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: awsv1alpha1.OperatorConfigName}}}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *OperatorConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = utils.NewSecretAuditClient(r.Client, controllerName)
+	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
+
+	// The OrganizationsAccessDenied condition is updated whenever the fleet is frozen or unfrozen
+	organizationsAccessChanges := make(chan event.GenericEvent, 1)
+	utils.OrganizationsAccess.OnChange(func() {
+		select {
+		case organizationsAccessChanges <- event.GenericEvent{Object: &awsv1alpha1.OperatorConfig{}}:
+		default:
+		}
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&awsv1alpha1.OperatorConfig{}, builder.WithPredicates(predicate.NewPredicateFuncs(isOperatorConfig), utils.NewReconcilePredicate(controllerName, nil))).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(operatorConfigForConfigMap),
+			builder.WithPredicates(predicate.NewPredicateFuncs(utils.IsOperatorConfigMap))).
+		Watches(&source.Channel{Source: organizationsAccessChanges}, handler.EnqueueRequestsFromMapFunc(operatorConfigForConfigMap)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).Complete(rwm)
+}
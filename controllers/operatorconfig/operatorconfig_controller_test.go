@@ -0,0 +1,184 @@
+package operatorconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsaccountapis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+func newReconciler(t *testing.T, objs ...runtime.Object) *OperatorConfigReconciler {
+	if err := awsaccountapis.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatalf("failed adding apis to scheme: %v", err)
+	}
+	return &OperatorConfigReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objs...).Build(),
+		Scheme: scheme.Scheme,
+	}
+}
+
+func newOperatorConfig(spec awsv1alpha1.OperatorConfigSpec) *awsv1alpha1.OperatorConfig {
+	return &awsv1alpha1.OperatorConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       awsv1alpha1.OperatorConfigName,
+			Namespace:  awsv1alpha1.AccountCrNamespace,
+			Generation: 3,
+		},
+		Spec: spec,
+	}
+}
+
+func operatorConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+		Data:       data,
+	}
+}
+
+var request = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: awsv1alpha1.OperatorConfigName}}
+
+func getConfigMap(t *testing.T, r *OperatorConfigReconciler) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{}
+	err := r.Get(context.TODO(), types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: awsv1alpha1.DefaultConfigMap}, cm)
+	assert.NoError(t, err)
+	return cm
+}
+
+func TestConfigMapData(t *testing.T) {
+	limit := 4801
+	data := ConfigMapData(awsv1alpha1.OperatorConfigSpec{
+		FeatureFlags:            map[string]bool{"compliance_tags": true, "opt_in_regions": false},
+		AccountLimit:            &limit,
+		MaxConcurrentReconciles: map[string]int{"account": 2},
+		OrganizationalUnits:     &awsv1alpha1.OperatorConfigOrganizationalUnits{Root: "r-abcd", Base: "ou-abcd-efgh"},
+		STSJumpRoles:            []string{"arn:jump-a", "arn:jump-b"},
+		SupportJumpRole:         "arn:support",
+		OptInRegions:            []string{"af-south-1", "ap-southeast-4"},
+		Quotas:                  map[string]int{"vcpu": 64},
+	})
+
+	assert.Equal(t, map[string]string{
+		"feature.compliance_tags":         "true",
+		"feature.opt_in_regions":          "false",
+		"account-limit":                   "4801",
+		"MaxConcurrentReconciles.account": "2",
+		"root":                            "r-abcd",
+		"base":                            "ou-abcd-efgh",
+		"sts-jump-roles":                  "arn:jump-a,arn:jump-b",
+		"support-jump-role":               "arn:support",
+		"opt-in-regions":                  "af-south-1,ap-southeast-4",
+		"quota.vcpu":                      "64",
+	}, data)
+	assert.Empty(t, ConfigMapData(awsv1alpha1.OperatorConfigSpec{}))
+}
+
+func TestReconcileUpdatesConfigMap(t *testing.T) {
+	limit := 200
+	r := newReconciler(t,
+		newOperatorConfig(awsv1alpha1.OperatorConfigSpec{
+			FeatureFlags: map[string]bool{"compliance_tags": true},
+			AccountLimit: &limit,
+		}),
+		operatorConfigMap(map[string]string{
+			"feature.compliance_tags": "false",
+			"feature.ownership_tags":  "true",
+			"account-limit":           "100",
+			"shard-name":              "hivep01ue1",
+		}),
+	)
+
+	_, err := r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+
+	cm := getConfigMap(t, r)
+	assert.Equal(t, map[string]string{
+		"feature.compliance_tags": "true",
+		"feature.ownership_tags":  "true",
+		"account-limit":           "200",
+		"shard-name":              "hivep01ue1",
+	}, cm.Data)
+
+	operatorConfig := &awsv1alpha1.OperatorConfig{}
+	assert.NoError(t, r.Get(context.TODO(), request.NamespacedName, operatorConfig))
+	assert.Equal(t, int64(3), operatorConfig.Status.ObservedGeneration)
+	assert.Equal(t, []string{"feature.ownership_tags", "shard-name"}, operatorConfig.Status.LegacyKeys)
+	assert.NotNil(t, operatorConfig.Status.LastSyncTime)
+}
+
+func TestReconcileRevertsConfigMapEdits(t *testing.T) {
+	r := newReconciler(t,
+		newOperatorConfig(awsv1alpha1.OperatorConfigSpec{Quotas: map[string]int{"vcpu": 64}}),
+		operatorConfigMap(map[string]string{"quota.vcpu": "64"}),
+	)
+	_, err := r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+
+	cm := getConfigMap(t, r)
+	cm.Data["quota.vcpu"] = "not-a-number"
+	assert.NoError(t, r.Update(context.TODO(), cm))
+
+	_, err = r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "64", getConfigMap(t, r).Data["quota.vcpu"])
+}
+
+func TestReconcileCreatesConfigMap(t *testing.T) {
+	r := newReconciler(t, newOperatorConfig(awsv1alpha1.OperatorConfigSpec{
+		OrganizationalUnits: &awsv1alpha1.OperatorConfigOrganizationalUnits{Root: "r-abcd", Base: "ou-abcd-efgh"},
+	}))
+
+	_, err := r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"root": "r-abcd", "base": "ou-abcd-efgh"}, getConfigMap(t, r).Data)
+}
+
+func TestReconcileWithoutOperatorConfig(t *testing.T) {
+	r := newReconciler(t, operatorConfigMap(map[string]string{"feature.compliance_tags": "false"}))
+
+	_, err := r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"feature.compliance_tags": "false"}, getConfigMap(t, r).Data)
+}
+
+func TestReconcileReportsOrganizationsAccess(t *testing.T) {
+	r := newReconciler(t, newOperatorConfig(awsv1alpha1.OperatorConfigSpec{}), operatorConfigMap(map[string]string{}))
+	r.organizationsAccess = utils.NewOrganizationsAccessBreaker()
+	for i := 0; i < 3; i++ {
+		r.organizationsAccess.Record(&smithy.GenericAPIError{Code: "AccessDeniedException"}, time.Now())
+	}
+
+	_, err := r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+
+	operatorConfig := &awsv1alpha1.OperatorConfig{}
+	assert.NoError(t, r.Get(context.TODO(), request.NamespacedName, operatorConfig))
+	condition := meta.FindStatusCondition(operatorConfig.Status.Conditions, awsv1alpha1.OperatorConfigOrganizationsAccessDenied)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Contains(t, condition.Message, "AccessDeniedException")
+	}
+
+	r.organizationsAccess.Record(nil, time.Now())
+	_, err = r.Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+
+	assert.NoError(t, r.Get(context.TODO(), request.NamespacedName, operatorConfig))
+	condition = meta.FindStatusCondition(operatorConfig.Status.Conditions, awsv1alpha1.OperatorConfigOrganizationsAccessDenied)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	}
+}
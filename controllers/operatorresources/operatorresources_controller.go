@@ -0,0 +1,148 @@
+package operatorresources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	controllerName = "operatorresources"
+
+	// OperatorResourcesFinalizer protects the operator ConfigMap and credentials Secret from
+	// being removed while Account CRs still depend on them
+	OperatorResourcesFinalizer = "finalizer.aws.managed.openshift.io/operator-resources"
+
+	// blockedDeletionRequeue is how long to wait before checking again whether a blocked
+	// deletion can proceed
+	blockedDeletionRequeue = 1 * time.Minute
+)
+
+var log = logf.Log.WithName("controller_operatorresources")
+
+// OperatorResourcesReconciler guards the operator ConfigMap and AWS credentials Secret
+// against deletion while any Account CRs exist
+type OperatorResourcesReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list;watch;update;patch
+
+// Reconcile adds the protection finalizer to the operator ConfigMap and credentials Secret,
+// and only releases it once no Account CRs are left in the operator namespace.
+func (r *OperatorResourcesReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
+
+	obj, err := newProtectedObject(request.Name)
+	if err != nil {
+		reqLogger.Error(err, "ignoring unexpected object")
+		return reconcile.Result{}, nil
+	}
+
+	err = r.Get(ctx, request.NamespacedName, obj)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if obj.GetDeletionTimestamp() == nil {
+		labeled := obj.GetLabels()[utils.ManagedLabel] == "true"
+		if utils.Contains(obj.GetFinalizers(), OperatorResourcesFinalizer) && labeled {
+			return reconcile.Result{}, nil
+		}
+		reqLogger.Info("Adding deletion protection finalizer and managed label")
+		utils.AddFinalizer(obj, OperatorResourcesFinalizer)
+		// Keeps the resource cached when the cache is restricted to labeled objects
+		utils.AddLabels(obj, map[string]string{utils.ManagedLabel: "true"})
+		return reconcile.Result{}, r.Update(ctx, obj)
+	}
+
+	if !utils.Contains(obj.GetFinalizers(), OperatorResourcesFinalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	accountCount, err := r.countAccounts(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if accountCount > 0 {
+		reqLogger.Info(fmt.Sprintf("Blocking deletion: %d Account CRs still exist", accountCount))
+		return reconcile.Result{RequeueAfter: blockedDeletionRequeue}, nil
+	}
+
+	return reconcile.Result{}, r.removeFinalizer(ctx, reqLogger, obj)
+}
+
+func (r *OperatorResourcesReconciler) countAccounts(ctx context.Context) (int, error) {
+	accountList := &awsv1alpha1.AccountList{}
+	if err := r.List(ctx, accountList, client.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		return 0, err
+	}
+	return len(accountList.Items), nil
+}
+
+func (r *OperatorResourcesReconciler) removeFinalizer(ctx context.Context, reqLogger logr.Logger, obj client.Object) error {
+	reqLogger.Info("No Account CRs left, releasing deletion protection finalizer")
+	obj.SetFinalizers(utils.Remove(obj.GetFinalizers(), OperatorResourcesFinalizer))
+	return r.Update(ctx, obj)
+}
+
+// newProtectedObject returns an empty object of the kind protected under the given name
+func newProtectedObject(name string) (client.Object, error) {
+	switch name {
+	case awsv1alpha1.DefaultConfigMap:
+		return &corev1.ConfigMap{}, nil
+	case utils.AwsSecretName:
+		return &corev1.Secret{}, nil
+	}
+	return nil, fmt.Errorf("%s is not a protected operator resource", name)
+}
+
+// isProtectedResource filters events down to the operator ConfigMap and credentials Secret
+func isProtectedResource(obj client.Object) bool {
+	if obj.GetNamespace() != awsv1alpha1.AccountCrNamespace {
+		return false
+	}
+	switch obj.(type) {
+	case *corev1.ConfigMap:
+		return obj.GetName() == awsv1alpha1.DefaultConfigMap
+	case *corev1.Secret:
+		return obj.GetName() == utils.AwsSecretName
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *OperatorResourcesReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = utils.NewSecretAuditClient(r.Client, controllerName)
+	protected := builder.WithPredicates(predicate.NewPredicateFuncs(isProtectedResource))
+
+	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&corev1.ConfigMap{}, protected).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForObject{}, protected).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).Complete(rwm)
+}
@@ -0,0 +1,150 @@
+package operatorresources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	awsaccountapis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+func newReconciler(t *testing.T, objs ...runtime.Object) *OperatorResourcesReconciler {
+	if err := awsaccountapis.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatalf("failed adding apis to scheme: %v", err)
+	}
+	return &OperatorResourcesReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objs...).Build(),
+		Scheme: scheme.Scheme,
+	}
+}
+
+func operatorConfigMap(finalizers []string, deleting bool) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       awsv1alpha1.DefaultConfigMap,
+			Namespace:  awsv1alpha1.AccountCrNamespace,
+			Finalizers: finalizers,
+		},
+	}
+	if deleting {
+		now := metav1.Now()
+		cm.DeletionTimestamp = &now
+	}
+	return cm
+}
+
+func request(name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: name}}
+}
+
+func TestReconcileAddsFinalizer(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: utils.AwsSecretName, Namespace: awsv1alpha1.AccountCrNamespace},
+	}
+	r := newReconciler(t, operatorConfigMap(nil, false), secret)
+
+	for _, name := range []string{awsv1alpha1.DefaultConfigMap, utils.AwsSecretName} {
+		_, err := r.Reconcile(context.TODO(), request(name))
+		assert.NoError(t, err)
+	}
+
+	cm := &corev1.ConfigMap{}
+	assert.NoError(t, r.Get(context.TODO(), request(awsv1alpha1.DefaultConfigMap).NamespacedName, cm))
+	assert.Contains(t, cm.Finalizers, OperatorResourcesFinalizer)
+
+	s := &corev1.Secret{}
+	assert.NoError(t, r.Get(context.TODO(), request(utils.AwsSecretName).NamespacedName, s))
+	assert.Contains(t, s.Finalizers, OperatorResourcesFinalizer)
+	assert.Equal(t, "true", cm.Labels[utils.ManagedLabel])
+	assert.Equal(t, "true", s.Labels[utils.ManagedLabel])
+}
+
+func TestReconcileBlocksDeletionWhileAccountsExist(t *testing.T) {
+	account := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-abcdef", Namespace: awsv1alpha1.AccountCrNamespace},
+	}
+	r := newReconciler(t, operatorConfigMap([]string{OperatorResourcesFinalizer}, true), account)
+
+	result, err := r.Reconcile(context.TODO(), request(awsv1alpha1.DefaultConfigMap))
+	assert.NoError(t, err)
+	assert.Equal(t, blockedDeletionRequeue, result.RequeueAfter)
+
+	cm := &corev1.ConfigMap{}
+	assert.NoError(t, r.Get(context.TODO(), request(awsv1alpha1.DefaultConfigMap).NamespacedName, cm))
+	assert.Contains(t, cm.Finalizers, OperatorResourcesFinalizer)
+}
+
+func TestReconcileReleasesDeletionWithoutAccounts(t *testing.T) {
+	// Accounts outside the operator namespace don't depend on the operator resources
+	account := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-abcdef", Namespace: "default"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       utils.AwsSecretName,
+			Namespace:  awsv1alpha1.AccountCrNamespace,
+			Finalizers: []string{OperatorResourcesFinalizer, "example.com/other"},
+		},
+	}
+	now := metav1.Now()
+	secret.DeletionTimestamp = &now
+	r := newReconciler(t, operatorConfigMap([]string{OperatorResourcesFinalizer, "example.com/other"}, true), secret, account)
+
+	for _, obj := range []client.Object{&corev1.ConfigMap{}, &corev1.Secret{}} {
+		name := awsv1alpha1.DefaultConfigMap
+		if _, ok := obj.(*corev1.Secret); ok {
+			name = utils.AwsSecretName
+		}
+		result, err := r.Reconcile(context.TODO(), request(name))
+		assert.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+
+		assert.NoError(t, r.Get(context.TODO(), request(name).NamespacedName, obj))
+		assert.Equal(t, []string{"example.com/other"}, obj.GetFinalizers(), name)
+	}
+}
+
+func TestIsProtectedResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      client.Object
+		expected bool
+	}{
+		{
+			name:     "operator configmap",
+			obj:      operatorConfigMap(nil, false),
+			expected: true,
+		},
+		{
+			name:     "operator credentials secret",
+			obj:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: utils.AwsSecretName, Namespace: awsv1alpha1.AccountCrNamespace}},
+			expected: true,
+		},
+		{
+			name:     "other secret in operator namespace",
+			obj:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "some-iam-secret", Namespace: awsv1alpha1.AccountCrNamespace}},
+			expected: false,
+		},
+		{
+			name:     "configmap with the same name in another namespace",
+			obj:      &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: "default"}},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isProtectedResource(test.obj))
+		})
+	}
+}
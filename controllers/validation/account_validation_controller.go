@@ -13,7 +13,9 @@ import (
 	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/aws/smithy-go"
 	"github.com/go-logr/logr"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -24,7 +26,9 @@ import (
 	"github.com/openshift/aws-account-operator/config"
 	"github.com/openshift/aws-account-operator/controllers/account"
 	"github.com/openshift/aws-account-operator/controllers/accountclaim"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
 	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/canary"
 	"github.com/openshift/aws-account-operator/pkg/utils"
 )
 
@@ -50,6 +54,11 @@ type AccountValidationReconciler struct {
 	OUNameIDMap      map[string]string
 }
 
+// operatorConfig returns the operator configuration, read with the client of the reconciler
+func (r *AccountValidationReconciler) operatorConfig() *utils.OperatorConfig {
+	return utils.NewOperatorConfig(r.Client)
+}
+
 type ValidationError int64
 
 const (
@@ -92,6 +101,11 @@ func (ave *AccountValidationError) Error() string {
 	return ave.Err.Error()
 }
 
+// Unwrap lets errors.As see the error behind the validation error, e.g. the DryRunError of a skipped AWS write
+func (ave *AccountValidationError) Unwrap() error {
+	return ave.Err
+}
+
 // Retrieve all parents of the given awsId until the predicate returns true.
 func ParentsTillPredicate(awsId string, client awsclient.Client, p func(s string) bool, parents *[]string) error {
 	listParentsInput := organizations.ListParentsInput{
@@ -197,7 +211,7 @@ func ValidateAccountTags(client awsclient.Client, accountId *string, shardName s
 					}
 
 					complianceTags := make(map[string]string)
-					err = account.TagAccount(client, aws.ToString(accountId), shardName, complianceTags)
+					err = accountprovision.TagAccount(client, aws.ToString(accountId), shardName, complianceTags)
 					if err != nil {
 						log.Error(err, "Unable to tag aws account.", "AWSAccountID", accountId)
 						return &AccountValidationError{
@@ -219,7 +233,7 @@ func ValidateAccountTags(client awsclient.Client, accountId *string, shardName s
 
 	if accountTagEnabled {
 		complianceTags := make(map[string]string)
-		err := account.TagAccount(client, *accountId, shardName, complianceTags)
+		err := accountprovision.TagAccount(client, *accountId, shardName, complianceTags)
 		if err != nil {
 			log.Error(err, "Unable to tag aws account.", "AWSAccountID", accountId)
 			return &AccountValidationError{
@@ -304,7 +318,7 @@ func ValidateComplianceTags(client awsclient.Client, accountId *string, shardNam
 			complianceTags["cost-center"] = costCenter
 		}
 	}
-	err = account.TagAccount(client, *accountId, shardName, complianceTags)
+	err = accountprovision.TagAccount(client, *accountId, shardName, complianceTags)
 	if err != nil {
 		log.Error(err, "Unable to update compliance tags on aws account.", "AWSAccountID", accountId)
 		return &AccountValidationError{
@@ -351,8 +365,19 @@ func (r *AccountValidationReconciler) ValidateAccountOU(awsClient awsclient.Clie
 
 	ouNeedsCreating := false
 
-	// If the legal entity is not empty, it should go into the legalEntity's OU
-	if account.Spec.LegalEntity.ID != "" {
+	requiredOU, err := r.claimRequiredOU(account)
+	if err != nil {
+		return &AccountValidationError{
+			Type: OULookupFailed,
+			Err:  fmt.Errorf("unexpected error attempting to get the required OU of the claim: %w", err),
+		}
+	}
+
+	// A claim requiring an OU keeps its account there, otherwise if the legal entity is not empty, it should go into
+	// the legalEntity's OU
+	if requiredOU != "" {
+		correctOU = requiredOU
+	} else if account.Spec.LegalEntity.ID != "" {
 		claimedOU, err := r.GetOUIDFromName(awsClient, baseOU, account.Spec.LegalEntity.ID)
 		if err != nil {
 			if errors.Is(err, awsv1alpha1.ErrNonexistentOU) {
@@ -409,6 +434,22 @@ func (r *AccountValidationReconciler) ValidateAccountOU(awsClient awsclient.Clie
 	return nil
 }
 
+// claimRequiredOU returns the OU required by the claim of the account, if it's claimed
+func (r *AccountValidationReconciler) claimRequiredOU(account awsv1alpha1.Account) (string, error) {
+	if account.Spec.ClaimLink == "" {
+		return "", nil
+	}
+	claim := &awsv1alpha1.AccountClaim{}
+	err := r.Client.Get(context.TODO(), types.NamespacedName{Namespace: account.Spec.ClaimLinkNamespace, Name: account.Spec.ClaimLink}, claim)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return claim.Spec.RequiredOU, nil
+}
+
 func (r *AccountValidationReconciler) GetOUIDFromName(client awsclient.Client, parentid string, ouName string) (string, error) {
 	// Check in-memory storage first
 	if ouID, ok := r.OUNameIDMap[ouName]; ok {
@@ -465,13 +506,13 @@ func ValidateRemoval(account awsv1alpha1.Account) error {
 	return nil
 }
 
-func (r *AccountValidationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+func (r *AccountValidationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
 	log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	reqLogger := log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
 	// Setup: retrieve account and awsClient
 	var account awsv1alpha1.Account
-	err := r.Client.Get(context.TODO(), request.NamespacedName, &account)
+	err = r.Client.Get(context.TODO(), request.NamespacedName, &account)
 	if err != nil {
 		log.Info("Account does not exist", "account-request", request.NamespacedName, "error", err)
 		return utils.DoNotRequeue()
@@ -487,7 +528,18 @@ func (r *AccountValidationReconciler) Reconcile(ctx context.Context, request ctr
 		return utils.DoNotRequeue()
 	}
 
-	cm, err := utils.GetOperatorConfigMap(r.Client)
+	// The outcome of the validation of a canary account decides whether the operator version rolls out to its pool
+	pool, err := canary.PoolOf(ctx, r.Client, &account)
+	if err != nil {
+		return utils.RequeueWithError(err)
+	}
+	if canary.IsCanary(pool, &account) {
+		defer func() {
+			r.recordCanaryValidation(ctx, reqLogger, pool, &account, result, err)
+		}()
+	}
+
+	cm, err := r.operatorConfig().ConfigMap(ctx)
 	if err != nil {
 		log.Error(err, "Could not retrieve the operator configmap")
 		return utils.RequeueAfter(5 * time.Minute)
@@ -797,6 +849,7 @@ func (r *AccountValidationReconciler) ValidateRegionalServiceQuotas(reqLogger lo
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AccountValidationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = utils.NewSecretAuditClient(r.Client, controllerName)
 	r.awsClientBuilder = &awsclient.Builder{}
 	r.OUNameIDMap = map[string]string{}
 	maxReconciles, err := utils.GetControllerMaxReconciles(controllerName)
@@ -806,7 +859,7 @@ func (r *AccountValidationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	rwm := utils.NewReconcilerWithMetrics(r, controllerName)
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&awsv1alpha1.Account{}).
+		For(&awsv1alpha1.Account{}, utils.WithReconcilePredicates(controllerName, utils.AccountStatusTrigger)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}).Complete(rwm)
@@ -237,11 +237,16 @@ func TestMoveAccount(t *testing.T) {
 }
 
 func TestValidateAccountOU(t *testing.T) {
+	err := apis.AddToScheme(scheme.Scheme)
+	if err != nil {
+		fmt.Printf("failed adding to scheme in account_validation_controller_test.go")
+	}
 	ctrl := gomock.NewController(t)
 
 	testPoolOUID := "ou-abcd-efghijk"
 	testBaseOUID := "ou-lmno-qrstuvwxyz"
 	testLegalEntityOUID := "ou-aabb-ccddeeff"
+	testRequiredOUID := "ou-ffee-ddccbbaa"
 
 	legalEntity := awsv1alpha1.LegalEntity{
 		ID:   "abcdefg",
@@ -255,6 +260,7 @@ func TestValidateAccountOU(t *testing.T) {
 		account   awsv1alpha1.Account
 		wantErr   error
 		ouMap     map[string]string
+		objects   []runtime.Object
 	}{
 		{
 			name:      "Account that has never been claimed and is in pool OU should return no errors",
@@ -319,12 +325,29 @@ func TestValidateAccountOU(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("unexpected error attempting to get OU ID for legal entity"),
 			ouMap:   map[string]string{},
+		}, {
+			name:      "Claimed account in the OU required by its claim should return no error",
+			awsClient: designatedOrganization(ctrl, testRequiredOUID),
+			account: awsv1alpha1.Account{
+				Spec: awsv1alpha1.AccountSpec{
+					AwsAccountID:       "111111",
+					LegalEntity:        legalEntity,
+					ClaimLink:          "claim",
+					ClaimLinkNamespace: "claim-namespace",
+				},
+			},
+			wantErr: nil,
+			ouMap:   map[string]string{},
+			objects: []runtime.Object{&awsv1alpha1.AccountClaim{
+				ObjectMeta: v1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+				Spec:       awsv1alpha1.AccountClaimSpec{RequiredOU: testRequiredOUID},
+			}},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := &AccountValidationReconciler{}
+			r := &AccountValidationReconciler{Client: fake.NewClientBuilder().WithRuntimeObjects(tt.objects...).Build()}
 			r.OUNameIDMap = tt.ouMap
 			err := r.ValidateAccountOU(tt.awsClient, tt.account, testPoolOUID, testBaseOUID)
 			if err != tt.wantErr {
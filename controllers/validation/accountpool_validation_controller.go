@@ -37,6 +37,11 @@ type AccountPoolValidationReconciler struct {
 	awsClientBuilder awsclient.IBuilder
 }
 
+// operatorConfig returns the operator configuration, read with the client of the reconciler
+func (r *AccountPoolValidationReconciler) operatorConfig() *utils.OperatorConfig {
+	return utils.NewOperatorConfig(r.Client)
+}
+
 func (r *AccountPoolValidationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	reqLogger := logs.WithValues("Controller", validationControllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
@@ -55,7 +60,7 @@ func (r *AccountPoolValidationReconciler) Reconcile(ctx context.Context, request
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
-	cm, err := utils.GetOperatorConfigMap(r.Client)
+	cm, err := r.operatorConfig().ConfigMap(ctx)
 	if err != nil {
 		logs.Error(err, "Could not retrieve the operator configmap")
 		return utils.RequeueAfter(5 * time.Minute)
@@ -73,11 +78,24 @@ func (r *AccountPoolValidationReconciler) Reconcile(ctx context.Context, request
 
 	reqLogger.Info("Checking ConfigMap for ServiceQuotas")
 	// check if accountpool has servicequota defined in configmap
-	reginalServiceQuotas, err := utils.GetServiceQuotasFromAccountPool(reqLogger, currentAccountPool.Name, r.Client)
+	reginalServiceQuotas, err := r.operatorConfig().ServiceQuotas(ctx, reqLogger, currentAccountPool.Name)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
+	// Accounts of the pool fail to create their IAM user with an invalid prefix, flag it before they do
+	if _, err := r.operatorConfig().IAMUserNamePrefix(ctx, currentAccountPool.Name); err != nil {
+		reqLogger.Error(err, "invalid IAM user name prefix in the accountpool configuration")
+	}
+	// Disruptive operations on accounts of the pool are held back while the maintenance window is invalid
+	if _, err := r.operatorConfig().MaintenanceWindow(ctx, currentAccountPool.Name); err != nil {
+		reqLogger.Error(err, "invalid maintenance window in the accountpool configuration")
+	}
+	// Claims of the pool fail admission while its supported regions are invalid
+	if _, err := r.operatorConfig().SupportedRegions(ctx, currentAccountPool.Name); err != nil {
+		reqLogger.Error(err, "invalid supported regions in the accountpool configuration")
+	}
+
 	reqLogger.Info("Updating Account ServiceQuotas")
 	_, err = r.checkAccountServiceQuota(reqLogger, currentAccountPool.Name, reginalServiceQuotas, isEnabled)
 	if err != nil {
@@ -193,6 +211,7 @@ func (r *AccountPoolValidationReconciler) checkAccountServiceQuota(reqLogger log
 }
 
 func (r *AccountPoolValidationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = utils.NewSecretAuditClient(r.Client, validationControllerName)
 	r.awsClientBuilder = &awsclient.Builder{}
 	maxReconciles, err := utils.GetControllerMaxReconciles(validationControllerName)
 	if err != nil {
@@ -201,7 +220,7 @@ func (r *AccountPoolValidationReconciler) SetupWithManager(mgr ctrl.Manager) err
 
 	rwm := utils.NewReconcilerWithMetrics(r, validationControllerName)
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&awsv1alpha1.AccountPool{}).
+		For(&awsv1alpha1.AccountPool{}, utils.WithReconcilePredicates(validationControllerName, nil)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}).Complete(rwm)
@@ -0,0 +1,24 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/canary"
+	"github.com/openshift/aws-account-operator/version"
+)
+
+// recordCanaryValidation records the outcome of the validation of a canary account in the rollout of the operator
+// version to its pool. A validation that failed is a regression, one still waiting on AWS, e.g. for quotas or opt-in
+// regions, is recorded once it completes.
+func (r *AccountValidationReconciler) recordCanaryValidation(ctx context.Context, reqLogger logr.Logger, pool *awsv1alpha1.AccountPool, account *awsv1alpha1.Account, result ctrl.Result, err error) {
+	if err == nil && (result.Requeue || result.RequeueAfter > 0) {
+		return
+	}
+	if recordErr := canary.RecordValidation(ctx, reqLogger, r.Client, pool, account, version.Version, err); recordErr != nil {
+		reqLogger.Error(recordErr, "failed recording the validation of the canary account", "accountPool", pool.Name)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -18,17 +19,20 @@ import (
 	"github.com/operator-framework/operator-lib/leader"
 
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
+	configv1 "github.com/openshift/api/config/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -40,9 +44,15 @@ import (
 	"github.com/openshift/aws-account-operator/controllers/accountpool"
 	"github.com/openshift/aws-account-operator/controllers/awsfederatedaccountaccess"
 	"github.com/openshift/aws-account-operator/controllers/awsfederatedrole"
+	"github.com/openshift/aws-account-operator/controllers/clusterdeployment"
+	"github.com/openshift/aws-account-operator/controllers/fleetfederatedaccess"
+	"github.com/openshift/aws-account-operator/controllers/operatorconfig"
+	"github.com/openshift/aws-account-operator/controllers/operatorresources"
 	"github.com/openshift/aws-account-operator/controllers/validation"
 	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/legacymigration"
 	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	"github.com/openshift/aws-account-operator/pkg/storageversion"
 	"github.com/openshift/aws-account-operator/pkg/totalaccountwatcher"
 	"github.com/openshift/aws-account-operator/pkg/utils"
 	"github.com/openshift/aws-account-operator/version"
@@ -65,6 +75,8 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(awsv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(routev1.Install(scheme))
+	utilruntime.Must(configv1.Install(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -79,11 +91,24 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var cacheLabeledOnly bool
+	var controllersFlag string
+	var dryRunFlag bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8081", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":9081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&cacheLabeledOnly, "cache-labeled-objects-only", false,
+		"Only cache the Secrets and ConfigMaps labeled "+utils.ManagedLabel+"=true. "+
+			"Label the operator ConfigMap and credentials Secret, and the secrets delivered to claims before the operator labeled them, before enabling it.")
+	flag.StringVar(&controllersFlag, "controllers", "",
+		"A comma separated list of the controllers to run. '*' enables all of them, 'foo' enables foo and '-foo' disables it. "+
+			"Defaults to the '"+utils.ControllersConfigMapKey+"' key of the operator ConfigMap, or all of them when unset. "+
+			"Controllers: "+strings.Join(utils.ToggleableControllers, ", ")+".")
+	flag.BoolVar(&dryRunFlag, "dry-run", false,
+		"Reconcile without writing to AWS or Kubernetes, logging the intended writes instead. "+
+			"Defaults to the '"+utils.DryRunConfigMapKey+"' key of the operator ConfigMap.")
 
 	isDebuggingEnabled := utils.GetEnvironmentBool("DEBUG_LOGGING", false)
 	opts := zap.Options{
@@ -106,12 +131,23 @@ func main() {
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "c0d5a6d1.managed.openshift.io",
+		NewCache:               cache.BuilderWithOptions(cache.Options{SelectorsByObject: utils.CacheSelectors(cacheLabeledOnly)}),
+		NewClient:              utils.WithDryRun(utils.NewCacheScopedClient(cacheLabeledOnly)),
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	if err = utils.IndexSecrets(context.TODO(), mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to index secrets")
+		os.Exit(1)
+	}
+	if err = utils.IndexAccounts(context.TODO(), mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to index accounts")
+		os.Exit(1)
+	}
+
 	// Become the leader before proceeding
 	// This doesn't work locally, so only perform it when running on-cluster
 	if utils.DetectDevMode != utils.DevModeLocal {
@@ -134,7 +170,7 @@ func main() {
 	// Define a kubeClient for any processes that need to run during operator startup or independent routines to use
 	// We should avoid using this kubeClient except for when necessary and utilize the operator-sdk provided client as much as possible.
 	// The operator-sdk kube client provides a level of caching that we don't get with building our own this way.
-	kubeClient, err := client.New(cfg, client.Options{})
+	kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
 	if err != nil {
 		setupLog.Error(err, "Failed to create a kubernetes client")
 		os.Exit(1)
@@ -148,54 +184,52 @@ func main() {
 		}
 	}
 
-	if err = (&accountclaim.AccountClaimReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AccountClaim")
-		os.Exit(1)
-	}
-	if err = (&awsfederatedrole.AWSFederatedRoleReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AWSFederatedRole")
-		os.Exit(1)
+	cm, err := utils.GetOperatorConfigMap(kubeClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to get the operator ConfigMap, the controllers are selected by the --controllers flag only")
 	}
-	if err = (&awsfederatedaccountaccess.AWSFederatedAccountAccessReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AWSFederatedAccountAccess")
+	enabledControllers, err := utils.EnabledControllersFromConfig(controllersFlag, cm)
+	if err != nil {
+		setupLog.Error(err, "invalid controller selection")
 		os.Exit(1)
 	}
-	if err = (&accountpool.AccountPoolReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AccountPool")
+	dryRun, err := utils.DryRunFromConfig(dryRunFlag, cm)
+	if err != nil {
+		setupLog.Error(err, "invalid dry-run mode")
 		os.Exit(1)
 	}
-	if err = (&account.AccountReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Account")
-		os.Exit(1)
+	utils.SetDryRun(dryRun)
+	if dryRun {
+		setupLog.Info("running in dry-run mode, the AWS and Kubernetes writes are logged and skipped")
+		kubeClient = utils.NewDryRunClient(kubeClient)
 	}
-	if err = (&validation.AccountValidationReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AccountValidation")
-		os.Exit(1)
+
+	controllers := []struct {
+		name       string
+		kind       string
+		reconciler interface{ SetupWithManager(ctrl.Manager) error }
+	}{
+		{"accountclaim", "AccountClaim", &accountclaim.AccountClaimReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"awsfederatedrole", "AWSFederatedRole", &awsfederatedrole.AWSFederatedRoleReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"awsfederatedaccountaccess", "AWSFederatedAccountAccess", &awsfederatedaccountaccess.AWSFederatedAccountAccessReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"fleetfederatedaccess", "FleetFederatedAccess", &fleetfederatedaccess.FleetFederatedAccessReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"accountpool", "AccountPool", &accountpool.AccountPoolReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"account", "Account", &account.AccountReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"accountvalidation", "AccountValidation", &validation.AccountValidationReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"accountpoolvalidation", "AccountPoolValidation", &validation.AccountPoolValidationReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"operatorresources", "OperatorResources", &operatorresources.OperatorResourcesReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"operatorconfig", "OperatorConfig", &operatorconfig.OperatorConfigReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"clusterdeployment", "ClusterDeployment", &clusterdeployment.ClusterDeploymentReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
 	}
-	if err = (&validation.AccountPoolValidationReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AccountPoolValidation")
-		os.Exit(1)
+	for _, c := range controllers {
+		if !enabledControllers.Enabled(c.name) {
+			setupLog.Info("controller disabled", "controller", c.kind)
+			continue
+		}
+		if err = c.reconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", c.kind)
+			os.Exit(1)
+		}
 	}
 
 	//+kubebuilder:scaffold:builder
@@ -252,6 +286,20 @@ func main() {
 	// Initialize our ConfigMap with default values if necessary.
 	initOperatorConfigMapVars(kubeClient)
 
+	// Rewrite the CRs still stored in an older version of their CRD in the background
+	go func() {
+		if _, err := storageversion.MigrateAll(stopCh, setupLog, kubeClient); err != nil {
+			setupLog.Error(err, "Failed migrating the storage version of the CRDs")
+		}
+	}()
+
+	// Normalize the finalizers, labels and secret names of objects created by older operator versions
+	go func() {
+		if _, err := legacymigration.Run(stopCh, setupLog, kubeClient); err != nil {
+			setupLog.Error(err, "Failed migrating legacy objects")
+		}
+	}()
+
 	// Initialize the TotalAccountWatcher
 	go totalaccountwatcher.TotalAccountWatcher.Start(setupLog, stopCh, kubeClient, totalWatcherInterval)
 
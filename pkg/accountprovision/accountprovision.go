@@ -0,0 +1,218 @@
+// Package accountprovision creates AWS accounts in the organization with the conventions of the operator: the email
+// address derived from the account name, the owner and compliance tags and the placement in an OU. It's used by the
+// account controller and can be imported by tools that create accounts without running the operator.
+package accountprovision
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/go-logr/logr"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// OwnerTagKey is the tag holding the name of the shard that owns the account
+const OwnerTagKey = "owner"
+
+// OrganizationsClient is the part of the AWS Organizations API account provisioning uses. awsclient.Client
+// implements it.
+type OrganizationsClient interface {
+	CreateAccount(context.Context, *organizations.CreateAccountInput) (*organizations.CreateAccountOutput, error)
+	DescribeCreateAccountStatus(context.Context, *organizations.DescribeCreateAccountStatusInput) (*organizations.DescribeCreateAccountStatusOutput, error)
+	TagResource(context.Context, *organizations.TagResourceInput) (*organizations.TagResourceOutput, error)
+	ListParents(context.Context, *organizations.ListParentsInput) (*organizations.ListParentsOutput, error)
+	MoveAccount(context.Context, *organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error)
+}
+
+// Request describes an account to provision
+type Request struct {
+	// Name is the name of the account, e.g. osd-creds-mgmt-aaaaaa
+	Name string
+	// Email is the email address of the account root user, derived from Name with FormatEmail when empty
+	Email string
+	// Owner is the shard name set as the owner tag
+	Owner string
+	// Tags are added to the owner tag, e.g. the compliance tags
+	Tags map[string]string
+	// OU is the ID of the OU the account is moved to. The account stays in the organization root when empty.
+	OU string
+}
+
+// Provision creates the account, tags it and moves it into its OU, and returns its ID. An account that failed to be
+// tagged or moved is still created, its ID is returned along with the error so the remaining steps can be retried.
+func Provision(reqLogger logr.Logger, client OrganizationsClient, request Request) (string, error) {
+	email := request.Email
+	if email == "" {
+		email = FormatEmail(request.Name)
+	}
+
+	status, _, err := CreateAccount(reqLogger, client, request.Name, email)
+	if err != nil {
+		return "", err
+	}
+	accountID := aws.ToString(status.CreateAccountStatus.AccountId)
+
+	err = TagAccount(client, accountID, request.Owner, request.Tags)
+	if err != nil {
+		return accountID, err
+	}
+
+	if request.OU != "" {
+		err = MoveToOU(reqLogger, client, accountID, request.OU)
+	}
+	return accountID, err
+}
+
+// FormatEmail returns the email address of an account, the name with its last segment as a subaddress:
+// osd-creds-mgmt-aaaaaa gets osd-creds-mgmt+aaaaaa@redhat.com
+func FormatEmail(name string) string {
+	splitString := strings.Split(name, "-")
+	prefix := splitString[0]
+	for i := 1; i < (len(splitString) - 1); i++ {
+		prefix = prefix + "-" + splitString[i]
+	}
+
+	return prefix + "+" + splitString[len(splitString)-1] + "@redhat.com"
+}
+
+// CreateAccount creates an account in the organization and waits for its creation to finish. Errors are mapped to
+// the errors of the awsv1alpha1 package, except the error of a call skipped in dry-run mode, which is returned as is.
+// The AWS request ID of a failed CreateAccount call is returned along with the error, as the mapped error doesn't
+// carry it.
+func CreateAccount(reqLogger logr.Logger, client OrganizationsClient, accountName, accountEmail string) (*organizations.DescribeCreateAccountStatusOutput, string, error) {
+
+	createInput := organizations.CreateAccountInput{
+		AccountName: aws.String(accountName),
+		Email:       aws.String(accountEmail),
+	}
+
+	createOutput, err := client.CreateAccount(context.TODO(), &createInput)
+	if utils.IsDryRunSkipped(err) {
+		return &organizations.DescribeCreateAccountStatusOutput{}, "", err
+	}
+	if err != nil {
+		errMsg := "Error creating account"
+		var returnErr error
+
+		// Check for specific AWS Organizations exception types
+		var concurrentModErr *organizationstypes.ConcurrentModificationException
+		var constraintViolationErr *organizationstypes.ConstraintViolationException
+		var serviceErr *organizationstypes.ServiceException
+		var tooManyRequestsErr *organizationstypes.TooManyRequestsException
+
+		switch {
+		case errors.As(err, &concurrentModErr):
+			returnErr = awsv1alpha1.ErrAwsConcurrentModification
+		case errors.As(err, &constraintViolationErr):
+			returnErr = awsv1alpha1.ErrAwsAccountLimitExceeded
+		case errors.As(err, &serviceErr):
+			returnErr = awsv1alpha1.ErrAwsInternalFailure
+		case errors.As(err, &tooManyRequestsErr):
+			returnErr = awsv1alpha1.ErrAwsTooManyRequests
+		default:
+			returnErr = awsv1alpha1.ErrAwsFailedCreateAccount
+		}
+
+		utils.LogAwsError(reqLogger, errMsg, returnErr, err)
+		return &organizations.DescribeCreateAccountStatusOutput{}, utils.AwsRequestID(err), returnErr
+	}
+
+	describeStatusInput := organizations.DescribeCreateAccountStatusInput{
+		CreateAccountRequestId: createOutput.CreateAccountStatus.Id,
+	}
+
+	var accountStatus *organizations.DescribeCreateAccountStatusOutput
+	for {
+		status, err := client.DescribeCreateAccountStatus(context.TODO(), &describeStatusInput)
+		if err != nil {
+			return &organizations.DescribeCreateAccountStatusOutput{}, "", err
+		}
+
+		accountStatus = status
+		createStatus := status.CreateAccountStatus.State
+
+		if createStatus == organizationstypes.CreateAccountStateFailed {
+			var returnErr error
+			switch status.CreateAccountStatus.FailureReason {
+			case organizationstypes.CreateAccountFailureReasonAccountLimitExceeded:
+				returnErr = awsv1alpha1.ErrAwsAccountLimitExceeded
+			case organizationstypes.CreateAccountFailureReasonInternalFailure:
+				returnErr = awsv1alpha1.ErrAwsInternalFailure
+			default:
+				returnErr = awsv1alpha1.ErrAwsFailedCreateAccount
+			}
+
+			return &organizations.DescribeCreateAccountStatusOutput{}, "", returnErr
+		}
+
+		if createStatus != organizationstypes.CreateAccountStateInProgress {
+			break
+		}
+	}
+
+	return accountStatus, "", nil
+}
+
+// Tags returns the tags of an account: the owner tag with the shard name, followed by the given tags
+func Tags(shardName string, tags map[string]string) []organizationstypes.Tag {
+	accountTags := []organizationstypes.Tag{
+		{
+			Key:   aws.String(OwnerTagKey),
+			Value: aws.String(shardName),
+		},
+	}
+
+	for key, value := range tags {
+		accountTags = append(accountTags, organizationstypes.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+	return accountTags
+}
+
+// TagAccount tags the account with the owner tag and the given tags, e.g. the compliance tags
+func TagAccount(client OrganizationsClient, awsAccountID string, shardName string, tags map[string]string) error {
+	inputTag := &organizations.TagResourceInput{
+		ResourceId: aws.String(awsAccountID),
+		Tags:       Tags(shardName, tags),
+	}
+
+	_, err := client.TagResource(context.TODO(), inputTag)
+	return err
+}
+
+// MoveToOU moves the account from its current parent into the OU, unless it's already there
+func MoveToOU(reqLogger logr.Logger, client OrganizationsClient, awsAccountID string, ouID string) error {
+	listParentsOutput, err := client.ListParents(context.TODO(), &organizations.ListParentsInput{
+		ChildId: aws.String(awsAccountID),
+	})
+	if err != nil {
+		reqLogger.Error(err, "Can not find parent for AWS account", "aws-account", awsAccountID)
+		return err
+	}
+	if len(listParentsOutput.Parents) == 0 {
+		return awsv1alpha1.ErrChildNotFound
+	}
+	parentID := aws.ToString(listParentsOutput.Parents[0].Id)
+	if parentID == ouID {
+		return nil
+	}
+
+	reqLogger.Info("Moving aws account from old ou to new ou", "aws-account", awsAccountID, "old-ou", parentID, "new-ou", ouID)
+	_, err = client.MoveAccount(context.TODO(), &organizations.MoveAccountInput{
+		AccountId:           aws.String(awsAccountID),
+		DestinationParentId: aws.String(ouID),
+		SourceParentId:      aws.String(parentID),
+	})
+	if err != nil {
+		reqLogger.Error(err, "Could not move aws account to new ou", "aws-account", awsAccountID, "ou", ouID)
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+package accountprovision
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+)
+
+func TestFormatEmail(t *testing.T) {
+	assert.Equal(t, "osd-creds-mgmt+aaaaaa@redhat.com", FormatEmail("osd-creds-mgmt-aaaaaa"))
+	assert.Equal(t, "libra-ops+bbbbbb@redhat.com", FormatEmail("libra-ops-bbbbbb"))
+}
+
+func TestTags(t *testing.T) {
+	tags := Tags("hivep01ue1", map[string]string{"app-code": "OSD-002"})
+
+	assert.Equal(t, []organizationstypes.Tag{
+		{Key: aws.String(OwnerTagKey), Value: aws.String("hivep01ue1")},
+		{Key: aws.String("app-code"), Value: aws.String("OSD-002")},
+	}, tags)
+}
+
+func expectCreatedAccount(client *mock.MockClient) {
+	client.EXPECT().CreateAccount(gomock.Any(), &organizations.CreateAccountInput{
+		AccountName: aws.String("osd-creds-mgmt-aaaaaa"),
+		Email:       aws.String("osd-creds-mgmt+aaaaaa@redhat.com"),
+	}).Return(&organizations.CreateAccountOutput{
+		CreateAccountStatus: &organizationstypes.CreateAccountStatus{Id: aws.String("car-1")},
+	}, nil)
+	client.EXPECT().DescribeCreateAccountStatus(gomock.Any(), gomock.Any()).Return(&organizations.DescribeCreateAccountStatusOutput{
+		CreateAccountStatus: &organizationstypes.CreateAccountStatus{State: organizationstypes.CreateAccountStateInProgress},
+	}, nil)
+	client.EXPECT().DescribeCreateAccountStatus(gomock.Any(), gomock.Any()).Return(&organizations.DescribeCreateAccountStatusOutput{
+		CreateAccountStatus: &organizationstypes.CreateAccountStatus{
+			State:     organizationstypes.CreateAccountStateSucceeded,
+			AccountId: aws.String("123456789012"),
+		},
+	}, nil)
+}
+
+func TestProvision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockClient(ctrl)
+	expectCreatedAccount(client)
+	client.EXPECT().TagResource(gomock.Any(), &organizations.TagResourceInput{
+		ResourceId: aws.String("123456789012"),
+		Tags:       Tags("hivep01ue1", nil),
+	}).Return(&organizations.TagResourceOutput{}, nil)
+	client.EXPECT().ListParents(gomock.Any(), gomock.Any()).Return(&organizations.ListParentsOutput{
+		Parents: []organizationstypes.Parent{{Id: aws.String("r-root")}},
+	}, nil)
+	client.EXPECT().MoveAccount(gomock.Any(), &organizations.MoveAccountInput{
+		AccountId:           aws.String("123456789012"),
+		DestinationParentId: aws.String("ou-pool"),
+		SourceParentId:      aws.String("r-root"),
+	}).Return(&organizations.MoveAccountOutput{}, nil)
+
+	accountID, err := Provision(testutils.NewTestLogger().Logger(), client, Request{
+		Name:  "osd-creds-mgmt-aaaaaa",
+		Owner: "hivep01ue1",
+		OU:    "ou-pool",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012", accountID)
+}
+
+func TestProvisionReturnsTheAccountIDOfPartialFailures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockClient(ctrl)
+	expectCreatedAccount(client)
+	client.EXPECT().TagResource(gomock.Any(), gomock.Any()).Return(nil, errors.New("throttled"))
+
+	accountID, err := Provision(testutils.NewTestLogger().Logger(), client, Request{Name: "osd-creds-mgmt-aaaaaa"})
+
+	assert.Error(t, err)
+	assert.Equal(t, "123456789012", accountID)
+}
+
+func TestCreateAccountFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockClient(ctrl)
+	client.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(&organizations.CreateAccountOutput{
+		CreateAccountStatus: &organizationstypes.CreateAccountStatus{Id: aws.String("car-1")},
+	}, nil)
+	client.EXPECT().DescribeCreateAccountStatus(gomock.Any(), gomock.Any()).Return(&organizations.DescribeCreateAccountStatusOutput{
+		CreateAccountStatus: &organizationstypes.CreateAccountStatus{
+			State:         organizationstypes.CreateAccountStateFailed,
+			FailureReason: organizationstypes.CreateAccountFailureReasonAccountLimitExceeded,
+		},
+	}, nil)
+
+	_, _, err := CreateAccount(testutils.NewTestLogger().Logger(), client, "osd-creds-mgmt-aaaaaa", "osd-creds-mgmt+aaaaaa@redhat.com")
+
+	assert.Equal(t, awsv1alpha1.ErrAwsAccountLimitExceeded, err)
+}
+
+func TestCreateAccountSkippedInDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockClient(ctrl)
+	client.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Return(nil, &awsclient.DryRunError{Service: "Organizations", Operation: "CreateAccount"})
+
+	_, _, err := CreateAccount(testutils.NewTestLogger().Logger(), client, "osd-creds-mgmt-aaaaaa", "osd-creds-mgmt+aaaaaa@redhat.com")
+
+	assert.True(t, awsclient.IsDryRunError(err))
+}
+
+func TestMoveToOUSkipsAccountsAlreadyInTheOU(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockClient(ctrl)
+	client.EXPECT().ListParents(gomock.Any(), gomock.Any()).Return(&organizations.ListParentsOutput{
+		Parents: []organizationstypes.Parent{{Id: aws.String("ou-pool")}},
+	}, nil)
+
+	assert.NoError(t, MoveToOU(testutils.NewTestLogger().Logger(), client, "123456789012", "ou-pool"))
+}
+
+func TestOwnershipTags(t *testing.T) {
+	account := &awsv1alpha1.Account{
+		Spec: awsv1alpha1.AccountSpec{
+			AccountPool:        "fm-pool",
+			ClaimLink:          "claim",
+			ClaimLinkNamespace: "claim-ns",
+			LegalEntity:        awsv1alpha1.LegalEntity{ID: "abcdefg", Name: "entity"},
+		},
+		Status: awsv1alpha1.AccountStatus{State: "Ready"},
+	}
+
+	tags := OwnershipTags(account, "hivep01ue1")
+
+	assert.Equal(t, map[string]string{
+		OwnerTagKey:       "hivep01ue1",
+		PoolTagKey:        "fm-pool",
+		ClaimTagKey:       "claim-ns/claim",
+		LegalEntityTagKey: HashTagValue("abcdefg"),
+		StateTagKey:       "Ready",
+	}, tags)
+	assert.NotContains(t, tags[LegalEntityTagKey], "abcdefg")
+}
+
+func TestOwnershipTagsLeaveOutEmptyValues(t *testing.T) {
+	tags := OwnershipTags(&awsv1alpha1.Account{}, "hivep01ue1")
+
+	assert.Equal(t, map[string]string{OwnerTagKey: "hivep01ue1"}, tags)
+}
+
+func TestParseClaimTag(t *testing.T) {
+	namespace, name := ParseClaimTag("claim-ns/claim")
+	assert.Equal(t, "claim-ns", namespace)
+	assert.Equal(t, "claim", name)
+}
@@ -0,0 +1,73 @@
+package accountprovision
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// The ownership tags record which shard, pool and claim an account belongs to and its state, so the accounts can be
+// recovered or found orphaned without the Account. OwnerTagKey is part of them.
+const (
+	// OwnershipTagPrefix prefixes the ownership tags the operator manages, other than the owner tag
+	OwnershipTagPrefix = "aws.managed.openshift.io/"
+	// PoolTagKey holds the AccountPool of the account
+	PoolTagKey = OwnershipTagPrefix + "pool"
+	// ClaimTagKey holds the namespace/name of the AccountClaim the account is linked to
+	ClaimTagKey = OwnershipTagPrefix + "claim"
+	// LegalEntityTagKey holds a hash of the ID of the legal entity the account was claimed by
+	LegalEntityTagKey = OwnershipTagPrefix + "legal-entity"
+	// StateTagKey holds the state of the Account
+	StateTagKey = OwnershipTagPrefix + "state"
+
+	// maxTagValueLength is the maximum length of an Organizations tag value
+	maxTagValueLength = 256
+)
+
+// invalidTagValueCharacters matches the characters Organizations doesn't allow in tag values
+var invalidTagValueCharacters = regexp.MustCompile(`[^\p{L}\p{Z}\p{N}_.:/=+\-@]`)
+
+// OwnershipTags returns the ownership tags of the account. Values are redacted so they can be read by anyone with
+// access to the organization: the legal entity ID is hashed, characters Organizations doesn't allow are replaced and
+// values are truncated to the maximum tag length. Tags without a value are left out.
+func OwnershipTags(account *awsv1alpha1.Account, shardName string) map[string]string {
+	tags := map[string]string{}
+	set := func(key, value string) {
+		value = invalidTagValueCharacters.ReplaceAllString(value, "_")
+		if len(value) > maxTagValueLength {
+			value = value[:maxTagValueLength]
+		}
+		if value != "" {
+			tags[key] = value
+		}
+	}
+
+	set(OwnerTagKey, shardName)
+	set(PoolTagKey, account.Spec.AccountPool)
+	if account.Spec.ClaimLink != "" {
+		set(ClaimTagKey, account.Spec.ClaimLinkNamespace+"/"+account.Spec.ClaimLink)
+	}
+	if account.Spec.LegalEntity.ID != "" {
+		set(LegalEntityTagKey, HashTagValue(account.Spec.LegalEntity.ID))
+	}
+	set(StateTagKey, account.Status.State)
+	return tags
+}
+
+// HashTagValue redacts a sensitive tag value, keeping it comparable
+func HashTagValue(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:8])
+}
+
+// ParseClaimTag returns the namespace and name of the claim held by the ClaimTagKey tag
+func ParseClaimTag(value string) (namespace string, name string) {
+	namespace, name, found := strings.Cut(value, "/")
+	if !found {
+		return "", value
+	}
+	return namespace, name
+}
@@ -29,7 +29,7 @@ var _ = Describe("AWS Resource Tag Builder", func() {
 				},
 			}
 
-			client, err := newClient("", "sss", "TESTSTETST", "eu-central-1", "eu-central-1")
+			client, err := newClient("", "sss", "TESTSTETST", "eu-central-1", "eu-central-1", defaultTransportConfig(), nil)
 			done := make(chan error)
 			// call describeRegions asynchronously
 			go func() {
@@ -0,0 +1,149 @@
+// gen generates the per-service interfaces of awsclient.Client and the methods of awsClient passing their calls
+// through to the SDK clients, from the services listed in services.go. The mocks are generated by mockgen from its
+// output, see the go:generate directives of pkg/awsclient/client.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"text/template"
+)
+
+const sdkImportPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+// service is an AWS service of the client, with the interface and SDK client it's called through
+type service struct {
+	// Interface is the name of the interface of the service, embedded in awsclient.Client
+	Interface string
+	// API names the service in the doc comment of the interface
+	API string
+	// Package is the name of the SDK package of the service, under github.com/aws/aws-sdk-go-v2/service
+	Package string
+	// Field is the field of awsClient holding the SDK client of the service
+	Field string
+	// Methods are the methods of the interface
+	Methods []method
+}
+
+// method is a method of the interface of a service
+type method struct {
+	Name string
+	// Custom methods are implemented by hand in client.go, the generator only adds them to the interface
+	Custom bool
+	// Signature overrides the signature of SDK operations, taking the input and returning the output of the operation
+	// named like the method. Only custom methods can have another signature.
+	Signature string
+}
+
+var fileTemplate = template.Must(template.New("client").Funcs(template.FuncMap{"signature": signature}).Parse(`// Code generated by pkg/awsclient/gen. DO NOT EDIT.
+
+package awsclient
+
+import (
+	"context"
+{{ range .Imports }}
+	"{{ . }}"
+{{- end }}
+)
+
+// Client is a wrapper object for actual AWS SDK clients to allow for easier testing. It is composed of a client per
+// AWS service, so code using a single service can depend on the client of that service only.
+type Client interface {
+{{- range .Services }}
+	{{ .Interface }}
+{{- end }}
+}
+{{ range $service := .Services }}
+// {{ .Interface }} is the part of Client calling {{ .API }}
+type {{ .Interface }} interface {
+{{- range .Methods }}
+	{{ .Name }}{{ signature $service . }}
+{{- end }}
+}
+{{ end }}
+{{- range $service := .Services }}
+{{- range .Methods }}
+{{- if not .Custom }}
+func (c *awsClient) {{ .Name }}(ctx context.Context, input *{{ $service.Package }}.{{ .Name }}Input) (*{{ $service.Package }}.{{ .Name }}Output, error) {
+	return c.{{ $service.Field }}.{{ .Name }}(ctx, input)
+}
+{{ end }}
+{{- end }}
+{{- end }}`))
+
+// signature returns the signature of a method of the interface of a service
+func signature(s service, m method) string {
+	if m.Signature != "" {
+		return m.Signature
+	}
+	return fmt.Sprintf("(context.Context, *%[1]s.%[2]sInput) (*%[1]s.%[2]sOutput, error)", s.Package, m.Name)
+}
+
+// validate rejects services the generated code wouldn't compile for
+func validate(services []service) error {
+	interfaces := map[string]bool{}
+	methods := map[string]string{}
+	for _, s := range services {
+		if s.Interface == "" || s.Package == "" || s.Field == "" {
+			return fmt.Errorf("service %q needs an interface, package and field", s.Interface)
+		}
+		if interfaces[s.Interface] {
+			return fmt.Errorf("interface %s is listed twice", s.Interface)
+		}
+		interfaces[s.Interface] = true
+		for _, m := range s.Methods {
+			if other, ok := methods[m.Name]; ok {
+				return fmt.Errorf("method %s is listed in both %s and %s", m.Name, other, s.Interface)
+			}
+			methods[m.Name] = s.Interface
+			if m.Signature != "" && !m.Custom {
+				return fmt.Errorf("method %s of %s has a signature but isn't custom", m.Name, s.Interface)
+			}
+		}
+	}
+	return nil
+}
+
+// generate returns the formatted source of the interfaces and passthrough methods of the services
+func generate(services []service) ([]byte, error) {
+	err := validate(services)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make([]string, 0, len(services))
+	for _, s := range services {
+		imports = append(imports, sdkImportPrefix+s.Package)
+	}
+	sort.Strings(imports)
+
+	var buf bytes.Buffer
+	err = fileTemplate.Execute(&buf, struct {
+		Imports  []string
+		Services []service
+	}{imports, services})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	output := flag.String("output", "zz_generated.client.go", "file to write the generated code to")
+	flag.Parse()
+
+	src, err := generate(services)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	err = os.WriteFile(*output, src, 0600)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
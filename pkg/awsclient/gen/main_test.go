@@ -0,0 +1,75 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedClientIsUpToDate(t *testing.T) {
+	generated, err := os.ReadFile("../zz_generated.client.go")
+	assert.NoError(t, err)
+
+	src, err := generate(services)
+	assert.NoError(t, err)
+	assert.Equal(t, string(src), string(generated), "run go generate ./pkg/awsclient after changing services.go")
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []service
+		err      string
+	}{
+		{
+			name: "valid",
+			services: []service{
+				{Interface: "STSClient", Package: "sts", Field: "stsClient", Methods: []method{{Name: "AssumeRole"}}},
+				{Interface: "S3Client", Package: "s3", Field: "s3Client", Methods: []method{
+					{Name: "BatchDeleteBucketObjects", Custom: true, Signature: "(context.Context, *string) error"},
+				}},
+			},
+		},
+		{
+			name:     "missing field",
+			services: []service{{Interface: "STSClient", Package: "sts"}},
+			err:      `service "STSClient" needs an interface, package and field`,
+		},
+		{
+			name: "duplicate interface",
+			services: []service{
+				{Interface: "STSClient", Package: "sts", Field: "stsClient"},
+				{Interface: "STSClient", Package: "sts", Field: "stsClient"},
+			},
+			err: "interface STSClient is listed twice",
+		},
+		{
+			name: "duplicate method",
+			services: []service{
+				{Interface: "IAMClient", Package: "iam", Field: "iamClient", Methods: []method{{Name: "TagResource"}}},
+				{Interface: "OrganizationsClient", Package: "organizations", Field: "orgClient", Methods: []method{{Name: "TagResource"}}},
+			},
+			err: "method TagResource is listed in both IAMClient and OrganizationsClient",
+		},
+		{
+			name: "signature of a passthrough",
+			services: []service{
+				{Interface: "S3Client", Package: "s3", Field: "s3Client", Methods: []method{
+					{Name: "BatchDeleteBucketObjects", Signature: "(context.Context, *string) error"},
+				}},
+			},
+			err: "method BatchDeleteBucketObjects of S3Client has a signature but isn't custom",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validate(test.services)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+// services lists the AWS services of the awsclient.Client and the methods it calls, in the order of the generated
+// interfaces. Adding a method here adds it to the interface of its service, to the passthrough methods of awsClient
+// calling the SDK client of the service, and to the mocks once they're regenerated.
+var services = []service{
+	{
+		Interface: "AccountClient",
+		API:       "the AWS Account Management API",
+		Package:   "account",
+		Field:     "acctClient",
+		Methods: []method{
+			{Name: "EnableRegion"},
+			{Name: "GetRegionOptStatus"},
+			{Name: "GetAlternateContact"},
+			{Name: "PutAlternateContact"},
+		},
+	},
+	{
+		Interface: "EC2Client",
+		API:       "EC2",
+		Package:   "ec2",
+		Field:     "ec2Client",
+		Methods: []method{
+			{Name: "RunInstances"},
+			{Name: "DescribeInstanceStatus"},
+			{Name: "TerminateInstances"},
+			{Name: "DescribeVolumes"},
+			{Name: "DeleteVolume"},
+			{Name: "DescribeSnapshots"},
+			{Name: "DeleteSnapshot"},
+			{Name: "DescribeSnapshotAttribute"},
+			{Name: "ModifySnapshotAttribute"},
+			{Name: "DescribeImages"},
+			{Name: "DescribeImageAttribute"},
+			{Name: "ModifyImageAttribute"},
+			{Name: "DeregisterImage"},
+			{Name: "CopyImage"},
+			{Name: "CreateTags"},
+			{Name: "DescribeInstances"},
+			{Name: "DescribeInstanceTypes"},
+			{Name: "DescribeRegions"},
+			{Name: "DescribeAvailabilityZones"},
+			{Name: "DescribeVpcEndpointServiceConfigurations"},
+			{Name: "DeleteVpcEndpointServiceConfigurations"},
+			{Name: "DescribeVpcEndpointServicePermissions"},
+			{Name: "ModifyVpcEndpointServicePermissions"},
+			{Name: "DescribeVpcs"},
+			{Name: "CreateVpc"},
+			{Name: "DeleteVpc"},
+			{Name: "DescribeSubnets"},
+			{Name: "CreateSubnet"},
+			{Name: "DeleteSubnet"},
+			{Name: "DescribeNatGateways"},
+			{Name: "DeleteNatGateway"},
+		},
+	},
+	{
+		Interface: "IAMClient",
+		API:       "IAM",
+		Package:   "iam",
+		Field:     "iamClient",
+		Methods: []method{
+			{Name: "CreateAccessKey"},
+			{Name: "CreateUser"},
+			{Name: "DeleteAccessKey"},
+			{Name: "DeleteUser"},
+			{Name: "DeleteUserPolicy"},
+			{Name: "GetUser"},
+			{Name: "ListUsers"},
+			{Name: "ListUsersPages", Custom: true, Signature: "(context.Context, *iam.ListUsersInput, func(*iam.ListUsersOutput, bool) bool) error"},
+			{Name: "ListUserTags"},
+			{Name: "ListAccessKeys"},
+			{Name: "ListUserPolicies"},
+			{Name: "PutUserPolicy"},
+			{Name: "AttachUserPolicy"},
+			{Name: "DetachUserPolicy"},
+			{Name: "ListPolicies"},
+			{Name: "ListAttachedUserPolicies"},
+			{Name: "CreatePolicy"},
+			{Name: "DeletePolicy"},
+			{Name: "DeletePolicyVersion"},
+			{Name: "GetPolicy"},
+			{Name: "GetPolicyVersion"},
+			{Name: "ListPolicyVersions"},
+			{Name: "AttachRolePolicy"},
+			{Name: "DetachRolePolicy"},
+			{Name: "ListAttachedRolePolicies"},
+			{Name: "ListRolePolicies"},
+			{Name: "DeleteRolePolicy"},
+			{Name: "CreateRole"},
+			{Name: "GetRole"},
+			{Name: "DeleteRole"},
+			{Name: "ListRoles"},
+			{Name: "PutRolePolicy"},
+			{Name: "GetRolePolicy"},
+			{Name: "UpdateAssumeRolePolicy"},
+			{Name: "ListRoleTags"},
+			{Name: "TagRole"},
+			{Name: "UntagRole"},
+			{Name: "TagUser"},
+			{Name: "UntagUser"},
+			{Name: "ListPolicyTags"},
+			{Name: "TagPolicy"},
+			{Name: "UntagPolicy"},
+		},
+	},
+	{
+		Interface: "OrganizationsClient",
+		API:       "Organizations",
+		Package:   "organizations",
+		Field:     "orgClient",
+		Methods: []method{
+			{Name: "ListAccounts"},
+			{Name: "CreateAccount"},
+			{Name: "DescribeCreateAccountStatus"},
+			{Name: "ListCreateAccountStatus"},
+			{Name: "MoveAccount"},
+			{Name: "CreateOrganizationalUnit"},
+			{Name: "ListOrganizationalUnitsForParent"},
+			{Name: "ListChildren"},
+			{Name: "TagResource"},
+			{Name: "UntagResource"},
+			{Name: "ListParents"},
+			{Name: "ListTagsForResource"},
+		},
+	},
+	{
+		Interface: "STSClient",
+		API:       "STS",
+		Package:   "sts",
+		Field:     "stsClient",
+		Methods: []method{
+			{Name: "AssumeRole"},
+			{Name: "GetCallerIdentity"},
+			{Name: "GetFederationToken", Custom: true},
+		},
+	},
+	{
+		Interface: "SupportClient",
+		API:       "the AWS Support API",
+		Package:   "support",
+		Field:     "supportClient",
+		Methods: []method{
+			{Name: "CreateCase"},
+			{Name: "DescribeCases"},
+		},
+	},
+	{
+		Interface: "S3Client",
+		API:       "S3",
+		Package:   "s3",
+		Field:     "s3Client",
+		Methods: []method{
+			{Name: "ListBuckets"},
+			{Name: "DeleteBucket"},
+			{Name: "BatchDeleteBucketObjects", Custom: true, Signature: "(context.Context, *string) error"},
+			{Name: "ListObjectsV2"},
+		},
+	},
+	{
+		Interface: "Route53Client",
+		API:       "Route 53",
+		Package:   "route53",
+		Field:     "route53client",
+		Methods: []method{
+			{Name: "ListHostedZones"},
+			{Name: "DeleteHostedZone"},
+			{Name: "ListResourceRecordSets"},
+			{Name: "ChangeResourceRecordSets"},
+		},
+	},
+	{
+		Interface: "ServiceQuotasClient",
+		API:       "Service Quotas",
+		Package:   "servicequotas",
+		Field:     "serviceQuotasClient",
+		Methods: []method{
+			{Name: "GetServiceQuota"},
+			{Name: "RequestServiceQuotaIncrease"},
+			{Name: "ListRequestedServiceQuotaChangeHistory"},
+			{Name: "ListRequestedServiceQuotaChangeHistoryByQuota"},
+		},
+	},
+	{
+		Interface: "DynamoDBClient",
+		API:       "DynamoDB",
+		Package:   "dynamodb",
+		Field:     "dynamodbClient",
+		Methods: []method{
+			{Name: "ListTables"},
+			{Name: "DeleteTable"},
+		},
+	},
+	{
+		Interface: "SQSClient",
+		API:       "SQS",
+		Package:   "sqs",
+		Field:     "sqsClient",
+		Methods: []method{
+			{Name: "ListQueues"},
+			{Name: "DeleteQueue"},
+		},
+	},
+	{
+		Interface: "SNSClient",
+		API:       "SNS",
+		Package:   "sns",
+		Field:     "snsClient",
+		Methods: []method{
+			{Name: "ListTopics"},
+			{Name: "DeleteTopic"},
+		},
+	},
+	{
+		Interface: "LambdaClient",
+		API:       "Lambda",
+		Package:   "lambda",
+		Field:     "lambdaClient",
+		Methods: []method{
+			{Name: "ListFunctions"},
+			{Name: "DeleteFunction"},
+			{Name: "ListLayers"},
+			{Name: "ListLayerVersions"},
+			{Name: "DeleteLayerVersion"},
+			{Name: "ListEventSourceMappings"},
+			{Name: "DeleteEventSourceMapping"},
+		},
+	},
+	{
+		Interface: "EventBridgeClient",
+		API:       "EventBridge",
+		Package:   "eventbridge",
+		Field:     "eventBridgeClient",
+		Methods: []method{
+			{Name: "ListEventBuses"},
+			{Name: "DeleteEventBus"},
+			{Name: "ListRules"},
+			{Name: "ListTargetsByRule"},
+			{Name: "RemoveTargets"},
+			{Name: "DeleteRule"},
+		},
+	},
+	{
+		Interface: "ELBClient",
+		API:       "Elastic Load Balancing",
+		Package:   "elasticloadbalancingv2",
+		Field:     "elbClient",
+		Methods: []method{
+			{Name: "DescribeLoadBalancers"},
+			{Name: "DeleteLoadBalancer"},
+		},
+	},
+	{
+		Interface: "RDSClient",
+		API:       "RDS",
+		Package:   "rds",
+		Field:     "rdsClient",
+		Methods: []method{
+			{Name: "DescribeDBInstances"},
+			{Name: "ModifyDBInstance"},
+			{Name: "DeleteDBInstance"},
+			{Name: "DescribeDBClusters"},
+			{Name: "ModifyDBCluster"},
+			{Name: "DeleteDBCluster"},
+			{Name: "DescribeDBSnapshots"},
+			{Name: "DeleteDBSnapshot"},
+			{Name: "DescribeDBClusterSnapshots"},
+			{Name: "DeleteDBClusterSnapshot"},
+		},
+	},
+}
@@ -16,7 +16,7 @@ import (
 )
 
 // ListIAMUserTags returns a list of the tags assigned to an IAM user in AWS
-func ListIAMUserTags(reqLogger logr.Logger, client Client, userName string) (*iam.ListUserTagsOutput, error) {
+func ListIAMUserTags(reqLogger logr.Logger, client IAMClient, userName string) (*iam.ListUserTagsOutput, error) {
 	input := &iam.ListUserTagsInput{
 		UserName: aws.String(userName),
 	}
@@ -47,7 +47,7 @@ func ListIAMUserTags(reqLogger logr.Logger, client Client, userName string) (*ia
 }
 
 // ListIAMUsers returns a types.User list of users from the current account
-func ListIAMUsers(reqLogger logr.Logger, client Client) ([]types.User, error) {
+func ListIAMUsers(reqLogger logr.Logger, client IAMClient) ([]types.User, error) {
 	input := &iam.ListUsersInput{}
 	// List of IAM users to return
 	iamUserList := []types.User{}
@@ -85,7 +85,7 @@ func ListIAMUsers(reqLogger logr.Logger, client Client) ([]types.User, error) {
 
 // CheckIAMUserExists checks if a given IAM user exists within an account
 // Takes a logger, an AWS client for the target account, and a target IAM username
-func CheckIAMUserExists(reqLogger logr.Logger, client Client, userName string) (bool, *iam.GetUserOutput, error) {
+func CheckIAMUserExists(reqLogger logr.Logger, client IAMClient, userName string) (bool, *iam.GetUserOutput, error) {
 	// Retry when getting IAM user information
 	// Sometimes we see a delay before credentials are ready to be user resulting in the AWS API returning 404's
 	var iamGetUserOutput *iam.GetUserOutput
@@ -140,7 +140,7 @@ func CheckIAMUserExists(reqLogger logr.Logger, client Client, userName string) (
 }
 
 // CreateIAMUser creates a new IAM user in the target AWS account
-func CreateIAMUser(reqLogger logr.Logger, client Client, account *awsv1alpha1.Account, userName string, managedTags []AWSTag, customTags []AWSTag) (*iam.CreateUserOutput, error) {
+func CreateIAMUser(reqLogger logr.Logger, client IAMClient, account *awsv1alpha1.Account, userName string, managedTags []AWSTag, customTags []AWSTag) (*iam.CreateUserOutput, error) {
 	var createUserOutput = &iam.CreateUserOutput{}
 	var err error
 
@@ -148,7 +148,7 @@ func CreateIAMUser(reqLogger logr.Logger, client Client, account *awsv1alpha1.Ac
 
 		createUserOutput, err = client.CreateUser(context.TODO(), &iam.CreateUserInput{
 			UserName: aws.String(userName),
-			Tags:     AWSTags.BuildTags(account, managedTags, customTags).GetIAMTags(),
+			Tags:     AccountIAMOwnership(account).Apply(AWSTags.BuildTags(account, managedTags, customTags).GetIAMTags()),
 		})
 
 		// handle errors
@@ -197,8 +197,21 @@ func CreateIAMUser(reqLogger logr.Logger, client Client, account *awsv1alpha1.Ac
 	return createUserOutput, err
 }
 
+// ListIAMUsersPage returns a page of at most maxItems users of the AWS account starting at marker, the start when nil,
+// and the marker of the next page, which is nil after the last page
+func ListIAMUsersPage(client IAMClient, marker *string, maxItems int32) ([]types.User, *string, error) {
+	output, err := client.ListUsers(context.TODO(), &iam.ListUsersInput{Marker: marker, MaxItems: aws.Int32(maxItems)})
+	if err != nil {
+		return nil, nil, err
+	}
+	if !output.IsTruncated {
+		return output.Users, nil, nil
+	}
+	return output.Users, output.Marker, nil
+}
+
 // ListIAMRoles returns a types.Role list of roles in the AWS account
-func ListIAMRoles(reqLogger logr.Logger, client Client) ([]types.Role, error) {
+func ListIAMRoles(reqLogger logr.Logger, client IAMClient) ([]types.Role, error) {
 
 	// List of IAM roles to return
 	iamRoleList := []types.Role{}
@@ -219,3 +232,62 @@ func ListIAMRoles(reqLogger logr.Logger, client Client) ([]types.Role, error) {
 		}
 	}
 }
+
+// legacyRoleDescriptions are the descriptions of the roles the operator created before tagging them with
+// ManagedByTagKey
+var legacyRoleDescriptions = map[string]bool{
+	"Managed by AAO": true,
+}
+
+// OwnershipTag returns the tag marking the IAM resources created by the operator
+func OwnershipTag() types.Tag {
+	return types.Tag{Key: aws.String(awsv1alpha1.ManagedByTagKey), Value: aws.String(awsv1alpha1.ManagedByTagValue)}
+}
+
+// RoleCollisionError is returned when a role the operator creates already exists in the AWS account without being
+// managed by the operator, e.g. a role of the customer of a CCS account
+type RoleCollisionError struct {
+	RoleName string
+}
+
+func (e *RoleCollisionError) Error() string {
+	return fmt.Sprintf("role %s exists in the AWS account and isn't managed by the operator, refusing to adopt it: rename or delete the role", e.RoleName)
+}
+
+// IsRoleCollision returns whether err is a RoleCollisionError
+func IsRoleCollision(err error) bool {
+	var collision *RoleCollisionError
+	return errors.As(err, &collision)
+}
+
+// VerifyRoleOwnership returns a RoleCollisionError unless the existing role was created by the operator: it's tagged
+// with ManagedByTagKey, or it's a role created by older operator versions, tagged with ClusterAccountNameTagKey or
+// carrying one of their descriptions.
+func VerifyRoleOwnership(client IAMClient, role *types.Role) error {
+	roleName := aws.ToString(role.RoleName)
+	if legacyRoleDescriptions[aws.ToString(role.Description)] {
+		return nil
+	}
+
+	var marker *string
+	for {
+		output, err := client.ListRoleTags(context.TODO(), &iam.ListRoleTagsInput{RoleName: role.RoleName, Marker: marker})
+		if err != nil {
+			return err
+		}
+		for _, tag := range output.Tags {
+			switch aws.ToString(tag.Key) {
+			case awsv1alpha1.ManagedByTagKey:
+				if aws.ToString(tag.Value) == awsv1alpha1.ManagedByTagValue {
+					return nil
+				}
+			case awsv1alpha1.ClusterAccountNameTagKey:
+				return nil
+			}
+		}
+		if !output.IsTruncated {
+			return &RoleCollisionError{RoleName: roleName}
+		}
+		marker = output.Marker
+	}
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./client.go
+// Source: ./zz_generated.client.go
 //
 // Generated by this command:
 //
-//	mockgen -source=./client.go -destination=./mock/zz_generated.mock_client.go -package=mock
+//	mockgen -source=./zz_generated.client.go -destination=./mock/zz_generated.mock_client.go -package=mock
 //
 
 // Package mock is a generated GoMock package.
@@ -14,17 +14,22 @@ import (
 	reflect "reflect"
 
 	account "github.com/aws/aws-sdk-go-v2/service/account"
+	dynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	elasticloadbalancingv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	eventbridge "github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	iam "github.com/aws/aws-sdk-go-v2/service/iam"
+	lambda "github.com/aws/aws-sdk-go-v2/service/lambda"
 	organizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	rds "github.com/aws/aws-sdk-go-v2/service/rds"
 	route53 "github.com/aws/aws-sdk-go-v2/service/route53"
 	s3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	servicequotas "github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	sns "github.com/aws/aws-sdk-go-v2/service/sns"
+	sqs "github.com/aws/aws-sdk-go-v2/service/sqs"
 	sts "github.com/aws/aws-sdk-go-v2/service/sts"
 	support "github.com/aws/aws-sdk-go-v2/service/support"
-	awsclient "github.com/openshift/aws-account-operator/pkg/awsclient"
 	gomock "go.uber.org/mock/gomock"
-	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // MockClient is a mock of Client interface.
@@ -124,6 +129,21 @@ func (mr *MockClientMockRecorder) ChangeResourceRecordSets(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeResourceRecordSets", reflect.TypeOf((*MockClient)(nil).ChangeResourceRecordSets), arg0, arg1)
 }
 
+// CopyImage mocks base method.
+func (m *MockClient) CopyImage(arg0 context.Context, arg1 *ec2.CopyImageInput) (*ec2.CopyImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyImage", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.CopyImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockClientMockRecorder) CopyImage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockClient)(nil).CopyImage), arg0, arg1)
+}
+
 // CreateAccessKey mocks base method.
 func (m *MockClient) CreateAccessKey(arg0 context.Context, arg1 *iam.CreateAccessKeyInput) (*iam.CreateAccessKeyOutput, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +249,21 @@ func (mr *MockClientMockRecorder) CreateSubnet(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnet", reflect.TypeOf((*MockClient)(nil).CreateSubnet), arg0, arg1)
 }
 
+// CreateTags mocks base method.
+func (m *MockClient) CreateTags(arg0 context.Context, arg1 *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTags", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.CreateTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTags indicates an expected call of CreateTags.
+func (mr *MockClientMockRecorder) CreateTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockClient)(nil).CreateTags), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockClient) CreateUser(arg0 context.Context, arg1 *iam.CreateUserInput) (*iam.CreateUserOutput, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +324,111 @@ func (mr *MockClientMockRecorder) DeleteBucket(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockClient)(nil).DeleteBucket), arg0, arg1)
 }
 
+// DeleteDBCluster mocks base method.
+func (m *MockClient) DeleteDBCluster(arg0 context.Context, arg1 *rds.DeleteDBClusterInput) (*rds.DeleteDBClusterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBCluster", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBClusterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBCluster indicates an expected call of DeleteDBCluster.
+func (mr *MockClientMockRecorder) DeleteDBCluster(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBCluster", reflect.TypeOf((*MockClient)(nil).DeleteDBCluster), arg0, arg1)
+}
+
+// DeleteDBClusterSnapshot mocks base method.
+func (m *MockClient) DeleteDBClusterSnapshot(arg0 context.Context, arg1 *rds.DeleteDBClusterSnapshotInput) (*rds.DeleteDBClusterSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBClusterSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBClusterSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBClusterSnapshot indicates an expected call of DeleteDBClusterSnapshot.
+func (mr *MockClientMockRecorder) DeleteDBClusterSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBClusterSnapshot", reflect.TypeOf((*MockClient)(nil).DeleteDBClusterSnapshot), arg0, arg1)
+}
+
+// DeleteDBInstance mocks base method.
+func (m *MockClient) DeleteDBInstance(arg0 context.Context, arg1 *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBInstance", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBInstance indicates an expected call of DeleteDBInstance.
+func (mr *MockClientMockRecorder) DeleteDBInstance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBInstance", reflect.TypeOf((*MockClient)(nil).DeleteDBInstance), arg0, arg1)
+}
+
+// DeleteDBSnapshot mocks base method.
+func (m *MockClient) DeleteDBSnapshot(arg0 context.Context, arg1 *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBSnapshot indicates an expected call of DeleteDBSnapshot.
+func (mr *MockClientMockRecorder) DeleteDBSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBSnapshot", reflect.TypeOf((*MockClient)(nil).DeleteDBSnapshot), arg0, arg1)
+}
+
+// DeleteEventBus mocks base method.
+func (m *MockClient) DeleteEventBus(arg0 context.Context, arg1 *eventbridge.DeleteEventBusInput) (*eventbridge.DeleteEventBusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.DeleteEventBusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventBus indicates an expected call of DeleteEventBus.
+func (mr *MockClientMockRecorder) DeleteEventBus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockClient)(nil).DeleteEventBus), arg0, arg1)
+}
+
+// DeleteEventSourceMapping mocks base method.
+func (m *MockClient) DeleteEventSourceMapping(arg0 context.Context, arg1 *lambda.DeleteEventSourceMappingInput) (*lambda.DeleteEventSourceMappingOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventSourceMapping", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.DeleteEventSourceMappingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventSourceMapping indicates an expected call of DeleteEventSourceMapping.
+func (mr *MockClientMockRecorder) DeleteEventSourceMapping(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSourceMapping", reflect.TypeOf((*MockClient)(nil).DeleteEventSourceMapping), arg0, arg1)
+}
+
+// DeleteFunction mocks base method.
+func (m *MockClient) DeleteFunction(arg0 context.Context, arg1 *lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFunction", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunction indicates an expected call of DeleteFunction.
+func (mr *MockClientMockRecorder) DeleteFunction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunction", reflect.TypeOf((*MockClient)(nil).DeleteFunction), arg0, arg1)
+}
+
 // DeleteHostedZone mocks base method.
 func (m *MockClient) DeleteHostedZone(arg0 context.Context, arg1 *route53.DeleteHostedZoneInput) (*route53.DeleteHostedZoneOutput, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +444,51 @@ func (mr *MockClientMockRecorder) DeleteHostedZone(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHostedZone", reflect.TypeOf((*MockClient)(nil).DeleteHostedZone), arg0, arg1)
 }
 
+// DeleteLayerVersion mocks base method.
+func (m *MockClient) DeleteLayerVersion(arg0 context.Context, arg1 *lambda.DeleteLayerVersionInput) (*lambda.DeleteLayerVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLayerVersion", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.DeleteLayerVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLayerVersion indicates an expected call of DeleteLayerVersion.
+func (mr *MockClientMockRecorder) DeleteLayerVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLayerVersion", reflect.TypeOf((*MockClient)(nil).DeleteLayerVersion), arg0, arg1)
+}
+
+// DeleteLoadBalancer mocks base method.
+func (m *MockClient) DeleteLoadBalancer(arg0 context.Context, arg1 *elasticloadbalancingv2.DeleteLoadBalancerInput) (*elasticloadbalancingv2.DeleteLoadBalancerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancer", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DeleteLoadBalancerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancer indicates an expected call of DeleteLoadBalancer.
+func (mr *MockClientMockRecorder) DeleteLoadBalancer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockClient)(nil).DeleteLoadBalancer), arg0, arg1)
+}
+
+// DeleteNatGateway mocks base method.
+func (m *MockClient) DeleteNatGateway(arg0 context.Context, arg1 *ec2.DeleteNatGatewayInput) (*ec2.DeleteNatGatewayOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNatGateway", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteNatGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNatGateway indicates an expected call of DeleteNatGateway.
+func (mr *MockClientMockRecorder) DeleteNatGateway(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockClient)(nil).DeleteNatGateway), arg0, arg1)
+}
+
 // DeletePolicy mocks base method.
 func (m *MockClient) DeletePolicy(arg0 context.Context, arg1 *iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +519,21 @@ func (mr *MockClientMockRecorder) DeletePolicyVersion(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyVersion", reflect.TypeOf((*MockClient)(nil).DeletePolicyVersion), arg0, arg1)
 }
 
+// DeleteQueue mocks base method.
+func (m *MockClient) DeleteQueue(arg0 context.Context, arg1 *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueue", arg0, arg1)
+	ret0, _ := ret[0].(*sqs.DeleteQueueOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteQueue indicates an expected call of DeleteQueue.
+func (mr *MockClientMockRecorder) DeleteQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueue", reflect.TypeOf((*MockClient)(nil).DeleteQueue), arg0, arg1)
+}
+
 // DeleteRole mocks base method.
 func (m *MockClient) DeleteRole(arg0 context.Context, arg1 *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
 	m.ctrl.T.Helper()
@@ -364,6 +564,21 @@ func (mr *MockClientMockRecorder) DeleteRolePolicy(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolePolicy", reflect.TypeOf((*MockClient)(nil).DeleteRolePolicy), arg0, arg1)
 }
 
+// DeleteRule mocks base method.
+func (m *MockClient) DeleteRule(arg0 context.Context, arg1 *eventbridge.DeleteRuleInput) (*eventbridge.DeleteRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.DeleteRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockClientMockRecorder) DeleteRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockClient)(nil).DeleteRule), arg0, arg1)
+}
+
 // DeleteSnapshot mocks base method.
 func (m *MockClient) DeleteSnapshot(arg0 context.Context, arg1 *ec2.DeleteSnapshotInput) (*ec2.DeleteSnapshotOutput, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +609,36 @@ func (mr *MockClientMockRecorder) DeleteSubnet(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockClient)(nil).DeleteSubnet), arg0, arg1)
 }
 
+// DeleteTable mocks base method.
+func (m *MockClient) DeleteTable(arg0 context.Context, arg1 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTable", arg0, arg1)
+	ret0, _ := ret[0].(*dynamodb.DeleteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTable indicates an expected call of DeleteTable.
+func (mr *MockClientMockRecorder) DeleteTable(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTable", reflect.TypeOf((*MockClient)(nil).DeleteTable), arg0, arg1)
+}
+
+// DeleteTopic mocks base method.
+func (m *MockClient) DeleteTopic(arg0 context.Context, arg1 *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopic", arg0, arg1)
+	ret0, _ := ret[0].(*sns.DeleteTopicOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTopic indicates an expected call of DeleteTopic.
+func (mr *MockClientMockRecorder) DeleteTopic(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopic", reflect.TypeOf((*MockClient)(nil).DeleteTopic), arg0, arg1)
+}
+
 // DeleteUser mocks base method.
 func (m *MockClient) DeleteUser(arg0 context.Context, arg1 *iam.DeleteUserInput) (*iam.DeleteUserOutput, error) {
 	m.ctrl.T.Helper()
@@ -469,6 +714,36 @@ func (mr *MockClientMockRecorder) DeleteVpcEndpointServiceConfigurations(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpointServiceConfigurations", reflect.TypeOf((*MockClient)(nil).DeleteVpcEndpointServiceConfigurations), arg0, arg1)
 }
 
+// DeregisterImage mocks base method.
+func (m *MockClient) DeregisterImage(arg0 context.Context, arg1 *ec2.DeregisterImageInput) (*ec2.DeregisterImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterImage", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeregisterImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterImage indicates an expected call of DeregisterImage.
+func (mr *MockClientMockRecorder) DeregisterImage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterImage", reflect.TypeOf((*MockClient)(nil).DeregisterImage), arg0, arg1)
+}
+
+// DescribeAvailabilityZones mocks base method.
+func (m *MockClient) DescribeAvailabilityZones(arg0 context.Context, arg1 *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAvailabilityZones", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeAvailabilityZonesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAvailabilityZones indicates an expected call of DescribeAvailabilityZones.
+func (mr *MockClientMockRecorder) DescribeAvailabilityZones(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailabilityZones", reflect.TypeOf((*MockClient)(nil).DescribeAvailabilityZones), arg0, arg1)
+}
+
 // DescribeCases mocks base method.
 func (m *MockClient) DescribeCases(arg0 context.Context, arg1 *support.DescribeCasesInput) (*support.DescribeCasesOutput, error) {
 	m.ctrl.T.Helper()
@@ -499,6 +774,81 @@ func (mr *MockClientMockRecorder) DescribeCreateAccountStatus(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCreateAccountStatus", reflect.TypeOf((*MockClient)(nil).DescribeCreateAccountStatus), arg0, arg1)
 }
 
+// DescribeDBClusterSnapshots mocks base method.
+func (m *MockClient) DescribeDBClusterSnapshots(arg0 context.Context, arg1 *rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBClusterSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBClusterSnapshotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBClusterSnapshots indicates an expected call of DescribeDBClusterSnapshots.
+func (mr *MockClientMockRecorder) DescribeDBClusterSnapshots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBClusterSnapshots", reflect.TypeOf((*MockClient)(nil).DescribeDBClusterSnapshots), arg0, arg1)
+}
+
+// DescribeDBClusters mocks base method.
+func (m *MockClient) DescribeDBClusters(arg0 context.Context, arg1 *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBClusters", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBClustersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBClusters indicates an expected call of DescribeDBClusters.
+func (mr *MockClientMockRecorder) DescribeDBClusters(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBClusters", reflect.TypeOf((*MockClient)(nil).DescribeDBClusters), arg0, arg1)
+}
+
+// DescribeDBInstances mocks base method.
+func (m *MockClient) DescribeDBInstances(arg0 context.Context, arg1 *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBInstances", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBInstances indicates an expected call of DescribeDBInstances.
+func (mr *MockClientMockRecorder) DescribeDBInstances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBInstances", reflect.TypeOf((*MockClient)(nil).DescribeDBInstances), arg0, arg1)
+}
+
+// DescribeDBSnapshots mocks base method.
+func (m *MockClient) DescribeDBSnapshots(arg0 context.Context, arg1 *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBSnapshotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBSnapshots indicates an expected call of DescribeDBSnapshots.
+func (mr *MockClientMockRecorder) DescribeDBSnapshots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBSnapshots", reflect.TypeOf((*MockClient)(nil).DescribeDBSnapshots), arg0, arg1)
+}
+
+// DescribeImageAttribute mocks base method.
+func (m *MockClient) DescribeImageAttribute(arg0 context.Context, arg1 *ec2.DescribeImageAttributeInput) (*ec2.DescribeImageAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImageAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeImageAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImageAttribute indicates an expected call of DescribeImageAttribute.
+func (mr *MockClientMockRecorder) DescribeImageAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageAttribute", reflect.TypeOf((*MockClient)(nil).DescribeImageAttribute), arg0, arg1)
+}
+
 // DescribeImages mocks base method.
 func (m *MockClient) DescribeImages(arg0 context.Context, arg1 *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
 	m.ctrl.T.Helper()
@@ -559,6 +909,36 @@ func (mr *MockClientMockRecorder) DescribeInstances(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstances", reflect.TypeOf((*MockClient)(nil).DescribeInstances), arg0, arg1)
 }
 
+// DescribeLoadBalancers mocks base method.
+func (m *MockClient) DescribeLoadBalancers(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeLoadBalancersInput) (*elasticloadbalancingv2.DescribeLoadBalancersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLoadBalancers", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DescribeLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLoadBalancers indicates an expected call of DescribeLoadBalancers.
+func (mr *MockClientMockRecorder) DescribeLoadBalancers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancers", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancers), arg0, arg1)
+}
+
+// DescribeNatGateways mocks base method.
+func (m *MockClient) DescribeNatGateways(arg0 context.Context, arg1 *ec2.DescribeNatGatewaysInput) (*ec2.DescribeNatGatewaysOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNatGateways", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeNatGatewaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNatGateways indicates an expected call of DescribeNatGateways.
+func (mr *MockClientMockRecorder) DescribeNatGateways(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNatGateways", reflect.TypeOf((*MockClient)(nil).DescribeNatGateways), arg0, arg1)
+}
+
 // DescribeRegions mocks base method.
 func (m *MockClient) DescribeRegions(arg0 context.Context, arg1 *ec2.DescribeRegionsInput) (*ec2.DescribeRegionsOutput, error) {
 	m.ctrl.T.Helper()
@@ -574,6 +954,21 @@ func (mr *MockClientMockRecorder) DescribeRegions(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegions", reflect.TypeOf((*MockClient)(nil).DescribeRegions), arg0, arg1)
 }
 
+// DescribeSnapshotAttribute mocks base method.
+func (m *MockClient) DescribeSnapshotAttribute(arg0 context.Context, arg1 *ec2.DescribeSnapshotAttributeInput) (*ec2.DescribeSnapshotAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSnapshotAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeSnapshotAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSnapshotAttribute indicates an expected call of DescribeSnapshotAttribute.
+func (mr *MockClientMockRecorder) DescribeSnapshotAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSnapshotAttribute", reflect.TypeOf((*MockClient)(nil).DescribeSnapshotAttribute), arg0, arg1)
+}
+
 // DescribeSnapshots mocks base method.
 func (m *MockClient) DescribeSnapshots(arg0 context.Context, arg1 *ec2.DescribeSnapshotsInput) (*ec2.DescribeSnapshotsOutput, error) {
 	m.ctrl.T.Helper()
@@ -634,6 +1029,21 @@ func (mr *MockClientMockRecorder) DescribeVpcEndpointServiceConfigurations(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServiceConfigurations", reflect.TypeOf((*MockClient)(nil).DescribeVpcEndpointServiceConfigurations), arg0, arg1)
 }
 
+// DescribeVpcEndpointServicePermissions mocks base method.
+func (m *MockClient) DescribeVpcEndpointServicePermissions(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointServicePermissionsInput) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointServicePermissions", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeVpcEndpointServicePermissionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointServicePermissions indicates an expected call of DescribeVpcEndpointServicePermissions.
+func (mr *MockClientMockRecorder) DescribeVpcEndpointServicePermissions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServicePermissions", reflect.TypeOf((*MockClient)(nil).DescribeVpcEndpointServicePermissions), arg0, arg1)
+}
+
 // DescribeVpcs mocks base method.
 func (m *MockClient) DescribeVpcs(arg0 context.Context, arg1 *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
 	m.ctrl.T.Helper()
@@ -694,6 +1104,21 @@ func (mr *MockClientMockRecorder) EnableRegion(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableRegion", reflect.TypeOf((*MockClient)(nil).EnableRegion), arg0, arg1)
 }
 
+// GetAlternateContact mocks base method.
+func (m *MockClient) GetAlternateContact(arg0 context.Context, arg1 *account.GetAlternateContactInput) (*account.GetAlternateContactOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlternateContact", arg0, arg1)
+	ret0, _ := ret[0].(*account.GetAlternateContactOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlternateContact indicates an expected call of GetAlternateContact.
+func (mr *MockClientMockRecorder) GetAlternateContact(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlternateContact", reflect.TypeOf((*MockClient)(nil).GetAlternateContact), arg0, arg1)
+}
+
 // GetCallerIdentity mocks base method.
 func (m *MockClient) GetCallerIdentity(arg0 context.Context, arg1 *sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
 	m.ctrl.T.Helper()
@@ -784,6 +1209,21 @@ func (mr *MockClientMockRecorder) GetRole(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockClient)(nil).GetRole), arg0, arg1)
 }
 
+// GetRolePolicy mocks base method.
+func (m *MockClient) GetRolePolicy(arg0 context.Context, arg1 *iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.GetRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolePolicy indicates an expected call of GetRolePolicy.
+func (mr *MockClientMockRecorder) GetRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolePolicy", reflect.TypeOf((*MockClient)(nil).GetRolePolicy), arg0, arg1)
+}
+
 // GetServiceQuota mocks base method.
 func (m *MockClient) GetServiceQuota(arg0 context.Context, arg1 *servicequotas.GetServiceQuotaInput) (*servicequotas.GetServiceQuotaOutput, error) {
 	m.ctrl.T.Helper()
@@ -919,54 +1359,129 @@ func (mr *MockClientMockRecorder) ListCreateAccountStatus(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCreateAccountStatus", reflect.TypeOf((*MockClient)(nil).ListCreateAccountStatus), arg0, arg1)
 }
 
-// ListHostedZones mocks base method.
-func (m *MockClient) ListHostedZones(arg0 context.Context, arg1 *route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error) {
+// ListEventBuses mocks base method.
+func (m *MockClient) ListEventBuses(arg0 context.Context, arg1 *eventbridge.ListEventBusesInput) (*eventbridge.ListEventBusesOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHostedZones", arg0, arg1)
-	ret0, _ := ret[0].(*route53.ListHostedZonesOutput)
+	ret := m.ctrl.Call(m, "ListEventBuses", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.ListEventBusesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListHostedZones indicates an expected call of ListHostedZones.
-func (mr *MockClientMockRecorder) ListHostedZones(arg0, arg1 any) *gomock.Call {
+// ListEventBuses indicates an expected call of ListEventBuses.
+func (mr *MockClientMockRecorder) ListEventBuses(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostedZones", reflect.TypeOf((*MockClient)(nil).ListHostedZones), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBuses", reflect.TypeOf((*MockClient)(nil).ListEventBuses), arg0, arg1)
 }
 
-// ListObjectsV2 mocks base method.
-func (m *MockClient) ListObjectsV2(arg0 context.Context, arg1 *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+// ListEventSourceMappings mocks base method.
+func (m *MockClient) ListEventSourceMappings(arg0 context.Context, arg1 *lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListObjectsV2", arg0, arg1)
-	ret0, _ := ret[0].(*s3.ListObjectsV2Output)
+	ret := m.ctrl.Call(m, "ListEventSourceMappings", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListEventSourceMappingsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListObjectsV2 indicates an expected call of ListObjectsV2.
-func (mr *MockClientMockRecorder) ListObjectsV2(arg0, arg1 any) *gomock.Call {
+// ListEventSourceMappings indicates an expected call of ListEventSourceMappings.
+func (mr *MockClientMockRecorder) ListEventSourceMappings(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsV2", reflect.TypeOf((*MockClient)(nil).ListObjectsV2), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappings", reflect.TypeOf((*MockClient)(nil).ListEventSourceMappings), arg0, arg1)
 }
 
-// ListOrganizationalUnitsForParent mocks base method.
-func (m *MockClient) ListOrganizationalUnitsForParent(arg0 context.Context, arg1 *organizations.ListOrganizationalUnitsForParentInput) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+// ListFunctions mocks base method.
+func (m *MockClient) ListFunctions(arg0 context.Context, arg1 *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOrganizationalUnitsForParent", arg0, arg1)
-	ret0, _ := ret[0].(*organizations.ListOrganizationalUnitsForParentOutput)
+	ret := m.ctrl.Call(m, "ListFunctions", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListFunctionsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListOrganizationalUnitsForParent indicates an expected call of ListOrganizationalUnitsForParent.
-func (mr *MockClientMockRecorder) ListOrganizationalUnitsForParent(arg0, arg1 any) *gomock.Call {
+// ListFunctions indicates an expected call of ListFunctions.
+func (mr *MockClientMockRecorder) ListFunctions(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationalUnitsForParent", reflect.TypeOf((*MockClient)(nil).ListOrganizationalUnitsForParent), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctions", reflect.TypeOf((*MockClient)(nil).ListFunctions), arg0, arg1)
 }
 
-// ListParents mocks base method.
-func (m *MockClient) ListParents(arg0 context.Context, arg1 *organizations.ListParentsInput) (*organizations.ListParentsOutput, error) {
-	m.ctrl.T.Helper()
+// ListHostedZones mocks base method.
+func (m *MockClient) ListHostedZones(arg0 context.Context, arg1 *route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostedZones", arg0, arg1)
+	ret0, _ := ret[0].(*route53.ListHostedZonesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostedZones indicates an expected call of ListHostedZones.
+func (mr *MockClientMockRecorder) ListHostedZones(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostedZones", reflect.TypeOf((*MockClient)(nil).ListHostedZones), arg0, arg1)
+}
+
+// ListLayerVersions mocks base method.
+func (m *MockClient) ListLayerVersions(arg0 context.Context, arg1 *lambda.ListLayerVersionsInput) (*lambda.ListLayerVersionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLayerVersions", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListLayerVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayerVersions indicates an expected call of ListLayerVersions.
+func (mr *MockClientMockRecorder) ListLayerVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLayerVersions", reflect.TypeOf((*MockClient)(nil).ListLayerVersions), arg0, arg1)
+}
+
+// ListLayers mocks base method.
+func (m *MockClient) ListLayers(arg0 context.Context, arg1 *lambda.ListLayersInput) (*lambda.ListLayersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLayers", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListLayersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayers indicates an expected call of ListLayers.
+func (mr *MockClientMockRecorder) ListLayers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLayers", reflect.TypeOf((*MockClient)(nil).ListLayers), arg0, arg1)
+}
+
+// ListObjectsV2 mocks base method.
+func (m *MockClient) ListObjectsV2(arg0 context.Context, arg1 *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectsV2", arg0, arg1)
+	ret0, _ := ret[0].(*s3.ListObjectsV2Output)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsV2 indicates an expected call of ListObjectsV2.
+func (mr *MockClientMockRecorder) ListObjectsV2(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsV2", reflect.TypeOf((*MockClient)(nil).ListObjectsV2), arg0, arg1)
+}
+
+// ListOrganizationalUnitsForParent mocks base method.
+func (m *MockClient) ListOrganizationalUnitsForParent(arg0 context.Context, arg1 *organizations.ListOrganizationalUnitsForParentInput) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationalUnitsForParent", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.ListOrganizationalUnitsForParentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationalUnitsForParent indicates an expected call of ListOrganizationalUnitsForParent.
+func (mr *MockClientMockRecorder) ListOrganizationalUnitsForParent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationalUnitsForParent", reflect.TypeOf((*MockClient)(nil).ListOrganizationalUnitsForParent), arg0, arg1)
+}
+
+// ListParents mocks base method.
+func (m *MockClient) ListParents(arg0 context.Context, arg1 *organizations.ListParentsInput) (*organizations.ListParentsOutput, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListParents", arg0, arg1)
 	ret0, _ := ret[0].(*organizations.ListParentsOutput)
 	ret1, _ := ret[1].(error)
@@ -994,6 +1509,21 @@ func (mr *MockClientMockRecorder) ListPolicies(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockClient)(nil).ListPolicies), arg0, arg1)
 }
 
+// ListPolicyTags mocks base method.
+func (m *MockClient) ListPolicyTags(arg0 context.Context, arg1 *iam.ListPolicyTagsInput) (*iam.ListPolicyTagsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicyTags", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListPolicyTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicyTags indicates an expected call of ListPolicyTags.
+func (mr *MockClientMockRecorder) ListPolicyTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyTags", reflect.TypeOf((*MockClient)(nil).ListPolicyTags), arg0, arg1)
+}
+
 // ListPolicyVersions mocks base method.
 func (m *MockClient) ListPolicyVersions(arg0 context.Context, arg1 *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
 	m.ctrl.T.Helper()
@@ -1009,6 +1539,21 @@ func (mr *MockClientMockRecorder) ListPolicyVersions(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyVersions", reflect.TypeOf((*MockClient)(nil).ListPolicyVersions), arg0, arg1)
 }
 
+// ListQueues mocks base method.
+func (m *MockClient) ListQueues(arg0 context.Context, arg1 *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueues", arg0, arg1)
+	ret0, _ := ret[0].(*sqs.ListQueuesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueues indicates an expected call of ListQueues.
+func (mr *MockClientMockRecorder) ListQueues(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockClient)(nil).ListQueues), arg0, arg1)
+}
+
 // ListRequestedServiceQuotaChangeHistory mocks base method.
 func (m *MockClient) ListRequestedServiceQuotaChangeHistory(arg0 context.Context, arg1 *servicequotas.ListRequestedServiceQuotaChangeHistoryInput) (*servicequotas.ListRequestedServiceQuotaChangeHistoryOutput, error) {
 	m.ctrl.T.Helper()
@@ -1069,6 +1614,21 @@ func (mr *MockClientMockRecorder) ListRolePolicies(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePolicies", reflect.TypeOf((*MockClient)(nil).ListRolePolicies), arg0, arg1)
 }
 
+// ListRoleTags mocks base method.
+func (m *MockClient) ListRoleTags(arg0 context.Context, arg1 *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListRoleTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockClientMockRecorder) ListRoleTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockClient)(nil).ListRoleTags), arg0, arg1)
+}
+
 // ListRoles mocks base method.
 func (m *MockClient) ListRoles(arg0 context.Context, arg1 *iam.ListRolesInput) (*iam.ListRolesOutput, error) {
 	m.ctrl.T.Helper()
@@ -1084,6 +1644,36 @@ func (mr *MockClientMockRecorder) ListRoles(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockClient)(nil).ListRoles), arg0, arg1)
 }
 
+// ListRules mocks base method.
+func (m *MockClient) ListRules(arg0 context.Context, arg1 *eventbridge.ListRulesInput) (*eventbridge.ListRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.ListRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockClientMockRecorder) ListRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockClient)(nil).ListRules), arg0, arg1)
+}
+
+// ListTables mocks base method.
+func (m *MockClient) ListTables(arg0 context.Context, arg1 *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTables", arg0, arg1)
+	ret0, _ := ret[0].(*dynamodb.ListTablesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTables indicates an expected call of ListTables.
+func (mr *MockClientMockRecorder) ListTables(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTables", reflect.TypeOf((*MockClient)(nil).ListTables), arg0, arg1)
+}
+
 // ListTagsForResource mocks base method.
 func (m *MockClient) ListTagsForResource(arg0 context.Context, arg1 *organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error) {
 	m.ctrl.T.Helper()
@@ -1099,6 +1689,36 @@ func (mr *MockClientMockRecorder) ListTagsForResource(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*MockClient)(nil).ListTagsForResource), arg0, arg1)
 }
 
+// ListTargetsByRule mocks base method.
+func (m *MockClient) ListTargetsByRule(arg0 context.Context, arg1 *eventbridge.ListTargetsByRuleInput) (*eventbridge.ListTargetsByRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTargetsByRule", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.ListTargetsByRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTargetsByRule indicates an expected call of ListTargetsByRule.
+func (mr *MockClientMockRecorder) ListTargetsByRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTargetsByRule", reflect.TypeOf((*MockClient)(nil).ListTargetsByRule), arg0, arg1)
+}
+
+// ListTopics mocks base method.
+func (m *MockClient) ListTopics(arg0 context.Context, arg1 *sns.ListTopicsInput) (*sns.ListTopicsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopics", arg0, arg1)
+	ret0, _ := ret[0].(*sns.ListTopicsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopics indicates an expected call of ListTopics.
+func (mr *MockClientMockRecorder) ListTopics(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*MockClient)(nil).ListTopics), arg0, arg1)
+}
+
 // ListUserPolicies mocks base method.
 func (m *MockClient) ListUserPolicies(arg0 context.Context, arg1 *iam.ListUserPoliciesInput) (*iam.ListUserPoliciesOutput, error) {
 	m.ctrl.T.Helper()
@@ -1158,6 +1778,81 @@ func (mr *MockClientMockRecorder) ListUsersPages(arg0, arg1, arg2 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersPages", reflect.TypeOf((*MockClient)(nil).ListUsersPages), arg0, arg1, arg2)
 }
 
+// ModifyDBCluster mocks base method.
+func (m *MockClient) ModifyDBCluster(arg0 context.Context, arg1 *rds.ModifyDBClusterInput) (*rds.ModifyDBClusterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyDBCluster", arg0, arg1)
+	ret0, _ := ret[0].(*rds.ModifyDBClusterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyDBCluster indicates an expected call of ModifyDBCluster.
+func (mr *MockClientMockRecorder) ModifyDBCluster(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDBCluster", reflect.TypeOf((*MockClient)(nil).ModifyDBCluster), arg0, arg1)
+}
+
+// ModifyDBInstance mocks base method.
+func (m *MockClient) ModifyDBInstance(arg0 context.Context, arg1 *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyDBInstance", arg0, arg1)
+	ret0, _ := ret[0].(*rds.ModifyDBInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyDBInstance indicates an expected call of ModifyDBInstance.
+func (mr *MockClientMockRecorder) ModifyDBInstance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDBInstance", reflect.TypeOf((*MockClient)(nil).ModifyDBInstance), arg0, arg1)
+}
+
+// ModifyImageAttribute mocks base method.
+func (m *MockClient) ModifyImageAttribute(arg0 context.Context, arg1 *ec2.ModifyImageAttributeInput) (*ec2.ModifyImageAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyImageAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifyImageAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyImageAttribute indicates an expected call of ModifyImageAttribute.
+func (mr *MockClientMockRecorder) ModifyImageAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyImageAttribute", reflect.TypeOf((*MockClient)(nil).ModifyImageAttribute), arg0, arg1)
+}
+
+// ModifySnapshotAttribute mocks base method.
+func (m *MockClient) ModifySnapshotAttribute(arg0 context.Context, arg1 *ec2.ModifySnapshotAttributeInput) (*ec2.ModifySnapshotAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySnapshotAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifySnapshotAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySnapshotAttribute indicates an expected call of ModifySnapshotAttribute.
+func (mr *MockClientMockRecorder) ModifySnapshotAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySnapshotAttribute", reflect.TypeOf((*MockClient)(nil).ModifySnapshotAttribute), arg0, arg1)
+}
+
+// ModifyVpcEndpointServicePermissions mocks base method.
+func (m *MockClient) ModifyVpcEndpointServicePermissions(arg0 context.Context, arg1 *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVpcEndpointServicePermissions", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifyVpcEndpointServicePermissionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVpcEndpointServicePermissions indicates an expected call of ModifyVpcEndpointServicePermissions.
+func (mr *MockClientMockRecorder) ModifyVpcEndpointServicePermissions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVpcEndpointServicePermissions", reflect.TypeOf((*MockClient)(nil).ModifyVpcEndpointServicePermissions), arg0, arg1)
+}
+
 // MoveAccount mocks base method.
 func (m *MockClient) MoveAccount(arg0 context.Context, arg1 *organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
 	m.ctrl.T.Helper()
@@ -1173,6 +1868,21 @@ func (mr *MockClientMockRecorder) MoveAccount(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveAccount", reflect.TypeOf((*MockClient)(nil).MoveAccount), arg0, arg1)
 }
 
+// PutAlternateContact mocks base method.
+func (m *MockClient) PutAlternateContact(arg0 context.Context, arg1 *account.PutAlternateContactInput) (*account.PutAlternateContactOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAlternateContact", arg0, arg1)
+	ret0, _ := ret[0].(*account.PutAlternateContactOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAlternateContact indicates an expected call of PutAlternateContact.
+func (mr *MockClientMockRecorder) PutAlternateContact(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAlternateContact", reflect.TypeOf((*MockClient)(nil).PutAlternateContact), arg0, arg1)
+}
+
 // PutRolePolicy mocks base method.
 func (m *MockClient) PutRolePolicy(arg0 context.Context, arg1 *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -1203,6 +1913,21 @@ func (mr *MockClientMockRecorder) PutUserPolicy(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUserPolicy", reflect.TypeOf((*MockClient)(nil).PutUserPolicy), arg0, arg1)
 }
 
+// RemoveTargets mocks base method.
+func (m *MockClient) RemoveTargets(arg0 context.Context, arg1 *eventbridge.RemoveTargetsInput) (*eventbridge.RemoveTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTargets", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.RemoveTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTargets indicates an expected call of RemoveTargets.
+func (mr *MockClientMockRecorder) RemoveTargets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTargets", reflect.TypeOf((*MockClient)(nil).RemoveTargets), arg0, arg1)
+}
+
 // RequestServiceQuotaIncrease mocks base method.
 func (m *MockClient) RequestServiceQuotaIncrease(arg0 context.Context, arg1 *servicequotas.RequestServiceQuotaIncreaseInput) (*servicequotas.RequestServiceQuotaIncreaseOutput, error) {
 	m.ctrl.T.Helper()
@@ -1233,6 +1958,21 @@ func (mr *MockClientMockRecorder) RunInstances(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInstances", reflect.TypeOf((*MockClient)(nil).RunInstances), arg0, arg1)
 }
 
+// TagPolicy mocks base method.
+func (m *MockClient) TagPolicy(arg0 context.Context, arg1 *iam.TagPolicyInput) (*iam.TagPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.TagPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagPolicy indicates an expected call of TagPolicy.
+func (mr *MockClientMockRecorder) TagPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagPolicy", reflect.TypeOf((*MockClient)(nil).TagPolicy), arg0, arg1)
+}
+
 // TagResource mocks base method.
 func (m *MockClient) TagResource(arg0 context.Context, arg1 *organizations.TagResourceInput) (*organizations.TagResourceOutput, error) {
 	m.ctrl.T.Helper()
@@ -1248,6 +1988,36 @@ func (mr *MockClientMockRecorder) TagResource(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockClient)(nil).TagResource), arg0, arg1)
 }
 
+// TagRole mocks base method.
+func (m *MockClient) TagRole(arg0 context.Context, arg1 *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagRole", arg0, arg1)
+	ret0, _ := ret[0].(*iam.TagRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagRole indicates an expected call of TagRole.
+func (mr *MockClientMockRecorder) TagRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagRole", reflect.TypeOf((*MockClient)(nil).TagRole), arg0, arg1)
+}
+
+// TagUser mocks base method.
+func (m *MockClient) TagUser(arg0 context.Context, arg1 *iam.TagUserInput) (*iam.TagUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagUser", arg0, arg1)
+	ret0, _ := ret[0].(*iam.TagUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagUser indicates an expected call of TagUser.
+func (mr *MockClientMockRecorder) TagUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagUser", reflect.TypeOf((*MockClient)(nil).TagUser), arg0, arg1)
+}
+
 // TerminateInstances mocks base method.
 func (m *MockClient) TerminateInstances(arg0 context.Context, arg1 *ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error) {
 	m.ctrl.T.Helper()
@@ -1263,6 +2033,21 @@ func (mr *MockClientMockRecorder) TerminateInstances(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstances", reflect.TypeOf((*MockClient)(nil).TerminateInstances), arg0, arg1)
 }
 
+// UntagPolicy mocks base method.
+func (m *MockClient) UntagPolicy(arg0 context.Context, arg1 *iam.UntagPolicyInput) (*iam.UntagPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UntagPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagPolicy indicates an expected call of UntagPolicy.
+func (mr *MockClientMockRecorder) UntagPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagPolicy", reflect.TypeOf((*MockClient)(nil).UntagPolicy), arg0, arg1)
+}
+
 // UntagResource mocks base method.
 func (m *MockClient) UntagResource(arg0 context.Context, arg1 *organizations.UntagResourceInput) (*organizations.UntagResourceOutput, error) {
 	m.ctrl.T.Helper()
@@ -1278,40 +2063,2468 @@ func (mr *MockClientMockRecorder) UntagResource(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockClient)(nil).UntagResource), arg0, arg1)
 }
 
-// MockIBuilder is a mock of IBuilder interface.
-type MockIBuilder struct {
+// UntagRole mocks base method.
+func (m *MockClient) UntagRole(arg0 context.Context, arg1 *iam.UntagRoleInput) (*iam.UntagRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagRole", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UntagRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagRole indicates an expected call of UntagRole.
+func (mr *MockClientMockRecorder) UntagRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagRole", reflect.TypeOf((*MockClient)(nil).UntagRole), arg0, arg1)
+}
+
+// UntagUser mocks base method.
+func (m *MockClient) UntagUser(arg0 context.Context, arg1 *iam.UntagUserInput) (*iam.UntagUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagUser", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UntagUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagUser indicates an expected call of UntagUser.
+func (mr *MockClientMockRecorder) UntagUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagUser", reflect.TypeOf((*MockClient)(nil).UntagUser), arg0, arg1)
+}
+
+// UpdateAssumeRolePolicy mocks base method.
+func (m *MockClient) UpdateAssumeRolePolicy(arg0 context.Context, arg1 *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssumeRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UpdateAssumeRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssumeRolePolicy indicates an expected call of UpdateAssumeRolePolicy.
+func (mr *MockClientMockRecorder) UpdateAssumeRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssumeRolePolicy", reflect.TypeOf((*MockClient)(nil).UpdateAssumeRolePolicy), arg0, arg1)
+}
+
+// MockAccountClient is a mock of AccountClient interface.
+type MockAccountClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockIBuilderMockRecorder
+	recorder *MockAccountClientMockRecorder
 }
 
-// MockIBuilderMockRecorder is the mock recorder for MockIBuilder.
-type MockIBuilderMockRecorder struct {
-	mock *MockIBuilder
+// MockAccountClientMockRecorder is the mock recorder for MockAccountClient.
+type MockAccountClientMockRecorder struct {
+	mock *MockAccountClient
 }
 
-// NewMockIBuilder creates a new mock instance.
-func NewMockIBuilder(ctrl *gomock.Controller) *MockIBuilder {
-	mock := &MockIBuilder{ctrl: ctrl}
-	mock.recorder = &MockIBuilderMockRecorder{mock}
+// NewMockAccountClient creates a new mock instance.
+func NewMockAccountClient(ctrl *gomock.Controller) *MockAccountClient {
+	mock := &MockAccountClient{ctrl: ctrl}
+	mock.recorder = &MockAccountClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockIBuilder) EXPECT() *MockIBuilderMockRecorder {
+func (m *MockAccountClient) EXPECT() *MockAccountClientMockRecorder {
 	return m.recorder
 }
 
-// GetClient mocks base method.
-func (m *MockIBuilder) GetClient(controllerName string, kubeClient client.Client, input awsclient.NewAwsClientInput) (awsclient.Client, error) {
+// EnableRegion mocks base method.
+func (m *MockAccountClient) EnableRegion(arg0 context.Context, arg1 *account.EnableRegionInput) (*account.EnableRegionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableRegion", arg0, arg1)
+	ret0, _ := ret[0].(*account.EnableRegionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableRegion indicates an expected call of EnableRegion.
+func (mr *MockAccountClientMockRecorder) EnableRegion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableRegion", reflect.TypeOf((*MockAccountClient)(nil).EnableRegion), arg0, arg1)
+}
+
+// GetAlternateContact mocks base method.
+func (m *MockAccountClient) GetAlternateContact(arg0 context.Context, arg1 *account.GetAlternateContactInput) (*account.GetAlternateContactOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlternateContact", arg0, arg1)
+	ret0, _ := ret[0].(*account.GetAlternateContactOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlternateContact indicates an expected call of GetAlternateContact.
+func (mr *MockAccountClientMockRecorder) GetAlternateContact(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlternateContact", reflect.TypeOf((*MockAccountClient)(nil).GetAlternateContact), arg0, arg1)
+}
+
+// GetRegionOptStatus mocks base method.
+func (m *MockAccountClient) GetRegionOptStatus(arg0 context.Context, arg1 *account.GetRegionOptStatusInput) (*account.GetRegionOptStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionOptStatus", arg0, arg1)
+	ret0, _ := ret[0].(*account.GetRegionOptStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionOptStatus indicates an expected call of GetRegionOptStatus.
+func (mr *MockAccountClientMockRecorder) GetRegionOptStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionOptStatus", reflect.TypeOf((*MockAccountClient)(nil).GetRegionOptStatus), arg0, arg1)
+}
+
+// PutAlternateContact mocks base method.
+func (m *MockAccountClient) PutAlternateContact(arg0 context.Context, arg1 *account.PutAlternateContactInput) (*account.PutAlternateContactOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAlternateContact", arg0, arg1)
+	ret0, _ := ret[0].(*account.PutAlternateContactOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAlternateContact indicates an expected call of PutAlternateContact.
+func (mr *MockAccountClientMockRecorder) PutAlternateContact(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAlternateContact", reflect.TypeOf((*MockAccountClient)(nil).PutAlternateContact), arg0, arg1)
+}
+
+// MockEC2Client is a mock of EC2Client interface.
+type MockEC2Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockEC2ClientMockRecorder
+}
+
+// MockEC2ClientMockRecorder is the mock recorder for MockEC2Client.
+type MockEC2ClientMockRecorder struct {
+	mock *MockEC2Client
+}
+
+// NewMockEC2Client creates a new mock instance.
+func NewMockEC2Client(ctrl *gomock.Controller) *MockEC2Client {
+	mock := &MockEC2Client{ctrl: ctrl}
+	mock.recorder = &MockEC2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEC2Client) EXPECT() *MockEC2ClientMockRecorder {
+	return m.recorder
+}
+
+// CopyImage mocks base method.
+func (m *MockEC2Client) CopyImage(arg0 context.Context, arg1 *ec2.CopyImageInput) (*ec2.CopyImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyImage", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.CopyImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockEC2ClientMockRecorder) CopyImage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockEC2Client)(nil).CopyImage), arg0, arg1)
+}
+
+// CreateSubnet mocks base method.
+func (m *MockEC2Client) CreateSubnet(arg0 context.Context, arg1 *ec2.CreateSubnetInput) (*ec2.CreateSubnetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubnet", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.CreateSubnetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubnet indicates an expected call of CreateSubnet.
+func (mr *MockEC2ClientMockRecorder) CreateSubnet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnet", reflect.TypeOf((*MockEC2Client)(nil).CreateSubnet), arg0, arg1)
+}
+
+// CreateTags mocks base method.
+func (m *MockEC2Client) CreateTags(arg0 context.Context, arg1 *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTags", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.CreateTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTags indicates an expected call of CreateTags.
+func (mr *MockEC2ClientMockRecorder) CreateTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockEC2Client)(nil).CreateTags), arg0, arg1)
+}
+
+// CreateVpc mocks base method.
+func (m *MockEC2Client) CreateVpc(arg0 context.Context, arg1 *ec2.CreateVpcInput) (*ec2.CreateVpcOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpc", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.CreateVpcOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpc indicates an expected call of CreateVpc.
+func (mr *MockEC2ClientMockRecorder) CreateVpc(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpc", reflect.TypeOf((*MockEC2Client)(nil).CreateVpc), arg0, arg1)
+}
+
+// DeleteNatGateway mocks base method.
+func (m *MockEC2Client) DeleteNatGateway(arg0 context.Context, arg1 *ec2.DeleteNatGatewayInput) (*ec2.DeleteNatGatewayOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNatGateway", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteNatGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNatGateway indicates an expected call of DeleteNatGateway.
+func (mr *MockEC2ClientMockRecorder) DeleteNatGateway(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockEC2Client)(nil).DeleteNatGateway), arg0, arg1)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockEC2Client) DeleteSnapshot(arg0 context.Context, arg1 *ec2.DeleteSnapshotInput) (*ec2.DeleteSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockEC2ClientMockRecorder) DeleteSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockEC2Client)(nil).DeleteSnapshot), arg0, arg1)
+}
+
+// DeleteSubnet mocks base method.
+func (m *MockEC2Client) DeleteSubnet(arg0 context.Context, arg1 *ec2.DeleteSubnetInput) (*ec2.DeleteSubnetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubnet", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteSubnetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubnet indicates an expected call of DeleteSubnet.
+func (mr *MockEC2ClientMockRecorder) DeleteSubnet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockEC2Client)(nil).DeleteSubnet), arg0, arg1)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockEC2Client) DeleteVolume(arg0 context.Context, arg1 *ec2.DeleteVolumeInput) (*ec2.DeleteVolumeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteVolumeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockEC2ClientMockRecorder) DeleteVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockEC2Client)(nil).DeleteVolume), arg0, arg1)
+}
+
+// DeleteVpc mocks base method.
+func (m *MockEC2Client) DeleteVpc(arg0 context.Context, arg1 *ec2.DeleteVpcInput) (*ec2.DeleteVpcOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpc", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteVpcOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpc indicates an expected call of DeleteVpc.
+func (mr *MockEC2ClientMockRecorder) DeleteVpc(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpc", reflect.TypeOf((*MockEC2Client)(nil).DeleteVpc), arg0, arg1)
+}
+
+// DeleteVpcEndpointServiceConfigurations mocks base method.
+func (m *MockEC2Client) DeleteVpcEndpointServiceConfigurations(arg0 context.Context, arg1 *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcEndpointServiceConfigurations", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteVpcEndpointServiceConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpcEndpointServiceConfigurations indicates an expected call of DeleteVpcEndpointServiceConfigurations.
+func (mr *MockEC2ClientMockRecorder) DeleteVpcEndpointServiceConfigurations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpointServiceConfigurations", reflect.TypeOf((*MockEC2Client)(nil).DeleteVpcEndpointServiceConfigurations), arg0, arg1)
+}
+
+// DeregisterImage mocks base method.
+func (m *MockEC2Client) DeregisterImage(arg0 context.Context, arg1 *ec2.DeregisterImageInput) (*ec2.DeregisterImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterImage", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeregisterImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterImage indicates an expected call of DeregisterImage.
+func (mr *MockEC2ClientMockRecorder) DeregisterImage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterImage", reflect.TypeOf((*MockEC2Client)(nil).DeregisterImage), arg0, arg1)
+}
+
+// DescribeAvailabilityZones mocks base method.
+func (m *MockEC2Client) DescribeAvailabilityZones(arg0 context.Context, arg1 *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAvailabilityZones", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeAvailabilityZonesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAvailabilityZones indicates an expected call of DescribeAvailabilityZones.
+func (mr *MockEC2ClientMockRecorder) DescribeAvailabilityZones(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailabilityZones", reflect.TypeOf((*MockEC2Client)(nil).DescribeAvailabilityZones), arg0, arg1)
+}
+
+// DescribeImageAttribute mocks base method.
+func (m *MockEC2Client) DescribeImageAttribute(arg0 context.Context, arg1 *ec2.DescribeImageAttributeInput) (*ec2.DescribeImageAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImageAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeImageAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImageAttribute indicates an expected call of DescribeImageAttribute.
+func (mr *MockEC2ClientMockRecorder) DescribeImageAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageAttribute", reflect.TypeOf((*MockEC2Client)(nil).DescribeImageAttribute), arg0, arg1)
+}
+
+// DescribeImages mocks base method.
+func (m *MockEC2Client) DescribeImages(arg0 context.Context, arg1 *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImages", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeImagesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImages indicates an expected call of DescribeImages.
+func (mr *MockEC2ClientMockRecorder) DescribeImages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImages", reflect.TypeOf((*MockEC2Client)(nil).DescribeImages), arg0, arg1)
+}
+
+// DescribeInstanceStatus mocks base method.
+func (m *MockEC2Client) DescribeInstanceStatus(arg0 context.Context, arg1 *ec2.DescribeInstanceStatusInput) (*ec2.DescribeInstanceStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceStatus", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeInstanceStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceStatus indicates an expected call of DescribeInstanceStatus.
+func (mr *MockEC2ClientMockRecorder) DescribeInstanceStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceStatus", reflect.TypeOf((*MockEC2Client)(nil).DescribeInstanceStatus), arg0, arg1)
+}
+
+// DescribeInstanceTypes mocks base method.
+func (m *MockEC2Client) DescribeInstanceTypes(arg0 context.Context, arg1 *ec2.DescribeInstanceTypesInput) (*ec2.DescribeInstanceTypesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceTypes", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeInstanceTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceTypes indicates an expected call of DescribeInstanceTypes.
+func (mr *MockEC2ClientMockRecorder) DescribeInstanceTypes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceTypes", reflect.TypeOf((*MockEC2Client)(nil).DescribeInstanceTypes), arg0, arg1)
+}
+
+// DescribeInstances mocks base method.
+func (m *MockEC2Client) DescribeInstances(arg0 context.Context, arg1 *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstances", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstances indicates an expected call of DescribeInstances.
+func (mr *MockEC2ClientMockRecorder) DescribeInstances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstances", reflect.TypeOf((*MockEC2Client)(nil).DescribeInstances), arg0, arg1)
+}
+
+// DescribeNatGateways mocks base method.
+func (m *MockEC2Client) DescribeNatGateways(arg0 context.Context, arg1 *ec2.DescribeNatGatewaysInput) (*ec2.DescribeNatGatewaysOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNatGateways", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeNatGatewaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNatGateways indicates an expected call of DescribeNatGateways.
+func (mr *MockEC2ClientMockRecorder) DescribeNatGateways(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNatGateways", reflect.TypeOf((*MockEC2Client)(nil).DescribeNatGateways), arg0, arg1)
+}
+
+// DescribeRegions mocks base method.
+func (m *MockEC2Client) DescribeRegions(arg0 context.Context, arg1 *ec2.DescribeRegionsInput) (*ec2.DescribeRegionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRegions", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeRegionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRegions indicates an expected call of DescribeRegions.
+func (mr *MockEC2ClientMockRecorder) DescribeRegions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegions", reflect.TypeOf((*MockEC2Client)(nil).DescribeRegions), arg0, arg1)
+}
+
+// DescribeSnapshotAttribute mocks base method.
+func (m *MockEC2Client) DescribeSnapshotAttribute(arg0 context.Context, arg1 *ec2.DescribeSnapshotAttributeInput) (*ec2.DescribeSnapshotAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSnapshotAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeSnapshotAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSnapshotAttribute indicates an expected call of DescribeSnapshotAttribute.
+func (mr *MockEC2ClientMockRecorder) DescribeSnapshotAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSnapshotAttribute", reflect.TypeOf((*MockEC2Client)(nil).DescribeSnapshotAttribute), arg0, arg1)
+}
+
+// DescribeSnapshots mocks base method.
+func (m *MockEC2Client) DescribeSnapshots(arg0 context.Context, arg1 *ec2.DescribeSnapshotsInput) (*ec2.DescribeSnapshotsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeSnapshotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSnapshots indicates an expected call of DescribeSnapshots.
+func (mr *MockEC2ClientMockRecorder) DescribeSnapshots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSnapshots", reflect.TypeOf((*MockEC2Client)(nil).DescribeSnapshots), arg0, arg1)
+}
+
+// DescribeSubnets mocks base method.
+func (m *MockEC2Client) DescribeSubnets(arg0 context.Context, arg1 *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSubnets", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeSubnetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSubnets indicates an expected call of DescribeSubnets.
+func (mr *MockEC2ClientMockRecorder) DescribeSubnets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubnets", reflect.TypeOf((*MockEC2Client)(nil).DescribeSubnets), arg0, arg1)
+}
+
+// DescribeVolumes mocks base method.
+func (m *MockEC2Client) DescribeVolumes(arg0 context.Context, arg1 *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVolumes", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeVolumesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVolumes indicates an expected call of DescribeVolumes.
+func (mr *MockEC2ClientMockRecorder) DescribeVolumes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVolumes", reflect.TypeOf((*MockEC2Client)(nil).DescribeVolumes), arg0, arg1)
+}
+
+// DescribeVpcEndpointServiceConfigurations mocks base method.
+func (m *MockEC2Client) DescribeVpcEndpointServiceConfigurations(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointServiceConfigurations", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeVpcEndpointServiceConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointServiceConfigurations indicates an expected call of DescribeVpcEndpointServiceConfigurations.
+func (mr *MockEC2ClientMockRecorder) DescribeVpcEndpointServiceConfigurations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServiceConfigurations", reflect.TypeOf((*MockEC2Client)(nil).DescribeVpcEndpointServiceConfigurations), arg0, arg1)
+}
+
+// DescribeVpcEndpointServicePermissions mocks base method.
+func (m *MockEC2Client) DescribeVpcEndpointServicePermissions(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointServicePermissionsInput) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointServicePermissions", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeVpcEndpointServicePermissionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointServicePermissions indicates an expected call of DescribeVpcEndpointServicePermissions.
+func (mr *MockEC2ClientMockRecorder) DescribeVpcEndpointServicePermissions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServicePermissions", reflect.TypeOf((*MockEC2Client)(nil).DescribeVpcEndpointServicePermissions), arg0, arg1)
+}
+
+// DescribeVpcs mocks base method.
+func (m *MockEC2Client) DescribeVpcs(arg0 context.Context, arg1 *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcs", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeVpcsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcs indicates an expected call of DescribeVpcs.
+func (mr *MockEC2ClientMockRecorder) DescribeVpcs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcs", reflect.TypeOf((*MockEC2Client)(nil).DescribeVpcs), arg0, arg1)
+}
+
+// ModifyImageAttribute mocks base method.
+func (m *MockEC2Client) ModifyImageAttribute(arg0 context.Context, arg1 *ec2.ModifyImageAttributeInput) (*ec2.ModifyImageAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyImageAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifyImageAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyImageAttribute indicates an expected call of ModifyImageAttribute.
+func (mr *MockEC2ClientMockRecorder) ModifyImageAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyImageAttribute", reflect.TypeOf((*MockEC2Client)(nil).ModifyImageAttribute), arg0, arg1)
+}
+
+// ModifySnapshotAttribute mocks base method.
+func (m *MockEC2Client) ModifySnapshotAttribute(arg0 context.Context, arg1 *ec2.ModifySnapshotAttributeInput) (*ec2.ModifySnapshotAttributeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySnapshotAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifySnapshotAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySnapshotAttribute indicates an expected call of ModifySnapshotAttribute.
+func (mr *MockEC2ClientMockRecorder) ModifySnapshotAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySnapshotAttribute", reflect.TypeOf((*MockEC2Client)(nil).ModifySnapshotAttribute), arg0, arg1)
+}
+
+// ModifyVpcEndpointServicePermissions mocks base method.
+func (m *MockEC2Client) ModifyVpcEndpointServicePermissions(arg0 context.Context, arg1 *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVpcEndpointServicePermissions", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifyVpcEndpointServicePermissionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVpcEndpointServicePermissions indicates an expected call of ModifyVpcEndpointServicePermissions.
+func (mr *MockEC2ClientMockRecorder) ModifyVpcEndpointServicePermissions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVpcEndpointServicePermissions", reflect.TypeOf((*MockEC2Client)(nil).ModifyVpcEndpointServicePermissions), arg0, arg1)
+}
+
+// RunInstances mocks base method.
+func (m *MockEC2Client) RunInstances(arg0 context.Context, arg1 *ec2.RunInstancesInput) (*ec2.RunInstancesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInstances", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.RunInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunInstances indicates an expected call of RunInstances.
+func (mr *MockEC2ClientMockRecorder) RunInstances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInstances", reflect.TypeOf((*MockEC2Client)(nil).RunInstances), arg0, arg1)
+}
+
+// TerminateInstances mocks base method.
+func (m *MockEC2Client) TerminateInstances(arg0 context.Context, arg1 *ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateInstances", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.TerminateInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TerminateInstances indicates an expected call of TerminateInstances.
+func (mr *MockEC2ClientMockRecorder) TerminateInstances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstances", reflect.TypeOf((*MockEC2Client)(nil).TerminateInstances), arg0, arg1)
+}
+
+// MockIAMClient is a mock of IAMClient interface.
+type MockIAMClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAMClientMockRecorder
+}
+
+// MockIAMClientMockRecorder is the mock recorder for MockIAMClient.
+type MockIAMClientMockRecorder struct {
+	mock *MockIAMClient
+}
+
+// NewMockIAMClient creates a new mock instance.
+func NewMockIAMClient(ctrl *gomock.Controller) *MockIAMClient {
+	mock := &MockIAMClient{ctrl: ctrl}
+	mock.recorder = &MockIAMClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAMClient) EXPECT() *MockIAMClientMockRecorder {
+	return m.recorder
+}
+
+// AttachRolePolicy mocks base method.
+func (m *MockIAMClient) AttachRolePolicy(arg0 context.Context, arg1 *iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.AttachRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachRolePolicy indicates an expected call of AttachRolePolicy.
+func (mr *MockIAMClientMockRecorder) AttachRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachRolePolicy", reflect.TypeOf((*MockIAMClient)(nil).AttachRolePolicy), arg0, arg1)
+}
+
+// AttachUserPolicy mocks base method.
+func (m *MockIAMClient) AttachUserPolicy(arg0 context.Context, arg1 *iam.AttachUserPolicyInput) (*iam.AttachUserPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachUserPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.AttachUserPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachUserPolicy indicates an expected call of AttachUserPolicy.
+func (mr *MockIAMClientMockRecorder) AttachUserPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachUserPolicy", reflect.TypeOf((*MockIAMClient)(nil).AttachUserPolicy), arg0, arg1)
+}
+
+// CreateAccessKey mocks base method.
+func (m *MockIAMClient) CreateAccessKey(arg0 context.Context, arg1 *iam.CreateAccessKeyInput) (*iam.CreateAccessKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessKey", arg0, arg1)
+	ret0, _ := ret[0].(*iam.CreateAccessKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessKey indicates an expected call of CreateAccessKey.
+func (mr *MockIAMClientMockRecorder) CreateAccessKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessKey", reflect.TypeOf((*MockIAMClient)(nil).CreateAccessKey), arg0, arg1)
+}
+
+// CreatePolicy mocks base method.
+func (m *MockIAMClient) CreatePolicy(arg0 context.Context, arg1 *iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.CreatePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockIAMClientMockRecorder) CreatePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockIAMClient)(nil).CreatePolicy), arg0, arg1)
+}
+
+// CreateRole mocks base method.
+func (m *MockIAMClient) CreateRole(arg0 context.Context, arg1 *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", arg0, arg1)
+	ret0, _ := ret[0].(*iam.CreateRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockIAMClientMockRecorder) CreateRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockIAMClient)(nil).CreateRole), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockIAMClient) CreateUser(arg0 context.Context, arg1 *iam.CreateUserInput) (*iam.CreateUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(*iam.CreateUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockIAMClientMockRecorder) CreateUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIAMClient)(nil).CreateUser), arg0, arg1)
+}
+
+// DeleteAccessKey mocks base method.
+func (m *MockIAMClient) DeleteAccessKey(arg0 context.Context, arg1 *iam.DeleteAccessKeyInput) (*iam.DeleteAccessKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccessKey", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DeleteAccessKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccessKey indicates an expected call of DeleteAccessKey.
+func (mr *MockIAMClientMockRecorder) DeleteAccessKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessKey", reflect.TypeOf((*MockIAMClient)(nil).DeleteAccessKey), arg0, arg1)
+}
+
+// DeletePolicy mocks base method.
+func (m *MockIAMClient) DeletePolicy(arg0 context.Context, arg1 *iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DeletePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockIAMClientMockRecorder) DeletePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockIAMClient)(nil).DeletePolicy), arg0, arg1)
+}
+
+// DeletePolicyVersion mocks base method.
+func (m *MockIAMClient) DeletePolicyVersion(arg0 context.Context, arg1 *iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicyVersion", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DeletePolicyVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePolicyVersion indicates an expected call of DeletePolicyVersion.
+func (mr *MockIAMClientMockRecorder) DeletePolicyVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyVersion", reflect.TypeOf((*MockIAMClient)(nil).DeletePolicyVersion), arg0, arg1)
+}
+
+// DeleteRole mocks base method.
+func (m *MockIAMClient) DeleteRole(arg0 context.Context, arg1 *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DeleteRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockIAMClientMockRecorder) DeleteRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockIAMClient)(nil).DeleteRole), arg0, arg1)
+}
+
+// DeleteRolePolicy mocks base method.
+func (m *MockIAMClient) DeleteRolePolicy(arg0 context.Context, arg1 *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DeleteRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRolePolicy indicates an expected call of DeleteRolePolicy.
+func (mr *MockIAMClientMockRecorder) DeleteRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolePolicy", reflect.TypeOf((*MockIAMClient)(nil).DeleteRolePolicy), arg0, arg1)
+}
+
+// DeleteUser mocks base method.
+func (m *MockIAMClient) DeleteUser(arg0 context.Context, arg1 *iam.DeleteUserInput) (*iam.DeleteUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DeleteUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockIAMClientMockRecorder) DeleteUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIAMClient)(nil).DeleteUser), arg0, arg1)
+}
+
+// DeleteUserPolicy mocks base method.
+func (m *MockIAMClient) DeleteUserPolicy(arg0 context.Context, arg1 *iam.DeleteUserPolicyInput) (*iam.DeleteUserPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DeleteUserPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserPolicy indicates an expected call of DeleteUserPolicy.
+func (mr *MockIAMClientMockRecorder) DeleteUserPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPolicy", reflect.TypeOf((*MockIAMClient)(nil).DeleteUserPolicy), arg0, arg1)
+}
+
+// DetachRolePolicy mocks base method.
+func (m *MockIAMClient) DetachRolePolicy(arg0 context.Context, arg1 *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DetachRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachRolePolicy indicates an expected call of DetachRolePolicy.
+func (mr *MockIAMClientMockRecorder) DetachRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachRolePolicy", reflect.TypeOf((*MockIAMClient)(nil).DetachRolePolicy), arg0, arg1)
+}
+
+// DetachUserPolicy mocks base method.
+func (m *MockIAMClient) DetachUserPolicy(arg0 context.Context, arg1 *iam.DetachUserPolicyInput) (*iam.DetachUserPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachUserPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.DetachUserPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachUserPolicy indicates an expected call of DetachUserPolicy.
+func (mr *MockIAMClientMockRecorder) DetachUserPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachUserPolicy", reflect.TypeOf((*MockIAMClient)(nil).DetachUserPolicy), arg0, arg1)
+}
+
+// GetPolicy mocks base method.
+func (m *MockIAMClient) GetPolicy(arg0 context.Context, arg1 *iam.GetPolicyInput) (*iam.GetPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.GetPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockIAMClientMockRecorder) GetPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockIAMClient)(nil).GetPolicy), arg0, arg1)
+}
+
+// GetPolicyVersion mocks base method.
+func (m *MockIAMClient) GetPolicyVersion(arg0 context.Context, arg1 *iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyVersion", arg0, arg1)
+	ret0, _ := ret[0].(*iam.GetPolicyVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyVersion indicates an expected call of GetPolicyVersion.
+func (mr *MockIAMClientMockRecorder) GetPolicyVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyVersion", reflect.TypeOf((*MockIAMClient)(nil).GetPolicyVersion), arg0, arg1)
+}
+
+// GetRole mocks base method.
+func (m *MockIAMClient) GetRole(arg0 context.Context, arg1 *iam.GetRoleInput) (*iam.GetRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", arg0, arg1)
+	ret0, _ := ret[0].(*iam.GetRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockIAMClientMockRecorder) GetRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockIAMClient)(nil).GetRole), arg0, arg1)
+}
+
+// GetRolePolicy mocks base method.
+func (m *MockIAMClient) GetRolePolicy(arg0 context.Context, arg1 *iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.GetRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolePolicy indicates an expected call of GetRolePolicy.
+func (mr *MockIAMClientMockRecorder) GetRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolePolicy", reflect.TypeOf((*MockIAMClient)(nil).GetRolePolicy), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockIAMClient) GetUser(arg0 context.Context, arg1 *iam.GetUserInput) (*iam.GetUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(*iam.GetUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockIAMClientMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockIAMClient)(nil).GetUser), arg0, arg1)
+}
+
+// ListAccessKeys mocks base method.
+func (m *MockIAMClient) ListAccessKeys(arg0 context.Context, arg1 *iam.ListAccessKeysInput) (*iam.ListAccessKeysOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccessKeys", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListAccessKeysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessKeys indicates an expected call of ListAccessKeys.
+func (mr *MockIAMClientMockRecorder) ListAccessKeys(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessKeys", reflect.TypeOf((*MockIAMClient)(nil).ListAccessKeys), arg0, arg1)
+}
+
+// ListAttachedRolePolicies mocks base method.
+func (m *MockIAMClient) ListAttachedRolePolicies(arg0 context.Context, arg1 *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachedRolePolicies", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListAttachedRolePoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachedRolePolicies indicates an expected call of ListAttachedRolePolicies.
+func (mr *MockIAMClientMockRecorder) ListAttachedRolePolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachedRolePolicies", reflect.TypeOf((*MockIAMClient)(nil).ListAttachedRolePolicies), arg0, arg1)
+}
+
+// ListAttachedUserPolicies mocks base method.
+func (m *MockIAMClient) ListAttachedUserPolicies(arg0 context.Context, arg1 *iam.ListAttachedUserPoliciesInput) (*iam.ListAttachedUserPoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachedUserPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListAttachedUserPoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachedUserPolicies indicates an expected call of ListAttachedUserPolicies.
+func (mr *MockIAMClientMockRecorder) ListAttachedUserPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachedUserPolicies", reflect.TypeOf((*MockIAMClient)(nil).ListAttachedUserPolicies), arg0, arg1)
+}
+
+// ListPolicies mocks base method.
+func (m *MockIAMClient) ListPolicies(arg0 context.Context, arg1 *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListPoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockIAMClientMockRecorder) ListPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockIAMClient)(nil).ListPolicies), arg0, arg1)
+}
+
+// ListPolicyTags mocks base method.
+func (m *MockIAMClient) ListPolicyTags(arg0 context.Context, arg1 *iam.ListPolicyTagsInput) (*iam.ListPolicyTagsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicyTags", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListPolicyTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicyTags indicates an expected call of ListPolicyTags.
+func (mr *MockIAMClientMockRecorder) ListPolicyTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyTags", reflect.TypeOf((*MockIAMClient)(nil).ListPolicyTags), arg0, arg1)
+}
+
+// ListPolicyVersions mocks base method.
+func (m *MockIAMClient) ListPolicyVersions(arg0 context.Context, arg1 *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicyVersions", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListPolicyVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicyVersions indicates an expected call of ListPolicyVersions.
+func (mr *MockIAMClientMockRecorder) ListPolicyVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyVersions", reflect.TypeOf((*MockIAMClient)(nil).ListPolicyVersions), arg0, arg1)
+}
+
+// ListRolePolicies mocks base method.
+func (m *MockIAMClient) ListRolePolicies(arg0 context.Context, arg1 *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolePolicies", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListRolePoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolePolicies indicates an expected call of ListRolePolicies.
+func (mr *MockIAMClientMockRecorder) ListRolePolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePolicies", reflect.TypeOf((*MockIAMClient)(nil).ListRolePolicies), arg0, arg1)
+}
+
+// ListRoleTags mocks base method.
+func (m *MockIAMClient) ListRoleTags(arg0 context.Context, arg1 *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListRoleTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockIAMClientMockRecorder) ListRoleTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockIAMClient)(nil).ListRoleTags), arg0, arg1)
+}
+
+// ListRoles mocks base method.
+func (m *MockIAMClient) ListRoles(arg0 context.Context, arg1 *iam.ListRolesInput) (*iam.ListRolesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListRolesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockIAMClientMockRecorder) ListRoles(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockIAMClient)(nil).ListRoles), arg0, arg1)
+}
+
+// ListUserPolicies mocks base method.
+func (m *MockIAMClient) ListUserPolicies(arg0 context.Context, arg1 *iam.ListUserPoliciesInput) (*iam.ListUserPoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListUserPoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserPolicies indicates an expected call of ListUserPolicies.
+func (mr *MockIAMClientMockRecorder) ListUserPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserPolicies", reflect.TypeOf((*MockIAMClient)(nil).ListUserPolicies), arg0, arg1)
+}
+
+// ListUserTags mocks base method.
+func (m *MockIAMClient) ListUserTags(arg0 context.Context, arg1 *iam.ListUserTagsInput) (*iam.ListUserTagsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserTags", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListUserTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserTags indicates an expected call of ListUserTags.
+func (mr *MockIAMClientMockRecorder) ListUserTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserTags", reflect.TypeOf((*MockIAMClient)(nil).ListUserTags), arg0, arg1)
+}
+
+// ListUsers mocks base method.
+func (m *MockIAMClient) ListUsers(arg0 context.Context, arg1 *iam.ListUsersInput) (*iam.ListUsersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1)
+	ret0, _ := ret[0].(*iam.ListUsersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockIAMClientMockRecorder) ListUsers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockIAMClient)(nil).ListUsers), arg0, arg1)
+}
+
+// ListUsersPages mocks base method.
+func (m *MockIAMClient) ListUsersPages(arg0 context.Context, arg1 *iam.ListUsersInput, arg2 func(*iam.ListUsersOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersPages", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListUsersPages indicates an expected call of ListUsersPages.
+func (mr *MockIAMClientMockRecorder) ListUsersPages(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersPages", reflect.TypeOf((*MockIAMClient)(nil).ListUsersPages), arg0, arg1, arg2)
+}
+
+// PutRolePolicy mocks base method.
+func (m *MockIAMClient) PutRolePolicy(arg0 context.Context, arg1 *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.PutRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRolePolicy indicates an expected call of PutRolePolicy.
+func (mr *MockIAMClientMockRecorder) PutRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRolePolicy", reflect.TypeOf((*MockIAMClient)(nil).PutRolePolicy), arg0, arg1)
+}
+
+// PutUserPolicy mocks base method.
+func (m *MockIAMClient) PutUserPolicy(arg0 context.Context, arg1 *iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutUserPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.PutUserPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutUserPolicy indicates an expected call of PutUserPolicy.
+func (mr *MockIAMClientMockRecorder) PutUserPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUserPolicy", reflect.TypeOf((*MockIAMClient)(nil).PutUserPolicy), arg0, arg1)
+}
+
+// TagPolicy mocks base method.
+func (m *MockIAMClient) TagPolicy(arg0 context.Context, arg1 *iam.TagPolicyInput) (*iam.TagPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.TagPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagPolicy indicates an expected call of TagPolicy.
+func (mr *MockIAMClientMockRecorder) TagPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagPolicy", reflect.TypeOf((*MockIAMClient)(nil).TagPolicy), arg0, arg1)
+}
+
+// TagRole mocks base method.
+func (m *MockIAMClient) TagRole(arg0 context.Context, arg1 *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagRole", arg0, arg1)
+	ret0, _ := ret[0].(*iam.TagRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagRole indicates an expected call of TagRole.
+func (mr *MockIAMClientMockRecorder) TagRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagRole", reflect.TypeOf((*MockIAMClient)(nil).TagRole), arg0, arg1)
+}
+
+// TagUser mocks base method.
+func (m *MockIAMClient) TagUser(arg0 context.Context, arg1 *iam.TagUserInput) (*iam.TagUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagUser", arg0, arg1)
+	ret0, _ := ret[0].(*iam.TagUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagUser indicates an expected call of TagUser.
+func (mr *MockIAMClientMockRecorder) TagUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagUser", reflect.TypeOf((*MockIAMClient)(nil).TagUser), arg0, arg1)
+}
+
+// UntagPolicy mocks base method.
+func (m *MockIAMClient) UntagPolicy(arg0 context.Context, arg1 *iam.UntagPolicyInput) (*iam.UntagPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UntagPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagPolicy indicates an expected call of UntagPolicy.
+func (mr *MockIAMClientMockRecorder) UntagPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagPolicy", reflect.TypeOf((*MockIAMClient)(nil).UntagPolicy), arg0, arg1)
+}
+
+// UntagRole mocks base method.
+func (m *MockIAMClient) UntagRole(arg0 context.Context, arg1 *iam.UntagRoleInput) (*iam.UntagRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagRole", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UntagRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagRole indicates an expected call of UntagRole.
+func (mr *MockIAMClientMockRecorder) UntagRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagRole", reflect.TypeOf((*MockIAMClient)(nil).UntagRole), arg0, arg1)
+}
+
+// UntagUser mocks base method.
+func (m *MockIAMClient) UntagUser(arg0 context.Context, arg1 *iam.UntagUserInput) (*iam.UntagUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagUser", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UntagUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagUser indicates an expected call of UntagUser.
+func (mr *MockIAMClientMockRecorder) UntagUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagUser", reflect.TypeOf((*MockIAMClient)(nil).UntagUser), arg0, arg1)
+}
+
+// UpdateAssumeRolePolicy mocks base method.
+func (m *MockIAMClient) UpdateAssumeRolePolicy(arg0 context.Context, arg1 *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssumeRolePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*iam.UpdateAssumeRolePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssumeRolePolicy indicates an expected call of UpdateAssumeRolePolicy.
+func (mr *MockIAMClientMockRecorder) UpdateAssumeRolePolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssumeRolePolicy", reflect.TypeOf((*MockIAMClient)(nil).UpdateAssumeRolePolicy), arg0, arg1)
+}
+
+// MockOrganizationsClient is a mock of OrganizationsClient interface.
+type MockOrganizationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationsClientMockRecorder
+}
+
+// MockOrganizationsClientMockRecorder is the mock recorder for MockOrganizationsClient.
+type MockOrganizationsClientMockRecorder struct {
+	mock *MockOrganizationsClient
+}
+
+// NewMockOrganizationsClient creates a new mock instance.
+func NewMockOrganizationsClient(ctrl *gomock.Controller) *MockOrganizationsClient {
+	mock := &MockOrganizationsClient{ctrl: ctrl}
+	mock.recorder = &MockOrganizationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationsClient) EXPECT() *MockOrganizationsClientMockRecorder {
+	return m.recorder
+}
+
+// CreateAccount mocks base method.
+func (m *MockOrganizationsClient) CreateAccount(arg0 context.Context, arg1 *organizations.CreateAccountInput) (*organizations.CreateAccountOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.CreateAccountOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockOrganizationsClientMockRecorder) CreateAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockOrganizationsClient)(nil).CreateAccount), arg0, arg1)
+}
+
+// CreateOrganizationalUnit mocks base method.
+func (m *MockOrganizationsClient) CreateOrganizationalUnit(arg0 context.Context, arg1 *organizations.CreateOrganizationalUnitInput) (*organizations.CreateOrganizationalUnitOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationalUnit", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.CreateOrganizationalUnitOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationalUnit indicates an expected call of CreateOrganizationalUnit.
+func (mr *MockOrganizationsClientMockRecorder) CreateOrganizationalUnit(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationalUnit", reflect.TypeOf((*MockOrganizationsClient)(nil).CreateOrganizationalUnit), arg0, arg1)
+}
+
+// DescribeCreateAccountStatus mocks base method.
+func (m *MockOrganizationsClient) DescribeCreateAccountStatus(arg0 context.Context, arg1 *organizations.DescribeCreateAccountStatusInput) (*organizations.DescribeCreateAccountStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCreateAccountStatus", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.DescribeCreateAccountStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCreateAccountStatus indicates an expected call of DescribeCreateAccountStatus.
+func (mr *MockOrganizationsClientMockRecorder) DescribeCreateAccountStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCreateAccountStatus", reflect.TypeOf((*MockOrganizationsClient)(nil).DescribeCreateAccountStatus), arg0, arg1)
+}
+
+// ListAccounts mocks base method.
+func (m *MockOrganizationsClient) ListAccounts(arg0 context.Context, arg1 *organizations.ListAccountsInput) (*organizations.ListAccountsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.ListAccountsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockOrganizationsClientMockRecorder) ListAccounts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockOrganizationsClient)(nil).ListAccounts), arg0, arg1)
+}
+
+// ListChildren mocks base method.
+func (m *MockOrganizationsClient) ListChildren(arg0 context.Context, arg1 *organizations.ListChildrenInput) (*organizations.ListChildrenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChildren", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.ListChildrenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChildren indicates an expected call of ListChildren.
+func (mr *MockOrganizationsClientMockRecorder) ListChildren(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChildren", reflect.TypeOf((*MockOrganizationsClient)(nil).ListChildren), arg0, arg1)
+}
+
+// ListCreateAccountStatus mocks base method.
+func (m *MockOrganizationsClient) ListCreateAccountStatus(arg0 context.Context, arg1 *organizations.ListCreateAccountStatusInput) (*organizations.ListCreateAccountStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCreateAccountStatus", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.ListCreateAccountStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCreateAccountStatus indicates an expected call of ListCreateAccountStatus.
+func (mr *MockOrganizationsClientMockRecorder) ListCreateAccountStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCreateAccountStatus", reflect.TypeOf((*MockOrganizationsClient)(nil).ListCreateAccountStatus), arg0, arg1)
+}
+
+// ListOrganizationalUnitsForParent mocks base method.
+func (m *MockOrganizationsClient) ListOrganizationalUnitsForParent(arg0 context.Context, arg1 *organizations.ListOrganizationalUnitsForParentInput) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationalUnitsForParent", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.ListOrganizationalUnitsForParentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationalUnitsForParent indicates an expected call of ListOrganizationalUnitsForParent.
+func (mr *MockOrganizationsClientMockRecorder) ListOrganizationalUnitsForParent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationalUnitsForParent", reflect.TypeOf((*MockOrganizationsClient)(nil).ListOrganizationalUnitsForParent), arg0, arg1)
+}
+
+// ListParents mocks base method.
+func (m *MockOrganizationsClient) ListParents(arg0 context.Context, arg1 *organizations.ListParentsInput) (*organizations.ListParentsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListParents", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.ListParentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListParents indicates an expected call of ListParents.
+func (mr *MockOrganizationsClientMockRecorder) ListParents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListParents", reflect.TypeOf((*MockOrganizationsClient)(nil).ListParents), arg0, arg1)
+}
+
+// ListTagsForResource mocks base method.
+func (m *MockOrganizationsClient) ListTagsForResource(arg0 context.Context, arg1 *organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResource", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResource indicates an expected call of ListTagsForResource.
+func (mr *MockOrganizationsClientMockRecorder) ListTagsForResource(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*MockOrganizationsClient)(nil).ListTagsForResource), arg0, arg1)
+}
+
+// MoveAccount mocks base method.
+func (m *MockOrganizationsClient) MoveAccount(arg0 context.Context, arg1 *organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveAccount", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.MoveAccountOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveAccount indicates an expected call of MoveAccount.
+func (mr *MockOrganizationsClientMockRecorder) MoveAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveAccount", reflect.TypeOf((*MockOrganizationsClient)(nil).MoveAccount), arg0, arg1)
+}
+
+// TagResource mocks base method.
+func (m *MockOrganizationsClient) TagResource(arg0 context.Context, arg1 *organizations.TagResourceInput) (*organizations.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResource", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResource indicates an expected call of TagResource.
+func (mr *MockOrganizationsClientMockRecorder) TagResource(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockOrganizationsClient)(nil).TagResource), arg0, arg1)
+}
+
+// UntagResource mocks base method.
+func (m *MockOrganizationsClient) UntagResource(arg0 context.Context, arg1 *organizations.UntagResourceInput) (*organizations.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResource", arg0, arg1)
+	ret0, _ := ret[0].(*organizations.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResource indicates an expected call of UntagResource.
+func (mr *MockOrganizationsClientMockRecorder) UntagResource(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockOrganizationsClient)(nil).UntagResource), arg0, arg1)
+}
+
+// MockSTSClient is a mock of STSClient interface.
+type MockSTSClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTSClientMockRecorder
+}
+
+// MockSTSClientMockRecorder is the mock recorder for MockSTSClient.
+type MockSTSClientMockRecorder struct {
+	mock *MockSTSClient
+}
+
+// NewMockSTSClient creates a new mock instance.
+func NewMockSTSClient(ctrl *gomock.Controller) *MockSTSClient {
+	mock := &MockSTSClient{ctrl: ctrl}
+	mock.recorder = &MockSTSClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTSClient) EXPECT() *MockSTSClientMockRecorder {
+	return m.recorder
+}
+
+// AssumeRole mocks base method.
+func (m *MockSTSClient) AssumeRole(arg0 context.Context, arg1 *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssumeRole", arg0, arg1)
+	ret0, _ := ret[0].(*sts.AssumeRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssumeRole indicates an expected call of AssumeRole.
+func (mr *MockSTSClientMockRecorder) AssumeRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssumeRole", reflect.TypeOf((*MockSTSClient)(nil).AssumeRole), arg0, arg1)
+}
+
+// GetCallerIdentity mocks base method.
+func (m *MockSTSClient) GetCallerIdentity(arg0 context.Context, arg1 *sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCallerIdentity", arg0, arg1)
+	ret0, _ := ret[0].(*sts.GetCallerIdentityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCallerIdentity indicates an expected call of GetCallerIdentity.
+func (mr *MockSTSClientMockRecorder) GetCallerIdentity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCallerIdentity", reflect.TypeOf((*MockSTSClient)(nil).GetCallerIdentity), arg0, arg1)
+}
+
+// GetFederationToken mocks base method.
+func (m *MockSTSClient) GetFederationToken(arg0 context.Context, arg1 *sts.GetFederationTokenInput) (*sts.GetFederationTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFederationToken", arg0, arg1)
+	ret0, _ := ret[0].(*sts.GetFederationTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFederationToken indicates an expected call of GetFederationToken.
+func (mr *MockSTSClientMockRecorder) GetFederationToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFederationToken", reflect.TypeOf((*MockSTSClient)(nil).GetFederationToken), arg0, arg1)
+}
+
+// MockSupportClient is a mock of SupportClient interface.
+type MockSupportClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupportClientMockRecorder
+}
+
+// MockSupportClientMockRecorder is the mock recorder for MockSupportClient.
+type MockSupportClientMockRecorder struct {
+	mock *MockSupportClient
+}
+
+// NewMockSupportClient creates a new mock instance.
+func NewMockSupportClient(ctrl *gomock.Controller) *MockSupportClient {
+	mock := &MockSupportClient{ctrl: ctrl}
+	mock.recorder = &MockSupportClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupportClient) EXPECT() *MockSupportClientMockRecorder {
+	return m.recorder
+}
+
+// CreateCase mocks base method.
+func (m *MockSupportClient) CreateCase(arg0 context.Context, arg1 *support.CreateCaseInput) (*support.CreateCaseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCase", arg0, arg1)
+	ret0, _ := ret[0].(*support.CreateCaseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCase indicates an expected call of CreateCase.
+func (mr *MockSupportClientMockRecorder) CreateCase(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCase", reflect.TypeOf((*MockSupportClient)(nil).CreateCase), arg0, arg1)
+}
+
+// DescribeCases mocks base method.
+func (m *MockSupportClient) DescribeCases(arg0 context.Context, arg1 *support.DescribeCasesInput) (*support.DescribeCasesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCases", arg0, arg1)
+	ret0, _ := ret[0].(*support.DescribeCasesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCases indicates an expected call of DescribeCases.
+func (mr *MockSupportClientMockRecorder) DescribeCases(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCases", reflect.TypeOf((*MockSupportClient)(nil).DescribeCases), arg0, arg1)
+}
+
+// MockS3Client is a mock of S3Client interface.
+type MockS3Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockS3ClientMockRecorder
+}
+
+// MockS3ClientMockRecorder is the mock recorder for MockS3Client.
+type MockS3ClientMockRecorder struct {
+	mock *MockS3Client
+}
+
+// NewMockS3Client creates a new mock instance.
+func NewMockS3Client(ctrl *gomock.Controller) *MockS3Client {
+	mock := &MockS3Client{ctrl: ctrl}
+	mock.recorder = &MockS3ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockS3Client) EXPECT() *MockS3ClientMockRecorder {
+	return m.recorder
+}
+
+// BatchDeleteBucketObjects mocks base method.
+func (m *MockS3Client) BatchDeleteBucketObjects(arg0 context.Context, arg1 *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteBucketObjects", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDeleteBucketObjects indicates an expected call of BatchDeleteBucketObjects.
+func (mr *MockS3ClientMockRecorder) BatchDeleteBucketObjects(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteBucketObjects", reflect.TypeOf((*MockS3Client)(nil).BatchDeleteBucketObjects), arg0, arg1)
+}
+
+// DeleteBucket mocks base method.
+func (m *MockS3Client) DeleteBucket(arg0 context.Context, arg1 *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucket", arg0, arg1)
+	ret0, _ := ret[0].(*s3.DeleteBucketOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBucket indicates an expected call of DeleteBucket.
+func (mr *MockS3ClientMockRecorder) DeleteBucket(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockS3Client)(nil).DeleteBucket), arg0, arg1)
+}
+
+// ListBuckets mocks base method.
+func (m *MockS3Client) ListBuckets(arg0 context.Context, arg1 *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuckets", arg0, arg1)
+	ret0, _ := ret[0].(*s3.ListBucketsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuckets indicates an expected call of ListBuckets.
+func (mr *MockS3ClientMockRecorder) ListBuckets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuckets", reflect.TypeOf((*MockS3Client)(nil).ListBuckets), arg0, arg1)
+}
+
+// ListObjectsV2 mocks base method.
+func (m *MockS3Client) ListObjectsV2(arg0 context.Context, arg1 *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectsV2", arg0, arg1)
+	ret0, _ := ret[0].(*s3.ListObjectsV2Output)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsV2 indicates an expected call of ListObjectsV2.
+func (mr *MockS3ClientMockRecorder) ListObjectsV2(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsV2", reflect.TypeOf((*MockS3Client)(nil).ListObjectsV2), arg0, arg1)
+}
+
+// MockRoute53Client is a mock of Route53Client interface.
+type MockRoute53Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoute53ClientMockRecorder
+}
+
+// MockRoute53ClientMockRecorder is the mock recorder for MockRoute53Client.
+type MockRoute53ClientMockRecorder struct {
+	mock *MockRoute53Client
+}
+
+// NewMockRoute53Client creates a new mock instance.
+func NewMockRoute53Client(ctrl *gomock.Controller) *MockRoute53Client {
+	mock := &MockRoute53Client{ctrl: ctrl}
+	mock.recorder = &MockRoute53ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoute53Client) EXPECT() *MockRoute53ClientMockRecorder {
+	return m.recorder
+}
+
+// ChangeResourceRecordSets mocks base method.
+func (m *MockRoute53Client) ChangeResourceRecordSets(arg0 context.Context, arg1 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeResourceRecordSets", arg0, arg1)
+	ret0, _ := ret[0].(*route53.ChangeResourceRecordSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeResourceRecordSets indicates an expected call of ChangeResourceRecordSets.
+func (mr *MockRoute53ClientMockRecorder) ChangeResourceRecordSets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeResourceRecordSets", reflect.TypeOf((*MockRoute53Client)(nil).ChangeResourceRecordSets), arg0, arg1)
+}
+
+// DeleteHostedZone mocks base method.
+func (m *MockRoute53Client) DeleteHostedZone(arg0 context.Context, arg1 *route53.DeleteHostedZoneInput) (*route53.DeleteHostedZoneOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHostedZone", arg0, arg1)
+	ret0, _ := ret[0].(*route53.DeleteHostedZoneOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHostedZone indicates an expected call of DeleteHostedZone.
+func (mr *MockRoute53ClientMockRecorder) DeleteHostedZone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHostedZone", reflect.TypeOf((*MockRoute53Client)(nil).DeleteHostedZone), arg0, arg1)
+}
+
+// ListHostedZones mocks base method.
+func (m *MockRoute53Client) ListHostedZones(arg0 context.Context, arg1 *route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostedZones", arg0, arg1)
+	ret0, _ := ret[0].(*route53.ListHostedZonesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostedZones indicates an expected call of ListHostedZones.
+func (mr *MockRoute53ClientMockRecorder) ListHostedZones(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostedZones", reflect.TypeOf((*MockRoute53Client)(nil).ListHostedZones), arg0, arg1)
+}
+
+// ListResourceRecordSets mocks base method.
+func (m *MockRoute53Client) ListResourceRecordSets(arg0 context.Context, arg1 *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceRecordSets", arg0, arg1)
+	ret0, _ := ret[0].(*route53.ListResourceRecordSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceRecordSets indicates an expected call of ListResourceRecordSets.
+func (mr *MockRoute53ClientMockRecorder) ListResourceRecordSets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceRecordSets", reflect.TypeOf((*MockRoute53Client)(nil).ListResourceRecordSets), arg0, arg1)
+}
+
+// MockServiceQuotasClient is a mock of ServiceQuotasClient interface.
+type MockServiceQuotasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceQuotasClientMockRecorder
+}
+
+// MockServiceQuotasClientMockRecorder is the mock recorder for MockServiceQuotasClient.
+type MockServiceQuotasClientMockRecorder struct {
+	mock *MockServiceQuotasClient
+}
+
+// NewMockServiceQuotasClient creates a new mock instance.
+func NewMockServiceQuotasClient(ctrl *gomock.Controller) *MockServiceQuotasClient {
+	mock := &MockServiceQuotasClient{ctrl: ctrl}
+	mock.recorder = &MockServiceQuotasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceQuotasClient) EXPECT() *MockServiceQuotasClientMockRecorder {
+	return m.recorder
+}
+
+// GetServiceQuota mocks base method.
+func (m *MockServiceQuotasClient) GetServiceQuota(arg0 context.Context, arg1 *servicequotas.GetServiceQuotaInput) (*servicequotas.GetServiceQuotaOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceQuota", arg0, arg1)
+	ret0, _ := ret[0].(*servicequotas.GetServiceQuotaOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceQuota indicates an expected call of GetServiceQuota.
+func (mr *MockServiceQuotasClientMockRecorder) GetServiceQuota(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceQuota", reflect.TypeOf((*MockServiceQuotasClient)(nil).GetServiceQuota), arg0, arg1)
+}
+
+// ListRequestedServiceQuotaChangeHistory mocks base method.
+func (m *MockServiceQuotasClient) ListRequestedServiceQuotaChangeHistory(arg0 context.Context, arg1 *servicequotas.ListRequestedServiceQuotaChangeHistoryInput) (*servicequotas.ListRequestedServiceQuotaChangeHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequestedServiceQuotaChangeHistory", arg0, arg1)
+	ret0, _ := ret[0].(*servicequotas.ListRequestedServiceQuotaChangeHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRequestedServiceQuotaChangeHistory indicates an expected call of ListRequestedServiceQuotaChangeHistory.
+func (mr *MockServiceQuotasClientMockRecorder) ListRequestedServiceQuotaChangeHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequestedServiceQuotaChangeHistory", reflect.TypeOf((*MockServiceQuotasClient)(nil).ListRequestedServiceQuotaChangeHistory), arg0, arg1)
+}
+
+// ListRequestedServiceQuotaChangeHistoryByQuota mocks base method.
+func (m *MockServiceQuotasClient) ListRequestedServiceQuotaChangeHistoryByQuota(arg0 context.Context, arg1 *servicequotas.ListRequestedServiceQuotaChangeHistoryByQuotaInput) (*servicequotas.ListRequestedServiceQuotaChangeHistoryByQuotaOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequestedServiceQuotaChangeHistoryByQuota", arg0, arg1)
+	ret0, _ := ret[0].(*servicequotas.ListRequestedServiceQuotaChangeHistoryByQuotaOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRequestedServiceQuotaChangeHistoryByQuota indicates an expected call of ListRequestedServiceQuotaChangeHistoryByQuota.
+func (mr *MockServiceQuotasClientMockRecorder) ListRequestedServiceQuotaChangeHistoryByQuota(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequestedServiceQuotaChangeHistoryByQuota", reflect.TypeOf((*MockServiceQuotasClient)(nil).ListRequestedServiceQuotaChangeHistoryByQuota), arg0, arg1)
+}
+
+// RequestServiceQuotaIncrease mocks base method.
+func (m *MockServiceQuotasClient) RequestServiceQuotaIncrease(arg0 context.Context, arg1 *servicequotas.RequestServiceQuotaIncreaseInput) (*servicequotas.RequestServiceQuotaIncreaseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestServiceQuotaIncrease", arg0, arg1)
+	ret0, _ := ret[0].(*servicequotas.RequestServiceQuotaIncreaseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestServiceQuotaIncrease indicates an expected call of RequestServiceQuotaIncrease.
+func (mr *MockServiceQuotasClientMockRecorder) RequestServiceQuotaIncrease(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestServiceQuotaIncrease", reflect.TypeOf((*MockServiceQuotasClient)(nil).RequestServiceQuotaIncrease), arg0, arg1)
+}
+
+// MockDynamoDBClient is a mock of DynamoDBClient interface.
+type MockDynamoDBClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDynamoDBClientMockRecorder
+}
+
+// MockDynamoDBClientMockRecorder is the mock recorder for MockDynamoDBClient.
+type MockDynamoDBClientMockRecorder struct {
+	mock *MockDynamoDBClient
+}
+
+// NewMockDynamoDBClient creates a new mock instance.
+func NewMockDynamoDBClient(ctrl *gomock.Controller) *MockDynamoDBClient {
+	mock := &MockDynamoDBClient{ctrl: ctrl}
+	mock.recorder = &MockDynamoDBClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDynamoDBClient) EXPECT() *MockDynamoDBClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteTable mocks base method.
+func (m *MockDynamoDBClient) DeleteTable(arg0 context.Context, arg1 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTable", arg0, arg1)
+	ret0, _ := ret[0].(*dynamodb.DeleteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTable indicates an expected call of DeleteTable.
+func (mr *MockDynamoDBClientMockRecorder) DeleteTable(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTable", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteTable), arg0, arg1)
+}
+
+// ListTables mocks base method.
+func (m *MockDynamoDBClient) ListTables(arg0 context.Context, arg1 *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTables", arg0, arg1)
+	ret0, _ := ret[0].(*dynamodb.ListTablesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTables indicates an expected call of ListTables.
+func (mr *MockDynamoDBClientMockRecorder) ListTables(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTables", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTables), arg0, arg1)
+}
+
+// MockSQSClient is a mock of SQSClient interface.
+type MockSQSClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSQSClientMockRecorder
+}
+
+// MockSQSClientMockRecorder is the mock recorder for MockSQSClient.
+type MockSQSClientMockRecorder struct {
+	mock *MockSQSClient
+}
+
+// NewMockSQSClient creates a new mock instance.
+func NewMockSQSClient(ctrl *gomock.Controller) *MockSQSClient {
+	mock := &MockSQSClient{ctrl: ctrl}
+	mock.recorder = &MockSQSClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSQSClient) EXPECT() *MockSQSClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteQueue mocks base method.
+func (m *MockSQSClient) DeleteQueue(arg0 context.Context, arg1 *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueue", arg0, arg1)
+	ret0, _ := ret[0].(*sqs.DeleteQueueOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteQueue indicates an expected call of DeleteQueue.
+func (mr *MockSQSClientMockRecorder) DeleteQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueue", reflect.TypeOf((*MockSQSClient)(nil).DeleteQueue), arg0, arg1)
+}
+
+// ListQueues mocks base method.
+func (m *MockSQSClient) ListQueues(arg0 context.Context, arg1 *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueues", arg0, arg1)
+	ret0, _ := ret[0].(*sqs.ListQueuesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueues indicates an expected call of ListQueues.
+func (mr *MockSQSClientMockRecorder) ListQueues(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockSQSClient)(nil).ListQueues), arg0, arg1)
+}
+
+// MockSNSClient is a mock of SNSClient interface.
+type MockSNSClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSNSClientMockRecorder
+}
+
+// MockSNSClientMockRecorder is the mock recorder for MockSNSClient.
+type MockSNSClientMockRecorder struct {
+	mock *MockSNSClient
+}
+
+// NewMockSNSClient creates a new mock instance.
+func NewMockSNSClient(ctrl *gomock.Controller) *MockSNSClient {
+	mock := &MockSNSClient{ctrl: ctrl}
+	mock.recorder = &MockSNSClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSNSClient) EXPECT() *MockSNSClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteTopic mocks base method.
+func (m *MockSNSClient) DeleteTopic(arg0 context.Context, arg1 *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopic", arg0, arg1)
+	ret0, _ := ret[0].(*sns.DeleteTopicOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTopic indicates an expected call of DeleteTopic.
+func (mr *MockSNSClientMockRecorder) DeleteTopic(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopic", reflect.TypeOf((*MockSNSClient)(nil).DeleteTopic), arg0, arg1)
+}
+
+// ListTopics mocks base method.
+func (m *MockSNSClient) ListTopics(arg0 context.Context, arg1 *sns.ListTopicsInput) (*sns.ListTopicsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopics", arg0, arg1)
+	ret0, _ := ret[0].(*sns.ListTopicsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopics indicates an expected call of ListTopics.
+func (mr *MockSNSClientMockRecorder) ListTopics(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*MockSNSClient)(nil).ListTopics), arg0, arg1)
+}
+
+// MockLambdaClient is a mock of LambdaClient interface.
+type MockLambdaClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLambdaClientMockRecorder
+}
+
+// MockLambdaClientMockRecorder is the mock recorder for MockLambdaClient.
+type MockLambdaClientMockRecorder struct {
+	mock *MockLambdaClient
+}
+
+// NewMockLambdaClient creates a new mock instance.
+func NewMockLambdaClient(ctrl *gomock.Controller) *MockLambdaClient {
+	mock := &MockLambdaClient{ctrl: ctrl}
+	mock.recorder = &MockLambdaClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLambdaClient) EXPECT() *MockLambdaClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteEventSourceMapping mocks base method.
+func (m *MockLambdaClient) DeleteEventSourceMapping(arg0 context.Context, arg1 *lambda.DeleteEventSourceMappingInput) (*lambda.DeleteEventSourceMappingOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventSourceMapping", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.DeleteEventSourceMappingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventSourceMapping indicates an expected call of DeleteEventSourceMapping.
+func (mr *MockLambdaClientMockRecorder) DeleteEventSourceMapping(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSourceMapping", reflect.TypeOf((*MockLambdaClient)(nil).DeleteEventSourceMapping), arg0, arg1)
+}
+
+// DeleteFunction mocks base method.
+func (m *MockLambdaClient) DeleteFunction(arg0 context.Context, arg1 *lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFunction", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunction indicates an expected call of DeleteFunction.
+func (mr *MockLambdaClientMockRecorder) DeleteFunction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunction", reflect.TypeOf((*MockLambdaClient)(nil).DeleteFunction), arg0, arg1)
+}
+
+// DeleteLayerVersion mocks base method.
+func (m *MockLambdaClient) DeleteLayerVersion(arg0 context.Context, arg1 *lambda.DeleteLayerVersionInput) (*lambda.DeleteLayerVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLayerVersion", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.DeleteLayerVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLayerVersion indicates an expected call of DeleteLayerVersion.
+func (mr *MockLambdaClientMockRecorder) DeleteLayerVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLayerVersion", reflect.TypeOf((*MockLambdaClient)(nil).DeleteLayerVersion), arg0, arg1)
+}
+
+// ListEventSourceMappings mocks base method.
+func (m *MockLambdaClient) ListEventSourceMappings(arg0 context.Context, arg1 *lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventSourceMappings", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListEventSourceMappingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSourceMappings indicates an expected call of ListEventSourceMappings.
+func (mr *MockLambdaClientMockRecorder) ListEventSourceMappings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappings", reflect.TypeOf((*MockLambdaClient)(nil).ListEventSourceMappings), arg0, arg1)
+}
+
+// ListFunctions mocks base method.
+func (m *MockLambdaClient) ListFunctions(arg0 context.Context, arg1 *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFunctions", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListFunctionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctions indicates an expected call of ListFunctions.
+func (mr *MockLambdaClientMockRecorder) ListFunctions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctions", reflect.TypeOf((*MockLambdaClient)(nil).ListFunctions), arg0, arg1)
+}
+
+// ListLayerVersions mocks base method.
+func (m *MockLambdaClient) ListLayerVersions(arg0 context.Context, arg1 *lambda.ListLayerVersionsInput) (*lambda.ListLayerVersionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLayerVersions", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListLayerVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayerVersions indicates an expected call of ListLayerVersions.
+func (mr *MockLambdaClientMockRecorder) ListLayerVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLayerVersions", reflect.TypeOf((*MockLambdaClient)(nil).ListLayerVersions), arg0, arg1)
+}
+
+// ListLayers mocks base method.
+func (m *MockLambdaClient) ListLayers(arg0 context.Context, arg1 *lambda.ListLayersInput) (*lambda.ListLayersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLayers", arg0, arg1)
+	ret0, _ := ret[0].(*lambda.ListLayersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayers indicates an expected call of ListLayers.
+func (mr *MockLambdaClientMockRecorder) ListLayers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLayers", reflect.TypeOf((*MockLambdaClient)(nil).ListLayers), arg0, arg1)
+}
+
+// MockEventBridgeClient is a mock of EventBridgeClient interface.
+type MockEventBridgeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBridgeClientMockRecorder
+}
+
+// MockEventBridgeClientMockRecorder is the mock recorder for MockEventBridgeClient.
+type MockEventBridgeClientMockRecorder struct {
+	mock *MockEventBridgeClient
+}
+
+// NewMockEventBridgeClient creates a new mock instance.
+func NewMockEventBridgeClient(ctrl *gomock.Controller) *MockEventBridgeClient {
+	mock := &MockEventBridgeClient{ctrl: ctrl}
+	mock.recorder = &MockEventBridgeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventBridgeClient) EXPECT() *MockEventBridgeClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteEventBus mocks base method.
+func (m *MockEventBridgeClient) DeleteEventBus(arg0 context.Context, arg1 *eventbridge.DeleteEventBusInput) (*eventbridge.DeleteEventBusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.DeleteEventBusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventBus indicates an expected call of DeleteEventBus.
+func (mr *MockEventBridgeClientMockRecorder) DeleteEventBus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBridgeClient)(nil).DeleteEventBus), arg0, arg1)
+}
+
+// DeleteRule mocks base method.
+func (m *MockEventBridgeClient) DeleteRule(arg0 context.Context, arg1 *eventbridge.DeleteRuleInput) (*eventbridge.DeleteRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.DeleteRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockEventBridgeClientMockRecorder) DeleteRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockEventBridgeClient)(nil).DeleteRule), arg0, arg1)
+}
+
+// ListEventBuses mocks base method.
+func (m *MockEventBridgeClient) ListEventBuses(arg0 context.Context, arg1 *eventbridge.ListEventBusesInput) (*eventbridge.ListEventBusesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventBuses", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.ListEventBusesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventBuses indicates an expected call of ListEventBuses.
+func (mr *MockEventBridgeClientMockRecorder) ListEventBuses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBuses", reflect.TypeOf((*MockEventBridgeClient)(nil).ListEventBuses), arg0, arg1)
+}
+
+// ListRules mocks base method.
+func (m *MockEventBridgeClient) ListRules(arg0 context.Context, arg1 *eventbridge.ListRulesInput) (*eventbridge.ListRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.ListRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockEventBridgeClientMockRecorder) ListRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockEventBridgeClient)(nil).ListRules), arg0, arg1)
+}
+
+// ListTargetsByRule mocks base method.
+func (m *MockEventBridgeClient) ListTargetsByRule(arg0 context.Context, arg1 *eventbridge.ListTargetsByRuleInput) (*eventbridge.ListTargetsByRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTargetsByRule", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.ListTargetsByRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTargetsByRule indicates an expected call of ListTargetsByRule.
+func (mr *MockEventBridgeClientMockRecorder) ListTargetsByRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTargetsByRule", reflect.TypeOf((*MockEventBridgeClient)(nil).ListTargetsByRule), arg0, arg1)
+}
+
+// RemoveTargets mocks base method.
+func (m *MockEventBridgeClient) RemoveTargets(arg0 context.Context, arg1 *eventbridge.RemoveTargetsInput) (*eventbridge.RemoveTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTargets", arg0, arg1)
+	ret0, _ := ret[0].(*eventbridge.RemoveTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTargets indicates an expected call of RemoveTargets.
+func (mr *MockEventBridgeClientMockRecorder) RemoveTargets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTargets", reflect.TypeOf((*MockEventBridgeClient)(nil).RemoveTargets), arg0, arg1)
+}
+
+// MockELBClient is a mock of ELBClient interface.
+type MockELBClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockELBClientMockRecorder
+}
+
+// MockELBClientMockRecorder is the mock recorder for MockELBClient.
+type MockELBClientMockRecorder struct {
+	mock *MockELBClient
+}
+
+// NewMockELBClient creates a new mock instance.
+func NewMockELBClient(ctrl *gomock.Controller) *MockELBClient {
+	mock := &MockELBClient{ctrl: ctrl}
+	mock.recorder = &MockELBClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockELBClient) EXPECT() *MockELBClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteLoadBalancer mocks base method.
+func (m *MockELBClient) DeleteLoadBalancer(arg0 context.Context, arg1 *elasticloadbalancingv2.DeleteLoadBalancerInput) (*elasticloadbalancingv2.DeleteLoadBalancerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancer", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DeleteLoadBalancerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancer indicates an expected call of DeleteLoadBalancer.
+func (mr *MockELBClientMockRecorder) DeleteLoadBalancer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockELBClient)(nil).DeleteLoadBalancer), arg0, arg1)
+}
+
+// DescribeLoadBalancers mocks base method.
+func (m *MockELBClient) DescribeLoadBalancers(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeLoadBalancersInput) (*elasticloadbalancingv2.DescribeLoadBalancersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLoadBalancers", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DescribeLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLoadBalancers indicates an expected call of DescribeLoadBalancers.
+func (mr *MockELBClientMockRecorder) DescribeLoadBalancers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancers", reflect.TypeOf((*MockELBClient)(nil).DescribeLoadBalancers), arg0, arg1)
+}
+
+// MockRDSClient is a mock of RDSClient interface.
+type MockRDSClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRDSClientMockRecorder
+}
+
+// MockRDSClientMockRecorder is the mock recorder for MockRDSClient.
+type MockRDSClientMockRecorder struct {
+	mock *MockRDSClient
+}
+
+// NewMockRDSClient creates a new mock instance.
+func NewMockRDSClient(ctrl *gomock.Controller) *MockRDSClient {
+	mock := &MockRDSClient{ctrl: ctrl}
+	mock.recorder = &MockRDSClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRDSClient) EXPECT() *MockRDSClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteDBCluster mocks base method.
+func (m *MockRDSClient) DeleteDBCluster(arg0 context.Context, arg1 *rds.DeleteDBClusterInput) (*rds.DeleteDBClusterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBCluster", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBClusterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBCluster indicates an expected call of DeleteDBCluster.
+func (mr *MockRDSClientMockRecorder) DeleteDBCluster(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBCluster", reflect.TypeOf((*MockRDSClient)(nil).DeleteDBCluster), arg0, arg1)
+}
+
+// DeleteDBClusterSnapshot mocks base method.
+func (m *MockRDSClient) DeleteDBClusterSnapshot(arg0 context.Context, arg1 *rds.DeleteDBClusterSnapshotInput) (*rds.DeleteDBClusterSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBClusterSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBClusterSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBClusterSnapshot indicates an expected call of DeleteDBClusterSnapshot.
+func (mr *MockRDSClientMockRecorder) DeleteDBClusterSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBClusterSnapshot", reflect.TypeOf((*MockRDSClient)(nil).DeleteDBClusterSnapshot), arg0, arg1)
+}
+
+// DeleteDBInstance mocks base method.
+func (m *MockRDSClient) DeleteDBInstance(arg0 context.Context, arg1 *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBInstance", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBInstance indicates an expected call of DeleteDBInstance.
+func (mr *MockRDSClientMockRecorder) DeleteDBInstance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBInstance", reflect.TypeOf((*MockRDSClient)(nil).DeleteDBInstance), arg0, arg1)
+}
+
+// DeleteDBSnapshot mocks base method.
+func (m *MockRDSClient) DeleteDBSnapshot(arg0 context.Context, arg1 *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DeleteDBSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDBSnapshot indicates an expected call of DeleteDBSnapshot.
+func (mr *MockRDSClientMockRecorder) DeleteDBSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBSnapshot", reflect.TypeOf((*MockRDSClient)(nil).DeleteDBSnapshot), arg0, arg1)
+}
+
+// DescribeDBClusterSnapshots mocks base method.
+func (m *MockRDSClient) DescribeDBClusterSnapshots(arg0 context.Context, arg1 *rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBClusterSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBClusterSnapshotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBClusterSnapshots indicates an expected call of DescribeDBClusterSnapshots.
+func (mr *MockRDSClientMockRecorder) DescribeDBClusterSnapshots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBClusterSnapshots", reflect.TypeOf((*MockRDSClient)(nil).DescribeDBClusterSnapshots), arg0, arg1)
+}
+
+// DescribeDBClusters mocks base method.
+func (m *MockRDSClient) DescribeDBClusters(arg0 context.Context, arg1 *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBClusters", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBClustersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBClusters indicates an expected call of DescribeDBClusters.
+func (mr *MockRDSClientMockRecorder) DescribeDBClusters(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBClusters", reflect.TypeOf((*MockRDSClient)(nil).DescribeDBClusters), arg0, arg1)
+}
+
+// DescribeDBInstances mocks base method.
+func (m *MockRDSClient) DescribeDBInstances(arg0 context.Context, arg1 *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBInstances", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBInstances indicates an expected call of DescribeDBInstances.
+func (mr *MockRDSClientMockRecorder) DescribeDBInstances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBInstances", reflect.TypeOf((*MockRDSClient)(nil).DescribeDBInstances), arg0, arg1)
+}
+
+// DescribeDBSnapshots mocks base method.
+func (m *MockRDSClient) DescribeDBSnapshots(arg0 context.Context, arg1 *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*rds.DescribeDBSnapshotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBSnapshots indicates an expected call of DescribeDBSnapshots.
+func (mr *MockRDSClientMockRecorder) DescribeDBSnapshots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBSnapshots", reflect.TypeOf((*MockRDSClient)(nil).DescribeDBSnapshots), arg0, arg1)
+}
+
+// ModifyDBCluster mocks base method.
+func (m *MockRDSClient) ModifyDBCluster(arg0 context.Context, arg1 *rds.ModifyDBClusterInput) (*rds.ModifyDBClusterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyDBCluster", arg0, arg1)
+	ret0, _ := ret[0].(*rds.ModifyDBClusterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyDBCluster indicates an expected call of ModifyDBCluster.
+func (mr *MockRDSClientMockRecorder) ModifyDBCluster(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDBCluster", reflect.TypeOf((*MockRDSClient)(nil).ModifyDBCluster), arg0, arg1)
+}
+
+// ModifyDBInstance mocks base method.
+func (m *MockRDSClient) ModifyDBInstance(arg0 context.Context, arg1 *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClient", controllerName, kubeClient, input)
-	ret0, _ := ret[0].(awsclient.Client)
+	ret := m.ctrl.Call(m, "ModifyDBInstance", arg0, arg1)
+	ret0, _ := ret[0].(*rds.ModifyDBInstanceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetClient indicates an expected call of GetClient.
-func (mr *MockIBuilderMockRecorder) GetClient(controllerName, kubeClient, input any) *gomock.Call {
+// ModifyDBInstance indicates an expected call of ModifyDBInstance.
+func (mr *MockRDSClientMockRecorder) ModifyDBInstance(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockIBuilder)(nil).GetClient), controllerName, kubeClient, input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDBInstance", reflect.TypeOf((*MockRDSClient)(nil).ModifyDBInstance), arg0, arg1)
 }
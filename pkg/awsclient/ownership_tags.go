@@ -0,0 +1,207 @@
+package awsclient
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// The IAM ownership tags record who an IAM user, role or policy created by the operator belongs to, so the resources
+// can be audited and the stale ones swept without the custom resources. They are written at creation, next to the
+// ManagedByTagKey tag, and verified on resync. The keys match the ownership tags of the AWS accounts.
+const (
+	// IAMPoolTagKey holds the AccountPool of the account the resource was created for
+	IAMPoolTagKey = "aws.managed.openshift.io/pool"
+	// IAMClaimTagKey holds the namespace/name of the AccountClaim the resource was created for
+	IAMClaimTagKey = "aws.managed.openshift.io/claim"
+	// IAMExpiryTagKey holds the time, in RFC3339, after which the resource can be swept
+	IAMExpiryTagKey = "aws.managed.openshift.io/expiry"
+)
+
+// iamOwnershipTagKeys are the tag keys of IAMOwnership other than ManagedByTagKey, in the order they are written
+var iamOwnershipTagKeys = []string{IAMPoolTagKey, IAMClaimTagKey, IAMExpiryTagKey}
+
+// IAMOwnership is the owner of an IAM resource created by the operator. Empty fields aren't tagged.
+type IAMOwnership struct {
+	Pool   string
+	Claim  string
+	Expiry string
+}
+
+// AccountIAMOwnership returns the ownership of the IAM resources created in the AWS account of the Account
+func AccountIAMOwnership(account *awsv1alpha1.Account) IAMOwnership {
+	ownership := ObjectIAMOwnership(account)
+	ownership.Pool = account.Spec.AccountPool
+	if account.Spec.ClaimLink != "" {
+		ownership.Claim = account.Spec.ClaimLinkNamespace + "/" + account.Spec.ClaimLink
+	}
+	return ownership
+}
+
+// ClaimIAMOwnership returns the ownership of the IAM resources created for the AccountClaim
+func ClaimIAMOwnership(claim *awsv1alpha1.AccountClaim) IAMOwnership {
+	ownership := ObjectIAMOwnership(claim)
+	ownership.Pool = claim.Spec.AccountPool
+	ownership.Claim = claim.Namespace + "/" + claim.Name
+	return ownership
+}
+
+// ObjectIAMOwnership returns the ownership of the IAM resources created for obj, which only carries the expiry set
+// by its IAMExpiryAnnotation
+func ObjectIAMOwnership(obj metav1.Object) IAMOwnership {
+	return IAMOwnership{Expiry: obj.GetAnnotations()[awsv1alpha1.IAMExpiryAnnotation]}
+}
+
+// values returns the value of each ownership tag key
+func (o IAMOwnership) values() map[string]string {
+	return map[string]string{
+		IAMPoolTagKey:   o.Pool,
+		IAMClaimTagKey:  o.Claim,
+		IAMExpiryTagKey: o.Expiry,
+	}
+}
+
+// Tags returns the tags of the ownership, starting with the ManagedByTagKey tag
+func (o IAMOwnership) Tags() []types.Tag {
+	tags := []types.Tag{OwnershipTag()}
+	values := o.values()
+	for _, key := range iamOwnershipTagKeys {
+		if values[key] != "" {
+			tags = append(tags, types.Tag{Key: aws.String(key), Value: aws.String(values[key])})
+		}
+	}
+	return tags
+}
+
+// Apply returns tags with the ownership tags appended, replacing the tags of the same keys
+func (o IAMOwnership) Apply(tags []types.Tag) []types.Tag {
+	owned := map[string]bool{awsv1alpha1.ManagedByTagKey: true}
+	for _, key := range iamOwnershipTagKeys {
+		owned[key] = true
+	}
+	applied := []types.Tag{}
+	for _, tag := range tags {
+		if !owned[aws.ToString(tag.Key)] {
+			applied = append(applied, tag)
+		}
+	}
+	return append(applied, o.Tags()...)
+}
+
+// Drift compares the current tags of a resource to the ownership, and returns the tags to write and the keys of the
+// ownership tags to remove. Tags outside of the ownership schema are left alone.
+func (o IAMOwnership) Drift(current []types.Tag) (toTag []types.Tag, toUntag []string) {
+	currentValues := map[string]string{}
+	for _, tag := range current {
+		currentValues[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	for _, tag := range o.Tags() {
+		value, ok := currentValues[aws.ToString(tag.Key)]
+		if !ok || value != aws.ToString(tag.Value) {
+			toTag = append(toTag, tag)
+		}
+	}
+	values := o.values()
+	for _, key := range iamOwnershipTagKeys {
+		if _, ok := currentValues[key]; ok && values[key] == "" {
+			toUntag = append(toUntag, key)
+		}
+	}
+	return toTag, toUntag
+}
+
+// EnsureRoleOwnershipTags brings the ownership tags of an existing role in line with the ownership
+func EnsureRoleOwnershipTags(client IAMClient, roleName string, ownership IAMOwnership) error {
+	var current []types.Tag
+	var marker *string
+	for {
+		output, err := client.ListRoleTags(context.TODO(), &iam.ListRoleTagsInput{RoleName: aws.String(roleName), Marker: marker})
+		if err != nil {
+			return err
+		}
+		current = append(current, output.Tags...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+
+	toTag, toUntag := ownership.Drift(current)
+	if len(toTag) > 0 {
+		if _, err := client.TagRole(context.TODO(), &iam.TagRoleInput{RoleName: aws.String(roleName), Tags: toTag}); err != nil {
+			return err
+		}
+	}
+	if len(toUntag) > 0 {
+		if _, err := client.UntagRole(context.TODO(), &iam.UntagRoleInput{RoleName: aws.String(roleName), TagKeys: toUntag}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnsureUserOwnershipTags brings the ownership tags of an existing user in line with the ownership
+func EnsureUserOwnershipTags(client IAMClient, userName string, ownership IAMOwnership) error {
+	var current []types.Tag
+	var marker *string
+	for {
+		output, err := client.ListUserTags(context.TODO(), &iam.ListUserTagsInput{UserName: aws.String(userName), Marker: marker})
+		if err != nil {
+			return err
+		}
+		current = append(current, output.Tags...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+
+	toTag, toUntag := ownership.Drift(current)
+	if len(toTag) > 0 {
+		if _, err := client.TagUser(context.TODO(), &iam.TagUserInput{UserName: aws.String(userName), Tags: toTag}); err != nil {
+			return err
+		}
+	}
+	if len(toUntag) > 0 {
+		if _, err := client.UntagUser(context.TODO(), &iam.UntagUserInput{UserName: aws.String(userName), TagKeys: toUntag}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnsurePolicyOwnershipTags brings the ownership tags of an existing customer managed policy in line with the
+// ownership
+func EnsurePolicyOwnershipTags(client IAMClient, policyArn string, ownership IAMOwnership) error {
+	var current []types.Tag
+	var marker *string
+	for {
+		output, err := client.ListPolicyTags(context.TODO(), &iam.ListPolicyTagsInput{PolicyArn: aws.String(policyArn), Marker: marker})
+		if err != nil {
+			return err
+		}
+		current = append(current, output.Tags...)
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+
+	toTag, toUntag := ownership.Drift(current)
+	if len(toTag) > 0 {
+		if _, err := client.TagPolicy(context.TODO(), &iam.TagPolicyInput{PolicyArn: aws.String(policyArn), Tags: toTag}); err != nil {
+			return err
+		}
+	}
+	if len(toUntag) > 0 {
+		if _, err := client.UntagPolicy(context.TODO(), &iam.UntagPolicyInput{PolicyArn: aws.String(policyArn), TagKeys: toUntag}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
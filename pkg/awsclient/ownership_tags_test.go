@@ -0,0 +1,65 @@
+package awsclient
+
+import (
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("IAM ownership tags", func() {
+	account := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "osd-creds-mgmt-abcdef",
+			Namespace:   "aws-account-operator",
+			Annotations: map[string]string{awsv1alpha1.IAMExpiryAnnotation: "2026-01-01T00:00:00Z"},
+		},
+		Spec: awsv1alpha1.AccountSpec{
+			AccountPool:        "hypershift-pool",
+			ClaimLink:          "claim",
+			ClaimLinkNamespace: "claim-namespace",
+		},
+	}
+
+	It("tags the pool, claim and expiry of the account", func() {
+		Expect(AccountIAMOwnership(account).Tags()).To(Equal([]iamtypes.Tag{
+			iamTag(awsv1alpha1.ManagedByTagKey, awsv1alpha1.ManagedByTagValue),
+			iamTag(IAMPoolTagKey, "hypershift-pool"),
+			iamTag(IAMClaimTagKey, "claim-namespace/claim"),
+			iamTag(IAMExpiryTagKey, "2026-01-01T00:00:00Z"),
+		}))
+	})
+
+	It("leaves the empty fields untagged", func() {
+		Expect(IAMOwnership{}.Tags()).To(Equal([]iamtypes.Tag{iamTag(awsv1alpha1.ManagedByTagKey, awsv1alpha1.ManagedByTagValue)}))
+	})
+
+	It("replaces the ownership tags of the same keys", func() {
+		tags := IAMOwnership{Pool: "pool"}.Apply([]iamtypes.Tag{iamTag("team", "sre"), iamTag(IAMPoolTagKey, "previous")})
+		Expect(tags).To(Equal([]iamtypes.Tag{
+			iamTag("team", "sre"),
+			iamTag(awsv1alpha1.ManagedByTagKey, awsv1alpha1.ManagedByTagValue),
+			iamTag(IAMPoolTagKey, "pool"),
+		}))
+	})
+
+	It("finds the ownership tags which drifted", func() {
+		ownership := IAMOwnership{Pool: "pool", Claim: "claim-namespace/claim"}
+		toTag, toUntag := ownership.Drift([]iamtypes.Tag{
+			iamTag("team", "sre"),
+			iamTag(awsv1alpha1.ManagedByTagKey, awsv1alpha1.ManagedByTagValue),
+			iamTag(IAMPoolTagKey, "previous"),
+			iamTag(IAMExpiryTagKey, "2026-01-01T00:00:00Z"),
+		})
+		Expect(toTag).To(Equal([]iamtypes.Tag{iamTag(IAMPoolTagKey, "pool"), iamTag(IAMClaimTagKey, "claim-namespace/claim")}))
+		Expect(toUntag).To(Equal([]string{IAMExpiryTagKey}))
+	})
+
+	It("finds no drift in up to date tags", func() {
+		ownership := AccountIAMOwnership(account)
+		toTag, toUntag := ownership.Drift(ownership.Tags())
+		Expect(toTag).To(BeEmpty())
+		Expect(toUntag).To(BeEmpty())
+	})
+})
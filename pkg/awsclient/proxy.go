@@ -0,0 +1,63 @@
+package awsclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"golang.org/x/net/http/httpproxy"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclientpkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// clusterProxyName is the name of the cluster-wide OpenShift Proxy
+const clusterProxyName = "cluster"
+
+// proxyEnvVars are the environment variables that override the cluster-wide proxy. OLM sets them from the cluster
+// Proxy for the operators it installs, they can also be set by hand on the operator Deployment.
+var proxyEnvVars = []string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy"}
+
+// ProxyConfig is the egress proxy the AWS clients send their requests through
+type ProxyConfig struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+}
+
+// GetProxyConfig returns the proxy of the AWS clients. The proxy environment variables take precedence, otherwise the
+// status of the cluster-wide Proxy is used. Clusters that aren't OpenShift, or have no Proxy, use no proxy.
+func GetProxyConfig(kubeClient kubeclientpkg.Client) (ProxyConfig, error) {
+	for _, env := range proxyEnvVars {
+		if _, ok := os.LookupEnv(env); ok {
+			config := httpproxy.FromEnvironment()
+			return ProxyConfig{HTTPProxy: config.HTTPProxy, HTTPSProxy: config.HTTPSProxy, NoProxy: config.NoProxy}, nil
+		}
+	}
+
+	if kubeClient == nil {
+		return ProxyConfig{}, nil
+	}
+	proxy := &configv1.Proxy{}
+	err := kubeClient.Get(context.TODO(), types.NamespacedName{Name: clusterProxyName}, proxy)
+	if err != nil {
+		if k8serr.IsNotFound(err) || meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+			return ProxyConfig{}, nil
+		}
+		return ProxyConfig{}, err
+	}
+	return ProxyConfig{HTTPProxy: proxy.Status.HTTPProxy, HTTPSProxy: proxy.Status.HTTPSProxy, NoProxy: proxy.Status.NoProxy}, nil
+}
+
+// proxyFunc returns the function selecting the proxy of a request, following the NO_PROXY rules
+func (p ProxyConfig) proxyFunc() func(*http.Request) (*url.URL, error) {
+	config := &httpproxy.Config{HTTPProxy: p.HTTPProxy, HTTPSProxy: p.HTTPSProxy, NoProxy: p.NoProxy}
+	proxyForURL := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyForURL(req.URL)
+	}
+}
@@ -0,0 +1,115 @@
+package awsclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Cluster proxy", func() {
+	var savedEnv map[string]string
+
+	BeforeEach(func() {
+		savedEnv = map[string]string{}
+		for _, env := range proxyEnvVars {
+			if value, ok := os.LookupEnv(env); ok {
+				savedEnv[env] = value
+				Expect(os.Unsetenv(env)).To(Succeed())
+			}
+		}
+	})
+
+	AfterEach(func() {
+		for _, env := range proxyEnvVars {
+			Expect(os.Unsetenv(env)).To(Succeed())
+		}
+		for env, value := range savedEnv {
+			Expect(os.Setenv(env, value)).To(Succeed())
+		}
+	})
+
+	clusterProxy := &configv1.Proxy{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterProxyName},
+		Status: configv1.ProxyStatus{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    ".cluster.local",
+		},
+	}
+
+	kubeClient := func() *fake.ClientBuilder {
+		s := runtime.NewScheme()
+		Expect(configv1.Install(s)).To(Succeed())
+		return fake.NewClientBuilder().WithScheme(s)
+	}
+
+	It("uses the status of the cluster Proxy", func() {
+		proxy, err := GetProxyConfig(kubeClient().WithObjects(clusterProxy).Build())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxy).To(Equal(ProxyConfig{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    ".cluster.local",
+		}))
+	})
+
+	It("uses no proxy without a cluster Proxy", func() {
+		proxy, err := GetProxyConfig(kubeClient().Build())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxy).To(Equal(ProxyConfig{}))
+
+		// Clusters that aren't OpenShift don't have the Proxy type
+		proxy, err = GetProxyConfig(fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxy).To(Equal(ProxyConfig{}))
+	})
+
+	It("lets the environment override the cluster Proxy", func() {
+		Expect(os.Setenv("HTTPS_PROXY", "http://override.example.com:8080")).To(Succeed())
+		Expect(os.Setenv("NO_PROXY", "169.254.169.254")).To(Succeed())
+
+		proxy, err := GetProxyConfig(kubeClient().WithObjects(clusterProxy).Build())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxy).To(Equal(ProxyConfig{HTTPSProxy: "http://override.example.com:8080", NoProxy: "169.254.169.254"}))
+	})
+
+	It("sends the AWS requests through the proxy", func() {
+		proxied := make(chan string, 1)
+		proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied <- r.Host
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer proxyServer.Close()
+
+		transport, err := transportFor(TransportConfig{Proxy: ProxyConfig{HTTPProxy: proxyServer.URL}})
+		Expect(err).NotTo(HaveOccurred())
+		httpClient := &http.Client{Transport: transport}
+		resp, err := httpClient.Get("http://ec2.us-east-1.amazonaws.com/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(proxied).To(Receive(Equal("ec2.us-east-1.amazonaws.com")))
+	})
+
+	It("doesn't proxy the hosts of NO_PROXY", func() {
+		proxyFunc := ProxyConfig{HTTPSProxy: "http://proxy.example.com:3128", NoProxy: ".amazonaws.com"}.proxyFunc()
+
+		req, err := http.NewRequest(http.MethodGet, "https://sts.amazonaws.com/", nil)
+		Expect(err).NotTo(HaveOccurred())
+		proxyURL, err := proxyFunc(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxyURL).To(BeNil())
+
+		req, err = http.NewRequest(http.MethodGet, "https://example.com/", nil)
+		Expect(err).NotTo(HaveOccurred())
+		proxyURL, err = proxyFunc(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxyURL.Host).To(Equal("proxy.example.com:3128"))
+	})
+})
@@ -0,0 +1,133 @@
+package awsclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+var log = logf.Log.WithName("awsclient")
+
+// readOnlyOperationPrefixes are the prefixes of the AWS operations that don't change anything
+var readOnlyOperationPrefixes = []string{"Describe", "Get", "List", "Search", "Lookup"}
+
+// isMutatingOperation returns true when the AWS operation changes something
+func isMutatingOperation(operation string) bool {
+	if operation == "" {
+		return false
+	}
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// dryRunAllowedOperations are the mutating operations still called in dry-run mode, as they only hand out the
+// credentials the read-only calls need
+var dryRunAllowedOperations = []string{"AssumeRole", "AssumeRoleWithSAML", "AssumeRoleWithWebIdentity"}
+
+// DryRunError is returned instead of calling a mutating AWS operation in dry-run mode
+type DryRunError struct {
+	Service   string
+	Operation string
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry-run: skipped %s %s", e.Service, e.Operation)
+}
+
+// DryRunSkipped lets the reconcilers stop at a skipped call without failing, see utils.IsDryRunSkipped
+func (e *DryRunError) DryRunSkipped() bool {
+	return true
+}
+
+// IsDryRunError returns whether err, or an error it wraps, is a DryRunError
+func IsDryRunError(err error) bool {
+	var dryRunErr *DryRunError
+	return errors.As(err, &dryRunErr)
+}
+
+// skipMutatingCallsInDryRun adds a middleware which, in dry-run mode, logs the mutating calls with their input
+// instead of sending them, and fails them with a DryRunError. It has to be added before logFailedMutatingCalls, so
+// skipped calls aren't logged as failed.
+func skipMutatingCallsInDryRun(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+		"DryRunMiddleware",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+			if !utils.IsDryRun() || !isMutatingOperation(operation) || utils.Contains(dryRunAllowedOperations, operation) {
+				return next.HandleInitialize(ctx, in)
+			}
+			service := awsmiddleware.GetServiceID(ctx)
+			params, err := json.Marshal(in.Parameters)
+			if err != nil {
+				params = []byte(fmt.Sprintf("%+v", in.Parameters))
+			}
+			log.Info("skipping AWS write", "service", service, "operation", operation, "input", string(params))
+			return middleware.InitializeOutput{}, middleware.Metadata{}, &DryRunError{Service: service, Operation: operation}
+		},
+	), middleware.After)
+}
+
+// logFailedMutatingCalls adds a middleware logging every failed mutating call with its AWS request ID. It runs in the
+// initialize step, so a call is only logged once all its retries failed.
+func logFailedMutatingCalls(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+		"FailedCallLoggingMiddleware",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			if err != nil {
+				operation := awsmiddleware.GetOperationName(ctx)
+				if isMutatingOperation(operation) {
+					log.Error(err, "AWS call failed",
+						"service", awsmiddleware.GetServiceID(ctx),
+						"operation", operation,
+						"awsRequestID", utils.AwsRequestID(err))
+				}
+			}
+			return out, metadata, err
+		},
+	), middleware.After)
+}
+
+// recordOrganizationsAccess adds a middleware recording the outcome of every Organizations call in
+// utils.OrganizationsAccess, which freezes the fleet while the operator is denied Organizations access. It has to be
+// added after skipMutatingCallsInDryRun, so calls skipped in dry-run mode aren't recorded.
+func recordOrganizationsAccess(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+		"OrganizationsAccessMiddleware",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			if awsmiddleware.GetServiceID(ctx) == "Organizations" {
+				utils.OrganizationsAccess.Record(err, time.Now())
+			}
+			return out, metadata, err
+		},
+	), middleware.After)
+}
+
+// traceCalls adds a middleware recording every call into the decision trace of the reconcile. Like
+// logFailedMutatingCalls it runs in the initialize step, so retries are recorded as a single call.
+func traceCalls(trace *utils.DecisionTrace) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+			"DecisionTraceMiddleware",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				out, metadata, err := next.HandleInitialize(ctx, in)
+				trace.AWSCall(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), err)
+				return out, metadata, err
+			},
+		), middleware.After)
+	}
+}
@@ -0,0 +1,46 @@
+package awsclient
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+var _ = Describe("Failed call logging", func() {
+	It("only considers operations that change something", func() {
+		for _, operation := range []string{"CreateAccount", "DeleteUser", "PutUserPolicy", "TerminateInstances"} {
+			Expect(isMutatingOperation(operation)).To(BeTrue(), operation)
+		}
+		for _, operation := range []string{"", "DescribeInstances", "GetUser", "ListUsers"} {
+			Expect(isMutatingOperation(operation)).To(BeFalse(), operation)
+		}
+	})
+})
+
+var _ = Describe("Dry-run mode", func() {
+	AfterEach(func() {
+		utils.SetDryRun(false)
+	})
+
+	It("skips the mutating calls", func() {
+		utils.SetDryRun(true)
+		client, err := newClient("", "sss", "TESTSTETST", "", "us-east-1", defaultTransportConfig(), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.CreateUser(context.TODO(), &iam.CreateUserInput{UserName: aws.String("osdManagedAdmin")})
+		Expect(err).To(HaveOccurred())
+		Expect(IsDryRunError(err)).To(BeTrue())
+		Expect(utils.IsDryRunSkipped(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("dry-run: skipped IAM CreateUser"))
+	})
+
+	It("still assumes roles", func() {
+		Expect(utils.Contains(dryRunAllowedOperations, "AssumeRole")).To(BeTrue())
+		Expect(isMutatingOperation("AssumeRole")).To(BeTrue())
+	})
+})
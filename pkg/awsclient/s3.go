@@ -0,0 +1,69 @@
+package awsclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// s3DeleteObjectsBatchSize is the most objects a DeleteObjects call deletes
+const s3DeleteObjectsBatchSize = 1000
+
+// s3ObjectsAPI is the part of the S3 API deleteBucketObjects calls
+type s3ObjectsAPI interface {
+	s3.ListObjectVersionsAPIClient
+	DeleteObjects(context.Context, *s3.DeleteObjectsInput, ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+}
+
+// deleteBucketObjects deletes the object versions and delete markers of a bucket page by page, so buckets with many
+// objects aren't listed into memory at once. Unversioned buckets list their objects with the "null" version.
+func deleteBucketObjects(ctx context.Context, api s3ObjectsAPI, bucketName *string) error {
+	paginator := s3.NewListObjectVersionsPaginator(api, &s3.ListObjectVersionsInput{
+		Bucket: bucketName,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		objects := make([]s3types.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+		for _, version := range page.Versions {
+			objects = append(objects, s3types.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objects = append(objects, s3types.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+
+		for i := 0; i < len(objects); i += s3DeleteObjectsBatchSize {
+			end := i + s3DeleteObjectsBatchSize
+			if end > len(objects) {
+				end = len(objects)
+			}
+
+			output, err := api.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket: bucketName,
+				Delete: &s3types.Delete{
+					Objects: objects[i:end],
+					Quiet:   aws.Bool(true),
+				},
+			})
+			if err != nil {
+				return err
+			}
+			// DeleteObjects succeeds when single objects fail to delete, they're only reported in the output
+			if len(output.Errors) > 0 {
+				failed := output.Errors[0]
+				return fmt.Errorf("failed to delete %d objects of bucket %s, %s (version %s): %s",
+					len(output.Errors), aws.ToString(bucketName), aws.ToString(failed.Key),
+					aws.ToString(failed.VersionId), aws.ToString(failed.Message))
+			}
+		}
+	}
+
+	return nil
+}
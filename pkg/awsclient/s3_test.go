@@ -0,0 +1,92 @@
+package awsclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeS3Objects lists its pages of object versions and records the objects deleted
+type fakeS3Objects struct {
+	pages   []*s3.ListObjectVersionsOutput
+	deleted [][]s3types.ObjectIdentifier
+	errors  []s3types.Error
+}
+
+func (f *fakeS3Objects) ListObjectVersions(_ context.Context, input *s3.ListObjectVersionsInput, _ ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	page := 0
+	if input.KeyMarker != nil {
+		_, err := fmt.Sscanf(*input.KeyMarker, "page-%d", &page)
+		if err != nil {
+			return nil, err
+		}
+	}
+	output := *f.pages[page]
+	if page+1 < len(f.pages) {
+		output.IsTruncated = aws.Bool(true)
+		output.NextKeyMarker = aws.String(fmt.Sprintf("page-%d", page+1))
+		output.NextVersionIdMarker = aws.String("version")
+	}
+	return &output, nil
+}
+
+func (f *fakeS3Objects) DeleteObjects(_ context.Context, input *s3.DeleteObjectsInput, _ ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	f.deleted = append(f.deleted, input.Delete.Objects)
+	return &s3.DeleteObjectsOutput{Errors: f.errors}, nil
+}
+
+func objectVersion(key string, version string) s3types.ObjectIdentifier {
+	return s3types.ObjectIdentifier{Key: aws.String(key), VersionId: aws.String(version)}
+}
+
+var _ = Describe("Bucket object deletion", func() {
+	bucket := aws.String("bucket")
+
+	It("deletes the versions and delete markers of every page", func() {
+		api := &fakeS3Objects{pages: []*s3.ListObjectVersionsOutput{
+			{
+				Versions:      []s3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}, {Key: aws.String("a"), VersionId: aws.String("2")}},
+				DeleteMarkers: []s3types.DeleteMarkerEntry{{Key: aws.String("b"), VersionId: aws.String("3")}},
+			},
+			{
+				Versions: []s3types.ObjectVersion{{Key: aws.String("c"), VersionId: aws.String("null")}},
+			},
+		}}
+		Expect(deleteBucketObjects(context.TODO(), api, bucket)).To(Succeed())
+		Expect(api.deleted).To(Equal([][]s3types.ObjectIdentifier{
+			{objectVersion("a", "1"), objectVersion("a", "2"), objectVersion("b", "3")},
+			{objectVersion("c", "null")},
+		}))
+	})
+
+	It("deletes at most 1000 objects at once", func() {
+		versions := make([]s3types.ObjectVersion, 1001)
+		for i := range versions {
+			versions[i] = s3types.ObjectVersion{Key: aws.String(fmt.Sprintf("key-%d", i)), VersionId: aws.String("null")}
+		}
+		api := &fakeS3Objects{pages: []*s3.ListObjectVersionsOutput{{Versions: versions}}}
+		Expect(deleteBucketObjects(context.TODO(), api, bucket)).To(Succeed())
+		Expect(api.deleted).To(HaveLen(2))
+		Expect(api.deleted[0]).To(HaveLen(1000))
+		Expect(api.deleted[1]).To(Equal([]s3types.ObjectIdentifier{objectVersion("key-1000", "null")}))
+	})
+
+	It("doesn't delete anything in empty buckets", func() {
+		api := &fakeS3Objects{pages: []*s3.ListObjectVersionsOutput{{}}}
+		Expect(deleteBucketObjects(context.TODO(), api, bucket)).To(Succeed())
+		Expect(api.deleted).To(BeEmpty())
+	})
+
+	It("fails when objects weren't deleted", func() {
+		api := &fakeS3Objects{
+			pages:  []*s3.ListObjectVersionsOutput{{Versions: []s3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}}}},
+			errors: []s3types.Error{{Key: aws.String("a"), VersionId: aws.String("1"), Message: aws.String("Access Denied")}},
+		}
+		Expect(deleteBucketObjects(context.TODO(), api, bucket)).To(MatchError("failed to delete 1 objects of bucket bucket, a (version 1): Access Denied"))
+	})
+})
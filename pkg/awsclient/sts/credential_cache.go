@@ -0,0 +1,114 @@
+package sts
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/go-logr/logr"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// credentialCacheExpiryMargin is how long before they expire cached credentials stop being handed out, so the calls
+// made with them don't outlive them
+const credentialCacheExpiryMargin = 10 * time.Minute
+
+// operatorRoleSessionName is the session name of the roles assumed by HandleRoleAssumption
+const operatorRoleSessionName = "awsAccountOperator"
+
+// CredentialCache shares the credentials of assumed roles between reconciles until shortly before they expire. It
+// is safe for concurrent use.
+type CredentialCache struct {
+	mutex   sync.Mutex
+	entries map[string]*sts.AssumeRoleOutput
+	now     func() time.Time
+}
+
+// Credentials is the credential cache shared by the controllers
+var Credentials = NewCredentialCache()
+
+// NewCredentialCache returns an empty cache
+func NewCredentialCache() *CredentialCache {
+	return &CredentialCache{entries: map[string]*sts.AssumeRoleOutput{}, now: time.Now}
+}
+
+// Get returns the cached credentials of the role, nil when there are none valid long enough
+func (c *CredentialCache) Get(roleArn string) *sts.AssumeRoleOutput {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	creds, ok := c.entries[roleArn]
+	if !ok {
+		return nil
+	}
+	if !c.now().Add(credentialCacheExpiryMargin).Before(*creds.Credentials.Expiration) {
+		delete(c.entries, roleArn)
+		return nil
+	}
+	return creds
+}
+
+// Put caches the credentials of the role. Credentials without an expiration aren't cached.
+func (c *CredentialCache) Put(roleArn string, creds *sts.AssumeRoleOutput) {
+	if creds == nil || creds.Credentials == nil || creds.Credentials.Expiration == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[roleArn] = creds
+}
+
+// PrewarmRoleAssumption assumes the role in the account and caches its credentials, unless valid ones are cached
+// already
+func PrewarmRoleAssumption(reqLogger logr.Logger, awsSetupClient awsclient.Client, account *awsv1alpha1.Account, roleToAssume string) error {
+	roleArn := config.GetIAMArn(account.Spec.AwsAccountID, config.AwsResourceTypeRole, roleToAssume)
+	if Credentials.Get(roleArn) != nil {
+		return nil
+	}
+	creds, err := GetSTSCredentials(reqLogger, awsSetupClient, roleArn, "", operatorRoleSessionName)
+	if err != nil {
+		return err
+	}
+	Credentials.Put(roleArn, creds)
+	return nil
+}
+
+// HandleCachedRoleAssumption is HandleRoleAssumption for the roles of non-CCS accounts, building the client from the
+// cached credentials of the role when there are, and caching the credentials it gets otherwise
+func HandleCachedRoleAssumption(
+	reqLogger logr.Logger,
+	awsClientBuilder awsclient.IBuilder,
+	currentAcctInstance *awsv1alpha1.Account,
+	client client.Client,
+	awsSetupClient awsclient.Client,
+	region string,
+	roleToAssume string) (awsclient.Client, *sts.AssumeRoleOutput, error) {
+	roleArn := config.GetIAMArn(currentAcctInstance.Spec.AwsAccountID, config.AwsResourceTypeRole, roleToAssume)
+	creds := Credentials.Get(roleArn)
+	if creds == nil {
+		awsClient, creds, err := HandleRoleAssumption(reqLogger, awsClientBuilder, currentAcctInstance, client, awsSetupClient, region, roleToAssume, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		Credentials.Put(roleArn, creds)
+		return awsClient, creds, nil
+	}
+
+	reqLogger.Info(fmt.Sprintf("Using cached STS credentials for AWS ARN: %s", roleArn))
+	if region == "" {
+		region = config.GetDefaultRegion()
+	}
+	awsClient, err := awsClientBuilder.GetClient(controllerName, client, awsclient.NewAwsClientInput{
+		AwsCredsSecretIDKey:     *creds.Credentials.AccessKeyId,
+		AwsCredsSecretAccessKey: *creds.Credentials.SecretAccessKey,
+		AwsToken:                *creds.Credentials.SessionToken,
+		AwsRegion:               region,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return awsClient, creds, nil
+}
@@ -0,0 +1,67 @@
+package sts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func credentialsExpiringAt(expiration time.Time) *sts.AssumeRoleOutput {
+	return &sts.AssumeRoleOutput{
+		Credentials: &ststypes.Credentials{
+			AccessKeyId:     aws.String("MyAccessKeyID"),
+			Expiration:      aws.Time(expiration),
+			SecretAccessKey: aws.String("MySecretAccessKey"),
+			SessionToken:    aws.String("MySessionToken"),
+		},
+	}
+}
+
+func TestCredentialCache(t *testing.T) {
+	now := time.Now()
+	cache := NewCredentialCache()
+	cache.now = func() time.Time { return now }
+
+	creds := credentialsExpiringAt(now.Add(time.Hour))
+	cache.Put("arn:aws:iam::123456789012:role/OrganizationAccountAccessRole", creds)
+	assert.Equal(t, creds, cache.Get("arn:aws:iam::123456789012:role/OrganizationAccountAccessRole"))
+	assert.Nil(t, cache.Get("arn:aws:iam::210987654321:role/OrganizationAccountAccessRole"))
+
+	// Credentials expiring within the margin aren't handed out
+	now = now.Add(time.Hour - credentialCacheExpiryMargin)
+	assert.Nil(t, cache.Get("arn:aws:iam::123456789012:role/OrganizationAccountAccessRole"))
+
+	// Credentials without an expiration aren't cached
+	cache.Put("arn:aws:iam::123456789012:role/OrganizationAccountAccessRole", &sts.AssumeRoleOutput{Credentials: &ststypes.Credentials{}})
+	assert.Nil(t, cache.Get("arn:aws:iam::123456789012:role/OrganizationAccountAccessRole"))
+}
+
+func TestHandleCachedRoleAssumption(t *testing.T) {
+	defer func(cache *CredentialCache) { Credentials = cache }(Credentials)
+	Credentials = NewCredentialCache()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	nullLogger := testutils.NewTestLogger().Logger()
+	builder := &mock.Builder{MockController: mockCtrl}
+	setupClient := mock.NewMockClient(mockCtrl)
+	account := &awsv1alpha1.Account{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "123456789012"}}
+
+	// Pre-warming assumes the role once, the cleanup then builds its client from the cached credentials
+	setupClient.EXPECT().AssumeRole(gomock.Any(), gomock.Any()).Return(credentialsExpiringAt(time.Now().Add(time.Hour)), nil).Times(1)
+	assert.NoError(t, PrewarmRoleAssumption(nullLogger, setupClient, account, awsv1alpha1.AccountOperatorIAMRole))
+	assert.NoError(t, PrewarmRoleAssumption(nullLogger, setupClient, account, awsv1alpha1.AccountOperatorIAMRole))
+
+	awsClient, creds, err := HandleCachedRoleAssumption(nullLogger, builder, account, nil, setupClient, "us-east-1", awsv1alpha1.AccountOperatorIAMRole)
+	assert.NoError(t, err)
+	assert.NotNil(t, awsClient)
+	assert.Equal(t, "MyAccessKeyID", *creds.Credentials.AccessKeyId)
+}
@@ -0,0 +1,122 @@
+package sts
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// JumpRoleKey is the operator ConfigMap key holding the single STS jump role
+	JumpRoleKey = "sts-jump-role"
+	// JumpRolesKey is the operator ConfigMap key holding a comma or newline separated list of STS jump roles. It takes
+	// precedence over JumpRoleKey.
+	JumpRolesKey = "sts-jump-roles"
+
+	// jumpRoleFailureThreshold is the number of consecutive failures after which a jump role is skipped
+	jumpRoleFailureThreshold = 3
+	// jumpRoleCooldown is how long a failing jump role is skipped before it is tried again
+	jumpRoleCooldown = 5 * time.Minute
+)
+
+// ErrNoJumpRoles is returned when the operator ConfigMap doesn't configure any STS jump role
+var ErrNoJumpRoles = errors.New("no STS jump role configured")
+
+// JumpRoles is the jump role pool shared by all controllers
+var JumpRoles = NewJumpRolePool()
+
+// JumpRolesFromConfigMap returns the STS jump roles configured in the operator ConfigMap. The ConfigMap is read on
+// every reconcile, so roles can be added or rotated out without restarting the operator.
+func JumpRolesFromConfigMap(cm *corev1.ConfigMap) []string {
+	value := cm.Data[JumpRolesKey]
+	if strings.TrimSpace(value) == "" {
+		value = cm.Data[JumpRoleKey]
+	}
+
+	roles := []string{}
+	for _, role := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+type jumpRoleHealth struct {
+	consecutiveFailures int
+	lastFailure         time.Time
+}
+
+// JumpRolePool spreads STS role assumptions over several jump roles in a round-robin fashion. Roles that failed
+// repeatedly are skipped for a cooldown period, so a single broken role doesn't block account initialization.
+type JumpRolePool struct {
+	mu     sync.Mutex
+	next   int
+	health map[string]*jumpRoleHealth
+	now    func() time.Time
+}
+
+// NewJumpRolePool creates an empty JumpRolePool
+func NewJumpRolePool() *JumpRolePool {
+	return &JumpRolePool{
+		health: map[string]*jumpRoleHealth{},
+		now:    time.Now,
+	}
+}
+
+// Select returns the next healthy jump role of roles. When all roles are failing, the one that failed the longest
+// ago is returned, it is better to keep trying than to stop initializing accounts altogether.
+func (p *JumpRolePool) Select(roles []string) (string, error) {
+	if len(roles) == 0 {
+		return "", ErrNoJumpRoles
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var fallback string
+	var fallbackFailure time.Time
+	for i := 0; i < len(roles); i++ {
+		role := roles[(p.next+i)%len(roles)]
+		if p.isHealthy(role) {
+			p.next = (p.next + i + 1) % len(roles)
+			return role, nil
+		}
+		if fallback == "" || p.health[role].lastFailure.Before(fallbackFailure) {
+			fallback, fallbackFailure = role, p.health[role].lastFailure
+		}
+	}
+	return fallback, nil
+}
+
+func (p *JumpRolePool) isHealthy(role string) bool {
+	health, ok := p.health[role]
+	if !ok || health.consecutiveFailures < jumpRoleFailureThreshold {
+		return true
+	}
+	return p.now().Sub(health.lastFailure) > jumpRoleCooldown
+}
+
+// ReportSuccess resets the failures of a jump role
+func (p *JumpRolePool) ReportSuccess(role string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.health, role)
+}
+
+// ReportFailure records a failed assumption of a jump role and returns its number of consecutive failures
+func (p *JumpRolePool) ReportFailure(role string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	health, ok := p.health[role]
+	if !ok {
+		health = &jumpRoleHealth{}
+		p.health[role] = health
+	}
+	health.consecutiveFailures++
+	health.lastFailure = p.now()
+	return health.consecutiveFailures
+}
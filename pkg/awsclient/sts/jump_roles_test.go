@@ -0,0 +1,75 @@
+package sts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestJumpRolesFromConfigMap(t *testing.T) {
+	single := &corev1.ConfigMap{Data: map[string]string{JumpRoleKey: "arn:jump"}}
+	assert.Equal(t, []string{"arn:jump"}, JumpRolesFromConfigMap(single))
+
+	pool := &corev1.ConfigMap{Data: map[string]string{
+		JumpRoleKey:  "arn:jump",
+		JumpRolesKey: "arn:jump-a, arn:jump-b\narn:jump-c,",
+	}}
+	assert.Equal(t, []string{"arn:jump-a", "arn:jump-b", "arn:jump-c"}, JumpRolesFromConfigMap(pool))
+
+	assert.Empty(t, JumpRolesFromConfigMap(&corev1.ConfigMap{}))
+}
+
+func TestJumpRolePoolSelect(t *testing.T) {
+	now := time.Now()
+	pool := NewJumpRolePool()
+	pool.now = func() time.Time { return now }
+	roles := []string{"a", "b", "c"}
+
+	_, err := pool.Select(nil)
+	assert.ErrorIs(t, err, ErrNoJumpRoles)
+
+	// Round-robin over healthy roles
+	for _, expected := range []string{"a", "b", "c", "a"} {
+		role, err := pool.Select(roles)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, role)
+	}
+
+	// A role is skipped once it failed repeatedly
+	for i := 0; i < jumpRoleFailureThreshold; i++ {
+		pool.ReportFailure("c")
+	}
+	for _, expected := range []string{"b", "a", "b"} {
+		role, _ := pool.Select(roles)
+		assert.Equal(t, expected, role)
+	}
+
+	// and tried again after the cooldown
+	now = now.Add(jumpRoleCooldown + time.Second)
+	role, _ := pool.Select(roles)
+	assert.Equal(t, "c", role)
+
+	// A success resets its failures
+	pool.ReportSuccess("c")
+	assert.True(t, pool.isHealthy("c"))
+}
+
+func TestJumpRolePoolAllFailing(t *testing.T) {
+	now := time.Now()
+	pool := NewJumpRolePool()
+	pool.now = func() time.Time { return now }
+
+	for i := 0; i < jumpRoleFailureThreshold; i++ {
+		pool.ReportFailure("a")
+	}
+	now = now.Add(time.Second)
+	for i := 0; i < jumpRoleFailureThreshold; i++ {
+		pool.ReportFailure("b")
+	}
+
+	role, err := pool.Select([]string{"b", "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", role)
+}
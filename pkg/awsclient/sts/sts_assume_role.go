@@ -31,6 +31,14 @@ func matchSubstring(roleID, role string) (bool, error) {
 	return matched, err
 }
 
+// SessionOptions customizes the session returned by GetSTSCredentialsWithOptions
+type SessionOptions struct {
+	// Duration of the session, one hour when unset
+	Duration time.Duration
+	// Policy is an optional IAM policy document restricting the session further
+	Policy string
+}
+
 // getSTSCredentials returns STS credentials for the specified account ARN
 func GetSTSCredentials(
 	reqLogger logr.Logger,
@@ -38,18 +46,37 @@ func GetSTSCredentials(
 	roleArn string,
 	externalID string,
 	roleSessionName string) (*sts.AssumeRoleOutput, error) {
+	return GetSTSCredentialsWithOptions(reqLogger, client, roleArn, externalID, roleSessionName, SessionOptions{})
+}
+
+// GetSTSCredentialsWithOptions returns STS credentials for the specified account ARN with a custom session
+// duration and policy
+func GetSTSCredentialsWithOptions(
+	reqLogger logr.Logger,
+	client awsclient.Client,
+	roleArn string,
+	externalID string,
+	roleSessionName string,
+	options SessionOptions) (*sts.AssumeRoleOutput, error) {
 	// Default duration in seconds of the session token 3600. We need to have the roles policy
 	// changed if we want it to be longer than 3600 seconds
+	duration := options.Duration
+	if duration == 0 {
+		duration = time.Hour
+	}
 	reqLogger.Info(fmt.Sprintf("Creating STS credentials for AWS ARN: %s", roleArn))
 	// Build input for AssumeRole
 	assumeRoleInput := sts.AssumeRoleInput{
-		DurationSeconds: aws.Int32(3600),
+		DurationSeconds: aws.Int32(int32(duration.Seconds())),
 		RoleArn:         &roleArn,
 		RoleSessionName: &roleSessionName,
 	}
 	if externalID != "" {
 		assumeRoleInput.ExternalId = &externalID
 	}
+	if options.Policy != "" {
+		assumeRoleInput.Policy = aws.String(options.Policy)
+	}
 
 	assumeRoleOutput := &sts.AssumeRoleOutput{}
 	var err error
@@ -110,7 +137,7 @@ func HandleRoleAssumption(
 
 	// Use the role session name to uniquely identify a session when the same role
 	// is assumed by different principals or for different reasons.
-	var roleSessionName = "awsAccountOperator"
+	var roleSessionName = operatorRoleSessionName
 
 	var creds *sts.AssumeRoleOutput
 	var credsErr error
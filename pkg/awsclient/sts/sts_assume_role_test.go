@@ -76,3 +76,23 @@ func TestGetSTSCredentials(t *testing.T) {
 	assert.Error(t, err, expectedErr)
 	assert.Equal(t, creds, &sts.AssumeRoleOutput{})
 }
+
+func TestGetSTSCredentialsWithOptions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	nullLogger := testutils.NewTestLogger().Logger()
+	mockAWSClient := mock.NewMockClient(mockCtrl)
+	defer mockCtrl.Finish()
+
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+	mockAWSClient.EXPECT().AssumeRole(gomock.Any(), &sts.AssumeRoleInput{
+		DurationSeconds: aws.Int32(1800),
+		RoleArn:         aws.String("arn:role"),
+		RoleSessionName: aws.String("session"),
+		ExternalId:      aws.String("external-id"),
+		Policy:          aws.String(policy),
+	}).Return(&sts.AssumeRoleOutput{Credentials: &ststypes.Credentials{}}, nil)
+
+	_, err := GetSTSCredentialsWithOptions(nullLogger, mockAWSClient, "arn:role", "external-id", "session",
+		SessionOptions{Duration: 30 * time.Minute, Policy: policy})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,186 @@
+package awsclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclientpkg "sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// caBundleConfigMapKey is the operator ConfigMap key naming the ConfigMap holding the extra CAs of the AWS endpoints
+	caBundleConfigMapKey = "aws-ca-bundle.configmap"
+	// caBundleSecretKey is the operator ConfigMap key naming the Secret holding the extra CAs of the AWS endpoints
+	caBundleSecretKey = "aws-ca-bundle.secret"
+	// caBundleKey is the key of the PEM encoded CAs in the CA bundle ConfigMap or Secret
+	caBundleKey = "ca-bundle.crt"
+
+	// httpTimeoutKey is the operator ConfigMap key of the timeout of every AWS HTTP request, e.g. "30s"
+	httpTimeoutKey = "aws-http.timeout"
+	// httpKeepAliveKey is the operator ConfigMap key of the time idle connections are kept alive, "0s" disables them
+	httpKeepAliveKey = "aws-http.keep-alive"
+	// httpMaxConnsPerHostKey is the operator ConfigMap key of the maximum number of connections to an AWS endpoint
+	httpMaxConnsPerHostKey = "aws-http.max-conns-per-host"
+)
+
+// TransportConfig is the configuration of the HTTP transport of the AWS clients
+type TransportConfig struct {
+	Proxy ProxyConfig
+	// CABundle holds PEM encoded CAs trusted on top of the system ones
+	CABundle string
+	// Timeout is the timeout of every HTTP request, each retry being a request of its own
+	Timeout time.Duration
+	// KeepAlive is the time idle connections are kept alive, no connection is reused when it's 0
+	KeepAlive time.Duration
+	// MaxConnsPerHost limits the connections to every AWS endpoint, there's no limit when it's 0
+	MaxConnsPerHost int
+}
+
+var (
+	transportsMu sync.Mutex
+	// transports are shared by all clients using the same configuration, so they share their connections
+	transports = map[TransportConfig]*http.Transport{}
+)
+
+// defaultTransportConfig returns the HTTP transport configuration used when the operator ConfigMap doesn't set one
+func defaultTransportConfig() TransportConfig {
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	return TransportConfig{
+		Timeout:         awsApiTimeout,
+		KeepAlive:       defaultTransport.IdleConnTimeout,
+		MaxConnsPerHost: defaultTransport.MaxConnsPerHost,
+	}
+}
+
+// GetTransportConfig returns the HTTP transport configuration of the AWS clients
+func GetTransportConfig(kubeClient kubeclientpkg.Client) (TransportConfig, error) {
+	config := defaultTransportConfig()
+	var err error
+	config.Proxy, err = GetProxyConfig(kubeClient)
+	if err != nil {
+		return TransportConfig{}, fmt.Errorf("failed to get the cluster proxy: %w", err)
+	}
+
+	if kubeClient == nil {
+		return config, nil
+	}
+	configMap, err := utils.GetOperatorConfigMap(kubeClient)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return config, nil
+		}
+		return TransportConfig{}, err
+	}
+
+	config.CABundle, err = getCABundle(kubeClient, configMap)
+	if err != nil {
+		return TransportConfig{}, fmt.Errorf("failed to get the AWS CA bundle: %w", err)
+	}
+	err = config.setHTTPSettings(configMap)
+	if err != nil {
+		return TransportConfig{}, err
+	}
+	return config, nil
+}
+
+// setHTTPSettings sets the timeout, keep-alive and connection limit from the operator ConfigMap
+func (c *TransportConfig) setHTTPSettings(configMap *corev1.ConfigMap) error {
+	for key, setting := range map[string]*time.Duration{httpTimeoutKey: &c.Timeout, httpKeepAliveKey: &c.KeepAlive} {
+		value, ok := configMap.Data[key]
+		if !ok {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration < 0 {
+			return fmt.Errorf("invalid %s %q in the operator ConfigMap, expected a duration such as 30s", key, value)
+		}
+		*setting = duration
+	}
+	if c.Timeout == 0 {
+		return fmt.Errorf("invalid %s in the operator ConfigMap, requests must time out", httpTimeoutKey)
+	}
+
+	if value, ok := configMap.Data[httpMaxConnsPerHostKey]; ok {
+		maxConns, err := strconv.Atoi(value)
+		if err != nil || maxConns < 0 {
+			return fmt.Errorf("invalid %s %q in the operator ConfigMap, expected a positive number", httpMaxConnsPerHostKey, value)
+		}
+		c.MaxConnsPerHost = maxConns
+	}
+	return nil
+}
+
+// getCABundle returns the extra CAs to trust for the AWS endpoints. They are read from the ca-bundle.crt key of the
+// ConfigMap or Secret named by the aws-ca-bundle.configmap or aws-ca-bundle.secret key of the operator ConfigMap.
+func getCABundle(kubeClient kubeclientpkg.Client, configMap *corev1.ConfigMap) (string, error) {
+	key := types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace}
+	switch {
+	case configMap.Data[caBundleConfigMapKey] != "":
+		key.Name = configMap.Data[caBundleConfigMapKey]
+		bundle := &corev1.ConfigMap{}
+		if err := kubeClient.Get(context.TODO(), key, bundle); err != nil {
+			return "", err
+		}
+		if bundle.Data[caBundleKey] == "" {
+			return "", fmt.Errorf("ConfigMap %s has no %s key", key, caBundleKey)
+		}
+		return bundle.Data[caBundleKey], nil
+	case configMap.Data[caBundleSecretKey] != "":
+		key.Name = configMap.Data[caBundleSecretKey]
+		bundle := &corev1.Secret{}
+		if err := kubeClient.Get(context.TODO(), key, bundle); err != nil {
+			return "", err
+		}
+		if len(bundle.Data[caBundleKey]) == 0 {
+			return "", fmt.Errorf("secret %s has no %s key", key, caBundleKey)
+		}
+		return string(bundle.Data[caBundleKey]), nil
+	}
+	return "", nil
+}
+
+// transportFor returns the HTTP transport of the clients using the configuration
+func transportFor(config TransportConfig) (*http.Transport, error) {
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+
+	if transport, ok := transports[config]; ok {
+		return transport, nil
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = config.Proxy.proxyFunc()
+	transport.MaxConnsPerHost = config.MaxConnsPerHost
+	if config.MaxConnsPerHost > transport.MaxIdleConnsPerHost {
+		// Keep the connections open between bursts of requests rather than dialing new ones
+		transport.MaxIdleConnsPerHost = config.MaxConnsPerHost
+	}
+	if config.KeepAlive == 0 {
+		transport.DisableKeepAlives = true
+	} else {
+		transport.IdleConnTimeout = config.KeepAlive
+	}
+	if config.CABundle != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(config.CABundle)) {
+			return nil, fmt.Errorf("the AWS CA bundle holds no PEM encoded certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+	transports[config] = transport
+	return transport, nil
+}
@@ -0,0 +1,141 @@
+package awsclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+var _ = Describe("AWS client transport", func() {
+	var (
+		endpoint *httptest.Server
+		caBundle string
+	)
+
+	BeforeEach(func() {
+		endpoint = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		caBundle = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: endpoint.Certificate().Raw}))
+	})
+
+	AfterEach(func() {
+		endpoint.Close()
+	})
+
+	operatorConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       data,
+		}
+	}
+
+	kubeClient := func(objects ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+	}
+
+	It("reads the CA bundle from a ConfigMap", func() {
+		bundle := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-ca-bundle", Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string]string{caBundleKey: caBundle},
+		}
+		config, err := GetTransportConfig(kubeClient(operatorConfigMap(map[string]string{caBundleConfigMapKey: "aws-ca-bundle"}), bundle))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CABundle).To(Equal(caBundle))
+	})
+
+	It("reads the CA bundle from a Secret", func() {
+		bundle := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-ca-bundle", Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string][]byte{caBundleKey: []byte(caBundle)},
+		}
+		config, err := GetTransportConfig(kubeClient(operatorConfigMap(map[string]string{caBundleSecretKey: "aws-ca-bundle"}), bundle))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CABundle).To(Equal(caBundle))
+	})
+
+	It("fails when the CA bundle is missing", func() {
+		_, err := GetTransportConfig(kubeClient(operatorConfigMap(map[string]string{caBundleConfigMapKey: "aws-ca-bundle"})))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("uses the defaults without settings in the operator ConfigMap", func() {
+		config, err := GetTransportConfig(kubeClient(operatorConfigMap(nil)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CABundle).To(BeEmpty())
+		Expect(config.Timeout).To(Equal(awsApiTimeout))
+		Expect(config.KeepAlive).To(Equal(http.DefaultTransport.(*http.Transport).IdleConnTimeout))
+		Expect(config.MaxConnsPerHost).To(BeZero())
+	})
+
+	It("reads the HTTP settings from the operator ConfigMap", func() {
+		config, err := GetTransportConfig(kubeClient(operatorConfigMap(map[string]string{
+			httpTimeoutKey:         "10s",
+			httpKeepAliveKey:       "0s",
+			httpMaxConnsPerHostKey: "20",
+		})))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Timeout).To(Equal(10 * time.Second))
+		Expect(config.KeepAlive).To(BeZero())
+		Expect(config.MaxConnsPerHost).To(Equal(20))
+
+		transport, err := transportFor(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transport.DisableKeepAlives).To(BeTrue())
+		Expect(transport.MaxConnsPerHost).To(Equal(20))
+		Expect(transport.MaxIdleConnsPerHost).To(Equal(20))
+	})
+
+	It("rejects invalid HTTP settings", func() {
+		for key, value := range map[string]string{
+			httpTimeoutKey:         "0s",
+			httpKeepAliveKey:       "forever",
+			httpMaxConnsPerHostKey: "-1",
+		} {
+			_, err := GetTransportConfig(kubeClient(operatorConfigMap(map[string]string{key: value})))
+			Expect(err).To(HaveOccurred(), key)
+		}
+	})
+
+	It("trusts the CA bundle", func() {
+		transport, err := transportFor(TransportConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = (&http.Client{Transport: transport}).Get(endpoint.URL)
+		Expect(err).To(HaveOccurred())
+
+		transport, err = transportFor(TransportConfig{CABundle: caBundle})
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := (&http.Client{Transport: transport}).Get(endpoint.URL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+	})
+
+	It("rejects CA bundles without certificates", func() {
+		_, err := transportFor(TransportConfig{CABundle: "not a certificate"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("shares the transport of the clients using the same configuration", func() {
+		config := TransportConfig{Proxy: ProxyConfig{HTTPSProxy: "http://proxy.example.com:3128"}}
+		first, err := transportFor(config)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := transportFor(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(BeIdenticalTo(second))
+
+		other, err := transportFor(TransportConfig{CABundle: caBundle})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).NotTo(BeIdenticalTo(other))
+	})
+})
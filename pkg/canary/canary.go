@@ -0,0 +1,162 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// PoolOf returns the pool of the account when it designates canary accounts, nil otherwise. CCS accounts aren't part
+// of any pool.
+func PoolOf(ctx context.Context, c client.Client, account *awsv1alpha1.Account) (*awsv1alpha1.AccountPool, error) {
+	if account.IsBYOC() {
+		return nil, nil
+	}
+	poolName := account.Spec.AccountPool
+	for _, ref := range account.OwnerReferences {
+		if poolName == "" && ref.Kind == "AccountPool" {
+			poolName = ref.Name
+		}
+	}
+	if poolName == "" {
+		return nil, nil
+	}
+
+	pool := &awsv1alpha1.AccountPool{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: awsv1alpha1.AccountCrNamespace, Name: poolName}, pool)
+	if k8serr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pool.Spec.Canary == nil || len(pool.Spec.Canary.Accounts) == 0 {
+		return nil, nil
+	}
+	return pool, nil
+}
+
+// IsCanary returns whether the account is a canary account of the pool
+func IsCanary(pool *awsv1alpha1.AccountPool, account *awsv1alpha1.Account) bool {
+	return pool != nil && pool.Spec.Canary != nil && utils.Contains(pool.Spec.Canary.Accounts, account.Name)
+}
+
+// Gated returns whether the operator version has to wait for the canary accounts of the pool before reconciling the
+// account. Only the accounts last reconciled by another version wait, the canaries themselves and the accounts
+// created since go on. The rest of the pool waits until the canaries were validated with the version, and while the
+// rollout is halted.
+func Gated(pool *awsv1alpha1.AccountPool, account *awsv1alpha1.Account, version string) bool {
+	if pool == nil || IsCanary(pool, account) {
+		return false
+	}
+	reconciledVersion := account.Annotations[awsv1alpha1.ReconciledVersionAnnotation]
+	if reconciledVersion == "" || reconciledVersion == version {
+		return false
+	}
+	if pool.Spec.Canary.Halt {
+		return true
+	}
+	status := pool.Status.Canary
+	return status == nil || status.Version != version || status.Phase != awsv1alpha1.CanaryPhasePromoted
+}
+
+// MarkReconciled records that the operator version reconciles the account
+func MarkReconciled(ctx context.Context, c client.Client, account *awsv1alpha1.Account, version string) error {
+	if account.Annotations[awsv1alpha1.ReconciledVersionAnnotation] == version {
+		return nil
+	}
+	if account.Annotations == nil {
+		account.Annotations = map[string]string{}
+	}
+	account.Annotations[awsv1alpha1.ReconciledVersionAnnotation] = version
+	return c.Update(ctx, account)
+}
+
+// RecordValidation records the outcome of the validation of a canary account of the pool by the operator version. A
+// regression, i.e. a failed account or validation, halts the rollout to the rest of the pool. Once every canary
+// account passed validation, the version is promoted to the rest of the pool.
+func RecordValidation(ctx context.Context, reqLogger logr.Logger, c client.Client, pool *awsv1alpha1.AccountPool, account *awsv1alpha1.Account, version string, regression error) error {
+	if account.Annotations[awsv1alpha1.ReconciledVersionAnnotation] != version {
+		// Not reconciled by this version yet, there is nothing to validate
+		return nil
+	}
+
+	phase := awsv1alpha1.CanaryPhaseValidating
+	message := ""
+	switch {
+	case regression != nil:
+		phase = awsv1alpha1.CanaryPhaseHalted
+		message = fmt.Sprintf("Canary account %s regressed: %s", account.Name, regression.Error())
+	case account.IsFailed():
+		phase = awsv1alpha1.CanaryPhaseHalted
+		message = fmt.Sprintf("Canary account %s failed", account.Name)
+	case account.IsReady():
+		if account.Annotations[awsv1alpha1.CanaryValidatedVersionAnnotation] != version {
+			account.Annotations[awsv1alpha1.CanaryValidatedVersionAnnotation] = version
+			if err := c.Update(ctx, account); err != nil {
+				return err
+			}
+		}
+		pending, err := pendingCanaries(ctx, c, pool, version)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			phase = awsv1alpha1.CanaryPhasePromoted
+		} else {
+			message = fmt.Sprintf("Waiting for the validation of canary accounts %s", strings.Join(pending, ", "))
+		}
+	default:
+		message = fmt.Sprintf("Waiting for canary account %s to be Ready", account.Name)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &awsv1alpha1.AccountPool{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(pool), current); err != nil {
+			return err
+		}
+		status := current.Status.Canary
+		if status != nil && status.Version == version && status.Phase == phase && status.Message == message {
+			return nil
+		}
+		if status == nil || status.Version != version || status.Phase != phase {
+			reqLogger.Info("Canary rollout of the operator version changed phase", "accountPool", current.Name, "version", version, "phase", phase, "message", message)
+		}
+		transition := metav1.Now()
+		if status != nil && status.Version == version && status.Phase == phase {
+			transition = status.LastTransitionTime
+		}
+		current.Status.Canary = &awsv1alpha1.AccountPoolCanaryStatus{
+			Version:            version,
+			Phase:              phase,
+			Message:            message,
+			LastTransitionTime: transition,
+		}
+		return c.Status().Update(ctx, current)
+	})
+}
+
+// pendingCanaries returns the canary accounts of the pool that weren't validated with the operator version yet
+func pendingCanaries(ctx context.Context, c client.Client, pool *awsv1alpha1.AccountPool, version string) ([]string, error) {
+	pending := []string{}
+	for _, name := range pool.Spec.Canary.Accounts {
+		canary := &awsv1alpha1.Account{}
+		err := c.Get(ctx, client.ObjectKey{Namespace: awsv1alpha1.AccountCrNamespace, Name: name}, canary)
+		if err != nil && !k8serr.IsNotFound(err) {
+			return nil, err
+		}
+		if err != nil || canary.Annotations[awsv1alpha1.CanaryValidatedVersionAnnotation] != version {
+			pending = append(pending, name)
+		}
+	}
+	return pending, nil
+}
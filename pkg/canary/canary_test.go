@@ -0,0 +1,149 @@
+package canary
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/client"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+)
+
+func newPool(canaries ...string) *awsv1alpha1.AccountPool {
+	return &awsv1alpha1.AccountPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: awsv1alpha1.AccountCrNamespace},
+		Spec:       awsv1alpha1.AccountPoolSpec{PoolSize: 10, Canary: &awsv1alpha1.AccountPoolCanary{Accounts: canaries}},
+	}
+}
+
+func newAccount(name string, state string, reconciledVersion string) *awsv1alpha1.Account {
+	account := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: awsv1alpha1.AccountCrNamespace},
+		Spec:       awsv1alpha1.AccountSpec{AccountPool: "pool"},
+		Status:     awsv1alpha1.AccountStatus{State: state},
+	}
+	if reconciledVersion != "" {
+		account.Annotations = map[string]string{awsv1alpha1.ReconciledVersionAnnotation: reconciledVersion}
+	}
+	return account
+}
+
+func newClient(t *testing.T, objs ...crclient.Object) crclient.Client {
+	scheme, err := client.NewScheme()
+	require.NoError(t, err)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestPoolOf(t *testing.T) {
+	c := newClient(t, newPool("canary"), &awsv1alpha1.AccountPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "plain", Namespace: awsv1alpha1.AccountCrNamespace},
+	})
+
+	pool, err := PoolOf(context.TODO(), c, newAccount("account", "Ready", ""))
+	require.NoError(t, err)
+	require.NotNil(t, pool)
+	assert.Equal(t, "pool", pool.Name)
+
+	plain := newAccount("account", "Ready", "")
+	plain.Spec.AccountPool = "plain"
+	pool, err = PoolOf(context.TODO(), c, plain)
+	require.NoError(t, err)
+	assert.Nil(t, pool, "pools without canaries are ignored")
+
+	byoc := newAccount("account", "Ready", "")
+	byoc.Spec.BYOC = true
+	pool, err = PoolOf(context.TODO(), c, byoc)
+	require.NoError(t, err)
+	assert.Nil(t, pool, "CCS accounts aren't part of a pool")
+}
+
+func TestGated(t *testing.T) {
+	promoted := newPool("canary")
+	promoted.Status.Canary = &awsv1alpha1.AccountPoolCanaryStatus{Version: "2.0", Phase: awsv1alpha1.CanaryPhasePromoted}
+	halted := newPool("canary")
+	halted.Status.Canary = &awsv1alpha1.AccountPoolCanaryStatus{Version: "2.0", Phase: awsv1alpha1.CanaryPhaseHalted}
+	haltSwitch := promoted.DeepCopy()
+	haltSwitch.Spec.Canary.Halt = true
+
+	tests := []struct {
+		name     string
+		pool     *awsv1alpha1.AccountPool
+		account  *awsv1alpha1.Account
+		expected bool
+	}{
+		{name: "no canaries", pool: nil, account: newAccount("account", "Ready", "1.0"), expected: false},
+		{name: "canary account", pool: newPool("canary"), account: newAccount("canary", "Ready", "1.0"), expected: false},
+		{name: "new account", pool: newPool("canary"), account: newAccount("account", "", ""), expected: false},
+		{name: "already reconciled", pool: newPool("canary"), account: newAccount("account", "Ready", "2.0"), expected: false},
+		{name: "canaries not validated", pool: newPool("canary"), account: newAccount("account", "Ready", "1.0"), expected: true},
+		{name: "canaries promoted", pool: promoted, account: newAccount("account", "Ready", "1.0"), expected: false},
+		{name: "rollout halted", pool: halted, account: newAccount("account", "Ready", "1.0"), expected: true},
+		{name: "halt switch", pool: haltSwitch, account: newAccount("account", "Ready", "1.0"), expected: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Gated(test.pool, test.account, "2.0"))
+		})
+	}
+}
+
+func TestRecordValidation(t *testing.T) {
+	nullLogger := testutils.NewTestLogger().Logger()
+	pool := newPool("canary-a", "canary-b")
+	canaryA := newAccount("canary-a", "Ready", "2.0")
+	canaryB := newAccount("canary-b", "Creating", "2.0")
+	c := newClient(t, pool, canaryA, canaryB)
+
+	getStatus := func() *awsv1alpha1.AccountPoolCanaryStatus {
+		current := &awsv1alpha1.AccountPool{}
+		require.NoError(t, c.Get(context.TODO(), crclient.ObjectKeyFromObject(pool), current))
+		return current.Status.Canary
+	}
+
+	require.NoError(t, RecordValidation(context.TODO(), nullLogger, c, pool, canaryA, "2.0", nil))
+	status := getStatus()
+	assert.Equal(t, awsv1alpha1.CanaryPhaseValidating, status.Phase)
+	assert.Equal(t, "Waiting for the validation of canary accounts canary-b", status.Message)
+	assert.Equal(t, "2.0", canaryA.Annotations[awsv1alpha1.CanaryValidatedVersionAnnotation])
+
+	require.NoError(t, RecordValidation(context.TODO(), nullLogger, c, pool, canaryB, "2.0", errors.New("account in the wrong OU")))
+	status = getStatus()
+	assert.Equal(t, awsv1alpha1.CanaryPhaseHalted, status.Phase)
+	assert.Equal(t, "Canary account canary-b regressed: account in the wrong OU", status.Message)
+
+	canaryB.Status.State = "Ready"
+	require.NoError(t, RecordValidation(context.TODO(), nullLogger, c, pool, canaryB, "2.0", nil))
+	assert.Equal(t, awsv1alpha1.CanaryPhasePromoted, getStatus().Phase)
+
+	canaryA.Status.State = "Failed"
+	require.NoError(t, RecordValidation(context.TODO(), nullLogger, c, pool, canaryA, "2.0", nil))
+	assert.Equal(t, awsv1alpha1.CanaryPhaseHalted, getStatus().Phase)
+}
+
+func TestRecordValidationIgnoresAccountsOfOtherVersions(t *testing.T) {
+	pool := newPool("canary")
+	account := newAccount("canary", "Failed", "1.0")
+	c := newClient(t, pool, account)
+
+	require.NoError(t, RecordValidation(context.TODO(), testutils.NewTestLogger().Logger(), c, pool, account, "2.0", nil))
+	current := &awsv1alpha1.AccountPool{}
+	require.NoError(t, c.Get(context.TODO(), crclient.ObjectKeyFromObject(pool), current))
+	assert.Nil(t, current.Status.Canary)
+}
+
+func TestMarkReconciled(t *testing.T) {
+	account := newAccount("account", "Ready", "1.0")
+	c := newClient(t, account)
+
+	require.NoError(t, MarkReconciled(context.TODO(), c, account, "2.0"))
+	current := &awsv1alpha1.Account{}
+	require.NoError(t, c.Get(context.TODO(), crclient.ObjectKeyFromObject(account), current))
+	assert.Equal(t, "2.0", current.Annotations[awsv1alpha1.ReconciledVersionAnnotation])
+}
@@ -0,0 +1,159 @@
+// Package client provides a typed client for the aws-account-operator custom resources, so
+// integrators don't need to hand-roll unstructured objects against the CRDs.
+package client
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	apis "github.com/openshift/aws-account-operator/api"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// Client gives typed access to the operator's custom resources
+type Client struct {
+	client crclient.Client
+}
+
+// AccountClient manages Account CRs in a namespace
+type AccountClient = ResourceClient[*awsv1alpha1.Account, *awsv1alpha1.AccountList]
+
+// AccountClaimClient manages AccountClaim CRs in a namespace
+type AccountClaimClient = ResourceClient[*awsv1alpha1.AccountClaim, *awsv1alpha1.AccountClaimList]
+
+// AccountPoolClient manages AccountPool CRs in a namespace
+type AccountPoolClient = ResourceClient[*awsv1alpha1.AccountPool, *awsv1alpha1.AccountPoolList]
+
+// AWSFederatedRoleClient manages AWSFederatedRole CRs in a namespace
+type AWSFederatedRoleClient = ResourceClient[*awsv1alpha1.AWSFederatedRole, *awsv1alpha1.AWSFederatedRoleList]
+
+// AWSFederatedAccountAccessClient manages AWSFederatedAccountAccess CRs in a namespace
+type AWSFederatedAccountAccessClient = ResourceClient[*awsv1alpha1.AWSFederatedAccountAccess, *awsv1alpha1.AWSFederatedAccountAccessList]
+
+// NewScheme returns a scheme with the Kubernetes built-in types and the operator's types registered
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := apis.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// New builds a Client from a rest config
+func New(config *rest.Config) (*Client, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	c, err := crclient.New(config, crclient.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+	return NewForClient(c), nil
+}
+
+// NewForClient wraps an existing controller-runtime client. Its scheme must know the operator's types.
+func NewForClient(c crclient.Client) *Client {
+	return &Client{client: c}
+}
+
+// Accounts returns a client for the Account CRs in the namespace
+func (c *Client) Accounts(namespace string) *AccountClient {
+	return newResourceClient(c.client, namespace,
+		func() *awsv1alpha1.Account { return &awsv1alpha1.Account{} },
+		func() *awsv1alpha1.AccountList { return &awsv1alpha1.AccountList{} })
+}
+
+// AccountClaims returns a client for the AccountClaim CRs in the namespace
+func (c *Client) AccountClaims(namespace string) *AccountClaimClient {
+	return newResourceClient(c.client, namespace,
+		func() *awsv1alpha1.AccountClaim { return &awsv1alpha1.AccountClaim{} },
+		func() *awsv1alpha1.AccountClaimList { return &awsv1alpha1.AccountClaimList{} })
+}
+
+// AccountPools returns a client for the AccountPool CRs in the namespace
+func (c *Client) AccountPools(namespace string) *AccountPoolClient {
+	return newResourceClient(c.client, namespace,
+		func() *awsv1alpha1.AccountPool { return &awsv1alpha1.AccountPool{} },
+		func() *awsv1alpha1.AccountPoolList { return &awsv1alpha1.AccountPoolList{} })
+}
+
+// AWSFederatedRoles returns a client for the AWSFederatedRole CRs in the namespace
+func (c *Client) AWSFederatedRoles(namespace string) *AWSFederatedRoleClient {
+	return newResourceClient(c.client, namespace,
+		func() *awsv1alpha1.AWSFederatedRole { return &awsv1alpha1.AWSFederatedRole{} },
+		func() *awsv1alpha1.AWSFederatedRoleList { return &awsv1alpha1.AWSFederatedRoleList{} })
+}
+
+// AWSFederatedAccountAccesses returns a client for the AWSFederatedAccountAccess CRs in the namespace
+func (c *Client) AWSFederatedAccountAccesses(namespace string) *AWSFederatedAccountAccessClient {
+	return newResourceClient(c.client, namespace,
+		func() *awsv1alpha1.AWSFederatedAccountAccess { return &awsv1alpha1.AWSFederatedAccountAccess{} },
+		func() *awsv1alpha1.AWSFederatedAccountAccessList { return &awsv1alpha1.AWSFederatedAccountAccessList{} })
+}
+
+// ResourceClient is a typed client for a single kind of custom resource in one namespace
+type ResourceClient[T crclient.Object, L crclient.ObjectList] struct {
+	client    crclient.Client
+	namespace string
+	newObject func() T
+	newList   func() L
+}
+
+func newResourceClient[T crclient.Object, L crclient.ObjectList](c crclient.Client, namespace string, newObject func() T, newList func() L) *ResourceClient[T, L] {
+	return &ResourceClient[T, L]{
+		client:    c,
+		namespace: namespace,
+		newObject: newObject,
+		newList:   newList,
+	}
+}
+
+// Get returns the resource with the given name
+func (r *ResourceClient[T, L]) Get(ctx context.Context, name string) (T, error) {
+	obj := r.newObject()
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: name}, obj)
+	return obj, err
+}
+
+// List returns the resources in the namespace matching the options
+func (r *ResourceClient[T, L]) List(ctx context.Context, opts ...crclient.ListOption) (L, error) {
+	list := r.newList()
+	opts = append([]crclient.ListOption{crclient.InNamespace(r.namespace)}, opts...)
+	err := r.client.List(ctx, list, opts...)
+	return list, err
+}
+
+// Create creates the resource, defaulting its namespace to the client's namespace
+func (r *ResourceClient[T, L]) Create(ctx context.Context, obj T) error {
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(r.namespace)
+	}
+	return r.client.Create(ctx, obj)
+}
+
+// Update updates the spec and metadata of the resource
+func (r *ResourceClient[T, L]) Update(ctx context.Context, obj T) error {
+	return r.client.Update(ctx, obj)
+}
+
+// UpdateStatus updates the status subresource of the resource
+func (r *ResourceClient[T, L]) UpdateStatus(ctx context.Context, obj T) error {
+	return r.client.Status().Update(ctx, obj)
+}
+
+// Delete deletes the resource with the given name
+func (r *ResourceClient[T, L]) Delete(ctx context.Context, name string, opts ...crclient.DeleteOption) error {
+	obj := r.newObject()
+	obj.SetName(name)
+	obj.SetNamespace(r.namespace)
+	return r.client.Delete(ctx, obj, opts...)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+func TestAccountClaimRoundTrip(t *testing.T) {
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+	c := NewForClient(fake.NewClientBuilder().WithScheme(scheme).Build())
+
+	claims := c.AccountClaims("claim-ns")
+	claim := NewSTSClaim("claim", "", awsv1alpha1.LegalEntity{Name: "entity", ID: "1234"}, "123456789012",
+		"arn:aws:iam::123456789012:role/installer", "external-id", awsv1alpha1.SecretRef{Name: "aws", Namespace: "claim-ns"}, "us-east-1")
+	require.NoError(t, claims.Create(context.TODO(), claim))
+
+	got, err := claims.Get(context.TODO(), "claim")
+	require.NoError(t, err)
+	assert.Equal(t, "claim-ns", got.Namespace)
+	assert.True(t, got.Spec.BYOC)
+	assert.True(t, got.Spec.ManualSTSMode)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/installer", got.Spec.STSRoleARN)
+	assert.Equal(t, []awsv1alpha1.AwsRegions{{Name: "us-east-1"}}, got.Spec.Aws.Regions)
+
+	list, err := claims.List(context.TODO())
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	require.NoError(t, claims.Delete(context.TODO(), "claim"))
+	list, err = claims.List(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+}
+
+func TestPoolsAreScopedToTheirNamespace(t *testing.T) {
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+	c := NewForClient(fake.NewClientBuilder().WithScheme(scheme).Build())
+
+	require.NoError(t, c.AccountPools("a").Create(context.TODO(), NewPool("pool", "", 3)))
+	require.NoError(t, c.AccountPools("b").Create(context.TODO(), NewPool("pool", "", 5)))
+
+	list, err := c.AccountPools("a").List(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, 3, list.Items[0].Spec.PoolSize)
+}
+
+func TestNewBYOCClaim(t *testing.T) {
+	claim := NewBYOCClaim("claim", "ns", awsv1alpha1.LegalEntity{Name: "entity", ID: "1234"}, "123456789012",
+		awsv1alpha1.SecretRef{Name: "byoc", Namespace: "ns"}, awsv1alpha1.SecretRef{Name: "aws", Namespace: "ns"})
+	assert.True(t, claim.Spec.BYOC)
+	assert.Equal(t, "123456789012", claim.Spec.BYOCAWSAccountID)
+	assert.Equal(t, "byoc", claim.Spec.BYOCSecretRef.Name)
+	assert.False(t, claim.Spec.ManualSTSMode)
+	assert.Empty(t, claim.Spec.Aws.Regions)
+}
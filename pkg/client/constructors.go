@@ -0,0 +1,62 @@
+package client
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// NewBYOCClaim returns an AccountClaim for a customer provided (CCS) AWS account. The byocSecret holds
+// the customer's credentials, the operator writes the credentials it creates to credentialSecret.
+func NewBYOCClaim(name, namespace string, legalEntity awsv1alpha1.LegalEntity, awsAccountID string, byocSecret, credentialSecret awsv1alpha1.SecretRef, regions ...string) *awsv1alpha1.AccountClaim {
+	claim := newClaim(name, namespace, legalEntity, credentialSecret, regions)
+	claim.Spec.BYOC = true
+	claim.Spec.BYOCAWSAccountID = awsAccountID
+	claim.Spec.BYOCSecretRef = byocSecret
+	return claim
+}
+
+// NewSTSClaim returns an AccountClaim for a customer provided AWS account accessed through the
+// customer's STS role instead of long lived credentials
+func NewSTSClaim(name, namespace string, legalEntity awsv1alpha1.LegalEntity, awsAccountID, roleARN, externalID string, credentialSecret awsv1alpha1.SecretRef, regions ...string) *awsv1alpha1.AccountClaim {
+	claim := newClaim(name, namespace, legalEntity, credentialSecret, regions)
+	claim.Spec.BYOC = true
+	claim.Spec.BYOCAWSAccountID = awsAccountID
+	claim.Spec.ManualSTSMode = true
+	claim.Spec.STSRoleARN = roleARN
+	claim.Spec.STSExternalID = externalID
+	return claim
+}
+
+// NewPool returns an AccountPool keeping size unclaimed accounts ready
+func NewPool(name, namespace string, size int) *awsv1alpha1.AccountPool {
+	return &awsv1alpha1.AccountPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: awsv1alpha1.AccountPoolSpec{
+			PoolSize: size,
+		},
+	}
+}
+
+func newClaim(name, namespace string, legalEntity awsv1alpha1.LegalEntity, credentialSecret awsv1alpha1.SecretRef, regions []string) *awsv1alpha1.AccountClaim {
+	awsRegions := []awsv1alpha1.AwsRegions{}
+	for _, region := range regions {
+		awsRegions = append(awsRegions, awsv1alpha1.AwsRegions{Name: region})
+	}
+	return &awsv1alpha1.AccountClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: awsv1alpha1.AccountClaimSpec{
+			LegalEntity:         legalEntity,
+			AwsCredentialSecret: credentialSecret,
+			Aws: awsv1alpha1.Aws{
+				Regions: awsRegions,
+			},
+		},
+	}
+}
@@ -0,0 +1,195 @@
+// Package inventory exports the Accounts, AccountClaims and AccountPools of a cluster to a portable snapshot and
+// restores them from it, e.g. to migrate to another hub cluster or to recover from the loss of one.
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// SnapshotVersion is the version of the snapshot format
+const SnapshotVersion = "inventory.aws.managed.openshift.io/v1"
+
+// Snapshot holds the specs and statuses of the operator's inventory. Secrets aren't part of it, the credentials they
+// hold have to be restored separately.
+type Snapshot struct {
+	Version       string                     `json:"version"`
+	CreatedAt     metav1.Time                `json:"createdAt"`
+	AccountPools  []awsv1alpha1.AccountPool  `json:"accountPools"`
+	AccountClaims []awsv1alpha1.AccountClaim `json:"accountClaims"`
+	Accounts      []awsv1alpha1.Account      `json:"accounts"`
+}
+
+// ImportResult lists the objects an import created and the ones it left alone because they already existed, as
+// namespace/name
+type ImportResult struct {
+	Created  []string `json:"created"`
+	Existing []string `json:"existing"`
+}
+
+// Export lists the inventory in all namespaces. Server managed metadata is dropped, so the objects can be created in
+// another cluster.
+func Export(ctx context.Context, c crclient.Client) (*Snapshot, error) {
+	pools := &awsv1alpha1.AccountPoolList{}
+	if err := c.List(ctx, pools); err != nil {
+		return nil, fmt.Errorf("failed listing AccountPools: %w", err)
+	}
+	claims := &awsv1alpha1.AccountClaimList{}
+	if err := c.List(ctx, claims); err != nil {
+		return nil, fmt.Errorf("failed listing AccountClaims: %w", err)
+	}
+	accounts := &awsv1alpha1.AccountList{}
+	if err := c.List(ctx, accounts); err != nil {
+		return nil, fmt.Errorf("failed listing Accounts: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		Version:       SnapshotVersion,
+		CreatedAt:     metav1.Now(),
+		AccountPools:  pools.Items,
+		AccountClaims: claims.Items,
+		Accounts:      accounts.Items,
+	}
+	for i := range snapshot.AccountPools {
+		snapshot.AccountPools[i].TypeMeta = metav1.TypeMeta{}
+		portableMeta(&snapshot.AccountPools[i].ObjectMeta)
+	}
+	for i := range snapshot.AccountClaims {
+		snapshot.AccountClaims[i].TypeMeta = metav1.TypeMeta{}
+		portableMeta(&snapshot.AccountClaims[i].ObjectMeta)
+	}
+	for i := range snapshot.Accounts {
+		snapshot.Accounts[i].TypeMeta = metav1.TypeMeta{}
+		portableMeta(&snapshot.Accounts[i].ObjectMeta)
+	}
+	return snapshot, nil
+}
+
+// portableMeta drops the metadata set by the API server. Owner references are kept, their UIDs are resolved again on
+// import.
+func portableMeta(meta *metav1.ObjectMeta) {
+	meta.UID = ""
+	meta.ResourceVersion = ""
+	meta.Generation = 0
+	meta.CreationTimestamp = metav1.Time{}
+	meta.DeletionTimestamp = nil
+	meta.DeletionGracePeriodSeconds = nil
+	meta.ManagedFields = nil
+}
+
+// Marshal encodes the snapshot as YAML, or JSON when asJSON is set
+func Marshal(snapshot *Snapshot, asJSON bool) ([]byte, error) {
+	if asJSON {
+		return json.MarshalIndent(snapshot, "", "  ")
+	}
+	return yaml.Marshal(snapshot)
+}
+
+// Unmarshal decodes a snapshot from YAML or JSON
+func Unmarshal(data []byte) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := yaml.UnmarshalStrict(data, snapshot); err != nil {
+		return nil, err
+	}
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %q, expected %q", snapshot.Version, SnapshotVersion)
+	}
+	return snapshot, nil
+}
+
+// Import creates the objects of the snapshot that don't exist yet and restores their status. Existing objects are left
+// untouched, so an interrupted import can be run again. Pools are created first, then claims and accounts, so owner
+// references can point to the new UIDs of their owners. References to owners that aren't found are dropped rather
+// than letting the garbage collector delete the object.
+func Import(ctx context.Context, c crclient.Client, snapshot *Snapshot) (*ImportResult, error) {
+	result := &ImportResult{}
+	for i := range snapshot.AccountPools {
+		pool := snapshot.AccountPools[i].DeepCopy()
+		portableMeta(&pool.ObjectMeta)
+		status := pool.Status
+		if err := restore(ctx, c, pool, func() { pool.Status = status }, result); err != nil {
+			return result, err
+		}
+	}
+	for i := range snapshot.AccountClaims {
+		claim := snapshot.AccountClaims[i].DeepCopy()
+		portableMeta(&claim.ObjectMeta)
+		status := claim.Status
+		if err := restore(ctx, c, claim, func() { claim.Status = status }, result); err != nil {
+			return result, err
+		}
+	}
+	for i := range snapshot.Accounts {
+		account := snapshot.Accounts[i].DeepCopy()
+		portableMeta(&account.ObjectMeta)
+		status := account.Status
+		if err := restore(ctx, c, account, func() { account.Status = status }, result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// restore creates obj unless it exists, then sets its status back with setStatus as creating it drops the status
+func restore(ctx context.Context, c crclient.Client, obj crclient.Object, setStatus func(), result *ImportResult) error {
+	key := crclient.ObjectKeyFromObject(obj).String()
+
+	ownerReferences, err := resolveOwnerReferences(ctx, c, obj.GetNamespace(), obj.GetOwnerReferences())
+	if err != nil {
+		return err
+	}
+	obj.SetOwnerReferences(ownerReferences)
+
+	err = c.Create(ctx, obj)
+	if k8serr.IsAlreadyExists(err) {
+		result.Existing = append(result.Existing, key)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed creating %s: %w", key, err)
+	}
+	result.Created = append(result.Created, key)
+
+	setStatus()
+	if err := c.Status().Update(ctx, obj); err != nil {
+		return fmt.Errorf("failed restoring the status of %s: %w", key, err)
+	}
+	return nil
+}
+
+// resolveOwnerReferences points the owner references to the UIDs of the owners in this cluster
+func resolveOwnerReferences(ctx context.Context, c crclient.Client, namespace string, references []metav1.OwnerReference) ([]metav1.OwnerReference, error) {
+	resolved := []metav1.OwnerReference{}
+	for _, reference := range references {
+		var owner crclient.Object
+		switch reference.Kind {
+		case "AccountPool":
+			owner = &awsv1alpha1.AccountPool{}
+		case "AccountClaim":
+			owner = &awsv1alpha1.AccountClaim{}
+		case "Account":
+			owner = &awsv1alpha1.Account{}
+		default:
+			continue
+		}
+		err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: reference.Name}, owner)
+		if k8serr.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		reference.UID = owner.GetUID()
+		resolved = append(resolved, reference)
+	}
+	return resolved, nil
+}
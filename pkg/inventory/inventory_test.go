@@ -0,0 +1,138 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/client"
+)
+
+func newFakeClient(t *testing.T, objects ...crclient.Object) crclient.Client {
+	scheme, err := client.NewScheme()
+	require.NoError(t, err)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func inventoryObjects() []crclient.Object {
+	pool := &awsv1alpha1.AccountPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: awsv1alpha1.AccountCrNamespace, UID: "pool-uid"},
+		Spec:       awsv1alpha1.AccountPoolSpec{PoolSize: 3},
+		Status:     awsv1alpha1.AccountPoolStatus{PoolSize: 3, UnclaimedAccounts: 1},
+	}
+	account := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "osd-creds-mgmt-aaaaaa",
+			Namespace:  awsv1alpha1.AccountCrNamespace,
+			UID:        "account-uid",
+			Finalizers: []string{"finalizer.aws.managed.openshift.io"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: awsv1alpha1.GroupVersion.String(),
+				Kind:       "AccountPool",
+				Name:       "pool",
+				UID:        "pool-uid",
+			}},
+		},
+		Spec:   awsv1alpha1.AccountSpec{AwsAccountID: "123456789012", AccountPool: "pool"},
+		Status: awsv1alpha1.AccountStatus{State: string(awsv1alpha1.AccountReady)},
+	}
+	claim := &awsv1alpha1.AccountClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-ns", UID: "claim-uid"},
+		Spec:       awsv1alpha1.AccountClaimSpec{AccountLink: "osd-creds-mgmt-aaaaaa"},
+		Status:     awsv1alpha1.AccountClaimStatus{State: awsv1alpha1.ClaimStatusReady},
+	}
+	return []crclient.Object{pool, account, claim}
+}
+
+func TestExportDropsServerManagedMetadata(t *testing.T) {
+	snapshot, err := Export(context.TODO(), newFakeClient(t, inventoryObjects()...))
+	require.NoError(t, err)
+
+	assert.Equal(t, SnapshotVersion, snapshot.Version)
+	require.Len(t, snapshot.AccountPools, 1)
+	require.Len(t, snapshot.AccountClaims, 1)
+	require.Len(t, snapshot.Accounts, 1)
+
+	account := snapshot.Accounts[0]
+	assert.Empty(t, account.UID)
+	assert.Empty(t, account.ResourceVersion)
+	assert.Equal(t, "123456789012", account.Spec.AwsAccountID)
+	assert.Equal(t, string(awsv1alpha1.AccountReady), account.Status.State)
+	assert.Equal(t, []string{"finalizer.aws.managed.openshift.io"}, account.Finalizers)
+	assert.Equal(t, "pool", account.OwnerReferences[0].Name)
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	snapshot, err := Export(context.TODO(), newFakeClient(t, inventoryObjects()...))
+	require.NoError(t, err)
+
+	for _, asJSON := range []bool{false, true} {
+		data, err := Marshal(snapshot, asJSON)
+		require.NoError(t, err)
+
+		decoded, err := Unmarshal(data)
+		require.NoError(t, err)
+		assert.Equal(t, snapshot.Accounts[0].Spec, decoded.Accounts[0].Spec)
+		assert.Equal(t, snapshot.AccountClaims[0].Status.State, decoded.AccountClaims[0].Status.State)
+	}
+}
+
+func TestUnmarshalRejectsUnknownVersions(t *testing.T) {
+	_, err := Unmarshal([]byte("version: inventory.aws.managed.openshift.io/v0\n"))
+	assert.Error(t, err)
+}
+
+func TestImportRestoresStatusAndOwners(t *testing.T) {
+	snapshot, err := Export(context.TODO(), newFakeClient(t, inventoryObjects()...))
+	require.NoError(t, err)
+	target := newFakeClient(t)
+
+	result, err := Import(context.TODO(), target, snapshot)
+	require.NoError(t, err)
+	assert.Len(t, result.Created, 3)
+	assert.Empty(t, result.Existing)
+
+	pool := &awsv1alpha1.AccountPool{}
+	require.NoError(t, target.Get(context.TODO(), types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: "pool"}, pool))
+	assert.Equal(t, 1, pool.Status.UnclaimedAccounts)
+
+	account := &awsv1alpha1.Account{}
+	require.NoError(t, target.Get(context.TODO(), types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-aaaaaa"}, account))
+	assert.Equal(t, string(awsv1alpha1.AccountReady), account.Status.State)
+	require.Len(t, account.OwnerReferences, 1)
+	assert.Equal(t, pool.UID, account.OwnerReferences[0].UID)
+}
+
+func TestImportIsIdempotent(t *testing.T) {
+	snapshot, err := Export(context.TODO(), newFakeClient(t, inventoryObjects()...))
+	require.NoError(t, err)
+	target := newFakeClient(t)
+
+	_, err = Import(context.TODO(), target, snapshot)
+	require.NoError(t, err)
+	result, err := Import(context.TODO(), target, snapshot)
+	require.NoError(t, err)
+	assert.Empty(t, result.Created)
+	assert.Len(t, result.Existing, 3)
+}
+
+func TestImportDropsOwnersThatDontExist(t *testing.T) {
+	snapshot, err := Export(context.TODO(), newFakeClient(t, inventoryObjects()...))
+	require.NoError(t, err)
+	snapshot.AccountPools = nil
+	target := newFakeClient(t)
+
+	_, err = Import(context.TODO(), target, snapshot)
+	require.NoError(t, err)
+
+	account := &awsv1alpha1.Account{}
+	require.NoError(t, target.Get(context.TODO(), types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-aaaaaa"}, account))
+	assert.Empty(t, account.OwnerReferences)
+}
@@ -0,0 +1,233 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/go-logr/logr"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/config"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	stsclient "github.com/openshift/aws-account-operator/pkg/awsclient/sts"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+// RecoveredAnnotation is set on the Accounts rebuilt from AWS by Recover
+const RecoveredAnnotation = "aws.managed.openshift.io/recovered"
+
+// OrganizationsClient is the part of the AWS Organizations API recovery uses. awsclient.Client implements it.
+type OrganizationsClient interface {
+	ListAccounts(context.Context, *organizations.ListAccountsInput) (*organizations.ListAccountsOutput, error)
+	ListTagsForResource(context.Context, *organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error)
+}
+
+// Claim is the claim an account was linked to, as recorded in the account
+type Claim struct {
+	Name      string
+	Namespace string
+}
+
+// ClaimProbe looks up the claim an account was linked to. It returns nil for an account that wasn't claimed.
+type ClaimProbe func(awsAccountID string) (*Claim, error)
+
+// RecoveryResult lists the Accounts a recovery created, the AWS accounts that already had one and the ones that
+// couldn't be probed, by AWS account ID
+type RecoveryResult struct {
+	Created  []string `json:"created"`
+	Existing []string `json:"existing"`
+	Skipped  []string `json:"skipped"`
+}
+
+// Recover creates an Account for every active account of the organization owned by the shard that has none. The pool
+// and claim of the account are read from its ownership tags, accounts without them are probed for their claim. Claimed
+// accounts are restored Ready and claimed, their AccountClaims have to be recreated by their owners. Unclaimed accounts
+// are created without a state, so the account controller initializes them again as an account that was already
+// created, which also recreates their IAM user secret. Accounts that fail to be probed are skipped rather than risking
+// handing out a claimed account.
+func Recover(ctx context.Context, reqLogger logr.Logger, c crclient.Client, org OrganizationsClient, shardName string, probe ClaimProbe) (*RecoveryResult, error) {
+	accounts := &awsv1alpha1.AccountList{}
+	if err := c.List(ctx, accounts, crclient.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing Accounts: %w", err)
+	}
+	known := map[string]bool{}
+	for _, account := range accounts.Items {
+		known[account.Spec.AwsAccountID] = true
+	}
+
+	result := &RecoveryResult{}
+	input := &organizations.ListAccountsInput{}
+	for {
+		output, err := org.ListAccounts(ctx, input)
+		if err != nil {
+			return result, fmt.Errorf("failed listing the accounts of the organization: %w", err)
+		}
+
+		for _, orgAccount := range output.Accounts {
+			accountID := aws.ToString(orgAccount.Id)
+			if orgAccount.Status != organizationstypes.AccountStatusActive {
+				continue
+			}
+			tags, err := listTags(ctx, org, accountID)
+			if err != nil {
+				return result, err
+			}
+			if tags[accountprovision.OwnerTagKey] != shardName {
+				continue
+			}
+			if known[accountID] {
+				result.Existing = append(result.Existing, accountID)
+				continue
+			}
+
+			claim, err := recoveredClaim(accountID, tags, probe)
+			if err != nil {
+				reqLogger.Error(err, "failed probing the account, skipping it", "awsAccountID", accountID)
+				result.Skipped = append(result.Skipped, accountID)
+				continue
+			}
+			err = createRecoveredAccount(ctx, c, orgAccount, tags[accountprovision.PoolTagKey], claim)
+			if err != nil {
+				return result, err
+			}
+			reqLogger.Info("recovered account", "awsAccountID", accountID, "claimed", claim != nil)
+			result.Created = append(result.Created, accountID)
+		}
+
+		if output.NextToken == nil {
+			return result, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func listTags(ctx context.Context, org OrganizationsClient, accountID string) (map[string]string, error) {
+	tags := map[string]string{}
+	input := &organizations.ListTagsForResourceInput{ResourceId: aws.String(accountID)}
+	for {
+		output, err := org.ListTagsForResource(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed listing the tags of account %s: %w", accountID, err)
+		}
+		for _, tag := range output.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		if output.NextToken == nil {
+			return tags, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// recoveredClaim returns the claim recorded in the ownership tags of the account, or probes the account when it has
+// none
+func recoveredClaim(accountID string, tags map[string]string, probe ClaimProbe) (*Claim, error) {
+	if _, ok := tags[accountprovision.StateTagKey]; !ok {
+		return probe(accountID)
+	}
+	value, ok := tags[accountprovision.ClaimTagKey]
+	if !ok {
+		return nil, nil
+	}
+	namespace, name := accountprovision.ParseClaimTag(value)
+	return &Claim{Name: name, Namespace: namespace}, nil
+}
+
+func createRecoveredAccount(ctx context.Context, c crclient.Client, orgAccount organizationstypes.Account, pool string, claim *Claim) error {
+	accountID := aws.ToString(orgAccount.Id)
+	// The accounts created by the operator are named after their Account, other names may not be valid
+	name := aws.ToString(orgAccount.Name)
+	if len(validation.IsDNS1123Subdomain(name)) > 0 {
+		name = "recovered-" + accountID
+	}
+	account := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   awsv1alpha1.AccountCrNamespace,
+			Annotations: map[string]string{RecoveredAnnotation: "true"},
+			Finalizers:  []string{awsv1alpha1.AccountFinalizer},
+		},
+		Spec: awsv1alpha1.AccountSpec{
+			AwsAccountID: accountID,
+			AccountPool:  pool,
+			AccountName:  aws.ToString(orgAccount.Name),
+			Email:        aws.ToString(orgAccount.Email),
+		},
+	}
+	if claim != nil {
+		account.Spec.ClaimLink = claim.Name
+		account.Spec.ClaimLinkNamespace = claim.Namespace
+	}
+
+	err := c.Create(ctx, account)
+	if k8serr.IsAlreadyExists(err) {
+		return fmt.Errorf("account %s can't be recovered, Account %s already exists", accountID, name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed creating the Account of %s: %w", accountID, err)
+	}
+	if claim == nil {
+		return nil
+	}
+
+	account.Status.Claimed = true
+	utils.SetAccountStatus(account, "Account recovered from AWS", awsv1alpha1.AccountReady, string(awsv1alpha1.AccountReady))
+	if err := c.Status().Update(ctx, account); err != nil {
+		return fmt.Errorf("failed setting the status of the Account of %s: %w", accountID, err)
+	}
+	return nil
+}
+
+// IAMClaimProbe returns a ClaimProbe that finds the claim an account was linked to in the tags of the IAM user the
+// operator created in it, assuming the OrganizationAccountAccessRole with the payer account client
+func IAMClaimProbe(reqLogger logr.Logger, builder awsclient.IBuilder, kubeClient crclient.Client, payerClient awsclient.Client) ClaimProbe {
+	return func(awsAccountID string) (*Claim, error) {
+		roleArn := config.GetIAMArn(awsAccountID, config.AwsResourceTypeRole, awsv1alpha1.AccountOperatorIAMRole)
+		creds, err := stsclient.GetSTSCredentials(reqLogger, payerClient, roleArn, "", "awsAccountOperator")
+		if err != nil {
+			return nil, err
+		}
+		accountClient, err := builder.GetClient("", kubeClient, awsclient.NewAwsClientInput{
+			AwsCredsSecretIDKey:     aws.ToString(creds.Credentials.AccessKeyId),
+			AwsCredsSecretAccessKey: aws.ToString(creds.Credentials.SecretAccessKey),
+			AwsToken:                aws.ToString(creds.Credentials.SessionToken),
+			AwsRegion:               config.GetDefaultRegion(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		users, err := awsclient.ListIAMUsers(reqLogger, accountClient)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			output, err := accountClient.GetUser(context.TODO(), &iam.GetUserInput{UserName: user.UserName})
+			if err != nil {
+				return nil, err
+			}
+			tags := map[string]string{}
+			for _, tag := range output.User.Tags {
+				tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			// Only the users created by the operator are tagged with the name of their Account
+			if tags[awsv1alpha1.ClusterAccountNameTagKey] == "" {
+				continue
+			}
+			if tags[awsv1alpha1.ClusterClaimLinkTagKey] == "" {
+				return nil, nil
+			}
+			return &Claim{Name: tags[awsv1alpha1.ClusterClaimLinkTagKey], Namespace: tags[awsv1alpha1.ClusterClaimLinkNamespaceTagKey]}, nil
+		}
+		return nil, nil
+	}
+}
@@ -0,0 +1,179 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/accountprovision"
+	"github.com/openshift/aws-account-operator/pkg/awsclient/mock"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+)
+
+func expectOrganizationAccounts(client *mock.MockClient, owners map[string]string) {
+	accounts := []organizationstypes.Account{}
+	for _, id := range []string{"111111111111", "222222222222", "333333333333"} {
+		accounts = append(accounts, organizationstypes.Account{
+			Id:     aws.String(id),
+			Name:   aws.String("osd-creds-mgmt-" + id),
+			Email:  aws.String("osd-creds-mgmt+" + id + "@redhat.com"),
+			Status: organizationstypes.AccountStatusActive,
+		})
+	}
+	accounts = append(accounts, organizationstypes.Account{Id: aws.String("444444444444"), Status: organizationstypes.AccountStatusSuspended})
+	client.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Return(&organizations.ListAccountsOutput{Accounts: accounts}, nil)
+
+	for id, owner := range owners {
+		client.EXPECT().ListTagsForResource(gomock.Any(), &organizations.ListTagsForResourceInput{ResourceId: aws.String(id)}).Return(
+			&organizations.ListTagsForResourceOutput{Tags: accountprovision.Tags(owner, nil)}, nil)
+	}
+}
+
+func TestRecoverCreatesTheAccountsOfTheShard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	org := mock.NewMockClient(ctrl)
+	expectOrganizationAccounts(org, map[string]string{
+		"111111111111": "hivep01ue1",
+		"222222222222": "hivep01ue1",
+		"333333333333": "hives02ue1",
+	})
+	c := newFakeClient(t)
+	probe := func(awsAccountID string) (*Claim, error) {
+		if awsAccountID == "222222222222" {
+			return &Claim{Name: "claim", Namespace: "claim-ns"}, nil
+		}
+		return nil, nil
+	}
+
+	result, err := Recover(context.TODO(), testutils.NewTestLogger().Logger(), c, org, "hivep01ue1", probe)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"111111111111", "222222222222"}, result.Created)
+
+	unclaimed := &awsv1alpha1.Account{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-111111111111"}, unclaimed))
+	assert.Equal(t, "111111111111", unclaimed.Spec.AwsAccountID)
+	assert.Equal(t, "osd-creds-mgmt-111111111111", unclaimed.Spec.AccountName)
+	assert.Equal(t, "osd-creds-mgmt+111111111111@redhat.com", unclaimed.Spec.Email)
+	assert.False(t, unclaimed.HasState())
+	assert.Equal(t, "true", unclaimed.Annotations[RecoveredAnnotation])
+
+	claimed := &awsv1alpha1.Account{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-222222222222"}, claimed))
+	assert.True(t, claimed.IsReady())
+	assert.True(t, claimed.IsClaimed())
+	assert.Equal(t, "claim", claimed.Spec.ClaimLink)
+	assert.Equal(t, "claim-ns", claimed.Spec.ClaimLinkNamespace)
+}
+
+func TestRecoverSkipsKnownAndUnprobedAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	org := mock.NewMockClient(ctrl)
+	expectOrganizationAccounts(org, map[string]string{
+		"111111111111": "hivep01ue1",
+		"222222222222": "hivep01ue1",
+		"333333333333": "hivep01ue1",
+	})
+	c := newFakeClient(t, &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: awsv1alpha1.AccountCrNamespace},
+		Spec:       awsv1alpha1.AccountSpec{AwsAccountID: "111111111111"},
+	})
+	probe := func(awsAccountID string) (*Claim, error) {
+		if awsAccountID == "333333333333" {
+			return nil, errors.New("access denied")
+		}
+		return nil, nil
+	}
+
+	result, err := Recover(context.TODO(), testutils.NewTestLogger().Logger(), c, org, "hivep01ue1", probe)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"222222222222"}, result.Created)
+	assert.Equal(t, []string{"111111111111"}, result.Existing)
+	assert.Equal(t, []string{"333333333333"}, result.Skipped)
+}
+
+func TestIAMClaimProbe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	builder := &mock.Builder{MockController: ctrl}
+	client := mock.GetMockClient(builder)
+	client.EXPECT().AssumeRole(gomock.Any(), gomock.Any()).Return(&sts.AssumeRoleOutput{
+		AssumedRoleUser: &ststypes.AssumedRoleUser{AssumedRoleId: aws.String("role-id")},
+		Credentials: &ststypes.Credentials{
+			AccessKeyId:     aws.String("access-key"),
+			SecretAccessKey: aws.String("secret"),
+			SessionToken:    aws.String("token"),
+		},
+	}, nil)
+	client.EXPECT().ListUsersPages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *iam.ListUsersInput, fn func(*iam.ListUsersOutput, bool) bool) error {
+			fn(&iam.ListUsersOutput{Users: []iamtypes.User{{UserName: aws.String("admin")}, {UserName: aws.String("osdManagedAdmin-abcdef")}}}, true)
+			return nil
+		})
+	client.EXPECT().GetUser(gomock.Any(), &iam.GetUserInput{UserName: aws.String("admin")}).Return(&iam.GetUserOutput{
+		User: &iamtypes.User{UserName: aws.String("admin")},
+	}, nil)
+	client.EXPECT().GetUser(gomock.Any(), &iam.GetUserInput{UserName: aws.String("osdManagedAdmin-abcdef")}).Return(&iam.GetUserOutput{
+		User: &iamtypes.User{
+			UserName: aws.String("osdManagedAdmin-abcdef"),
+			Tags: []iamtypes.Tag{
+				{Key: aws.String(awsv1alpha1.ClusterAccountNameTagKey), Value: aws.String("osd-creds-mgmt-aaaaaa")},
+				{Key: aws.String(awsv1alpha1.ClusterClaimLinkTagKey), Value: aws.String("claim")},
+				{Key: aws.String(awsv1alpha1.ClusterClaimLinkNamespaceTagKey), Value: aws.String("claim-ns")},
+			},
+		},
+	}, nil)
+
+	probe := IAMClaimProbe(testutils.NewTestLogger().Logger(), builder, newFakeClient(t), client)
+	claim, err := probe("123456789012")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Claim{Name: "claim", Namespace: "claim-ns"}, claim)
+}
+
+func TestRecoverReadsTheOwnershipTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	org := mock.NewMockClient(ctrl)
+	org.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Return(&organizations.ListAccountsOutput{
+		Accounts: []organizationstypes.Account{{
+			Id:     aws.String("111111111111"),
+			Name:   aws.String("osd-creds-mgmt-aaaaaa"),
+			Status: organizationstypes.AccountStatusActive,
+		}},
+	}, nil)
+	org.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).Return(&organizations.ListTagsForResourceOutput{
+		Tags: []organizationstypes.Tag{
+			{Key: aws.String(accountprovision.OwnerTagKey), Value: aws.String("hivep01ue1")},
+			{Key: aws.String(accountprovision.PoolTagKey), Value: aws.String("fm-pool")},
+			{Key: aws.String(accountprovision.ClaimTagKey), Value: aws.String("claim-ns/claim")},
+			{Key: aws.String(accountprovision.StateTagKey), Value: aws.String("Ready")},
+		},
+	}, nil)
+	c := newFakeClient(t)
+	probe := func(string) (*Claim, error) {
+		t.Fatal("accounts with ownership tags shouldn't be probed")
+		return nil, nil
+	}
+
+	_, err := Recover(context.TODO(), testutils.NewTestLogger().Logger(), c, org, "hivep01ue1", probe)
+	require.NoError(t, err)
+
+	account := &awsv1alpha1.Account{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-aaaaaa"}, account))
+	assert.Equal(t, "fm-pool", account.Spec.AccountPool)
+	assert.Equal(t, "claim", account.Spec.ClaimLink)
+	assert.Equal(t, "claim-ns", account.Spec.ClaimLinkNamespace)
+	assert.True(t, account.IsClaimed())
+}
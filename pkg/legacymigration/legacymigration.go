@@ -0,0 +1,234 @@
+package legacymigration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+const (
+	// ReportConfigMapName is the ConfigMap of the operator namespace the report of the last migration that changed
+	// something is written to
+	ReportConfigMapName = "aws-account-operator-legacy-migration"
+	// ReportKey is the key of the report in the ConfigMap
+	ReportKey = "report.json"
+)
+
+// legacyFinalizers maps the finalizers set by older operator versions to their current name. Every entry has to cite
+// the operator release that set it. None is listed: the operator has set utils.Finalizer for as far back as its
+// history goes.
+var legacyFinalizers = map[string]string{}
+
+// legacyLabels maps the label keys set by older operator versions to their current key. Every entry has to cite the
+// operator release that set it. None is listed: the operator has set awsv1alpha1.IAMUserIDLabel and
+// awsv1alpha1.AccountIDLabel for as far back as its history goes.
+var legacyLabels = map[string]string{}
+
+// Change is a change the migration made to an object
+type Change struct {
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Report lists the changes a migration made
+type Report struct {
+	Completed metav1.Time `json:"completed"`
+	Changes   []Change    `json:"changes"`
+}
+
+// Run normalizes the finalizers and label keys of the Accounts and AccountClaims, and the name of the IAM user secrets
+// of the Accounts, created by older operator versions to the current scheme. It's idempotent: once migrated, objects
+// are left alone. The report of a run that changed something is written to the ReportConfigMapName ConfigMap. Objects
+// that fail to migrate are logged and migrated on the next run.
+func Run(ctx context.Context, reqLogger logr.Logger, c client.Client) (*Report, error) {
+	report := &Report{Changes: []Change{}}
+
+	accounts := &awsv1alpha1.AccountList{}
+	if err := c.List(ctx, accounts, client.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing Accounts: %w", err)
+	}
+	for i := range accounts.Items {
+		account := &accounts.Items[i]
+		changes, err := migrateAccount(ctx, c, account)
+		report.Changes = append(report.Changes, changes...)
+		if err != nil {
+			reqLogger.Error(err, "failed migrating Account", "account", account.Name)
+		}
+	}
+
+	claims := &awsv1alpha1.AccountClaimList{}
+	if err := c.List(ctx, claims); err != nil {
+		return nil, fmt.Errorf("failed listing AccountClaims: %w", err)
+	}
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		changes, err := migrateMetadata(ctx, c, "AccountClaim", claim)
+		report.Changes = append(report.Changes, changes...)
+		if err != nil {
+			reqLogger.Error(err, "failed migrating AccountClaim", "namespace", claim.Namespace, "name", claim.Name)
+		}
+	}
+
+	report.Completed = metav1.Now()
+	if len(report.Changes) == 0 {
+		return report, nil
+	}
+	for _, change := range report.Changes {
+		reqLogger.Info("Migrated legacy object", "kind", change.Kind, "namespace", change.Namespace, "name", change.Name, "change", change.Description)
+	}
+	return report, writeReport(ctx, c, report)
+}
+
+// migrateAccount migrates the metadata of the Account, then the name of its IAM user secret
+func migrateAccount(ctx context.Context, c client.Client, account *awsv1alpha1.Account) ([]Change, error) {
+	changes, err := migrateMetadata(ctx, c, "Account", account)
+	if err != nil {
+		return changes, err
+	}
+	change, err := migrateIAMUserSecret(ctx, c, account)
+	if change != nil {
+		changes = append(changes, *change)
+	}
+	return changes, err
+}
+
+// migrateMetadata renames the legacy finalizers and label keys of the object
+func migrateMetadata(ctx context.Context, c client.Client, kind string, obj client.Object) ([]Change, error) {
+	changes := []Change{}
+	newChange := func(description string) Change {
+		return Change{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName(), Description: description}
+	}
+
+	finalizers := []string{}
+	for _, finalizer := range obj.GetFinalizers() {
+		if current, ok := legacyFinalizers[finalizer]; ok {
+			changes = append(changes, newChange(fmt.Sprintf("renamed finalizer %s to %s", finalizer, current)))
+			finalizer = current
+		}
+		if !utils.Contains(finalizers, finalizer) {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+
+	labels := obj.GetLabels()
+	legacyKeys := []string{}
+	for key := range labels {
+		if _, ok := legacyLabels[key]; ok {
+			legacyKeys = append(legacyKeys, key)
+		}
+	}
+	sort.Strings(legacyKeys)
+	for _, key := range legacyKeys {
+		current := legacyLabels[key]
+		if _, ok := labels[current]; !ok {
+			labels[current] = labels[key]
+		}
+		delete(labels, key)
+		changes = append(changes, newChange(fmt.Sprintf("renamed label %s to %s", key, current)))
+	}
+
+	if len(changes) == 0 {
+		return changes, nil
+	}
+	obj.SetFinalizers(finalizers)
+	obj.SetLabels(labels)
+	if err := c.Update(ctx, obj); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// migrateIAMUserSecret moves the IAM user secret of the Account to the current name, the lower case
+// <account name>-secret returned by createIAMUserSecretName of the account controller. The secret is copied before
+// the Account is pointed to it and the legacy secret deleted, so an interrupted migration is resumed on the next run.
+func migrateIAMUserSecret(ctx context.Context, c client.Client, account *awsv1alpha1.Account) (*Change, error) {
+	legacyName := account.Spec.IAMUserSecret
+	currentName := utils.IAMUserSecretName(account.Name)
+	if legacyName == "" || legacyName == currentName {
+		return nil, nil
+	}
+
+	legacySecret := &corev1.Secret{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: account.Namespace, Name: legacyName}, legacySecret)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return nil, err
+	}
+	legacySecretExists := err == nil
+	if legacySecretExists {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            currentName,
+				Namespace:       account.Namespace,
+				Labels:          legacySecret.Labels,
+				Annotations:     legacySecret.Annotations,
+				OwnerReferences: legacySecret.OwnerReferences,
+			},
+			Type: legacySecret.Type,
+			Data: legacySecret.Data,
+		}
+		if err := c.Create(ctx, secret); err != nil && !k8serr.IsAlreadyExists(err) {
+			return nil, err
+		}
+	} else if err := c.Get(ctx, client.ObjectKey{Namespace: account.Namespace, Name: currentName}, &corev1.Secret{}); err != nil {
+		// Neither secret exists, the account controller recreates it
+		if k8serr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	account.Spec.IAMUserSecret = currentName
+	if err := c.Update(ctx, account); err != nil {
+		return nil, err
+	}
+	if legacySecretExists {
+		if err := c.Delete(ctx, legacySecret); err != nil && !k8serr.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return &Change{
+		Kind:        "Account",
+		Namespace:   account.Namespace,
+		Name:        account.Name,
+		Description: fmt.Sprintf("renamed IAM user secret %s to %s", legacyName, currentName),
+	}, nil
+}
+
+// writeReport writes the report to the ReportConfigMapName ConfigMap
+func writeReport(ctx context.Context, c client.Client, report *Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = c.Get(ctx, client.ObjectKey{Namespace: awsv1alpha1.AccountCrNamespace, Name: ReportConfigMapName}, configMap)
+	if k8serr.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ReportConfigMapName,
+				Namespace: awsv1alpha1.AccountCrNamespace,
+				Labels:    map[string]string{utils.ManagedLabel: "true"},
+			},
+			Data: map[string]string{ReportKey: string(data)},
+		}
+		return c.Create(ctx, configMap)
+	}
+	if err != nil {
+		return err
+	}
+	configMap.Data = map[string]string{ReportKey: string(data)}
+	return c.Update(ctx, configMap)
+}
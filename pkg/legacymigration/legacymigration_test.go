@@ -0,0 +1,96 @@
+package legacymigration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/client"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+	"github.com/openshift/aws-account-operator/pkg/utils"
+)
+
+func TestRun(t *testing.T) {
+	// No legacy names are known, the test registers its own
+	legacyFinalizers["test.aws.managed.openshift.io/legacy"] = utils.Finalizer
+	legacyLabels["testLegacyIAMUserID"] = awsv1alpha1.IAMUserIDLabel
+	defer delete(legacyFinalizers, "test.aws.managed.openshift.io/legacy")
+	defer delete(legacyLabels, "testLegacyIAMUserID")
+
+	legacyAccount := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "osd-creds-mgmt-aaaaaa",
+			Namespace:  awsv1alpha1.AccountCrNamespace,
+			Finalizers: []string{"test.aws.managed.openshift.io/legacy", utils.Finalizer},
+			Labels:     map[string]string{"testLegacyIAMUserID": "abc123"},
+		},
+		Spec: awsv1alpha1.AccountSpec{IAMUserSecret: "osd-creds-mgmt-aaaaaa-osdmanagedadmin-secret"},
+	}
+	legacySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa-osdmanagedadmin-secret", Namespace: awsv1alpha1.AccountCrNamespace},
+		Data:       map[string][]byte{"aws_access_key_id": []byte("AKIA")},
+	}
+	currentAccount := &awsv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "osd-creds-mgmt-bbbbbb",
+			Namespace:  awsv1alpha1.AccountCrNamespace,
+			Finalizers: []string{utils.Finalizer},
+			Labels:     map[string]string{awsv1alpha1.IAMUserIDLabel: "def456"},
+		},
+		Spec: awsv1alpha1.AccountSpec{IAMUserSecret: "osd-creds-mgmt-bbbbbb-secret"},
+	}
+	legacyClaim := &awsv1alpha1.AccountClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "claim",
+			Namespace:  "claim-namespace",
+			Finalizers: []string{"test.aws.managed.openshift.io/legacy"},
+		},
+	}
+	scheme, err := client.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(legacyAccount, legacySecret, currentAccount, legacyClaim).Build()
+	nullLogger := testutils.NewTestLogger().Logger()
+
+	report, err := Run(context.TODO(), nullLogger, c)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Kind: "Account", Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-aaaaaa", Description: "renamed finalizer test.aws.managed.openshift.io/legacy to finalizer.aws.managed.openshift.io"},
+		{Kind: "Account", Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-aaaaaa", Description: "renamed label testLegacyIAMUserID to iamUserId"},
+		{Kind: "Account", Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-aaaaaa", Description: "renamed IAM user secret osd-creds-mgmt-aaaaaa-osdmanagedadmin-secret to osd-creds-mgmt-aaaaaa-secret"},
+		{Kind: "AccountClaim", Namespace: "claim-namespace", Name: "claim", Description: "renamed finalizer test.aws.managed.openshift.io/legacy to finalizer.aws.managed.openshift.io"},
+	}, report.Changes)
+
+	account := &awsv1alpha1.Account{}
+	require.NoError(t, c.Get(context.TODO(), crclient.ObjectKeyFromObject(legacyAccount), account))
+	assert.Equal(t, []string{utils.Finalizer}, account.Finalizers)
+	assert.Equal(t, map[string]string{awsv1alpha1.IAMUserIDLabel: "abc123"}, account.Labels)
+	assert.Equal(t, "osd-creds-mgmt-aaaaaa-secret", account.Spec.IAMUserSecret)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(context.TODO(), crclient.ObjectKey{Namespace: awsv1alpha1.AccountCrNamespace, Name: "osd-creds-mgmt-aaaaaa-secret"}, secret))
+	assert.Equal(t, legacySecret.Data, secret.Data)
+	err = c.Get(context.TODO(), crclient.ObjectKeyFromObject(legacySecret), secret)
+	assert.True(t, k8serr.IsNotFound(err))
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(context.TODO(), crclient.ObjectKey{Namespace: awsv1alpha1.AccountCrNamespace, Name: ReportConfigMapName}, configMap))
+	written := &Report{}
+	require.NoError(t, json.Unmarshal([]byte(configMap.Data[ReportKey]), written))
+	assert.Equal(t, report.Changes, written.Changes)
+
+	// A second run has nothing left to change and keeps the report
+	report, err = Run(context.TODO(), nullLogger, c)
+	require.NoError(t, err)
+	assert.Empty(t, report.Changes)
+	require.NoError(t, c.Get(context.TODO(), crclient.ObjectKey{Namespace: awsv1alpha1.AccountCrNamespace, Name: ReportConfigMapName}, configMap))
+	assert.Contains(t, configMap.Data[ReportKey], "osd-creds-mgmt-aaaaaa-osdmanagedadmin-secret")
+}
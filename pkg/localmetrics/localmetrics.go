@@ -19,12 +19,15 @@ import (
 	"errors"
 	"net/http"
 	neturl "net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
 
 	"github.com/aws/smithy-go"
 	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,6 +35,25 @@ import (
 
 const (
 	operatorName = "aws-account-operator"
+
+	// ReconcileOutcomeSuccess is a reconcile that finished without asking to be requeued
+	ReconcileOutcomeSuccess = "success"
+	// ReconcileOutcomeError is a reconcile that returned an error
+	ReconcileOutcomeError = "error"
+	// ReconcileOutcomeRequeue is a reconcile that asked to be requeued right away
+	ReconcileOutcomeRequeue = "requeue"
+	// ReconcileOutcomeRequeueAfter is a reconcile that asked to be requeued after a delay
+	ReconcileOutcomeRequeueAfter = "requeue_after"
+
+	// WatchEventProcessed is a watch event that was queued for a reconcile
+	WatchEventProcessed = "processed"
+	// WatchEventSkipped is a watch event that was filtered out as there was nothing to reconcile for it
+	WatchEventSkipped = "skipped"
+
+	// SecretAccessSucceeded is a Secret access the API server or the cache completed
+	SecretAccessSucceeded = "success"
+	// SecretAccessFailed is a Secret access that returned an error
+	SecretAccessFailed = "error"
 )
 
 var (
@@ -44,6 +66,7 @@ var (
 type MetricsCollector struct {
 	store                           cache.Cache
 	awsAccounts                     prometheus.Gauge
+	accountCapacityRemaining        prometheus.Gauge
 	accounts                        *prometheus.GaugeVec
 	ccsAccounts                     *prometheus.GaugeVec
 	accountClaims                   *prometheus.GaugeVec
@@ -52,6 +75,8 @@ type MetricsCollector struct {
 	awsLimitDelta                   *prometheus.GaugeVec
 	availableOSDAccounts            *prometheus.GaugeVec
 	accountsProgressing             *prometheus.GaugeVec
+	accountPoolClaimRate            *prometheus.GaugeVec
+	accountPoolExhaustion           *prometheus.GaugeVec
 	accountReadyDuration            prometheus.Histogram
 	ccsAccountReadyDuration         prometheus.Histogram
 	accountClaimReadyDuration       prometheus.Histogram
@@ -59,8 +84,26 @@ type MetricsCollector struct {
 	accountClaimPendingDuration     prometheus.Histogram
 	ccsAccountClaimPendingDuration  prometheus.Histogram
 	accountReuseCleanupDuration     prometheus.Histogram
+	accountReuseSpendFreezeDuration prometheus.Histogram
 	accountReuseCleanupFailureCount prometheus.Counter
+	accountClaimsBlocked            *prometheus.GaugeVec
+	accountClaimsEscalated          *prometheus.CounterVec
+	accountClaimsUnsupported        *prometheus.CounterVec
+	ownershipTagsBatchPending       prometheus.Gauge
+	ownershipTagsBatchUpdates       *prometheus.CounterVec
+	organizationsAccessDenied       prometheus.Gauge
+	accountsPendingQuotaRollback    prometheus.Gauge
+	accountsByReuseCount            *prometheus.GaugeVec
+	accountsByAge                   *prometheus.GaugeVec
+	accountsRetired                 *prometheus.CounterVec
+	accountLinkConflicts            *prometheus.GaugeVec
 	reconcileDuration               *prometheus.HistogramVec
+	reconcileOutcomes               *prometheus.CounterVec
+	reconcilePanics                 *prometheus.CounterVec
+	watchEvents                     *prometheus.CounterVec
+	secretAccesses                  *prometheus.CounterVec
+	customResources                 *prometheus.GaugeVec
+	storageVersionMigration         *prometheus.GaugeVec
 	apiCallDuration                 *prometheus.HistogramVec
 }
 
@@ -73,6 +116,11 @@ func NewMetricsCollector(store cache.Cache) *MetricsCollector {
 			Help:        "Report how many accounts have been created in AWS org",
 			ConstLabels: prometheus.Labels{"name": operatorName},
 		}),
+		accountCapacityRemaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_account_capacity_remaining",
+			Help:        "Report how many more accounts can be created in AWS org before reaching the account limit",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}),
 		accounts: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name:        "aws_account_operator_account_crs",
 			Help:        "Report how many account crs in the cluster",
@@ -121,6 +169,18 @@ func NewMetricsCollector(store cache.Cache) *MetricsCollector {
 			ConstLabels: prometheus.Labels{"name": operatorName},
 		}, []string{"namespace", "pool_name"}),
 
+		accountPoolClaimRate: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_account_pool_claims_per_hour",
+			Help:        "Rate at which the accounts of the pool were claimed over the last 24 hours",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"namespace", "pool_name"}),
+
+		accountPoolExhaustion: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_account_pool_exhaustion_timestamp_seconds",
+			Help:        "Unix time the available accounts of the pool run out at the claim rate of the last 24 hours",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"namespace", "pool_name"}),
+
 		accountReadyDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
 			Name:        "aws_account_operator_account_ready_duration_seconds",
 			Help:        "The duration for account cr to get ready",
@@ -165,18 +225,109 @@ func NewMetricsCollector(store cache.Cache) *MetricsCollector {
 			ConstLabels: prometheus.Labels{"name": operatorName},
 			Buckets:     []float64{1, 3, 5, 10, 15, 20, 30},
 		}),
+		accountReuseSpendFreezeDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:        "aws_account_operator_account_reuse_spend_freeze_duration_seconds",
+			Help:        "The duration until the billing-impacting resources of a reused account are deleted",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+			Buckets:     []float64{1, 3, 5, 10, 15, 20, 30},
+		}),
 
 		accountReuseCleanupFailureCount: prometheus.NewCounter(prometheus.CounterOpts{
 			Name:        "aws_account_operator_account_reuse_cleanup_failures_total",
 			Help:        "Number of account reuse cleanup failures",
 			ConstLabels: prometheus.Labels{"name": operatorName},
 		}),
+		accountClaimsBlocked: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_account_claim_blocked_by_finalizers_seconds",
+			Help:        "Seconds a deleted account claim has been blocked by finalizers of other controllers",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"namespace", "claim"}),
+		accountClaimsEscalated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_account_claims_escalated_total",
+			Help:        "Account claims that weren't Ready within their fulfillment deadline",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"ccs"}),
+		accountClaimsUnsupported: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_account_claims_unsupported_in_partition_total",
+			Help:        "Account claims requesting a capability the AWS partition doesn't have",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"partition", "capability"}),
+		ownershipTagsBatchPending: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_ownership_tags_batch_pending",
+			Help:        "AWS accounts waiting for their ownership tags to be synced by the tag batch",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}),
+		ownershipTagsBatchUpdates: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_ownership_tags_batch_updates_total",
+			Help:        "AWS accounts whose ownership tags were synced by the tag batch, by result",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"result"}),
+		organizationsAccessDenied: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_organizations_access_denied",
+			Help:        "1 while the account state transitions are frozen because the operator is denied Organizations access",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}),
+		accountsPendingQuotaRollback: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_accounts_pending_quota_rollback",
+			Help:        "Accounts with quotas raised for a former claim which have to be lowered back",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}),
+		accountsByReuseCount: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_accounts_by_reuse_count",
+			Help:        "Report how many accounts of each pool were reused how many times, by bucket of reuse counts",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"account_pool", "reuse_count"}),
+		accountsByAge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_accounts_by_age",
+			Help:        "Report how many accounts of each pool are how old since their creation, by bucket of ages",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"account_pool", "age"}),
+		accountsRetired: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_accounts_retired_total",
+			Help:        "Number of accounts retired for reaching a limit of their pool, by reason: MaxReuseCount or MaxAccountAge",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"reason"}),
+		accountLinkConflicts: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_account_claim_link_conflicts",
+			Help:        "Account claims blocked because their account is linked to another claim",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"namespace", "claim", "account"}),
 		reconcileDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Name:        "aws_account_operator_reconcile_duration_seconds",
 			Help:        "Distribution of the number of seconds a Reconcile takes, broken down by controller",
 			ConstLabels: prometheus.Labels{"name": operatorName},
 			Buckets:     []float64{0.001, 0.01, 0.1, 1, 5, 10, 20},
 		}, []string{"controller", "error", "error_source"}),
+		reconcileOutcomes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_reconcile_outcomes_total",
+			Help:        "Number of reconciles by controller and outcome: success, error, requeue or requeue_after",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"controller", "outcome"}),
+		reconcilePanics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_reconcile_panics_total",
+			Help:        "Number of reconciles that panicked and were recovered, by controller",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"controller"}),
+		watchEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_watch_events_total",
+			Help:        "Number of watch events by controller and result: processed or skipped",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"controller", "result"}),
+		secretAccesses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aws_account_operator_secret_accesses_total",
+			Help:        "Number of Secret reads and writes by controller, verb and result: success or error",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"controller", "verb", "result"}),
+		customResources: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_custom_resources",
+			Help:        "Report how many custom resources of the operator are in the cluster, by kind and state",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"kind", "state"}),
+		storageVersionMigration: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "aws_account_operator_storage_version_migration_objects",
+			Help:        "Objects of a CRD rewritten to its storage version by the startup migration, by state: total, migrated or failed",
+			ConstLabels: prometheus.Labels{"name": operatorName},
+		}, []string{"crd", "state"}),
 
 		// apiCallDuration times API requests. Histogram also gives us a _count metric for free.
 		apiCallDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
@@ -193,6 +344,7 @@ func NewMetricsCollector(store cache.Cache) *MetricsCollector {
 // Describe implements the prometheus.Collector interface.
 func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.awsAccounts.Describe(ch)
+	c.accountCapacityRemaining.Describe(ch)
 	c.accounts.Describe(ch)
 	c.ccsAccounts.Describe(ch)
 	c.accountClaims.Describe(ch)
@@ -200,6 +352,8 @@ func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.awsLimitDelta.Describe(ch)
 	c.availableOSDAccounts.Describe(ch)
 	c.accountsProgressing.Describe(ch)
+	c.accountPoolClaimRate.Describe(ch)
+	c.accountPoolExhaustion.Describe(ch)
 	c.accountPoolSize.Describe(ch)
 	c.accountPoolSize.Describe(ch)
 	c.accountReuseAvailable.Describe(ch)
@@ -210,8 +364,26 @@ func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.accountClaimPendingDuration.Describe(ch)
 	c.ccsAccountClaimPendingDuration.Describe(ch)
 	c.accountReuseCleanupDuration.Describe(ch)
+	c.accountReuseSpendFreezeDuration.Describe(ch)
 	c.accountReuseCleanupFailureCount.Describe(ch)
+	c.accountClaimsBlocked.Describe(ch)
+	c.accountClaimsEscalated.Describe(ch)
+	c.accountClaimsUnsupported.Describe(ch)
+	c.ownershipTagsBatchPending.Describe(ch)
+	c.ownershipTagsBatchUpdates.Describe(ch)
+	c.organizationsAccessDenied.Describe(ch)
+	c.accountsPendingQuotaRollback.Describe(ch)
+	c.accountsByReuseCount.Describe(ch)
+	c.accountsByAge.Describe(ch)
+	c.accountsRetired.Describe(ch)
+	c.accountLinkConflicts.Describe(ch)
 	c.reconcileDuration.Describe(ch)
+	c.reconcileOutcomes.Describe(ch)
+	c.reconcilePanics.Describe(ch)
+	c.watchEvents.Describe(ch)
+	c.secretAccesses.Describe(ch)
+	c.customResources.Describe(ch)
+	c.storageVersionMigration.Describe(ch)
 	c.apiCallDuration.Describe(ch)
 }
 
@@ -219,6 +391,7 @@ func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
 	c.collect()
 	c.awsAccounts.Collect(ch)
+	c.accountCapacityRemaining.Collect(ch)
 	c.accounts.Collect(ch)
 	c.ccsAccounts.Collect(ch)
 	c.accountClaims.Collect(ch)
@@ -226,6 +399,8 @@ func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
 	c.awsLimitDelta.Collect(ch)
 	c.availableOSDAccounts.Collect(ch)
 	c.accountsProgressing.Collect(ch)
+	c.accountPoolClaimRate.Collect(ch)
+	c.accountPoolExhaustion.Collect(ch)
 	c.accountReuseAvailable.Collect(ch)
 	c.accountReadyDuration.Collect(ch)
 	c.ccsAccountReadyDuration.Collect(ch)
@@ -234,8 +409,26 @@ func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
 	c.accountClaimPendingDuration.Collect(ch)
 	c.ccsAccountClaimPendingDuration.Collect(ch)
 	c.accountReuseCleanupDuration.Collect(ch)
+	c.accountReuseSpendFreezeDuration.Collect(ch)
 	c.accountReuseCleanupFailureCount.Collect(ch)
+	c.accountClaimsBlocked.Collect(ch)
+	c.accountClaimsEscalated.Collect(ch)
+	c.accountClaimsUnsupported.Collect(ch)
+	c.ownershipTagsBatchPending.Collect(ch)
+	c.ownershipTagsBatchUpdates.Collect(ch)
+	c.organizationsAccessDenied.Collect(ch)
+	c.accountsPendingQuotaRollback.Collect(ch)
+	c.accountsByReuseCount.Collect(ch)
+	c.accountsByAge.Collect(ch)
+	c.accountsRetired.Collect(ch)
+	c.accountLinkConflicts.Collect(ch)
 	c.reconcileDuration.Collect(ch)
+	c.reconcileOutcomes.Collect(ch)
+	c.reconcilePanics.Collect(ch)
+	c.watchEvents.Collect(ch)
+	c.secretAccesses.Collect(ch)
+	c.customResources.Collect(ch)
+	c.storageVersionMigration.Collect(ch)
 	c.apiCallDuration.Collect(ch)
 }
 
@@ -250,6 +443,11 @@ func (c *MetricsCollector) collect() {
 	c.availableOSDAccounts.Reset()
 	c.accountsProgressing.Reset()
 	c.accountReuseAvailable.Reset()
+	c.accountClaimsBlocked.Reset()
+	c.accountLinkConflicts.Reset()
+	c.customResources.Reset()
+	c.accountsByReuseCount.Reset()
+	c.accountsByAge.Reset()
 
 	ctx := context.TODO()
 	var (
@@ -275,7 +473,11 @@ func (c *MetricsCollector) collect() {
 		return
 	}
 
+	pendingQuotaRollback := 0
 	for _, account := range accounts.Items {
+		if account.Annotations[awsv1alpha1.QuotaRollbackAnnotation] != "" {
+			pendingQuotaRollback++
+		}
 		if account.Status.Claimed {
 			claimed = "true"
 		} else {
@@ -293,15 +495,37 @@ func (c *MetricsCollector) collect() {
 			c.accountReuseAvailable.WithLabelValues(account.Spec.LegalEntity.ID).Inc()
 		}
 
+		c.customResources.WithLabelValues("Account", account.Status.State).Inc()
 		if account.Spec.BYOC {
 			c.ccsAccounts.WithLabelValues(claimed, reused, account.Status.State).Inc()
 		} else {
 			c.accounts.WithLabelValues(claimed, reused, account.Status.State).Inc()
+			c.accountsByReuseCount.WithLabelValues(account.Spec.AccountPool, reuseCountBucket(account.Status.ReuseCount)).Inc()
+			c.accountsByAge.WithLabelValues(account.Spec.AccountPool, accountAgeBucket(time.Since(account.CreationTimestamp.Time))).Inc()
 		}
 	}
 
+	c.accountsPendingQuotaRollback.Set(float64(pendingQuotaRollback))
+
 	for _, accountClaim := range accountClaims.Items {
 		c.accountClaims.WithLabelValues(string(accountClaim.Status.State)).Inc()
+		c.customResources.WithLabelValues("AccountClaim", string(accountClaim.Status.State)).Inc()
+
+		for _, condition := range accountClaim.Status.Conditions {
+			if condition.Type == awsv1alpha1.AccountLinkConflict && condition.Status == corev1.ConditionTrue {
+				c.accountLinkConflicts.WithLabelValues(accountClaim.Namespace, accountClaim.Name, accountClaim.Spec.AccountLink).Set(1)
+			}
+		}
+
+		if accountClaim.DeletionTimestamp == nil {
+			continue
+		}
+		for _, condition := range accountClaim.Status.Conditions {
+			if condition.Type == awsv1alpha1.BlockedByFinalizers && condition.Status == corev1.ConditionTrue {
+				blocked := time.Since(accountClaim.DeletionTimestamp.Time).Seconds()
+				c.accountClaimsBlocked.WithLabelValues(accountClaim.Namespace, accountClaim.Name).Set(blocked)
+			}
+		}
 	}
 
 	for _, pool := range accountPool.Items {
@@ -310,6 +534,55 @@ func (c *MetricsCollector) collect() {
 		c.availableOSDAccounts.WithLabelValues(pool.Namespace, pool.Name).Set(float64(pool.Status.AvailableAccounts))
 		c.accountsProgressing.WithLabelValues(pool.Namespace, pool.Name).Set(float64(pool.Status.AccountsProgressing))
 	}
+
+	var federatedRoles awsv1alpha1.AWSFederatedRoleList
+	if err := c.store.List(ctx, &federatedRoles); err != nil {
+		log.Error(err, "failed to list federated roles")
+	}
+	for _, role := range federatedRoles.Items {
+		c.customResources.WithLabelValues("AWSFederatedRole", string(role.Status.State)).Inc()
+	}
+
+	var federatedAccountAccesses awsv1alpha1.AWSFederatedAccountAccessList
+	if err := c.store.List(ctx, &federatedAccountAccesses); err != nil {
+		log.Error(err, "failed to list federated account accesses")
+	}
+	for _, access := range federatedAccountAccesses.Items {
+		c.customResources.WithLabelValues("AWSFederatedAccountAccess", string(access.Status.State)).Inc()
+	}
+}
+
+// reuseCountBucket returns the bucket of the aws_account_operator_accounts_by_reuse_count metric an account reused
+// count times is counted in
+func reuseCountBucket(count int) string {
+	switch {
+	case count <= 1:
+		return strconv.Itoa(count)
+	case count < 5:
+		return "2-4"
+	case count < 10:
+		return "5-9"
+	default:
+		return "10+"
+	}
+}
+
+// accountAgeBucket returns the bucket of the aws_account_operator_accounts_by_age metric an account created age ago is
+// counted in
+func accountAgeBucket(age time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case age < 30*day:
+		return "0-30d"
+	case age < 90*day:
+		return "30-90d"
+	case age < 180*day:
+		return "90-180d"
+	case age < 365*day:
+		return "180-365d"
+	default:
+		return "365d+"
+	}
 }
 
 // SetTotalAWSAccounts sets the metric watching the total number of AWS accounts known by the operator
@@ -317,6 +590,12 @@ func (c *MetricsCollector) SetTotalAWSAccounts(total int) {
 	c.awsAccounts.Set(float64(total))
 }
 
+// SetAccountCapacityRemaining sets the metric watching how many more AWS accounts the organization can have before
+// reaching the account limit
+func (c *MetricsCollector) SetAccountCapacityRemaining(remaining int) {
+	c.accountCapacityRemaining.Set(float64(remaining))
+}
+
 // SetAccountReadyDuration sets the metric describing the time it takes for an account to go into the Ready state
 func (c *MetricsCollector) SetAccountReadyDuration(ccs bool, duration float64) {
 	if ccs {
@@ -349,11 +628,30 @@ func (c *MetricsCollector) SetAccountReusedCleanupDuration(duration float64) {
 	c.accountReuseCleanupDuration.Observe(duration)
 }
 
+// SetAccountReuseSpendFreezeDuration sets the metric describing the time it takes to delete the billing-impacting
+// resources of a reused account
+func (c *MetricsCollector) SetAccountReuseSpendFreezeDuration(duration float64) {
+	c.accountReuseSpendFreezeDuration.Observe(duration)
+}
+
+// SetStorageVersionMigrationProgress sets the number of objects of the CRD the storage version migration went
+// through so far
+func (c *MetricsCollector) SetStorageVersionMigrationProgress(crd string, total int, migrated int, failed int) {
+	c.storageVersionMigration.With(prometheus.Labels{"crd": crd, "state": "total"}).Set(float64(total))
+	c.storageVersionMigration.With(prometheus.Labels{"crd": crd, "state": "migrated"}).Set(float64(migrated))
+	c.storageVersionMigration.With(prometheus.Labels{"crd": crd, "state": "failed"}).Set(float64(failed))
+}
+
 // AddAccountReuseCleanupFailure describes the number of accounts that have failed reuse
 func (c *MetricsCollector) AddAccountReuseCleanupFailure() {
 	c.accountReuseCleanupFailureCount.Inc()
 }
 
+// AddAccountRetired counts an account retired for reaching the limit reason of its pool
+func (c *MetricsCollector) AddAccountRetired(reason string) {
+	c.accountsRetired.WithLabelValues(reason).Inc()
+}
+
 type ReportedError struct {
 	Source string
 	Code   string
@@ -386,6 +684,75 @@ func (c *MetricsCollector) SetReconcileDuration(controller string, duration floa
 	c.reconcileDuration.WithLabelValues(controller, e.Code, e.Source).Observe(duration)
 }
 
+// AddAccountClaimEscalated counts a claim that wasn't Ready within its fulfillment deadline
+func (c *MetricsCollector) AddAccountClaimEscalated(ccs bool) {
+	c.accountClaimsEscalated.WithLabelValues(strconv.FormatBool(ccs)).Inc()
+}
+
+// AddAccountClaimUnsupportedInPartition counts a claim requesting a capability the partition doesn't have
+func (c *MetricsCollector) AddAccountClaimUnsupportedInPartition(partition string, capability string) {
+	c.accountClaimsUnsupported.WithLabelValues(partition, capability).Inc()
+}
+
+// SetOwnershipTagsBatchPending sets the number of AWS accounts waiting in the ownership tag batch
+func (c *MetricsCollector) SetOwnershipTagsBatchPending(pending int) {
+	c.ownershipTagsBatchPending.Set(float64(pending))
+}
+
+// AddOwnershipTagsBatchUpdate counts an AWS account synced by the ownership tag batch, result is one of applied,
+// unchanged and failed
+func (c *MetricsCollector) AddOwnershipTagsBatchUpdate(result string) {
+	c.ownershipTagsBatchUpdates.WithLabelValues(result).Inc()
+}
+
+// SetOrganizationsAccessDenied sets whether the account state transitions are frozen because the operator is denied
+// Organizations access
+func (c *MetricsCollector) SetOrganizationsAccessDenied(denied bool) {
+	value := 0.0
+	if denied {
+		value = 1
+	}
+	c.organizationsAccessDenied.Set(value)
+}
+
+// AddReconcileOutcome counts a reconcile of controller by its outcome, one of the ReconcileOutcome constants
+func (c *MetricsCollector) AddReconcileOutcome(controller string, outcome string) {
+	c.reconcileOutcomes.WithLabelValues(controller, outcome).Inc()
+}
+
+// SetAccountPoolForecast sets the claim rate of the pool and when its available accounts run out at that rate, the
+// latter is removed when exhaustion is nil, i.e. the accounts of the pool aren't claimed
+func (c *MetricsCollector) SetAccountPoolForecast(namespace string, pool string, claimsPerHour float64, exhaustion *time.Time) {
+	c.accountPoolClaimRate.WithLabelValues(namespace, pool).Set(claimsPerHour)
+	if exhaustion == nil {
+		c.accountPoolExhaustion.DeleteLabelValues(namespace, pool)
+		return
+	}
+	c.accountPoolExhaustion.WithLabelValues(namespace, pool).Set(float64(exhaustion.Unix()))
+}
+
+// DeleteAccountPoolForecast removes the forecast of a deleted pool
+func (c *MetricsCollector) DeleteAccountPoolForecast(namespace string, pool string) {
+	c.accountPoolClaimRate.DeleteLabelValues(namespace, pool)
+	c.accountPoolExhaustion.DeleteLabelValues(namespace, pool)
+}
+
+// AddReconcilePanic counts a panic of a reconcile of controller that was recovered
+func (c *MetricsCollector) AddReconcilePanic(controller string) {
+	c.reconcilePanics.WithLabelValues(controller).Inc()
+}
+
+// AddWatchEvent counts a watch event of controller by its result, one of the WatchEvent constants
+func (c *MetricsCollector) AddWatchEvent(controller string, result string) {
+	c.watchEvents.WithLabelValues(controller, result).Inc()
+}
+
+// AddSecretAccess counts a Secret access of controller by its verb, e.g. get or update, and its result, one of the
+// SecretAccess constants
+func (c *MetricsCollector) AddSecretAccess(controller string, verb string, result string) {
+	c.secretAccesses.WithLabelValues(controller, verb, result).Inc()
+}
+
 // AddAPICall observes metrics for a call to an external API
 // - param controller: The name of the controller making the API call
 // - param req: The HTTP Request structure
@@ -0,0 +1,176 @@
+package storageversion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+// migrationPageSize is how many objects are listed at once while migrating a CRD
+const migrationPageSize = 100
+
+// Result is the outcome of the migration of a CRD
+type Result struct {
+	CRD      string
+	Total    int
+	Migrated int
+	Failed   int
+}
+
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=update
+
+// MigrateAll migrates the objects of the operator's CRDs still stored in an older version, see Migrate. It goes on
+// with the other CRDs when one fails to migrate.
+func MigrateAll(ctx context.Context, reqLogger logr.Logger, c client.Client) ([]Result, error) {
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := c.List(ctx, crds); err != nil {
+		return nil, fmt.Errorf("failed listing CRDs: %w", err)
+	}
+
+	results := []Result{}
+	var errs []error
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if crd.Spec.Group != awsv1alpha1.GroupVersion.Group {
+			continue
+		}
+		result, err := Migrate(ctx, reqLogger, c, crd)
+		if result != nil {
+			results = append(results, *result)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("failed migrating the storage version of %d CRDs: %v", len(errs), errs)
+	}
+	return results, nil
+}
+
+// Migrate rewrites every object of the CRD in its current storage version when older versions are still recorded as
+// stored, then verifies they can be read in every served version before dropping the older versions from the stored
+// versions of the CRD, so removing them from the CRD later doesn't strand objects. It returns nil when there is nothing
+// to migrate. Its progress is reported in aws_account_operator_storage_version_migration_objects.
+func Migrate(ctx context.Context, reqLogger logr.Logger, c client.Client, crd *apiextensionsv1.CustomResourceDefinition) (*Result, error) {
+	storageVersion := ""
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+		}
+	}
+	if storageVersion == "" {
+		return nil, fmt.Errorf("CRD %s has no storage version", crd.Name)
+	}
+	if !needsMigration(crd, storageVersion) {
+		return nil, nil
+	}
+
+	reqLogger.Info("Migrating the storage version of the CRD", "CRD", crd.Name, "storedVersions", crd.Status.StoredVersions, "storageVersion", storageVersion)
+	result := &Result{CRD: crd.Name}
+	gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: storageVersion, Kind: crd.Spec.Names.ListKind}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk)
+	options := &client.ListOptions{Limit: migrationPageSize}
+	for {
+		if err := c.List(ctx, list, options); err != nil {
+			return result, fmt.Errorf("failed listing the objects of CRD %s: %w", crd.Name, err)
+		}
+		result.Total += len(list.Items)
+		for i := range list.Items {
+			if err := rewrite(ctx, c, &list.Items[i]); err != nil {
+				reqLogger.Error(err, "failed migrating object", "CRD", crd.Name, "namespace", list.Items[i].GetNamespace(), "name", list.Items[i].GetName())
+				result.Failed++
+				continue
+			}
+			result.Migrated++
+		}
+		reportProgress(result)
+		if list.GetContinue() == "" {
+			break
+		}
+		options.Continue = list.GetContinue()
+	}
+	if result.Failed > 0 {
+		return result, fmt.Errorf("failed migrating %d objects of CRD %s", result.Failed, crd.Name)
+	}
+
+	if err := verifyConversion(ctx, c, crd); err != nil {
+		return result, err
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := c.Status().Update(ctx, crd); err != nil {
+		return result, fmt.Errorf("failed updating the stored versions of CRD %s: %w", crd.Name, err)
+	}
+	reqLogger.Info("Migrated the storage version of the CRD", "CRD", crd.Name, "objects", result.Migrated)
+	return result, nil
+}
+
+// needsMigration returns whether versions other than the storage version are recorded as stored for the CRD
+func needsMigration(crd *apiextensionsv1.CustomResourceDefinition, storageVersion string) bool {
+	for _, version := range crd.Status.StoredVersions {
+		if version != storageVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// rewrite updates the object unchanged, which has the API server store it in the current storage version. Objects
+// deleted meanwhile are done with.
+func rewrite(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := c.Update(ctx, obj)
+		if !k8serr.IsConflict(err) {
+			return err
+		}
+		if getErr := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); getErr != nil {
+			return getErr
+		}
+		return err
+	})
+	if k8serr.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// verifyConversion lists the objects of the CRD in every served version, which fails when they can't be converted
+func verifyConversion(ctx context.Context, c client.Client, crd *apiextensionsv1.CustomResourceDefinition) error {
+	for _, version := range crd.Spec.Versions {
+		if !version.Served {
+			continue
+		}
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.ListKind})
+		options := &client.ListOptions{Limit: migrationPageSize}
+		for {
+			if err := c.List(ctx, list, options); err != nil {
+				return fmt.Errorf("objects of CRD %s fail to convert to version %s: %w", crd.Name, version.Name, err)
+			}
+			if list.GetContinue() == "" {
+				break
+			}
+			options.Continue = list.GetContinue()
+		}
+	}
+	return nil
+}
+
+func reportProgress(result *Result) {
+	if localmetrics.Collector != nil {
+		localmetrics.Collector.SetStorageVersionMigrationProgress(result.CRD, result.Total, result.Migrated, result.Failed)
+	}
+}
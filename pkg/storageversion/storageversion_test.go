@@ -0,0 +1,81 @@
+package storageversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+)
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, awsv1alpha1.AddToScheme(scheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func accountsCRD(storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "accounts.aws.managed.openshift.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: awsv1alpha1.GroupVersion.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Account", ListKind: "AccountList"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha0", Served: false},
+				{Name: "v1alpha1", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+}
+
+func TestMigrateAll(t *testing.T) {
+	c := newFakeClient(t,
+		accountsCRD("v1alpha0", "v1alpha1"),
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "proxies.config.openshift.io"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group:    "config.openshift.io",
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1", Served: true, Storage: true}},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1beta1", "v1"}},
+		},
+		&awsv1alpha1.Account{ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace}},
+		&awsv1alpha1.Account{ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-bbbbbb", Namespace: awsv1alpha1.AccountCrNamespace}},
+	)
+
+	results, err := MigrateAll(context.TODO(), testutils.NewTestLogger().Logger(), c)
+	require.NoError(t, err)
+	assert.Equal(t, []Result{{CRD: "accounts.aws.managed.openshift.io", Total: 2, Migrated: 2}}, results)
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, c.Get(context.TODO(), client.ObjectKey{Name: "accounts.aws.managed.openshift.io"}, crd))
+	assert.Equal(t, []string{"v1alpha1"}, crd.Status.StoredVersions)
+
+	// CRDs of other groups are left alone
+	require.NoError(t, c.Get(context.TODO(), client.ObjectKey{Name: "proxies.config.openshift.io"}, crd))
+	assert.Equal(t, []string{"v1beta1", "v1"}, crd.Status.StoredVersions)
+
+	// Nothing is left to migrate on the next start
+	results, err = MigrateAll(context.TODO(), testutils.NewTestLogger().Logger(), c)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestMigrateWithoutStorageVersion(t *testing.T) {
+	crd := accountsCRD("v1alpha1")
+	crd.Spec.Versions[1].Storage = false
+
+	result, err := Migrate(context.TODO(), testutils.NewTestLogger().Logger(), newFakeClient(t, crd), crd)
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
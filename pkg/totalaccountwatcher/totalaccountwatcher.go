@@ -134,6 +134,7 @@ func (s *AccountWatcher) UpdateTotalAccounts(log logr.Logger) error {
 		return err
 	}
 	s.accountsCanBeCreated = (!limitReached)
+	localmetrics.Collector.SetAccountCapacityRemaining(s.CapacityRemaining())
 	return nil
 }
 
@@ -211,6 +212,14 @@ func (s *AccountWatcher) GetLimit() int {
 	return s.limit
 }
 
+// CapacityRemaining returns how many more accounts can be created before reaching the limit
+func (s *AccountWatcher) CapacityRemaining() int {
+	if s.total >= s.limit {
+		return 0
+	}
+	return s.limit - s.total
+}
+
 // accountLimitReached returns True if our account limit is reached or False if the account limit is not reached and we can create accounts.
 func (s *AccountWatcher) accountLimitReached(log logr.Logger, currentAccounts int) (bool, error) {
 	limit, err := s.getAwsAccountLimit()
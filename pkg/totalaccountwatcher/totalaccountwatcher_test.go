@@ -337,6 +337,7 @@ func TestTotalAccountsUpdate(t *testing.T) {
 	tests := []struct {
 		name         string
 		expected     bool
+		remaining    int
 		configMap    corev1.ConfigMap
 		expectErr    bool
 		setupAWSMock func(r *mockAWS.MockClientMockRecorder)
@@ -465,6 +466,7 @@ func TestTotalAccountsUpdate(t *testing.T) {
 			name:      "Returns higher Limit than Current Accounts",
 			expected:  true,
 			expectErr: false,
+			remaining: 4,
 			configMap: corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      awsv1alpha1.DefaultConfigMap,
@@ -521,6 +523,7 @@ func TestTotalAccountsUpdate(t *testing.T) {
 			name:      "Returns Limit above Current+Creating Accounts",
 			expected:  true,
 			expectErr: false,
+			remaining: 7,
 			configMap: corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      awsv1alpha1.DefaultConfigMap,
@@ -577,6 +580,13 @@ func TestTotalAccountsUpdate(t *testing.T) {
 						"expected:", test.expected,
 					)
 				}
+
+				if TotalAccountWatcher.CapacityRemaining() != test.remaining {
+					t.Error(
+						"got:", TotalAccountWatcher.CapacityRemaining(),
+						"expected remaining:", test.remaining,
+					)
+				}
 			},
 		)
 	}
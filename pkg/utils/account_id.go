@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// AccountAwsIDIndex indexes the cached Accounts by the ID of their AWS account
+const AccountAwsIDIndex = "spec.awsAccountID"
+
+// awsAccountIDPattern is the format of AWS account IDs, 12 digits
+var awsAccountIDPattern = regexp.MustCompile(`^[0-9]{12}$`)
+
+// IsValidAwsAccountID returns whether id is an AWS account ID
+func IsValidAwsAccountID(id string) bool {
+	return awsAccountIDPattern.MatchString(id)
+}
+
+// SetAccountIDLabel sets the AccountIDLabel of the account to the ID of its AWS account, so the Account of an AWS
+// account can be found with a label selector. The label is removed while the account has no valid AWS account ID. It
+// returns whether the labels changed.
+func SetAccountIDLabel(account *awsv1alpha1.Account) bool {
+	current, labeled := account.Labels[awsv1alpha1.AccountIDLabel]
+	if !IsValidAwsAccountID(account.Spec.AwsAccountID) {
+		if labeled {
+			delete(account.Labels, awsv1alpha1.AccountIDLabel)
+		}
+		return labeled
+	}
+	if current == account.Spec.AwsAccountID {
+		return false
+	}
+	if account.Labels == nil {
+		account.Labels = map[string]string{}
+	}
+	account.Labels[awsv1alpha1.AccountIDLabel] = account.Spec.AwsAccountID
+	return true
+}
+
+// IndexAccounts adds the account indexes to the manager cache
+func IndexAccounts(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &awsv1alpha1.Account{}, AccountAwsIDIndex, accountAwsID)
+}
+
+// accountAwsID returns the ID of the AWS account of the Account
+func accountAwsID(obj client.Object) []string {
+	account, ok := obj.(*awsv1alpha1.Account)
+	if !ok || account.Spec.AwsAccountID == "" {
+		return nil
+	}
+	return []string{account.Spec.AwsAccountID}
+}
+
+// AccountsForAwsAccountID returns the Accounts of the AWS account awsAccountID from the AccountAwsIDIndex. CCS accounts
+// may have several, e.g. while a claim of the same AWS account is recreated.
+func AccountsForAwsAccountID(ctx context.Context, c client.Reader, awsAccountID string) ([]awsv1alpha1.Account, error) {
+	accounts := &awsv1alpha1.AccountList{}
+	err := c.List(ctx, accounts, client.InNamespace(awsv1alpha1.AccountCrNamespace), client.MatchingFields{AccountAwsIDIndex: awsAccountID})
+	return accounts.Items, err
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+var _ = Describe("Account ID", func() {
+	var account *awsv1alpha1.Account
+
+	BeforeEach(func() {
+		account = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "osd-creds-mgmt-aaaaaa", Namespace: awsv1alpha1.AccountCrNamespace},
+			Spec:       awsv1alpha1.AccountSpec{AwsAccountID: "123456789012"},
+		}
+	})
+
+	It("Should only accept 12 digit account IDs", func() {
+		Expect(IsValidAwsAccountID("123456789012")).To(BeTrue())
+		Expect(IsValidAwsAccountID("12345678901")).To(BeFalse())
+		Expect(IsValidAwsAccountID("1234567890123")).To(BeFalse())
+		Expect(IsValidAwsAccountID("12345678901a")).To(BeFalse())
+		Expect(IsValidAwsAccountID(" 123456789012")).To(BeFalse())
+	})
+
+	It("Should label the account with its AWS account ID", func() {
+		Expect(SetAccountIDLabel(account)).To(BeTrue())
+		Expect(account.Labels).To(HaveKeyWithValue(awsv1alpha1.AccountIDLabel, "123456789012"))
+		Expect(SetAccountIDLabel(account)).To(BeFalse())
+
+		By("correcting a stale label")
+		account.Spec.AwsAccountID = "210987654321"
+		Expect(SetAccountIDLabel(account)).To(BeTrue())
+		Expect(account.Labels).To(HaveKeyWithValue(awsv1alpha1.AccountIDLabel, "210987654321"))
+
+		By("removing the label of an invalid ID")
+		account.Spec.AwsAccountID = "not-an-id"
+		Expect(SetAccountIDLabel(account)).To(BeTrue())
+		Expect(account.Labels).NotTo(HaveKey(awsv1alpha1.AccountIDLabel))
+		Expect(SetAccountIDLabel(account)).To(BeFalse())
+	})
+
+	It("Should find the accounts of an AWS account through the index", func() {
+		other := account.DeepCopy()
+		other.Name = "osd-creds-mgmt-bbbbbb"
+		other.Spec.AwsAccountID = "210987654321"
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithIndex(&awsv1alpha1.Account{}, AccountAwsIDIndex, accountAwsID).
+			WithObjects(account, other).Build()
+
+		accounts, err := AccountsForAwsAccountID(context.TODO(), c, "123456789012")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(accounts).To(HaveLen(1))
+		Expect(accounts[0].Name).To(Equal("osd-creds-mgmt-aaaaaa"))
+	})
+})
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// ManagedLabel marks the Secrets and ConfigMaps the operator reads, so they're cached when the cache is restricted to
+// labeled objects
+const ManagedLabel = "aws.managed.openshift.io/managed"
+
+// CacheSelectors restricts the objects cached by the manager. Accounts, AccountPools, ConfigMaps and Secrets are only
+// cached in the operator namespace. With labeledOnly, ConfigMaps and Secrets are only cached when they carry
+// ManagedLabel, in any namespace. Roles and RoleBindings are only cached when they carry ManagedLabel, they're
+// the ones granting access to the delivered secrets. So are the Jobs, the operator only reads the pre-reset hook Jobs
+// it created.
+func CacheSelectors(labeledOnly bool) cache.SelectorsByObject {
+	inOperatorNamespace := cache.ObjectSelector{Field: fields.OneTermEqualSelector("metadata.namespace", awsv1alpha1.AccountCrNamespace)}
+	labeled := cache.ObjectSelector{Label: labels.SelectorFromSet(labels.Set{ManagedLabel: "true"})}
+	managed := inOperatorNamespace
+	if labeledOnly {
+		managed = labeled
+	}
+	return cache.SelectorsByObject{
+		&awsv1alpha1.Account{}:     inOperatorNamespace,
+		&awsv1alpha1.AccountPool{}: inOperatorNamespace,
+		&corev1.ConfigMap{}:        managed,
+		&corev1.Secret{}:           managed,
+		&rbacv1.Role{}:             labeled,
+		&rbacv1.RoleBinding{}:      labeled,
+		&batchv1.Job{}:             labeled,
+	}
+}
+
+// NewCacheScopedClient returns the constructor of the manager client matching CacheSelectors. Secrets and ConfigMaps
+// the cache doesn't hold are read from the API server: the ones outside the operator namespace or, with labeledOnly,
+// the ones the cache doesn't find.
+func NewCacheScopedClient(labeledOnly bool) cluster.NewClientFunc {
+	return func(cache cache.Cache, config *rest.Config, options client.Options, uncachedObjects ...client.Object) (client.Client, error) {
+		cached, err := cluster.DefaultNewClient(cache, config, options, uncachedObjects...)
+		if err != nil {
+			return nil, err
+		}
+		direct, err := client.New(config, options)
+		if err != nil {
+			return nil, err
+		}
+		return &cacheScopedClient{Client: cached, direct: direct, labeledOnly: labeledOnly}, nil
+	}
+}
+
+// cacheScopedClient reads the Secrets and ConfigMaps the cache doesn't hold with the direct reader
+type cacheScopedClient struct {
+	client.Client
+	direct      client.Reader
+	labeledOnly bool
+}
+
+func (c *cacheScopedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	switch obj.(type) {
+	case *corev1.Secret, *corev1.ConfigMap:
+	default:
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+	if c.labeledOnly {
+		err := c.Client.Get(ctx, key, obj, opts...)
+		if k8serr.IsNotFound(err) {
+			return c.direct.Get(ctx, key, obj, opts...)
+		}
+		return err
+	}
+	if key.Namespace != awsv1alpha1.AccountCrNamespace {
+		return c.direct.Get(ctx, key, obj, opts...)
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func (c *cacheScopedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	switch list.(type) {
+	case *corev1.SecretList, *corev1.ConfigMapList:
+	default:
+		return c.Client.List(ctx, list, opts...)
+	}
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	// Lists through an index need the cache, other lists may need objects it doesn't hold
+	if listOpts.FieldSelector != nil && !listOpts.FieldSelector.Empty() {
+		return c.Client.List(ctx, list, opts...)
+	}
+	if c.labeledOnly || listOpts.Namespace != awsv1alpha1.AccountCrNamespace {
+		return c.direct.List(ctx, list, opts...)
+	}
+	return c.Client.List(ctx, list, opts...)
+}
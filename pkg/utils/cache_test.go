@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+var _ = Describe("Cache scoped client", func() {
+	var (
+		operatorSecret *corev1.Secret
+		claimSecret    *corev1.Secret
+		cached         client.Client
+		direct         client.Client
+	)
+
+	BeforeEach(func() {
+		operatorSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: AwsSecretName, Namespace: awsv1alpha1.AccountCrNamespace}}
+		claimSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "secret-namespace"}}
+		cached = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(operatorSecret).Build()
+		direct = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(operatorSecret.DeepCopy(), claimSecret).Build()
+	})
+
+	It("Should read secrets outside the operator namespace from the direct reader", func() {
+		c := &cacheScopedClient{Client: cached, direct: direct}
+
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(operatorSecret), &corev1.Secret{})).To(Succeed())
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(claimSecret), &corev1.Secret{})).To(Succeed())
+
+		secrets := &corev1.SecretList{}
+		Expect(c.List(context.TODO(), secrets, client.InNamespace("secret-namespace"))).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(1))
+		Expect(secrets.Items[0].Name).To(Equal("aws"))
+	})
+
+	It("Should only read operator namespace secrets from the cache", func() {
+		c := &cacheScopedClient{Client: cached, direct: direct}
+		Expect(direct.Delete(context.TODO(), operatorSecret.DeepCopy())).To(Succeed())
+
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(operatorSecret), &corev1.Secret{})).To(Succeed())
+	})
+
+	It("Should fall back to the direct reader for secrets the labeled cache doesn't hold", func() {
+		c := &cacheScopedClient{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), direct: direct, labeledOnly: true}
+
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(operatorSecret), &corev1.Secret{})).To(Succeed())
+		err := c.Get(context.TODO(), client.ObjectKey{Name: "missing", Namespace: awsv1alpha1.AccountCrNamespace}, &corev1.Secret{})
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should restrict ConfigMaps and Secrets to labeled ones", func() {
+		Expect(CacheSelectors(false)).To(HaveLen(7))
+		for obj, selector := range CacheSelectors(true) {
+			switch obj.(type) {
+			case *corev1.Secret, *corev1.ConfigMap, *rbacv1.Role, *rbacv1.RoleBinding, *batchv1.Job:
+				Expect(selector.Label.String()).To(Equal(ManagedLabel + "=true"))
+				Expect(selector.Field).To(BeNil())
+			default:
+				Expect(selector.Field.String()).To(Equal("metadata.namespace=" + awsv1alpha1.AccountCrNamespace))
+			}
+		}
+	})
+
+	It("Should only cache the labeled Roles, RoleBindings and Jobs", func() {
+		for obj, selector := range CacheSelectors(false) {
+			switch obj.(type) {
+			case *rbacv1.Role, *rbacv1.RoleBinding, *batchv1.Job:
+				Expect(selector.Label.String()).To(Equal(ManagedLabel + "=true"))
+				Expect(selector.Field).To(BeNil())
+			}
+		}
+	})
+})
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+// IsOperatorConfigMap returns whether the object is the operator ConfigMap
+func IsOperatorConfigMap(obj client.Object) bool {
+	return obj.GetNamespace() == awsv1alpha1.AccountCrNamespace && obj.GetName() == awsv1alpha1.DefaultConfigMap
+}
+
+// OperatorConfigMapKeysChanged filters the events of the operator ConfigMap down to the ones changing any of keys,
+// e.g. the feature flags gating a step of a controller, including its creation and deletion
+func OperatorConfigMapKeysChanged(keys ...string) predicate.Predicate {
+	changed := func(old, new client.Object) bool {
+		oldConfigMap, ok := old.(*corev1.ConfigMap)
+		if !ok {
+			return false
+		}
+		newConfigMap, ok := new.(*corev1.ConfigMap)
+		if !ok {
+			return false
+		}
+		for _, key := range keys {
+			oldValue, oldOk := oldConfigMap.Data[key]
+			newValue, newOk := newConfigMap.Data[key]
+			if oldOk != newOk || oldValue != newValue {
+				return true
+			}
+		}
+		return false
+	}
+	hasKeys := func(obj client.Object) bool {
+		configMap, ok := obj.(*corev1.ConfigMap)
+		if !ok {
+			return false
+		}
+		for _, key := range keys {
+			if _, ok := configMap.Data[key]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return IsOperatorConfigMap(e.Object) && hasKeys(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return IsOperatorConfigMap(e.ObjectNew) && changed(e.ObjectOld, e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return IsOperatorConfigMap(e.Object) && hasKeys(e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+var _ = Describe("Operator ConfigMap watch", func() {
+	var (
+		old     *corev1.ConfigMap
+		updated *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		old = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string]string{"feature.flag": "false", "other": "value"},
+		}
+		updated = old.DeepCopy()
+	})
+
+	update := func() bool {
+		return OperatorConfigMapKeysChanged("feature.flag").Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})
+	}
+
+	It("Should pass updates changing a watched key", func() {
+		updated.Data["feature.flag"] = "true"
+		Expect(update()).To(BeTrue())
+
+		updated = old.DeepCopy()
+		delete(updated.Data, "feature.flag")
+		Expect(update()).To(BeTrue())
+	})
+
+	It("Should filter updates of other keys", func() {
+		updated.Data["other"] = "changed"
+		Expect(update()).To(BeFalse())
+	})
+
+	It("Should filter other ConfigMaps", func() {
+		old.Name = "other"
+		updated.Name = "other"
+		updated.Data["feature.flag"] = "true"
+		Expect(update()).To(BeFalse())
+	})
+})
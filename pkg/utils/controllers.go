@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ControllersConfigMapKey is the operator ConfigMap key selecting the controllers to run, when the --controllers flag
+// isn't set
+const ControllersConfigMapKey = "controllers"
+
+// ToggleableControllers are the controllers which can be disabled. The operatorconfig and operatorresources controllers
+// maintain the operator itself and always run.
+var ToggleableControllers = []string{
+	"account",
+	"accountclaim",
+	"accountpool",
+	"accountpoolvalidation",
+	"accountvalidation",
+	"awsfederatedaccountaccess",
+	"awsfederatedrole",
+	"fleetfederatedaccess",
+}
+
+// OptionalControllers are the controllers integrating with other operators, which only run when listed: the wildcard
+// doesn't enable them
+var OptionalControllers = []string{
+	"clusterdeployment",
+}
+
+// EnabledControllers is the set of controllers the operator runs
+type EnabledControllers map[string]bool
+
+// ParseEnabledControllers parses a comma separated list of controllers, as kube-controller-manager does: "*" enables
+// all of them but the optional ones, "foo" enables foo and "-foo" disables it. An empty list is the wildcard.
+func ParseEnabledControllers(value string) (EnabledControllers, error) {
+	enabled := EnabledControllers{}
+	for _, name := range ToggleableControllers {
+		enabled[name] = false
+	}
+	for _, name := range OptionalControllers {
+		enabled[name] = false
+	}
+	items := strings.Split(value, ",")
+	if strings.TrimSpace(value) == "" {
+		items = []string{"*"}
+	}
+	// The wildcard applies first, so "-foo,*" disables foo like "*,-foo" does
+	for _, item := range items {
+		if strings.TrimSpace(item) == "*" {
+			for _, name := range ToggleableControllers {
+				enabled[name] = true
+			}
+		}
+	}
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "*" {
+			continue
+		}
+		name := strings.TrimPrefix(item, "-")
+		if _, ok := enabled[name]; !ok {
+			known := append(append([]string{}, ToggleableControllers...), OptionalControllers...)
+			return nil, fmt.Errorf("unknown controller %q, expected one of %s", name, strings.Join(known, ", "))
+		}
+		enabled[name] = !strings.HasPrefix(item, "-")
+	}
+	return enabled, nil
+}
+
+// EnabledControllersFromConfig returns the controllers selected by the --controllers flag, or by the operator ConfigMap
+// when the flag is empty. A missing ConfigMap enables all of them.
+func EnabledControllersFromConfig(flagValue string, cm *corev1.ConfigMap) (EnabledControllers, error) {
+	if flagValue == "" && cm != nil {
+		flagValue = cm.Data[ControllersConfigMapKey]
+	}
+	return ParseEnabledControllers(flagValue)
+}
+
+// Enabled returns whether the controller runs. Controllers which can't be disabled always do.
+func (e EnabledControllers) Enabled(name string) bool {
+	enabled, ok := e[name]
+	return !ok || enabled
+}
+
+// Disabled returns the sorted names of the disabled controllers. Optional controllers aren't listed, as they're
+// disabled unless listed.
+func (e EnabledControllers) Disabled() []string {
+	disabled := []string{}
+	for name, enabled := range e {
+		if !enabled && !Contains(OptionalControllers, name) {
+			disabled = append(disabled, name)
+		}
+	}
+	sort.Strings(disabled)
+	return disabled
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Enabled controllers", func() {
+	It("Should enable every controller by default", func() {
+		enabled, err := ParseEnabledControllers("")
+		Expect(err).NotTo(HaveOccurred())
+		for _, name := range ToggleableControllers {
+			Expect(enabled.Enabled(name)).To(BeTrue(), name)
+		}
+		Expect(enabled.Disabled()).To(BeEmpty())
+	})
+
+	It("Should only enable the listed controllers", func() {
+		enabled, err := ParseEnabledControllers("accountclaim, awsfederatedrole")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Enabled("accountclaim")).To(BeTrue())
+		Expect(enabled.Enabled("awsfederatedrole")).To(BeTrue())
+		Expect(enabled.Enabled("account")).To(BeFalse())
+		Expect(enabled.Disabled()).To(ContainElements("account", "accountpool"))
+	})
+
+	It("Should disable controllers after the wildcard whatever their order", func() {
+		enabled, err := ParseEnabledControllers("-accountpool,*,-accountvalidation")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Disabled()).To(Equal([]string{"accountpool", "accountvalidation"}))
+	})
+
+	It("Should always enable the controllers maintaining the operator", func() {
+		enabled, err := ParseEnabledControllers("account")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Enabled("operatorconfig")).To(BeTrue())
+	})
+
+	It("Should only enable the optional controllers when listed", func() {
+		enabled, err := ParseEnabledControllers("*")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Enabled("clusterdeployment")).To(BeFalse())
+
+		enabled, err = ParseEnabledControllers("*,clusterdeployment")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Enabled("clusterdeployment")).To(BeTrue())
+		Expect(enabled.Disabled()).To(BeEmpty())
+	})
+
+	It("Should reject unknown controllers", func() {
+		_, err := ParseEnabledControllers("*,-operatorconfig")
+		Expect(err).To(MatchError(ContainSubstring(`unknown controller "operatorconfig"`)))
+	})
+
+	It("Should prefer the flag to the ConfigMap", func() {
+		cm := &corev1.ConfigMap{Data: map[string]string{ControllersConfigMapKey: "*,-account"}}
+		enabled, err := EnabledControllersFromConfig("", cm)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Disabled()).To(Equal([]string{"account"}))
+
+		enabled, err = EnabledControllersFromConfig("*", cm)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Disabled()).To(BeEmpty())
+
+		enabled, err = EnabledControllersFromConfig("", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled.Disabled()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// CredentialProfileAdmin grants full access to the account, the access of claims without a credential profile
+	CredentialProfileAdmin = "admin"
+	// CredentialProfileNetworkingOnly grants access to the network resources of the account
+	CredentialProfileNetworkingOnly = "networking-only"
+	// CredentialProfileReadOnly grants read access to the account
+	CredentialProfileReadOnly = "read-only"
+
+	// credentialProfilesKey is the operator ConfigMap key defining credential profiles
+	credentialProfilesKey = "credential-profiles"
+)
+
+// ErrUnknownCredentialProfile is returned for credential profiles that aren't defined
+var ErrUnknownCredentialProfile = errors.New("unknown credential profile")
+
+// CredentialProfile sets the IAM policies of the IAM user whose credentials are delivered for a claim
+type CredentialProfile struct {
+	// ManagedPolicies are the names of the AWS managed policies attached to the IAM user, e.g.
+	// job-function/NetworkAdministrator, or policy ARNs
+	ManagedPolicies []string `yaml:"managedPolicies"`
+}
+
+// defaultCredentialProfiles are the profiles available without configuration
+var defaultCredentialProfiles = map[string]CredentialProfile{
+	CredentialProfileAdmin:          {ManagedPolicies: []string{"AdministratorAccess"}},
+	CredentialProfileNetworkingOnly: {ManagedPolicies: []string{"job-function/NetworkAdministrator"}},
+	CredentialProfileReadOnly:       {ManagedPolicies: []string{"ReadOnlyAccess"}},
+}
+
+// CredentialProfileName returns the credential profile of a claim asking for profile, admin when it's empty
+func CredentialProfileName(profile string) string {
+	if profile == "" {
+		return CredentialProfileAdmin
+	}
+	return profile
+}
+
+// CredentialProfile returns the credential profile named name, admin when it's empty. The profiles of the
+// credential-profiles ConfigMap key are added to the admin, networking-only and read-only ones, and override them.
+// Without the operator ConfigMap, only those are available.
+func (o *OperatorConfig) CredentialProfile(ctx context.Context, name string) (*CredentialProfile, error) {
+	name = CredentialProfileName(name)
+	profiles := map[string]CredentialProfile{}
+	for profileName, profile := range defaultCredentialProfiles {
+		profiles[profileName] = profile
+	}
+
+	cm, err := o.ConfigMap(ctx)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return nil, err
+	}
+	if value := cm.Data[credentialProfilesKey]; value != "" {
+		configured := map[string]CredentialProfile{}
+		if err := yaml.UnmarshalStrict([]byte(value), &configured); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", credentialProfilesKey, err)
+		}
+		for profileName, profile := range configured {
+			profiles[profileName] = profile
+		}
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCredentialProfile, name)
+	}
+	if len(profile.ManagedPolicies) == 0 {
+		return nil, fmt.Errorf("credential profile %q has no managed policies", name)
+	}
+	return &profile, nil
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+var _ = Describe("Credential profiles", func() {
+	var configMap *v1.ConfigMap
+
+	BeforeEach(func() {
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      awsv1alpha1.DefaultConfigMap,
+				Namespace: awsv1alpha1.AccountCrNamespace,
+			},
+			Data: map[string]string{
+				credentialProfilesKey: `read-only:
+  managedPolicies: [job-function/ViewOnlyAccess]
+billing:
+  managedPolicies: [job-function/Billing, arn:aws:iam::123456789012:policy/billing]
+`,
+			},
+		}
+	})
+
+	credentialProfile := func(name string) (*CredentialProfile, error) {
+		config := NewOperatorConfig(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build())
+		return config.CredentialProfile(context.TODO(), name)
+	}
+
+	It("Should default to the admin profile", func() {
+		profile, err := credentialProfile("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.ManagedPolicies).To(Equal([]string{"AdministratorAccess"}))
+	})
+
+	It("Should add and override profiles from the operator ConfigMap", func() {
+		profile, err := credentialProfile("billing")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.ManagedPolicies).To(HaveLen(2))
+
+		profile, err = credentialProfile(CredentialProfileReadOnly)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.ManagedPolicies).To(Equal([]string{"job-function/ViewOnlyAccess"}))
+
+		profile, err = credentialProfile(CredentialProfileNetworkingOnly)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.ManagedPolicies).To(Equal([]string{"job-function/NetworkAdministrator"}))
+	})
+
+	It("Should return ErrUnknownCredentialProfile for undefined profiles", func() {
+		_, err := credentialProfile("unknown")
+		Expect(err).To(MatchError(ErrUnknownCredentialProfile))
+	})
+
+	It("Should reject profiles without policies", func() {
+		configMap.Data[credentialProfilesKey] = "empty: {}\n"
+		_, err := credentialProfile("empty")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should use the default profiles without an operator ConfigMap", func() {
+		config := NewOperatorConfig(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build())
+		profile, err := config.CredentialProfile(context.TODO(), CredentialProfileReadOnly)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.ManagedPolicies).To(Equal([]string{"ReadOnlyAccess"}))
+	})
+})
@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DryRunConfigMapKey is the operator ConfigMap key enabling the dry-run mode, when the --dry-run flag isn't set
+const DryRunConfigMapKey = "dry-run"
+
+// dryRun is whether the operator runs in dry-run mode, see SetDryRun
+var dryRun atomic.Bool
+
+var dryRunLog = logf.Log.WithName("dry-run")
+
+// SetDryRun sets whether the operator runs in dry-run mode. In dry-run mode the controllers reconcile as usual, but
+// their Kubernetes writes are only sent as server side dry-runs, their AWS writes are refused and their calls to
+// notification sinks, pre-reset webhooks and admission endpoints are skipped, and all of them are logged.
+func SetDryRun(enabled bool) {
+	dryRun.Store(enabled)
+}
+
+// IsDryRun returns whether the operator runs in dry-run mode
+func IsDryRun() bool {
+	return dryRun.Load()
+}
+
+// dryRunSkipped is implemented by the errors returned instead of a write skipped in dry-run mode, e.g. the
+// DryRunError of the AWS client
+type dryRunSkipped interface {
+	DryRunSkipped() bool
+}
+
+// IsDryRunSkipped returns whether err, or an error it wraps, was returned instead of a write skipped in dry-run mode
+func IsDryRunSkipped(err error) bool {
+	var skipped dryRunSkipped
+	return errors.As(err, &skipped) && skipped.DryRunSkipped()
+}
+
+// DryRunFromConfig returns whether the dry-run mode is enabled by the --dry-run flag or, when it's not set, by the
+// operator ConfigMap
+func DryRunFromConfig(flagValue bool, cm *corev1.ConfigMap) (bool, error) {
+	if flagValue || cm == nil || cm.Data[DryRunConfigMapKey] == "" {
+		return flagValue, nil
+	}
+	return strconv.ParseBool(cm.Data[DryRunConfigMapKey])
+}
+
+// NewDryRunClient wraps the client so its writes are only sent as server side dry-runs, and logged, while the operator
+// runs in dry-run mode
+func NewDryRunClient(c client.Client) client.Client {
+	return &dryRunClient{Client: c, dry: client.NewDryRunClient(c)}
+}
+
+// WithDryRun wraps the clients built by newClient with NewDryRunClient
+func WithDryRun(newClient cluster.NewClientFunc) cluster.NewClientFunc {
+	return func(cache cache.Cache, config *rest.Config, options client.Options, uncachedObjects ...client.Object) (client.Client, error) {
+		c, err := newClient(cache, config, options, uncachedObjects...)
+		if err != nil {
+			return nil, err
+		}
+		return NewDryRunClient(c), nil
+	}
+}
+
+// dryRunClient sends the writes through dry while the operator runs in dry-run mode
+type dryRunClient struct {
+	client.Client
+	dry client.Client
+}
+
+// writer returns the client to write obj with, logging the intended write in dry-run mode
+func (c *dryRunClient) writer(verb string, obj client.Object) client.Client {
+	if !IsDryRun() {
+		return c.Client
+	}
+	logDryRunWrite(c.Scheme(), verb, obj)
+	return c.dry
+}
+
+func (c *dryRunClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.writer("create", obj).Create(ctx, obj, opts...)
+}
+
+func (c *dryRunClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.writer("update", obj).Update(ctx, obj, opts...)
+}
+
+func (c *dryRunClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.writer("patch", obj).Patch(ctx, obj, patch, opts...)
+}
+
+func (c *dryRunClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.writer("delete", obj).Delete(ctx, obj, opts...)
+}
+
+func (c *dryRunClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.writer("deletecollection", obj).DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *dryRunClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *dryRunClient) SubResource(subResource string) client.SubResourceClient {
+	return &dryRunSubResourceClient{
+		SubResourceClient: c.Client.SubResource(subResource),
+		dry:               c.dry.SubResource(subResource),
+		scheme:            c.Scheme(),
+		subResource:       subResource,
+	}
+}
+
+// dryRunSubResourceClient sends the writes of a subresource through dry while the operator runs in dry-run mode
+type dryRunSubResourceClient struct {
+	client.SubResourceClient
+	dry         client.SubResourceClient
+	scheme      *runtime.Scheme
+	subResource string
+}
+
+func (c *dryRunSubResourceClient) writer(verb string, obj client.Object) client.SubResourceWriter {
+	if !IsDryRun() {
+		return c.SubResourceClient
+	}
+	logDryRunWrite(c.scheme, verb+" "+c.subResource, obj)
+	return c.dry
+}
+
+func (c *dryRunSubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return c.writer("create", obj).Create(ctx, obj, subResource, opts...)
+}
+
+func (c *dryRunSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return c.writer("update", obj).Update(ctx, obj, opts...)
+}
+
+func (c *dryRunSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return c.writer("patch", obj).Patch(ctx, obj, patch, opts...)
+}
+
+// logDryRunWrite logs a Kubernetes write the operator would have made
+func logDryRunWrite(scheme *runtime.Scheme, verb string, obj client.Object) {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+		kind = gvk.Kind
+	}
+	dryRunLog.Info("skipping Kubernetes write", "verb", verb, "kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+}
+
+// LogDryRunCall logs a call to a service outside of Kubernetes and AWS, e.g. a webhook, the operator would have made
+func LogDryRunCall(kind string, keysAndValues ...interface{}) {
+	dryRunLog.Info("skipping external call", append([]interface{}{"kind", kind}, keysAndValues...)...)
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Dry-run mode", func() {
+	It("Should prefer the flag to the ConfigMap", func() {
+		cm := &corev1.ConfigMap{Data: map[string]string{DryRunConfigMapKey: "false"}}
+		Expect(DryRunFromConfig(true, cm)).To(BeTrue())
+	})
+
+	It("Should default to the ConfigMap", func() {
+		Expect(DryRunFromConfig(false, &corev1.ConfigMap{Data: map[string]string{DryRunConfigMapKey: "true"}})).To(BeTrue())
+		Expect(DryRunFromConfig(false, &corev1.ConfigMap{})).To(BeFalse())
+		Expect(DryRunFromConfig(false, nil)).To(BeFalse())
+	})
+
+	It("Should reject invalid ConfigMap values", func() {
+		_, err := DryRunFromConfig(false, &corev1.ConfigMap{Data: map[string]string{DryRunConfigMapKey: "maybe"}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("Client", func() {
+		var (
+			existing *corev1.ConfigMap
+			inner    client.Client
+			c        client.Client
+		)
+
+		BeforeEach(func() {
+			existing = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "namespace"},
+				Data:       map[string]string{"key": "value"},
+			}
+			inner = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+			c = NewDryRunClient(inner)
+		})
+
+		AfterEach(func() {
+			SetDryRun(false)
+		})
+
+		It("Should write through outside of dry-run mode", func() {
+			created := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "namespace"}}
+			Expect(c.Create(context.TODO(), created)).To(Succeed())
+			Expect(inner.Get(context.TODO(), client.ObjectKeyFromObject(created), &corev1.ConfigMap{})).To(Succeed())
+		})
+
+		It("Should not persist writes in dry-run mode", func() {
+			SetDryRun(true)
+
+			created := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "namespace"}}
+			Expect(c.Create(context.TODO(), created)).To(Succeed())
+			err := inner.Get(context.TODO(), client.ObjectKeyFromObject(created), &corev1.ConfigMap{})
+			Expect(k8serr.IsNotFound(err)).To(BeTrue())
+
+			updated := existing.DeepCopy()
+			updated.Data["key"] = "changed"
+			Expect(c.Update(context.TODO(), updated)).To(Succeed())
+			Expect(c.Delete(context.TODO(), existing.DeepCopy())).To(Succeed())
+
+			current := &corev1.ConfigMap{}
+			Expect(inner.Get(context.TODO(), client.ObjectKeyFromObject(existing), current)).To(Succeed())
+			Expect(current.Data).To(Equal(map[string]string{"key": "value"}))
+		})
+	})
+})
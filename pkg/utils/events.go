@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// DefaultEventDeduplicationWindow is the window identical warnings of an object are collapsed in
+	DefaultEventDeduplicationWindow = 10 * time.Minute
+
+	// EventCountAnnotation, EventFirstTimestampAnnotation and EventLastTimestampAnnotation are set on a warning emitted
+	// after identical ones were collapsed, with the number of occurrences and when the first and last one happened
+	EventCountAnnotation          = "aws.managed.openshift.io/event-count"
+	EventFirstTimestampAnnotation = "aws.managed.openshift.io/event-first-timestamp"
+	EventLastTimestampAnnotation  = "aws.managed.openshift.io/event-last-timestamp"
+)
+
+// eventKey identifies identical warnings of an object
+type eventKey struct {
+	kind      string
+	namespace string
+	name      string
+	reason    string
+	message   string
+}
+
+// eventOccurrences tracks the occurrences of a warning, since it was first seen and in the current window
+type eventOccurrences struct {
+	first       time.Time
+	last        time.Time
+	windowStart time.Time
+	count       int
+}
+
+// dedupingEventRecorder is a record.EventRecorder collapsing identical warnings of an object, see
+// NewDedupingEventRecorder
+type dedupingEventRecorder struct {
+	recorder record.EventRecorder
+	window   time.Duration
+	now      func() time.Time
+
+	mu   sync.Mutex
+	seen map[eventKey]*eventOccurrences
+}
+
+// NewDedupingEventRecorder wraps the recorder so repetitive warnings, e.g. the ones of an account waiting on a quota
+// on every reconcile, don't spam etcd. An identical warning of the same object, i.e. with the same reason and message,
+// is emitted at most once per window. The warning emitted after the window, if it happened again, carries the number
+// of occurrences and the time of the first and last one in its annotations. Normal events are emitted as-is.
+func NewDedupingEventRecorder(recorder record.EventRecorder, window time.Duration) record.EventRecorder {
+	return &dedupingEventRecorder{
+		recorder: recorder,
+		window:   window,
+		now:      time.Now,
+		seen:     map[eventKey]*eventOccurrences{},
+	}
+}
+
+func (d *dedupingEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	d.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (d *dedupingEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	d.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (d *dedupingEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if eventtype != corev1.EventTypeWarning {
+		d.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+		return
+	}
+	occurrences, emit := d.observe(d.keyFor(object, reason, message))
+	if !emit {
+		return
+	}
+	if occurrences.count > 1 {
+		merged := map[string]string{
+			EventCountAnnotation:          strconv.Itoa(occurrences.count),
+			EventFirstTimestampAnnotation: occurrences.first.UTC().Format(time.RFC3339),
+			EventLastTimestampAnnotation:  occurrences.last.UTC().Format(time.RFC3339),
+		}
+		for key, value := range annotations {
+			merged[key] = value
+		}
+		annotations = merged
+	}
+	d.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+}
+
+// observe records an occurrence of the warning and returns whether to emit it, along with a copy of its
+// occurrences. Warnings not seen for two windows are forgotten.
+func (d *dedupingEventRecorder) observe(key eventKey) (eventOccurrences, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	for seenKey, seen := range d.seen {
+		if now.Sub(seen.last) > 2*d.window {
+			delete(d.seen, seenKey)
+		}
+	}
+
+	occurrences, ok := d.seen[key]
+	if !ok {
+		occurrences = &eventOccurrences{first: now, windowStart: now}
+		d.seen[key] = occurrences
+	}
+	occurrences.count++
+	occurrences.last = now
+	if ok && now.Sub(occurrences.windowStart) < d.window {
+		return *occurrences, false
+	}
+	occurrences.windowStart = now
+	return *occurrences, true
+}
+
+// keyFor returns the key of the warning of the object
+func (d *dedupingEventRecorder) keyFor(object runtime.Object, reason, message string) eventKey {
+	key := eventKey{kind: fmt.Sprintf("%T", object), reason: reason, message: message}
+	if accessor, err := meta.Accessor(object); err == nil {
+		key.namespace = accessor.GetNamespace()
+		key.name = accessor.GetName()
+	}
+	return key
+}
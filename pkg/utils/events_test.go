@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type recordedEvent struct {
+	annotations map[string]string
+	message     string
+}
+
+// annotationRecorder records the events along with their annotations, which record.FakeRecorder drops
+type annotationRecorder struct {
+	events []recordedEvent
+}
+
+func (a *annotationRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	a.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (a *annotationRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	a.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (a *annotationRecorder) AnnotatedEventf(_ runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	a.events = append(a.events, recordedEvent{
+		annotations: annotations,
+		message:     fmt.Sprintf("%s %s %s", eventtype, reason, fmt.Sprintf(messageFmt, args...)),
+	})
+}
+
+var _ = Describe("Event deduplication", func() {
+	var (
+		recorded *annotationRecorder
+		deduping *dedupingEventRecorder
+		now      time.Time
+		claim    *corev1.Secret
+		other    *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		recorded = &annotationRecorder{}
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		deduping = NewDedupingEventRecorder(recorded, 10*time.Minute).(*dedupingEventRecorder)
+		deduping.now = func() time.Time { return now }
+		claim = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"}}
+		other = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "claim-namespace"}}
+	})
+
+	It("Should collapse identical warnings of an object within the window", func() {
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		now = now.Add(time.Minute)
+		deduping.Eventf(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on %s", "quota")
+		now = now.Add(time.Minute)
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		Expect(recorded.events).To(HaveLen(1))
+		Expect(recorded.events[0].annotations).To(BeEmpty())
+
+		now = now.Add(10 * time.Minute)
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		Expect(recorded.events).To(HaveLen(2))
+		Expect(recorded.events[1].message).To(Equal("Warning QuotaPending Waiting on quota"))
+		Expect(recorded.events[1].annotations).To(Equal(map[string]string{
+			EventCountAnnotation:          "4",
+			EventFirstTimestampAnnotation: "2024-01-01T12:00:00Z",
+			EventLastTimestampAnnotation:  "2024-01-01T12:12:00Z",
+		}))
+	})
+
+	It("Should keep warnings that differ apart", func() {
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		deduping.Event(other, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		deduping.Event(claim, corev1.EventTypeWarning, "Throttled", "Waiting on quota")
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on another quota")
+		Expect(recorded.events).To(HaveLen(4))
+	})
+
+	It("Should emit normal events as-is", func() {
+		deduping.Event(claim, corev1.EventTypeNormal, "Claimed", "Account claimed")
+		deduping.Event(claim, corev1.EventTypeNormal, "Claimed", "Account claimed")
+		Expect(recorded.events).To(HaveLen(2))
+	})
+
+	It("Should forget warnings that stopped", func() {
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		now = now.Add(time.Hour)
+		deduping.Event(other, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		Expect(deduping.seen).To(HaveLen(1))
+
+		deduping.Event(claim, corev1.EventTypeWarning, "QuotaPending", "Waiting on quota")
+		Expect(recorded.events).To(HaveLen(3))
+		Expect(recorded.events[2].annotations).To(BeEmpty())
+	})
+})
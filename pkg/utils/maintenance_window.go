@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxMaintenanceWindowDuration is the longest a maintenance window can stay open after each start
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// MaintenanceWindow is a recurring window during which disruptive operations are allowed on the accounts of a pool,
+// set by maintenanceWindow in the pool's entry of the accountpool ConfigMap key
+type MaintenanceWindow struct {
+	// Schedule is a cron expression, in UTC, of the times the window opens: minute hour day-of-month month day-of-week
+	Schedule string `yaml:"schedule"`
+	// Duration is how long the window stays open each time, e.g. 4h
+	Duration string `yaml:"duration"`
+}
+
+// cronSchedule is a parsed cron expression, with one entry per allowed value of each field
+type cronSchedule struct {
+	minutes, hours, daysOfMonth, months, daysOfWeek []bool
+	// Like cron, a day matches either field when both days of month and of week are restricted
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+// parse returns the schedule and duration of the window
+func (w *MaintenanceWindow) parse() (*cronSchedule, time.Duration, error) {
+	schedule, err := parseCronSchedule(w.Schedule)
+	if err != nil {
+		return nil, 0, err
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid maintenance window duration %q: %w", w.Duration, err)
+	}
+	if duration < time.Minute || duration > maxMaintenanceWindowDuration {
+		return nil, 0, fmt.Errorf("maintenance window duration %q must be between 1m and %s", w.Duration, maxMaintenanceWindowDuration)
+	}
+	if _, ok := schedule.next(time.Now()); !ok {
+		return nil, 0, fmt.Errorf("maintenance window schedule %q never opens", w.Schedule)
+	}
+	return schedule, duration, nil
+}
+
+// Validate checks the schedule and duration of the window
+func (w *MaintenanceWindow) Validate() error {
+	_, _, err := w.parse()
+	return err
+}
+
+// Open reports whether the window is open at now and, when it isn't, when it opens next
+func (w *MaintenanceWindow) Open(now time.Time) (bool, time.Time, error) {
+	schedule, duration, err := w.parse()
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	now = now.UTC().Truncate(time.Minute)
+	for start := now; now.Sub(start) < duration; start = start.Add(-time.Minute) {
+		if schedule.matches(start) {
+			return true, time.Time{}, nil
+		}
+	}
+	next, _ := schedule.next(now)
+	return false, next, nil
+}
+
+// parseCronSchedule parses a cron expression of five fields. Each field takes *, values, ranges, steps and lists of
+// them, e.g. 0 2 * * 6,0 or */30 1-4 * * *. Days of week go from 0 (Sunday) to 7 (Sunday again).
+func parseCronSchedule(expression string) (*cronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid maintenance window schedule %q: expected 5 fields, found %d", expression, len(fields))
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	parsed := make([][]bool, len(fields))
+	for i, field := range fields {
+		var err error
+		parsed[i], err = parseCronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window schedule %q: %w", expression, err)
+		}
+	}
+
+	schedule := &cronSchedule{
+		minutes:       parsed[0],
+		hours:         parsed[1],
+		daysOfMonth:   parsed[2],
+		months:        parsed[3],
+		daysOfWeek:    parsed[4],
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}
+	schedule.daysOfWeek[0] = schedule.daysOfWeek[0] || schedule.daysOfWeek[7]
+	return schedule, nil
+}
+
+// parseCronField returns the values between min and max a field allows
+func parseCronField(field string, min, max int) ([]bool, error) {
+	values := make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangePart = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+		}
+
+		first, last := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			first, err = strconv.Atoi(bounds[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in %q", part)
+			}
+			last = first
+			if len(bounds) == 2 {
+				last, err = strconv.Atoi(bounds[1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid value in %q", part)
+				}
+			} else if step > 1 {
+				last = max
+			}
+		}
+		if first < min || last > max || first > last {
+			return nil, fmt.Errorf("%q is out of the range %d-%d", part, min, max)
+		}
+
+		for value := first; value <= last; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+// matches reports whether the schedule starts a window at the minute t
+func (s *cronSchedule) matches(t time.Time) bool {
+	return s.minutes[t.Minute()] && s.hours[t.Hour()] && s.months[t.Month()] && s.dayMatches(t)
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth, dayOfWeek := s.daysOfMonth[t.Day()], s.daysOfWeek[t.Weekday()]
+	if !s.anyDayOfMonth && !s.anyDayOfWeek {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+// next returns the first start of a window after t, within the next four years so leap days are found
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(4, 0, 1)
+	for t.Before(limit) {
+		switch {
+		case !s.months[t.Month()]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !s.hours[t.Hour()]:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case !s.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// GetMaintenanceWindowFromAccountPool returns the maintenance window of a pool, see OperatorConfig.MaintenanceWindow
+func GetMaintenanceWindowFromAccountPool(accountPoolName string, client client.Client) (*MaintenanceWindow, error) {
+	return NewOperatorConfig(client).MaintenanceWindow(context.TODO(), accountPoolName)
+}
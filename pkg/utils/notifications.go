@@ -0,0 +1,303 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+const (
+	// notificationsKey is the operator ConfigMap key holding the notification sinks
+	notificationsKey = "notifications"
+	// defaultPoolLowThreshold is the number of available accounts a pool is low under, unless configured otherwise
+	defaultPoolLowThreshold = 1
+	// notificationDedupWindow is how long a notification isn't sent again, e.g. for a cleanup failing on every retry
+	notificationDedupWindow = time.Hour
+)
+
+// NotificationEvent is what a notification is about
+type NotificationEvent string
+
+const (
+	// NotificationClaimReady is sent when a claim turns Ready
+	NotificationClaimReady NotificationEvent = "claim-ready"
+	// NotificationClaimFailed is sent when a claim turns Error
+	NotificationClaimFailed NotificationEvent = "claim-failed"
+	// NotificationPoolLow is sent when the available accounts of a pool drop under the pool low threshold
+	NotificationPoolLow NotificationEvent = "pool-low"
+	// NotificationCleanupFailed is sent when the cleanup of an account released by a claim fails
+	NotificationCleanupFailed NotificationEvent = "cleanup-failed"
+	// NotificationClaimEscalated is sent when a claim isn't Ready within its fulfillment deadline
+	NotificationClaimEscalated NotificationEvent = "claim-escalated"
+)
+
+// Notification is an event of the operator sent to the notification sinks
+type Notification struct {
+	Event     NotificationEvent `json:"event"`
+	Kind      string            `json:"kind"`
+	Namespace string            `json:"namespace,omitempty"`
+	Name      string            `json:"name"`
+	Message   string            `json:"message"`
+}
+
+// text returns the notification as a line of text, for chat and email sinks
+func (n Notification) text() string {
+	name := n.Name
+	if n.Namespace != "" {
+		name = n.Namespace + "/" + n.Name
+	}
+	return fmt.Sprintf("[aws-account-operator] %s: %s %s: %s", n.Event, n.Kind, name, n.Message)
+}
+
+// NotificationSink delivers notifications somewhere people or automation watch
+type NotificationSink interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// notificationsConfig is the configuration of the notifications in the operator ConfigMap
+type notificationsConfig struct {
+	// PoolLowThreshold is the number of available accounts a pool is low under
+	PoolLowThreshold *int                     `yaml:"poolLowThreshold,omitempty"`
+	Sinks            []notificationSinkConfig `yaml:"sinks"`
+}
+
+// notificationSinkConfig configures a sink. Credentials are read from SecretRef, a Secret of the operator namespace:
+// the token of a webhook, the url of a Slack incoming webhook, and the host, port, username and password of a SMTP
+// server.
+type notificationSinkConfig struct {
+	// Type is webhook, slack or smtp
+	Type      string   `yaml:"type"`
+	URL       string   `yaml:"url,omitempty"`
+	SecretRef string   `yaml:"secretRef,omitempty"`
+	From      string   `yaml:"from,omitempty"`
+	To        []string `yaml:"to,omitempty"`
+	// Events are the events sent to the sink, all of them when empty
+	Events []NotificationEvent `yaml:"events,omitempty"`
+}
+
+var notificationHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+func (o *OperatorConfig) notificationsConfig(ctx context.Context) (*notificationsConfig, error) {
+	cm, err := o.ConfigMap(ctx)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return &notificationsConfig{}, nil
+		}
+		return nil, err
+	}
+	config := &notificationsConfig{}
+	if err := yaml.UnmarshalStrict([]byte(cm.Data[notificationsKey]), config); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", notificationsKey, err)
+	}
+	return config, nil
+}
+
+// PoolLowThreshold returns the number of available accounts a pool is low under
+func (o *OperatorConfig) PoolLowThreshold(ctx context.Context) (int, error) {
+	config, err := o.notificationsConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if config.PoolLowThreshold == nil {
+		return defaultPoolLowThreshold, nil
+	}
+	return *config.PoolLowThreshold, nil
+}
+
+// NotificationSinks returns the notification sinks configured in the operator ConfigMap
+func (o *OperatorConfig) NotificationSinks(ctx context.Context) ([]NotificationSink, error) {
+	config, err := o.notificationsConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sinks := []NotificationSink{}
+	for i, sinkConfig := range config.Sinks {
+		secret := &corev1.Secret{}
+		if sinkConfig.SecretRef != "" {
+			err := o.client.Get(ctx, types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace, Name: sinkConfig.SecretRef}, secret)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read the secret of notification sink %d: %w", i, err)
+			}
+		}
+		sink, err := newNotificationSink(sinkConfig, secret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid notification sink %d: %w", i, err)
+		}
+		if len(sinkConfig.Events) > 0 {
+			sink = &eventFilterSink{events: sinkConfig.Events, sink: sink}
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+func newNotificationSink(config notificationSinkConfig, secret *corev1.Secret) (NotificationSink, error) {
+	switch config.Type {
+	case "webhook":
+		if config.URL == "" {
+			return nil, fmt.Errorf("webhook sinks need a url")
+		}
+		return &webhookSink{url: config.URL, token: string(secret.Data["token"])}, nil
+	case "slack":
+		url := string(secret.Data["url"])
+		if url == "" {
+			return nil, fmt.Errorf("slack sinks need a secretRef with the url of the incoming webhook")
+		}
+		return &slackSink{url: url}, nil
+	case "smtp":
+		host, port := string(secret.Data["host"]), string(secret.Data["port"])
+		if host == "" || port == "" || config.From == "" || len(config.To) == 0 {
+			return nil, fmt.Errorf("smtp sinks need from, to and a secretRef with the host and port of the server")
+		}
+		sink := &smtpSink{addr: net.JoinHostPort(host, port), from: config.From, to: config.To}
+		if username := string(secret.Data["username"]); username != "" {
+			sink.auth = smtp.PlainAuth("", username, string(secret.Data["password"]), host)
+		}
+		return sink, nil
+	default:
+		return nil, fmt.Errorf("unknown type %q, expected webhook, slack or smtp", config.Type)
+	}
+}
+
+// eventFilterSink only sends some events to its sink
+type eventFilterSink struct {
+	events []NotificationEvent
+	sink   NotificationSink
+}
+
+func (s *eventFilterSink) Notify(ctx context.Context, notification Notification) error {
+	for _, event := range s.events {
+		if event == notification.Event {
+			return s.sink.Notify(ctx, notification)
+		}
+	}
+	return nil
+}
+
+// webhookSink posts the notification as JSON, with the token as bearer token when there's one
+type webhookSink struct {
+	url   string
+	token string
+}
+
+func (s *webhookSink) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{}
+	if s.token != "" {
+		headers["Authorization"] = "Bearer " + s.token
+	}
+	return postNotification(ctx, s.url, body, headers)
+}
+
+// slackSink posts the notification to a Slack incoming webhook
+type slackSink struct {
+	url string
+}
+
+func (s *slackSink) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(map[string]string{"text": notification.text()})
+	if err != nil {
+		return err
+	}
+	return postNotification(ctx, s.url, body, nil)
+}
+
+func postNotification(ctx context.Context, url string, body []byte, headers map[string]string) error {
+	if IsDryRun() {
+		LogDryRunCall("notification", "url", url)
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := notificationHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification sink returned %s", resp.Status)
+	}
+	return nil
+}
+
+// smtpSink emails the notification
+type smtpSink struct {
+	addr string
+	auth smtp.Auth
+	from string
+	to   []string
+}
+
+func (s *smtpSink) Notify(_ context.Context, notification Notification) error {
+	if IsDryRun() {
+		LogDryRunCall("notification", "smtp", s.addr, "to", s.to)
+		return nil
+	}
+	text := notification.text()
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", s.from, strings.Join(s.to, ", "), text, text)
+	return smtp.SendMail(s.addr, s.auth, s.from, s.to, []byte(message))
+}
+
+var (
+	sentNotificationsMutex sync.Mutex
+	sentNotifications      = map[Notification]time.Time{}
+)
+
+// shouldSendNotification returns whether the notification wasn't sent within notificationDedupWindow, and records it
+// as sent
+func shouldSendNotification(notification Notification, now time.Time) bool {
+	sentNotificationsMutex.Lock()
+	defer sentNotificationsMutex.Unlock()
+	for sent, at := range sentNotifications {
+		if now.Sub(at) >= notificationDedupWindow {
+			delete(sentNotifications, sent)
+		}
+	}
+	if _, ok := sentNotifications[notification]; ok {
+		return false
+	}
+	sentNotifications[notification] = now
+	return true
+}
+
+// PublishNotification sends the notification to the sinks of the operator ConfigMap. Notifications never fail the
+// caller: errors are logged, and the same notification isn't sent again within an hour.
+func PublishNotification(ctx context.Context, config *OperatorConfig, reqLogger logr.Logger, notification Notification) {
+	sinks, err := config.NotificationSinks(ctx)
+	if err != nil {
+		reqLogger.Error(err, "failed reading the notification sinks")
+		return
+	}
+	if len(sinks) == 0 || !shouldSendNotification(notification, time.Now()) {
+		return
+	}
+	for _, sink := range sinks {
+		if err := sink.Notify(ctx, notification); err != nil {
+			reqLogger.Error(err, "failed sending notification", "event", notification.Event, "name", notification.Name)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+)
+
+var _ = Describe("Notifications", func() {
+	var (
+		nullLogger = testutils.NewTestLogger().Logger()
+		configMap  *v1.ConfigMap
+		secret     *v1.Secret
+		server     *httptest.Server
+		received   []map[string]string
+		headers    []http.Header
+	)
+
+	BeforeEach(func() {
+		received, headers = nil, nil
+		sentNotifications = map[Notification]time.Time{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			payload := map[string]string{}
+			Expect(json.NewDecoder(req.Body).Decode(&payload)).To(Succeed())
+			received = append(received, payload)
+			headers = append(headers, req.Header)
+		}))
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "notifications", Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string][]byte{"token": []byte("s3cr3t"), "url": []byte(server.URL)},
+		}
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				notificationsKey: `poolLowThreshold: 3
+sinks:
+- type: webhook
+  url: ` + server.URL + `
+  secretRef: notifications
+- type: slack
+  secretRef: notifications
+  events: [pool-low]
+`,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	operatorConfig := func() *OperatorConfig {
+		return NewOperatorConfig(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap, secret).Build())
+	}
+
+	claimReady := Notification{Event: NotificationClaimReady, Kind: "AccountClaim", Namespace: "ns", Name: "claim", Message: "Claim is ready"}
+
+	It("sends notifications to the sinks subscribed to their event", func() {
+		PublishNotification(context.TODO(), operatorConfig(), nullLogger, claimReady)
+		Expect(received).To(HaveLen(1))
+		Expect(received[0]).To(HaveKeyWithValue("event", "claim-ready"))
+		Expect(received[0]).To(HaveKeyWithValue("name", "claim"))
+		Expect(headers[0].Get("Authorization")).To(Equal("Bearer s3cr3t"))
+
+		PublishNotification(context.TODO(), operatorConfig(), nullLogger, Notification{Event: NotificationPoolLow, Kind: "AccountPool", Name: "default", Message: "0 accounts available"})
+		Expect(received).To(HaveLen(3))
+		Expect(received[2]).To(HaveKeyWithValue("text", "[aws-account-operator] pool-low: AccountPool default: 0 accounts available"))
+	})
+
+	It("doesn't send the same notification twice within the dedup window", func() {
+		PublishNotification(context.TODO(), operatorConfig(), nullLogger, claimReady)
+		PublishNotification(context.TODO(), operatorConfig(), nullLogger, claimReady)
+		Expect(received).To(HaveLen(1))
+
+		Expect(shouldSendNotification(claimReady, time.Now().Add(notificationDedupWindow))).To(BeTrue())
+	})
+
+	It("doesn't call the sinks in dry-run mode", func() {
+		SetDryRun(true)
+		defer SetDryRun(false)
+		configMap.Data[notificationsKey] += `- type: smtp
+  from: aao@example.com
+  to: [sre@example.com]
+  secretRef: smtp
+`
+		smtpSecret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "smtp", Namespace: awsv1alpha1.AccountCrNamespace},
+			// Nothing listens on port 1, the email would fail if it was sent
+			Data: map[string][]byte{"host": []byte("127.0.0.1"), "port": []byte("1")},
+		}
+		config := NewOperatorConfig(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap, secret, smtpSecret).Build())
+		sinks, err := config.NotificationSinks(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sinks).To(HaveLen(3))
+
+		poolLow := Notification{Event: NotificationPoolLow, Kind: "AccountPool", Name: "default", Message: "0 accounts available"}
+		for _, sink := range sinks {
+			Expect(sink.Notify(context.TODO(), poolLow)).To(Succeed())
+		}
+		Expect(received).To(BeEmpty())
+	})
+
+	It("sends nothing without sinks", func() {
+		delete(configMap.Data, notificationsKey)
+		sinks, err := operatorConfig().NotificationSinks(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sinks).To(BeEmpty())
+	})
+
+	It("rejects invalid sinks", func() {
+		configMap.Data[notificationsKey] = `sinks:
+- type: smtp
+  secretRef: notifications
+`
+		_, err := operatorConfig().NotificationSinks(context.TODO())
+		Expect(err).To(MatchError(ContainSubstring("smtp sinks need")))
+
+		configMap.Data[notificationsKey] = `sinks:
+- type: pager
+`
+		_, err = operatorConfig().NotificationSinks(context.TODO())
+		Expect(err).To(MatchError(ContainSubstring(`unknown type "pager"`)))
+	})
+
+	It("reads the pool low threshold", func() {
+		Expect(operatorConfig().PoolLowThreshold(context.TODO())).To(Equal(3))
+
+		delete(configMap.Data, notificationsKey)
+		Expect(operatorConfig().PoolLowThreshold(context.TODO())).To(Equal(defaultPoolLowThreshold))
+	})
+})
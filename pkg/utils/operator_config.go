@@ -0,0 +1,172 @@
+package utils
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/test/fixtures"
+)
+
+// OperatorConfig reads the operator configuration, the operator ConfigMap and the account pool settings it holds,
+// with the client it's built with. Its methods take the context of the caller, so lookups are cancelled with the
+// reconcile that makes them.
+type OperatorConfig struct {
+	client client.Reader
+}
+
+// NewOperatorConfig returns an OperatorConfig reading the operator configuration with the client
+func NewOperatorConfig(c client.Reader) *OperatorConfig {
+	return &OperatorConfig{client: c}
+}
+
+// ConfigMap retrieves the operator ConfigMap
+func (o *OperatorConfig) ConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	err := o.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace,
+			Name: awsv1alpha1.DefaultConfigMap}, configMap)
+	return configMap, err
+}
+
+// accountPoolConfig returns the configuration of a pool in the accountpool ConfigMap key, or of the default pool
+// when accountPoolName is empty. Pools without configuration get an empty one.
+func (o *OperatorConfig) accountPoolConfig(ctx context.Context, accountPoolName string) (accountPoolConfig, error) {
+	cm, err := o.ConfigMap(ctx)
+	if err != nil {
+		return accountPoolConfig{}, err
+	}
+
+	accountpoolString, found := cm.Data["accountpool"]
+	if !found {
+		return accountPoolConfig{}, nil
+	}
+	data := make(map[string]accountPoolConfig)
+	if err := yaml.Unmarshal([]byte(accountpoolString), &data); err != nil {
+		return accountPoolConfig{}, err
+	}
+
+	if accountPoolName != "" {
+		return data[accountPoolName], nil
+	}
+	for _, pool := range data {
+		if pool.IsDefault {
+			return pool, nil
+		}
+	}
+	return accountPoolConfig{}, nil
+}
+
+// IAMUserNamePrefix returns the prefix of the managed IAM user names of the accounts of a pool, set by
+// iamUserNamePrefix in the accountpool ConfigMap key. Accounts that don't belong to a pool use the prefix of the
+// default pool.
+func (o *OperatorConfig) IAMUserNamePrefix(ctx context.Context, accountPoolName string) (string, error) {
+	poolData, err := o.accountPoolConfig(ctx, accountPoolName)
+	if err != nil {
+		return "", err
+	}
+
+	if poolData.IAMUserNamePrefix == "" {
+		return DefaultIAMUserNamePrefix, nil
+	}
+	if err := ValidateIAMUserNamePrefix(poolData.IAMUserNamePrefix); err != nil {
+		return "", err
+	}
+	return poolData.IAMUserNamePrefix, nil
+}
+
+// MaintenanceWindow returns the maintenance window of a pool, or of the default pool for accounts that don't belong
+// to a pool. It returns nil when the pool has none, in which case disruptive operations are always allowed.
+func (o *OperatorConfig) MaintenanceWindow(ctx context.Context, accountPoolName string) (*MaintenanceWindow, error) {
+	poolData, err := o.accountPoolConfig(ctx, accountPoolName)
+	if err != nil {
+		return nil, err
+	}
+	if poolData.MaintenanceWindow == nil {
+		return nil, nil
+	}
+	if err := poolData.MaintenanceWindow.Validate(); err != nil {
+		return nil, err
+	}
+	return poolData.MaintenanceWindow, nil
+}
+
+// SupportedRegions returns the regions a pool supports, or the default pool for claims that don't name a pool. It
+// returns nil when the pool supports all regions.
+func (o *OperatorConfig) SupportedRegions(ctx context.Context, accountPoolName string) (*SupportedRegions, error) {
+	poolData, err := o.accountPoolConfig(ctx, accountPoolName)
+	if err != nil {
+		return nil, err
+	}
+	if poolData.SupportedRegions == nil {
+		return nil, nil
+	}
+	if err := poolData.SupportedRegions.Validate(); err != nil {
+		return nil, err
+	}
+	return poolData.SupportedRegions, nil
+}
+
+// SkipSupportVerification reports whether the accounts of a pool skip the Enterprise Support verification, set by
+// skipSupportVerification in the accountpool ConfigMap key for pools of payer accounts without Support API access.
+// Accounts that don't belong to a pool use the setting of the default pool.
+func (o *OperatorConfig) SkipSupportVerification(ctx context.Context, accountPoolName string) (bool, error) {
+	poolData, err := o.accountPoolConfig(ctx, accountPoolName)
+	if err != nil {
+		return false, err
+	}
+	return poolData.SkipSupportVerification, nil
+}
+
+// ServiceQuotas retrieves and processes the account pool's service quotas from the ConfigMap
+func (o *OperatorConfig) ServiceQuotas(ctx context.Context, reqLogger logr.Logger, accountPoolName string) (awsv1alpha1.RegionalServiceQuotas, error) {
+	reqLogger.Info("Loading Service Quotas")
+
+	cm, err := o.ConfigMap(ctx)
+	if err != nil {
+		reqLogger.Error(err, "failed retrieving configmap")
+		return nil, err
+	}
+
+	accountpoolString, found := cm.Data["accountpool"]
+	if !found {
+		reqLogger.Error(fixtures.NotFound, "failed getting accountpool data from configmap")
+		return nil, fixtures.NotFound
+	}
+
+	data := make(map[string]accountPoolConfig)
+	err = yaml.Unmarshal([]byte(accountpoolString), &data)
+
+	if err != nil {
+		reqLogger.Error(err, "Failed to unmarshal yaml")
+		return nil, err
+	}
+
+	var parsedRegionalServiceQuotas = make(awsv1alpha1.RegionalServiceQuotas)
+
+	if poolData, ok := data[accountPoolName]; !ok {
+		reqLogger.Info("Accountpool not found in configmap. Not setting servicequotas.")
+		return parsedRegionalServiceQuotas, nil
+	} else {
+		// for each service quota in a given region, we'll need to parse and save to use in the account spec.
+		for regionName, serviceQuotas := range poolData.RegionedServicequotas {
+			var parsedServiceQuotas = make(awsv1alpha1.AccountServiceQuota)
+			for quotaCode, quotaValue := range serviceQuotas {
+				qv, _ := strconv.Atoi(quotaValue)
+				parsedServiceQuotas[awsv1alpha1.SupportedServiceQuotas(quotaCode)] = &awsv1alpha1.ServiceQuotaStatus{
+					Value: qv,
+				}
+			}
+			parsedRegionalServiceQuotas[regionName] = parsedServiceQuotas
+		}
+	}
+
+	return parsedRegionalServiceQuotas, nil
+}
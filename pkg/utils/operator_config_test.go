@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+)
+
+type contextKey struct{}
+
+// contextRecordingReader records the context of the last Get
+type contextRecordingReader struct {
+	client.Reader
+	ctx context.Context
+}
+
+func (r *contextRecordingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	r.ctx = ctx
+	return r.Reader.Get(ctx, key, obj, opts...)
+}
+
+var _ = Describe("OperatorConfig", func() {
+	var (
+		configMap *v1.ConfigMap
+		reader    *contextRecordingReader
+		config    *OperatorConfig
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      awsv1alpha1.DefaultConfigMap,
+				Namespace: awsv1alpha1.AccountCrNamespace,
+			},
+			Data: map[string]string{
+				"accountpool": `hives02ue1:
+  default: true
+  iamUserNamePrefix: stageManagedAdmin
+  maintenanceWindow:
+    schedule: "0 2 * * 6"
+    duration: 4h
+fm-accountpool:
+  skipSupportVerification: true
+  supportedRegions:
+    regions: [us-east-1, us-west-2]
+    policy: trim
+  servicequotas:
+    us-east-1:
+      L-1216C47A: "750"
+`,
+			},
+		}
+		reader = &contextRecordingReader{Reader: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()}
+		config = NewOperatorConfig(reader)
+		ctx = context.WithValue(context.TODO(), contextKey{}, "reconcile")
+	})
+
+	It("Should read the operator ConfigMap with the context of the caller", func() {
+		cm, err := config.ConfigMap(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cm.Data).To(HaveKey("accountpool"))
+		Expect(reader.ctx.Value(contextKey{})).To(Equal("reconcile"))
+	})
+
+	It("Should return NotFound without an operator ConfigMap", func() {
+		config = NewOperatorConfig(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build())
+		_, err := config.ConfigMap(ctx)
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should read the settings of the pools with the context of the caller", func() {
+		prefix, err := config.IAMUserNamePrefix(ctx, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prefix).To(Equal("stageManagedAdmin"))
+
+		window, err := config.MaintenanceWindow(ctx, "hives02ue1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(window).To(Equal(&MaintenanceWindow{Schedule: "0 2 * * 6", Duration: "4h"}))
+
+		quotas, err := config.ServiceQuotas(ctx, testutils.NewTestLogger().Logger(), "fm-accountpool")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quotas["us-east-1"][awsv1alpha1.RunningStandardInstances].Value).To(Equal(750))
+		Expect(reader.ctx.Value(contextKey{})).To(Equal("reconcile"))
+	})
+
+	It("Should return the supported regions of a pool", func() {
+		supported, err := config.SupportedRegions(ctx, "fm-accountpool")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(supported.Trim()).To(BeTrue())
+		Expect(supported.Unsupported([]string{"af-south-1", "us-west-2", "ap-east-1"})).To(Equal([]string{"af-south-1", "ap-east-1"}))
+
+		supported, err = config.SupportedRegions(ctx, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(supported).To(BeNil())
+	})
+
+	It("Should return whether the accounts of a pool skip the support verification", func() {
+		skip, err := config.SkipSupportVerification(ctx, "fm-accountpool")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(skip).To(BeTrue())
+
+		skip, err = config.SkipSupportVerification(ctx, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(skip).To(BeFalse())
+	})
+
+	It("Should reject an invalid supported regions policy", func() {
+		configMap.Data["accountpool"] = `fm-accountpool:
+  supportedRegions:
+    regions: [us-east-1]
+    policy: ignore
+`
+		config = NewOperatorConfig(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build())
+		_, err := config.SupportedRegions(ctx, "fm-accountpool")
+		Expect(err).To(MatchError(ContainSubstring(`invalid supported regions policy "ignore"`)))
+	})
+})
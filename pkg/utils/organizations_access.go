@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+const (
+	// organizationsAccessDeniedThreshold is how many Organizations calls in a row have to be denied before the fleet
+	// is frozen, so a single denied call, e.g. on an account being moved out of the organization, doesn't freeze it
+	organizationsAccessDeniedThreshold = 3
+	// OrganizationsAccessFrozenRequeue is how long the reconciles of the frozen controllers are put off
+	OrganizationsAccessFrozenRequeue = time.Minute
+)
+
+// organizationsAccessDeniedCodes are the error codes of the Organizations calls the operator isn't allowed to make,
+// e.g. because the permissions of the payer account were suspended
+var organizationsAccessDeniedCodes = []string{"AccessDeniedException", "AccessDenied"}
+
+// OrganizationsFrozenControllers are the controllers whose reconciles are put off while the operator is denied
+// Organizations access, as they move accounts and claims between states
+var OrganizationsFrozenControllers = []string{"account", "accountclaim", "accountpool", "accountvalidation"}
+
+// OrganizationsAccess tracks whether the operator is denied Organizations access. All Organizations calls of the
+// operator are recorded in it by the AWS client.
+var OrganizationsAccess = NewOrganizationsAccessBreaker()
+
+var organizationsAccessLog = logf.Log.WithName("organizations-access")
+
+// OrganizationsAccessBreaker freezes the account state transitions of the whole fleet once Organizations denied
+// organizationsAccessDeniedThreshold calls in a row, instead of failing every account one by one, and unfreezes them
+// on the first Organizations call succeeding again. It is safe for concurrent use.
+type OrganizationsAccessBreaker struct {
+	mutex     sync.Mutex
+	denied    int
+	frozen    bool
+	since     time.Time
+	lastError string
+	onChange  []func()
+}
+
+// NewOrganizationsAccessBreaker returns a breaker which isn't frozen
+func NewOrganizationsAccessBreaker() *OrganizationsAccessBreaker {
+	return &OrganizationsAccessBreaker{}
+}
+
+// IsOrganizationsAccessDenied returns whether err is Organizations refusing a call for lack of permissions
+func IsOrganizationsAccessDenied(err error) bool {
+	var aerr smithy.APIError
+	return errors.As(err, &aerr) && Contains(organizationsAccessDeniedCodes, aerr.ErrorCode())
+}
+
+// Record adds the outcome of an Organizations call. Denied calls freeze the fleet once they reach the threshold, a
+// successful call unfreezes it. Other errors, e.g. throttling, don't tell whether the operator has access and are
+// ignored.
+func (b *OrganizationsAccessBreaker) Record(err error, now time.Time) {
+	if err != nil && !IsOrganizationsAccessDenied(err) {
+		return
+	}
+
+	b.mutex.Lock()
+	changed := false
+	if err == nil {
+		b.denied = 0
+		if b.frozen {
+			organizationsAccessLog.Info("Organizations access restored, resuming account state transitions", "frozenSince", b.since)
+			b.frozen = false
+			b.since = time.Time{}
+			b.lastError = ""
+			changed = true
+		}
+	} else {
+		b.denied++
+		b.lastError = err.Error()
+		if !b.frozen && b.denied >= organizationsAccessDeniedThreshold {
+			organizationsAccessLog.Error(err, "Organizations access denied, freezing account state transitions until it's restored", "deniedCalls", b.denied)
+			b.frozen = true
+			b.since = now
+			changed = true
+		}
+	}
+	frozen := b.frozen
+	callbacks := b.onChange
+	b.mutex.Unlock()
+
+	if !changed {
+		return
+	}
+	if localmetrics.Collector != nil {
+		localmetrics.Collector.SetOrganizationsAccessDenied(frozen)
+	}
+	for _, callback := range callbacks {
+		callback()
+	}
+}
+
+// Frozen returns whether the fleet is frozen, since when, and the last denied call
+func (b *OrganizationsAccessBreaker) Frozen() (bool, time.Time, string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.frozen, b.since, b.lastError
+}
+
+// OnChange registers callback to be called whenever the fleet is frozen or unfrozen
+func (b *OrganizationsAccessBreaker) OnChange(callback func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.onChange = append(b.onChange, callback)
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+var _ = Describe("Organizations access", func() {
+	var (
+		breaker *OrganizationsAccessBreaker
+		now     time.Time
+		changes int
+		denied  error
+	)
+
+	BeforeEach(func() {
+		breaker = NewOrganizationsAccessBreaker()
+		now = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		changes = 0
+		breaker.OnChange(func() { changes++ })
+		denied = &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "You don't have permissions to access this resource."}
+	})
+
+	It("freezes once enough calls in a row are denied", func() {
+		breaker.Record(denied, now)
+		breaker.Record(denied, now)
+		frozen, _, _ := breaker.Frozen()
+		Expect(frozen).To(BeFalse())
+
+		breaker.Record(denied, now.Add(time.Minute))
+		frozen, since, lastError := breaker.Frozen()
+		Expect(frozen).To(BeTrue())
+		Expect(since).To(Equal(now.Add(time.Minute)))
+		Expect(lastError).To(ContainSubstring("AccessDeniedException"))
+		Expect(changes).To(Equal(1))
+
+		By("notifying only once")
+		breaker.Record(denied, now.Add(2*time.Minute))
+		Expect(changes).To(Equal(1))
+	})
+
+	It("resets the denied calls on a successful call", func() {
+		breaker.Record(denied, now)
+		breaker.Record(denied, now)
+		breaker.Record(nil, now)
+		breaker.Record(denied, now)
+		frozen, _, _ := breaker.Frozen()
+		Expect(frozen).To(BeFalse())
+		Expect(changes).To(Equal(0))
+	})
+
+	It("ignores the errors which aren't access denials", func() {
+		for i := 0; i < organizationsAccessDeniedThreshold; i++ {
+			breaker.Record(&smithy.GenericAPIError{Code: "TooManyRequestsException"}, now)
+			breaker.Record(errors.New("connection reset"), now)
+		}
+		frozen, _, _ := breaker.Frozen()
+		Expect(frozen).To(BeFalse())
+	})
+
+	It("unfreezes on the first successful call", func() {
+		for i := 0; i < organizationsAccessDeniedThreshold; i++ {
+			breaker.Record(denied, now)
+		}
+		breaker.Record(&smithy.GenericAPIError{Code: "TooManyRequestsException"}, now)
+		frozen, _, _ := breaker.Frozen()
+		Expect(frozen).To(BeTrue())
+
+		breaker.Record(nil, now)
+		frozen, since, lastError := breaker.Frozen()
+		Expect(frozen).To(BeFalse())
+		Expect(since.IsZero()).To(BeTrue())
+		Expect(lastError).To(BeEmpty())
+		Expect(changes).To(Equal(2))
+	})
+
+	Context("When the fleet is frozen", func() {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "aws-account-operator", Name: "osd-creds-mgmt-abc123"}}
+
+		BeforeEach(func() {
+			DeferCleanup(func(collector *localmetrics.MetricsCollector, access *OrganizationsAccessBreaker) {
+				localmetrics.Collector = collector
+				OrganizationsAccess = access
+			}, localmetrics.Collector, OrganizationsAccess)
+			localmetrics.Collector = localmetrics.NewMetricsCollector(nil)
+			OrganizationsAccess = breaker
+			for i := 0; i < organizationsAccessDeniedThreshold; i++ {
+				breaker.Record(denied, now)
+			}
+		})
+
+		It("puts off the reconciles of the frozen controllers", func() {
+			reconciler := &panickingReconciler{panicValue: "reconciled while frozen"}
+			result, err := NewReconcilerWithMetrics(reconciler, "account").Reconcile(context.TODO(), request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: OrganizationsAccessFrozenRequeue}))
+		})
+
+		It("keeps reconciling the other controllers", func() {
+			result, err := NewReconcilerWithMetrics(&quietReconciler{}, "operatorconfig").Reconcile(context.TODO(), request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: 1}))
+		})
+	})
+})
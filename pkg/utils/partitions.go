@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The AWS partitions the operator knows the capabilities of
+const (
+	PartitionAWS      = "aws"
+	PartitionAWSUSGov = "aws-us-gov"
+	PartitionAWSCN    = "aws-cn"
+)
+
+// PartitionCapability is a capability a claim can request which not every AWS partition has
+type PartitionCapability string
+
+const (
+	// CapabilityRegions is serving the regions of the claim, which only exist in their own partition
+	CapabilityRegions PartitionCapability = "Regions"
+	// CapabilityOptInRegions is enabling opt-in regions in the accounts
+	CapabilityOptInRegions PartitionCapability = "OptInRegions"
+	// CapabilityEnterpriseSupport is enabling Enterprise Support on the accounts of the organization through support
+	// cases, which non-CCS claims need
+	CapabilityEnterpriseSupport PartitionCapability = "EnterpriseSupport"
+)
+
+// PartitionCapabilities describes what an AWS partition can do for claims
+type PartitionCapabilities struct {
+	// RegionPrefix prefixes the names of the regions of the partition. The commercial partition has none, it has the
+	// regions of no other partition.
+	RegionPrefix string
+	// OptInRegions are the regions of the partition which have to be enabled in an account before it's used there
+	OptInRegions []string
+	// Unsupported are the capabilities the partition doesn't have
+	Unsupported []PartitionCapability
+}
+
+// partitionCapabilities is the capability table of the partitions. Partitions missing from it aren't checked.
+var partitionCapabilities = map[string]PartitionCapabilities{
+	PartitionAWS: {
+		OptInRegions: []string{
+			"af-south-1", "ap-east-1", "ap-east-2", "ap-south-2", "ap-southeast-3", "ap-southeast-4", "ap-southeast-5",
+			"ap-southeast-6", "ap-southeast-7", "ca-west-1", "eu-central-2", "eu-south-1", "eu-south-2", "il-central-1",
+			"me-central-1", "me-south-1", "mx-central-1",
+		},
+	},
+	PartitionAWSUSGov: {
+		RegionPrefix: "us-gov-",
+		Unsupported:  []PartitionCapability{CapabilityOptInRegions, CapabilityEnterpriseSupport},
+	},
+	PartitionAWSCN: {
+		RegionPrefix: "cn-",
+		Unsupported:  []PartitionCapability{CapabilityOptInRegions, CapabilityEnterpriseSupport},
+	},
+}
+
+// PartitionOfRegion returns the partition the region belongs to
+func PartitionOfRegion(region string) string {
+	for name, capabilities := range partitionCapabilities {
+		if capabilities.RegionPrefix != "" && strings.HasPrefix(region, capabilities.RegionPrefix) {
+			return name
+		}
+	}
+	return PartitionAWS
+}
+
+// Supports returns whether the partition has the capability
+func (p PartitionCapabilities) Supports(capability PartitionCapability) bool {
+	for _, unsupported := range p.Unsupported {
+		if unsupported == capability {
+			return false
+		}
+	}
+	return true
+}
+
+// PartitionMismatch is a capability a claim requests which its partition doesn't have
+type PartitionMismatch struct {
+	Capability PartitionCapability
+	Message    string
+}
+
+// UnsupportedInPartition returns the capabilities a claim of the regions, of a CCS account or not, requests which the
+// partition doesn't have, in the order of the regions
+func UnsupportedInPartition(partition string, regions []string, ccs bool) []PartitionMismatch {
+	capabilities, ok := partitionCapabilities[partition]
+	if !ok {
+		return nil
+	}
+
+	mismatches := []PartitionMismatch{}
+	for _, region := range regions {
+		regionPartition := PartitionOfRegion(region)
+		if !capabilities.Supports(CapabilityOptInRegions) && Contains(partitionCapabilities[regionPartition].OptInRegions, region) {
+			mismatches = append(mismatches, PartitionMismatch{
+				Capability: CapabilityOptInRegions,
+				Message:    fmt.Sprintf("region %s is an opt-in region, partition %s has no opt-in regions", region, partition),
+			})
+		} else if regionPartition != partition {
+			mismatches = append(mismatches, PartitionMismatch{
+				Capability: CapabilityRegions,
+				Message:    fmt.Sprintf("region %s belongs to partition %s, not %s", region, regionPartition, partition),
+			})
+		}
+	}
+	if !ccs && !capabilities.Supports(CapabilityEnterpriseSupport) {
+		mismatches = append(mismatches, PartitionMismatch{
+			Capability: CapabilityEnterpriseSupport,
+			Message:    fmt.Sprintf("partition %s has no Enterprise Support for the accounts of the organization, only CCS claims are supported", partition),
+		})
+	}
+	return mismatches
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Partition capabilities", func() {
+	It("Should find the partition of a region", func() {
+		Expect(PartitionOfRegion("us-east-1")).To(Equal(PartitionAWS))
+		Expect(PartitionOfRegion("us-gov-west-1")).To(Equal(PartitionAWSUSGov))
+		Expect(PartitionOfRegion("cn-north-1")).To(Equal(PartitionAWSCN))
+	})
+
+	It("Should support opt-in regions and non-CCS claims in the commercial partition", func() {
+		Expect(UnsupportedInPartition(PartitionAWS, []string{"us-east-1", "af-south-1"}, false)).To(BeEmpty())
+	})
+
+	It("Should refuse opt-in regions in GovCloud", func() {
+		Expect(UnsupportedInPartition(PartitionAWSUSGov, []string{"us-gov-west-1", "af-south-1"}, true)).To(Equal([]PartitionMismatch{{
+			Capability: CapabilityOptInRegions,
+			Message:    "region af-south-1 is an opt-in region, partition aws-us-gov has no opt-in regions",
+		}}))
+	})
+
+	It("Should refuse regions of other partitions", func() {
+		Expect(UnsupportedInPartition(PartitionAWSUSGov, []string{"us-east-1"}, true)).To(Equal([]PartitionMismatch{{
+			Capability: CapabilityRegions,
+			Message:    "region us-east-1 belongs to partition aws, not aws-us-gov",
+		}}))
+		Expect(UnsupportedInPartition(PartitionAWS, []string{"us-gov-east-1"}, false)).To(HaveLen(1))
+	})
+
+	It("Should refuse non-CCS claims in GovCloud as it has no Enterprise Support for them", func() {
+		mismatches := UnsupportedInPartition(PartitionAWSUSGov, []string{"us-gov-west-1"}, false)
+		Expect(mismatches).To(HaveLen(1))
+		Expect(mismatches[0].Capability).To(Equal(CapabilityEnterpriseSupport))
+	})
+
+	It("Should not check unknown partitions", func() {
+		Expect(UnsupportedInPartition("aws-iso", []string{"us-east-1"}, false)).To(BeEmpty())
+	})
+})
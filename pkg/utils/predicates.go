@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+// StatusTrigger returns the part of the status of an object other controllers act upon, e.g. its state. Changes to
+// the rest of the status, like the conditions, are written by the controller reconciling the object and don't need
+// to be reconciled again
+type StatusTrigger func(obj client.Object) interface{}
+
+// AccountStatusTrigger triggers a reconcile when the account changes state, is claimed, reused or has its credentials
+// rotated
+func AccountStatusTrigger(obj client.Object) interface{} {
+	account, ok := obj.(*awsv1alpha1.Account)
+	if !ok {
+		return nil
+	}
+	return struct {
+		State                    string
+		Claimed                  bool
+		Reused                   bool
+		RotateCredentials        bool
+		RotateConsoleCredentials bool
+	}{
+		account.Status.State,
+		account.Status.Claimed,
+		account.Status.Reused,
+		account.Status.RotateCredentials,
+		account.Status.RotateConsoleCredentials,
+	}
+}
+
+// AccountClaimStatusTrigger triggers a reconcile when the claim changes state
+func AccountClaimStatusTrigger(obj client.Object) interface{} {
+	accountClaim, ok := obj.(*awsv1alpha1.AccountClaim)
+	if !ok {
+		return nil
+	}
+	return accountClaim.Status.State
+}
+
+// AWSFederatedAccountAccessStatusTrigger triggers a reconcile when the account access changes state
+func AWSFederatedAccountAccessStatusTrigger(obj client.Object) interface{} {
+	accountAccess, ok := obj.(*awsv1alpha1.AWSFederatedAccountAccess)
+	if !ok {
+		return nil
+	}
+	return accountAccess.Status.State
+}
+
+// AWSFederatedRoleStatusTrigger triggers a reconcile when the role changes state
+func AWSFederatedRoleStatusTrigger(obj client.Object) interface{} {
+	role, ok := obj.(*awsv1alpha1.AWSFederatedRole)
+	if !ok {
+		return nil
+	}
+	return role.Status.State
+}
+
+// WithReconcilePredicates filters the events of the watch of a controller with NewReconcilePredicate
+func WithReconcilePredicates(controllerName string, trigger StatusTrigger) builder.Predicates {
+	return builder.WithPredicates(NewReconcilePredicate(controllerName, trigger))
+}
+
+// NewReconcilePredicate skips the update events there is nothing to reconcile for: the ones changing neither the
+// generation of the object, the metadata the operator acts upon nor its status trigger, if any. That is most of the
+// status-only writes. It reports the processed and skipped events of the controller
+func NewReconcilePredicate(controllerName string, trigger StatusTrigger) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return countWatchEvent(controllerName, true)
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return countWatchEvent(controllerName, true)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return countWatchEvent(controllerName, true)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return countWatchEvent(controllerName, needsReconcile(e.ObjectOld, e.ObjectNew, trigger))
+		},
+	}
+}
+
+// needsReconcile returns whether the update of the object from old to new needs to be reconciled
+func needsReconcile(old client.Object, new client.Object, trigger StatusTrigger) bool {
+	if old == nil || new == nil {
+		return true
+	}
+	if old.GetGeneration() != new.GetGeneration() {
+		return true
+	}
+	if (old.GetDeletionTimestamp() == nil) != (new.GetDeletionTimestamp() == nil) {
+		return true
+	}
+	if !equality.Semantic.DeepEqual(old.GetLabels(), new.GetLabels()) ||
+		!equality.Semantic.DeepEqual(old.GetAnnotations(), new.GetAnnotations()) ||
+		!equality.Semantic.DeepEqual(old.GetFinalizers(), new.GetFinalizers()) ||
+		!equality.Semantic.DeepEqual(old.GetOwnerReferences(), new.GetOwnerReferences()) {
+		return true
+	}
+	return trigger != nil && !equality.Semantic.DeepEqual(trigger(old), trigger(new))
+}
+
+func countWatchEvent(controllerName string, processed bool) bool {
+	if localmetrics.Collector != nil {
+		result := localmetrics.WatchEventSkipped
+		if processed {
+			result = localmetrics.WatchEventProcessed
+		}
+		localmetrics.Collector.AddWatchEvent(controllerName, result)
+	}
+	return processed
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+var _ = Describe("Reconcile predicates", func() {
+	var (
+		old     *awsv1alpha1.Account
+		updated *awsv1alpha1.Account
+	)
+
+	BeforeEach(func() {
+		old = &awsv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "account", Namespace: awsv1alpha1.AccountCrNamespace, Generation: 1},
+			Status:     awsv1alpha1.AccountStatus{State: "Creating"},
+		}
+		updated = old.DeepCopy()
+	})
+
+	update := func(trigger StatusTrigger) bool {
+		return NewReconcilePredicate("test", trigger).Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})
+	}
+
+	It("skips status-only writes", func() {
+		updated.Status.Conditions = []awsv1alpha1.AccountCondition{{Type: awsv1alpha1.AccountCreating}}
+		updated.Status.ObservedGeneration = 1
+		Expect(update(AccountStatusTrigger)).To(BeFalse())
+	})
+
+	It("processes generation changes", func() {
+		updated.Generation = 2
+		Expect(update(AccountStatusTrigger)).To(BeTrue())
+	})
+
+	It("processes changes to the metadata the operator acts upon", func() {
+		updated.Annotations = map[string]string{"annotation": "value"}
+		Expect(update(nil)).To(BeTrue())
+
+		updated = old.DeepCopy()
+		updated.Finalizers = []string{"finalizer"}
+		Expect(update(nil)).To(BeTrue())
+
+		updated = old.DeepCopy()
+		now := metav1.Now()
+		updated.DeletionTimestamp = &now
+		Expect(update(nil)).To(BeTrue())
+	})
+
+	It("doesn't consider empty and missing metadata a change", func() {
+		updated.Labels = map[string]string{}
+		Expect(update(nil)).To(BeFalse())
+	})
+
+	It("processes changes to the status trigger", func() {
+		updated.Status.State = "Ready"
+		Expect(update(AccountStatusTrigger)).To(BeTrue())
+		Expect(update(nil)).To(BeFalse())
+	})
+})
@@ -2,6 +2,9 @@ package utils
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -10,12 +13,29 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// ReconcilePanicReason is the reason of the Failed condition recording a panic of a reconcile
+const ReconcilePanicReason = "ReconcilePanic"
+
+// maxPanicMessageLength caps the panic message recorded in the conditions of an object
+const maxPanicMessageLength = 1024
+
+// PanicRecorder is implemented by the Reconcilers that record a panic of their reconcile in the status of the object,
+// message holds the panic value and a reference to where it happened
+type PanicRecorder interface {
+	RecordPanic(ctx context.Context, request reconcile.Request, message string) error
+}
+
 // NewReconcilerWithMetrics wraps an existing Reconciler such that calls to Reconcile report the
-// reconcileDuration metric.
+// reconcileDuration and reconcileOutcomes metrics. A panic of the wrapped Reconciler is recovered and returned as an
+// error, so only the object is requeued and the other controllers go on. The decision trace started by the wrapped
+// Reconciler, see StartTrace, is written once it returns. The reconciles of the OrganizationsFrozenControllers are put
+// off while the operator is denied Organizations access, see OrganizationsAccess. In dry-run mode, a reconcile
+// failing on a skipped write is stopped without an error, see IsDryRunSkipped.
 func NewReconcilerWithMetrics(wrapped reconcile.Reconciler, controllerName string) reconcile.Reconciler {
 	return &reconcilerWithMetrics{
 		wrappedReconciler: wrapped,
 		controllerName:    controllerName,
+		freezable:         Contains(OrganizationsFrozenControllers, controllerName),
 		logger:            logf.Log.WithName("controller_"+controllerName).WithValues("Controller", controllerName),
 	}
 }
@@ -23,19 +43,129 @@ func NewReconcilerWithMetrics(wrapped reconcile.Reconciler, controllerName strin
 type reconcilerWithMetrics struct {
 	wrappedReconciler reconcile.Reconciler
 	controllerName    string
+	freezable         bool
 	logger            logr.Logger
 }
 
 // Reconcile implements Reconciler. It logs and reports duration metrics for the wrapped Reconciler.
 func (rwm *reconcilerWithMetrics) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	reqLogger := rwm.logger.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	if rwm.freezable {
+		if frozen, since, _ := OrganizationsAccess.Frozen(); frozen {
+			reqLogger.Info("Organizations access denied, putting off the reconcile", "frozenSince", since)
+			localmetrics.Collector.AddReconcileOutcome(rwm.controllerName, localmetrics.ReconcileOutcomeRequeueAfter)
+			return reconcile.Result{RequeueAfter: OrganizationsAccessFrozenRequeue}, nil
+		}
+	}
 	reqLogger.Info("Reconciling")
 
 	start := time.Now()
-	result, err := rwm.wrappedReconciler.Reconcile(ctx, request)
+	ctx, slot := withTraceSlot(ctx)
+	result, err := rwm.reconcile(ctx, reqLogger, request)
+	if err != nil && IsDryRunSkipped(err) {
+		// Nothing was persisted, retrying would only skip the same write again
+		reqLogger.Info("dry-run: stopping the reconcile at a skipped write", "skipped", err.Error())
+		result, err = reconcile.Result{}, nil
+	}
+	if slot.trace != nil {
+		if traceErr := slot.trace.finish(ctx, result, err); traceErr != nil {
+			reqLogger.Error(traceErr, "failed writing the decision trace")
+		}
+	}
 	dur := time.Since(start)
 	localmetrics.Collector.SetReconcileDuration(rwm.controllerName, dur.Seconds(), err)
+	localmetrics.Collector.AddReconcileOutcome(rwm.controllerName, reconcileOutcome(result, err))
 
 	rwm.logger.WithValues("Duration", dur).Info("Reconcile complete")
 	return result, err
 }
+
+// reconcile calls the wrapped Reconciler, recovering from its panics. A recovered panic is logged with its stack,
+// counted in aws_account_operator_reconcile_panics_total and recorded in the object when the Reconciler is a
+// PanicRecorder.
+func (rwm *reconcilerWithMetrics) reconcile(ctx context.Context, reqLogger logr.Logger, request reconcile.Request) (result reconcile.Result, err error) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		stack := debug.Stack()
+		reqLogger.Error(fmt.Errorf("%v", recovered), "Reconcile panicked", "stack", string(stack))
+		if localmetrics.Collector != nil {
+			localmetrics.Collector.AddReconcilePanic(rwm.controllerName)
+		}
+		message := panicMessage(recovered, stack)
+		if recorder, ok := rwm.wrappedReconciler.(PanicRecorder); ok {
+			rwm.recordPanic(ctx, reqLogger, recorder, request, message)
+		}
+		result = reconcile.Result{}
+		err = fmt.Errorf("reconcile panicked: %v", recovered)
+	}()
+	return rwm.wrappedReconciler.Reconcile(ctx, request)
+}
+
+// recordPanic records the panic in the object, a failure to do so, even a panic, is only logged
+func (rwm *reconcilerWithMetrics) recordPanic(ctx context.Context, reqLogger logr.Logger, recorder PanicRecorder, request reconcile.Request, message string) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			reqLogger.Error(fmt.Errorf("%v", recovered), "Recording the panic of the reconcile panicked")
+		}
+	}()
+	if err := recorder.RecordPanic(ctx, request, message); err != nil {
+		reqLogger.Error(err, "failed recording the panic of the reconcile")
+	}
+}
+
+// panicMessage returns the panic value along with the function and line the panic happened at, truncated to
+// maxPanicMessageLength
+func panicMessage(recovered interface{}, stack []byte) string {
+	message := fmt.Sprintf("Reconcile panicked: %v", recovered)
+	if site := panicSite(string(stack)); site != "" {
+		message = fmt.Sprintf("%s at %s", message, site)
+	}
+	if len(message) > maxPanicMessageLength {
+		message = message[:maxPanicMessageLength-3] + "..."
+	}
+	return message
+}
+
+// panicSite returns the frame of the stack that caused the panic, as "function file:line", empty when it's not found.
+// Frames of the runtime following panic, e.g. of a nil pointer dereference, are skipped.
+func panicSite(stack string) string {
+	lines := strings.Split(stack, "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "panic(") {
+			continue
+		}
+		// Frames are a function line followed by its location, starting after the location of panic itself
+		for j := i + 2; j+1 < len(lines); j += 2 {
+			function := lines[j]
+			if strings.HasPrefix(function, "runtime.") {
+				continue
+			}
+			if paren := strings.LastIndex(function, "("); paren > 0 {
+				function = function[:paren]
+			}
+			location := strings.TrimSpace(lines[j+1])
+			if offset := strings.LastIndex(location, " +0x"); offset > 0 {
+				location = location[:offset]
+			}
+			return fmt.Sprintf("%s %s", function, location)
+		}
+		return ""
+	}
+	return ""
+}
+
+func reconcileOutcome(result reconcile.Result, err error) string {
+	switch {
+	case err != nil:
+		return localmetrics.ReconcileOutcomeError
+	case result.RequeueAfter > 0:
+		return localmetrics.ReconcileOutcomeRequeueAfter
+	case result.Requeue:
+		return localmetrics.ReconcileOutcomeRequeue
+	default:
+		return localmetrics.ReconcileOutcomeSuccess
+	}
+}
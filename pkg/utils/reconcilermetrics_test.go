@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+type panickingReconciler struct {
+	panicValue interface{}
+	recorded   []string
+	recordErr  error
+}
+
+func (p *panickingReconciler) Reconcile(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	if p.panicValue == nil {
+		var nilMap *map[string]string
+		_ = (*nilMap)["key"]
+	}
+	panic(p.panicValue)
+}
+
+func (p *panickingReconciler) RecordPanic(_ context.Context, _ reconcile.Request, message string) error {
+	p.recorded = append(p.recorded, message)
+	return p.recordErr
+}
+
+type quietReconciler struct{}
+
+func (q *quietReconciler) Reconcile(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	return reconcile.Result{RequeueAfter: 1}, nil
+}
+
+type dryRunSkippedError struct{}
+
+func (dryRunSkippedError) Error() string       { return "dry-run: skipped IAM CreateUser" }
+func (dryRunSkippedError) DryRunSkipped() bool { return true }
+
+type dryRunReconciler struct{}
+
+func (d *dryRunReconciler) Reconcile(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	return reconcile.Result{Requeue: true}, fmt.Errorf("failed creating the IAM user: %w", dryRunSkippedError{})
+}
+
+var _ = Describe("Reconcile panic recovery", func() {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "aws-account-operator", Name: "osd-creds-mgmt-abc123"}}
+
+	BeforeEach(func() {
+		DeferCleanup(func(collector *localmetrics.MetricsCollector) {
+			localmetrics.Collector = collector
+		}, localmetrics.Collector)
+		localmetrics.Collector = localmetrics.NewMetricsCollector(nil)
+	})
+
+	It("Should return the panic as an error and record it in the object", func() {
+		reconciler := &panickingReconciler{panicValue: "unexpected state"}
+		result, err := NewReconcilerWithMetrics(reconciler, "test").Reconcile(context.TODO(), request)
+
+		Expect(err).To(MatchError("reconcile panicked: unexpected state"))
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(reconciler.recorded).To(HaveLen(1))
+		Expect(reconciler.recorded[0]).To(HavePrefix("Reconcile panicked: unexpected state at "))
+		Expect(reconciler.recorded[0]).To(ContainSubstring("panickingReconciler).Reconcile"))
+		Expect(reconciler.recorded[0]).To(ContainSubstring("reconcilermetrics_test.go:"))
+	})
+
+	It("Should point runtime errors at the code that caused them", func() {
+		reconciler := &panickingReconciler{}
+		_, err := NewReconcilerWithMetrics(reconciler, "test").Reconcile(context.TODO(), request)
+
+		Expect(err).To(MatchError(ContainSubstring("nil pointer dereference")))
+		Expect(reconciler.recorded).To(HaveLen(1))
+		Expect(reconciler.recorded[0]).To(ContainSubstring("panickingReconciler).Reconcile"))
+		Expect(reconciler.recorded[0]).ToNot(ContainSubstring("runtime."))
+	})
+
+	It("Should go on when recording the panic fails", func() {
+		reconciler := &panickingReconciler{panicValue: "unexpected state", recordErr: fmt.Errorf("conflict")}
+		_, err := NewReconcilerWithMetrics(reconciler, "test").Reconcile(context.TODO(), request)
+		Expect(err).To(MatchError("reconcile panicked: unexpected state"))
+	})
+
+	It("Should truncate long panic values", func() {
+		reconciler := &panickingReconciler{panicValue: strings.Repeat("a", 2*maxPanicMessageLength)}
+		_, err := NewReconcilerWithMetrics(reconciler, "test").Reconcile(context.TODO(), request)
+		Expect(err).To(HaveOccurred())
+		Expect(reconciler.recorded[0]).To(HaveLen(maxPanicMessageLength))
+		Expect(reconciler.recorded[0]).To(HaveSuffix("..."))
+	})
+
+	It("Should stop reconciles failing on a write skipped in dry-run mode without an error", func() {
+		result, err := NewReconcilerWithMetrics(&dryRunReconciler{}, "test").Reconcile(context.TODO(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("Should leave reconciles that don't panic alone", func() {
+		result, err := NewReconcilerWithMetrics(&quietReconciler{}, "test").Reconcile(context.TODO(), request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: 1}))
+	})
+})
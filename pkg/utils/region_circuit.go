@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// regionCircuitWindow is how far back the outcomes of the AWS operations in a region count towards its error rate
+	regionCircuitWindow = 15 * time.Minute
+	// regionCircuitMinOutcomes is how many outcomes a region needs in the window before its circuit can open, so a
+	// single failure doesn't skip a region
+	regionCircuitMinOutcomes = 3
+	// regionCircuitErrorRate is the error rate opening the circuit of a region
+	regionCircuitErrorRate = 0.5
+	// RegionCircuitOpenDuration is how long a region is skipped once its circuit opened
+	RegionCircuitOpenDuration = 30 * time.Minute
+)
+
+// RegionCircuits tracks the error rate of the region initializations and cleanups of every account, so that a region
+// degraded by an AWS outage is skipped instead of failing whole account operations
+var RegionCircuits = NewRegionCircuitBreaker()
+
+type regionOutcome struct {
+	at     time.Time
+	failed bool
+}
+
+// regionCircuit is the circuit state of a region. It's open until openUntil, then half open: operations are allowed
+// again and the first outcome decides whether the circuit closes or opens again.
+type regionCircuit struct {
+	outcomes  []regionOutcome
+	openUntil time.Time
+	halfOpen  bool
+}
+
+// RegionCircuitBreaker is the circuit state of each region. It is safe for concurrent use.
+type RegionCircuitBreaker struct {
+	mutex   sync.Mutex
+	regions map[string]*regionCircuit
+}
+
+// NewRegionCircuitBreaker returns a breaker with the circuits of every region closed
+func NewRegionCircuitBreaker() *RegionCircuitBreaker {
+	return &RegionCircuitBreaker{regions: map[string]*regionCircuit{}}
+}
+
+func (b *RegionCircuitBreaker) circuit(region string) *regionCircuit {
+	circuit, ok := b.regions[region]
+	if !ok {
+		circuit = &regionCircuit{}
+		b.regions[region] = circuit
+	}
+	return circuit
+}
+
+// Allow returns whether operations can run in the region, and when it will be retried when they can't
+func (b *RegionCircuitBreaker) Allow(region string, now time.Time) (bool, time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	circuit := b.circuit(region)
+	if now.Before(circuit.openUntil) {
+		return false, circuit.openUntil
+	}
+	if !circuit.openUntil.IsZero() {
+		circuit.openUntil = time.Time{}
+		circuit.halfOpen = true
+	}
+	return true, time.Time{}
+}
+
+// Record adds the outcome of an operation in the region, opening its circuit when the error rate within the window
+// crosses the threshold
+func (b *RegionCircuitBreaker) Record(region string, failed bool, now time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	circuit := b.circuit(region)
+
+	if circuit.halfOpen {
+		circuit.halfOpen = false
+		circuit.outcomes = nil
+		if failed {
+			circuit.openUntil = now.Add(RegionCircuitOpenDuration)
+			return
+		}
+	}
+
+	kept := circuit.outcomes[:0]
+	for _, outcome := range circuit.outcomes {
+		if now.Sub(outcome.at) < regionCircuitWindow {
+			kept = append(kept, outcome)
+		}
+	}
+	circuit.outcomes = append(kept, regionOutcome{at: now, failed: failed})
+
+	failures := 0
+	for _, outcome := range circuit.outcomes {
+		if outcome.failed {
+			failures++
+		}
+	}
+	if len(circuit.outcomes) >= regionCircuitMinOutcomes && float64(failures)/float64(len(circuit.outcomes)) >= regionCircuitErrorRate {
+		circuit.openUntil = now.Add(RegionCircuitOpenDuration)
+		circuit.outcomes = nil
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Region circuits", func() {
+	var (
+		breaker *RegionCircuitBreaker
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		breaker = NewRegionCircuitBreaker()
+		now = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("opens once the error rate crosses the threshold", func() {
+		breaker.Record("us-east-1", true, now)
+		breaker.Record("us-east-1", false, now)
+		allowed, _ := breaker.Allow("us-east-1", now)
+		Expect(allowed).To(BeTrue())
+
+		breaker.Record("us-east-1", true, now)
+		allowed, retryAt := breaker.Allow("us-east-1", now)
+		Expect(allowed).To(BeFalse())
+		Expect(retryAt).To(Equal(now.Add(RegionCircuitOpenDuration)))
+
+		By("leaving the other regions alone")
+		allowed, _ = breaker.Allow("us-west-2", now)
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("forgets outcomes older than the window", func() {
+		breaker.Record("us-east-1", true, now)
+		breaker.Record("us-east-1", true, now)
+		breaker.Record("us-east-1", true, now.Add(regionCircuitWindow))
+		allowed, _ := breaker.Allow("us-east-1", now.Add(regionCircuitWindow))
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("retries the region once the circuit was open long enough", func() {
+		for i := 0; i < regionCircuitMinOutcomes; i++ {
+			breaker.Record("us-east-1", true, now)
+		}
+		later := now.Add(RegionCircuitOpenDuration)
+		allowed, _ := breaker.Allow("us-east-1", later)
+		Expect(allowed).To(BeTrue())
+
+		By("opening again on the first failure")
+		breaker.Record("us-east-1", true, later)
+		allowed, _ = breaker.Allow("us-east-1", later)
+		Expect(allowed).To(BeFalse())
+
+		By("closing on the first success")
+		later = later.Add(RegionCircuitOpenDuration)
+		allowed, _ = breaker.Allow("us-east-1", later)
+		Expect(allowed).To(BeTrue())
+		breaker.Record("us-east-1", false, later)
+		breaker.Record("us-east-1", true, later)
+		allowed, _ = breaker.Allow("us-east-1", later)
+		Expect(allowed).To(BeTrue())
+	})
+})
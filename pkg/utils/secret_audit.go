@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+var secretAuditLog = logf.Log.WithName("secret_audit")
+
+// NewSecretAuditClient wraps the client of a controller so every Secret it reads or writes is logged and counted
+// with the name of the controller, for security reviews of how credentials are handled. Other objects go straight to
+// the wrapped client.
+func NewSecretAuditClient(c client.Client, controllerName string) client.Client {
+	return &secretAuditClient{Client: c, controller: controllerName}
+}
+
+type secretAuditClient struct {
+	client.Client
+	controller string
+}
+
+func (c *secretAuditClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	err := c.Client.Get(ctx, key, obj, opts...)
+	if _, ok := obj.(*corev1.Secret); ok {
+		c.audit("get", key.Namespace, key.Name, err)
+	}
+	return err
+}
+
+func (c *secretAuditClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	err := c.Client.List(ctx, list, opts...)
+	secrets, ok := list.(*corev1.SecretList)
+	if !ok {
+		return err
+	}
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	c.audit("list", listOpts.Namespace, "", err)
+	if err == nil {
+		for _, secret := range secrets.Items {
+			secretAuditLog.V(1).Info("Secret listed", "Controller", c.controller, "Namespace", secret.Namespace, "Name", secret.Name)
+		}
+	}
+	return err
+}
+
+func (c *secretAuditClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	err := c.Client.Create(ctx, obj, opts...)
+	c.auditObject("create", obj, err)
+	return err
+}
+
+func (c *secretAuditClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	err := c.Client.Update(ctx, obj, opts...)
+	c.auditObject("update", obj, err)
+	return err
+}
+
+func (c *secretAuditClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	err := c.Client.Patch(ctx, obj, patch, opts...)
+	c.auditObject("patch", obj, err)
+	return err
+}
+
+func (c *secretAuditClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	err := c.Client.Delete(ctx, obj, opts...)
+	c.auditObject("delete", obj, err)
+	return err
+}
+
+func (c *secretAuditClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	err := c.Client.DeleteAllOf(ctx, obj, opts...)
+	if _, ok := obj.(*corev1.Secret); ok {
+		deleteOpts := &client.DeleteAllOfOptions{}
+		deleteOpts.ApplyOptions(opts)
+		c.audit("deletecollection", deleteOpts.Namespace, "", err)
+	}
+	return err
+}
+
+func (c *secretAuditClient) auditObject(verb string, obj client.Object, err error) {
+	if _, ok := obj.(*corev1.Secret); ok {
+		c.audit(verb, obj.GetNamespace(), obj.GetName(), err)
+	}
+}
+
+// audit records a Secret access. name is empty for the accesses to several Secrets, namespace when they're in any.
+func (c *secretAuditClient) audit(verb, namespace, name string, err error) {
+	result := localmetrics.SecretAccessSucceeded
+	if err != nil {
+		result = localmetrics.SecretAccessFailed
+	}
+	if localmetrics.Collector != nil {
+		localmetrics.Collector.AddSecretAccess(c.controller, verb, result)
+	}
+	keysAndValues := []interface{}{"Controller", c.controller, "Verb", verb, "Namespace", namespace, "Name", name, "Result", result}
+	if err != nil {
+		keysAndValues = append(keysAndValues, "Error", err.Error())
+	}
+	secretAuditLog.Info("Secret accessed", keysAndValues...)
+}
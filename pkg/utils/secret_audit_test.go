@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+)
+
+var _ = Describe("Secret audit client", func() {
+	var (
+		secret  *corev1.Secret
+		audited client.Client
+	)
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: awsv1alpha1.AccountCrNamespace},
+			Data:       map[string][]byte{"aws_access_key_id": []byte("key")},
+		}
+		audited = NewSecretAuditClient(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), "test")
+	})
+
+	It("Should pass Secret reads and writes through", func() {
+		Expect(audited.Create(context.TODO(), secret)).To(Succeed())
+
+		read := &corev1.Secret{}
+		Expect(audited.Get(context.TODO(), client.ObjectKeyFromObject(secret), read)).To(Succeed())
+		Expect(read.Data).To(Equal(secret.Data))
+
+		secrets := &corev1.SecretList{}
+		Expect(audited.List(context.TODO(), secrets, client.InNamespace(awsv1alpha1.AccountCrNamespace))).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(1))
+
+		Expect(audited.Delete(context.TODO(), read)).To(Succeed())
+		err := audited.Get(context.TODO(), client.ObjectKeyFromObject(secret), read)
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should record failed accesses with the metrics collector", func() {
+		defer func(collector *localmetrics.MetricsCollector) {
+			localmetrics.Collector = collector
+		}(localmetrics.Collector)
+		localmetrics.Collector = localmetrics.NewMetricsCollector(nil)
+
+		Expect(audited.Create(context.TODO(), secret)).To(Succeed())
+		err := audited.Create(context.TODO(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secret.Name, Namespace: secret.Namespace}})
+		Expect(k8serr.IsAlreadyExists(err)).To(BeTrue())
+	})
+
+	It("Should leave other objects alone", func() {
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: awsv1alpha1.AccountCrNamespace}}
+		Expect(audited.Create(context.TODO(), configMap)).To(Succeed())
+		Expect(audited.Get(context.TODO(), client.ObjectKeyFromObject(configMap), &corev1.ConfigMap{})).To(Succeed())
+	})
+})
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+const (
+	// SecretAccountIndex indexes the cached secrets by the name of the Account controlling them
+	SecretAccountIndex = "aws.managed.openshift.io/account"
+
+	// ClaimSecretLabel and ClaimSecretNamespaceLabel identify the claim a secret was delivered for, as the secret may
+	// live in another namespace than the claim
+	ClaimSecretLabel          = "aws.managed.openshift.io/accountclaim"
+	ClaimSecretNamespaceLabel = "aws.managed.openshift.io/accountclaim-namespace"
+
+	// AccountSecretLabel identifies the Account a secret was delivered for, so the secrets delivered for every claim of
+	// the account can be found when it's reset
+	AccountSecretLabel = "aws.managed.openshift.io/account"
+)
+
+// IAMUserSecretName returns the name of the secret holding the credentials of the IAM user of the account, a lower
+// case concatenation of the account name and "secret" separated by "-"
+func IAMUserSecretName(account string) string {
+	return strings.ToLower(fmt.Sprintf("%s-%s", account, "secret"))
+}
+
+// IndexSecrets adds the secret indexes to the manager cache
+func IndexSecrets(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &corev1.Secret{}, SecretAccountIndex, secretAccount)
+}
+
+// secretAccount returns the name of the Account controlling the secret
+func secretAccount(obj client.Object) []string {
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.Controller != nil && *owner.Controller && owner.Kind == "Account" {
+			return []string{owner.Name}
+		}
+	}
+	return nil
+}
+
+// SecretsForAccount returns the secrets controlled by the Account, e.g. the credentials of its IAM user, from the
+// SecretAccountIndex
+func SecretsForAccount(ctx context.Context, c client.Reader, accountName string) ([]corev1.Secret, error) {
+	secrets := &corev1.SecretList{}
+	err := c.List(ctx, secrets, client.InNamespace(awsv1alpha1.AccountCrNamespace), client.MatchingFields{SecretAccountIndex: accountName})
+	return secrets.Items, err
+}
+
+// ClaimSecretLabels are the labels of the secrets delivered for the claim, which are managed by the operator. Once
+// the claim is linked to an account, they include the account too, unless its name isn't a valid label value.
+func ClaimSecretLabels(accountClaim *awsv1alpha1.AccountClaim) map[string]string {
+	labels := map[string]string{
+		ClaimSecretLabel:          accountClaim.Name,
+		ClaimSecretNamespaceLabel: accountClaim.Namespace,
+		ManagedLabel:              "true",
+	}
+	if accountClaim.Spec.AccountLink != "" && len(validation.IsValidLabelValue(accountClaim.Spec.AccountLink)) == 0 {
+		labels[AccountSecretLabel] = accountClaim.Spec.AccountLink
+	}
+	return labels
+}
+
+// SecretsForClaim returns the secrets delivered for the claim in the namespace of its credentials secret
+func SecretsForClaim(ctx context.Context, c client.Reader, accountClaim *awsv1alpha1.AccountClaim, opts ...client.ListOption) ([]corev1.Secret, error) {
+	secrets := &corev1.SecretList{}
+	opts = append([]client.ListOption{
+		client.InNamespace(accountClaim.Spec.AwsCredentialSecret.Namespace),
+		client.MatchingLabels{ClaimSecretLabel: accountClaim.Name, ClaimSecretNamespaceLabel: accountClaim.Namespace},
+	}, opts...)
+	err := c.List(ctx, secrets, opts...)
+	return secrets.Items, err
+}
+
+// DeliveredSecretsForAccount returns the secrets delivered for any claim of the account, in every namespace
+func DeliveredSecretsForAccount(ctx context.Context, c client.Reader, accountName string) ([]corev1.Secret, error) {
+	secrets := &corev1.SecretList{}
+	err := c.List(ctx, secrets, client.MatchingLabels{AccountSecretLabel: accountName, ManagedLabel: "true"})
+	return secrets.Items, err
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+)
+
+var _ = Describe("Secrets", func() {
+	var (
+		accountSecret *corev1.Secret
+		claimSecret   *corev1.Secret
+		accountClaim  *awsv1alpha1.AccountClaim
+	)
+
+	BeforeEach(func() {
+		controller := true
+		accountSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      "osd-creds-mgmt-aaaaaa-secret",
+			Namespace: awsv1alpha1.AccountCrNamespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "Account", Name: "osd-creds-mgmt-aaaaaa", Controller: &controller},
+			},
+		}}
+		accountClaim = &awsv1alpha1.AccountClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "claim-namespace"},
+			Spec: awsv1alpha1.AccountClaimSpec{
+				AwsCredentialSecret: awsv1alpha1.SecretRef{Name: "aws", Namespace: "secret-namespace"},
+			},
+		}
+		claimSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      "aws",
+			Namespace: "secret-namespace",
+			Labels:    ClaimSecretLabels(accountClaim),
+		}}
+	})
+
+	It("Should find the secrets of an account through the index", func() {
+		other := accountSecret.DeepCopy()
+		other.Name = "osd-creds-mgmt-bbbbbb-secret"
+		other.OwnerReferences[0].Name = "osd-creds-mgmt-bbbbbb"
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithIndex(&corev1.Secret{}, SecretAccountIndex, secretAccount).
+			WithObjects(accountSecret, other).Build()
+
+		secrets, err := SecretsForAccount(context.TODO(), c, "osd-creds-mgmt-aaaaaa")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(HaveLen(1))
+		Expect(secrets[0].Name).To(Equal("osd-creds-mgmt-aaaaaa-secret"))
+	})
+
+	It("Should find the secrets delivered for a claim", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(claimSecret, accountSecret).Build()
+
+		secrets, err := SecretsForClaim(context.TODO(), c, accountClaim)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(HaveLen(1))
+		Expect(secrets[0].Name).To(Equal("aws"))
+	})
+
+	It("Should label the secrets delivered for a claim with its account", func() {
+		Expect(claimSecret.Labels).NotTo(HaveKey(AccountSecretLabel))
+
+		accountClaim.Spec.AccountLink = "osd-creds-mgmt-aaaaaa"
+		Expect(ClaimSecretLabels(accountClaim)).To(HaveKeyWithValue(AccountSecretLabel, "osd-creds-mgmt-aaaaaa"))
+
+		accountClaim.Spec.AccountLink = strings.Repeat("a", 64)
+		Expect(ClaimSecretLabels(accountClaim)).NotTo(HaveKey(AccountSecretLabel))
+	})
+
+	It("Should find the secrets delivered for every claim of an account", func() {
+		accountClaim.Spec.AccountLink = "osd-creds-mgmt-aaaaaa"
+		claimSecret.Labels = ClaimSecretLabels(accountClaim)
+		earlier := claimSecret.DeepCopy()
+		earlier.Namespace = "earlier-namespace"
+		earlier.Labels[ClaimSecretLabel] = "earlier-claim"
+		unmanaged := claimSecret.DeepCopy()
+		unmanaged.Namespace = "unmanaged-namespace"
+		delete(unmanaged.Labels, ManagedLabel)
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(claimSecret, earlier, unmanaged, accountSecret).Build()
+
+		secrets, err := DeliveredSecretsForAccount(context.TODO(), c, "osd-creds-mgmt-aaaaaa")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(HaveLen(2))
+		Expect([]string{secrets[0].Namespace, secrets[1].Namespace}).To(ConsistOf("secret-namespace", "earlier-namespace"))
+	})
+})
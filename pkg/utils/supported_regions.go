@@ -0,0 +1,46 @@
+package utils
+
+import "fmt"
+
+// RegionPolicy is what's done with the claims of a pool requesting regions the pool doesn't support
+type RegionPolicy string
+
+const (
+	// RegionPolicyReject denies claims requesting unsupported regions
+	RegionPolicyReject RegionPolicy = "reject"
+	// RegionPolicyTrim removes the unsupported regions from claims
+	RegionPolicyTrim RegionPolicy = "trim"
+)
+
+// SupportedRegions are the regions the accounts of a pool can serve, e.g. leaving out opt-in regions that aren't
+// enabled for the pool, set by supportedRegions in the pool's entry of the accountpool ConfigMap key
+type SupportedRegions struct {
+	Regions []string `yaml:"regions"`
+	// Policy defaults to reject
+	Policy RegionPolicy `yaml:"policy,omitempty"`
+}
+
+// Validate checks the policy
+func (s *SupportedRegions) Validate() error {
+	switch s.Policy {
+	case "", RegionPolicyReject, RegionPolicyTrim:
+		return nil
+	}
+	return fmt.Errorf("invalid supported regions policy %q, expected %s or %s", s.Policy, RegionPolicyReject, RegionPolicyTrim)
+}
+
+// Trim reports whether unsupported regions are removed from claims rather than denied
+func (s *SupportedRegions) Trim() bool {
+	return s.Policy == RegionPolicyTrim
+}
+
+// Unsupported returns the regions that aren't supported, in the order given
+func (s *SupportedRegions) Unsupported(regions []string) []string {
+	unsupported := []string{}
+	for _, region := range regions {
+		if !Contains(s.Regions, region) {
+			unsupported = append(unsupported, region)
+		}
+	}
+	return unsupported
+}
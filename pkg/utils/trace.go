@@ -0,0 +1,266 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// TraceAnnotation requests a decision trace of the next reconciles of the object when set to "true"
+	TraceAnnotation = "debug.aws.managed.openshift.io/trace"
+	// TraceReconcilesAnnotation sets the number of reconciles traced, defaultTraceReconciles when unset
+	TraceReconcilesAnnotation = "debug.aws.managed.openshift.io/trace-reconciles"
+
+	defaultTraceReconciles = 5
+	maxTraceReconciles     = 20
+	// maxTraceSteps caps the steps recorded for a single reconcile, keeping the ConfigMap well below its size limit
+	maxTraceSteps = 200
+
+	traceCountKey = "reconciles"
+)
+
+// DecisionTrace records the steps of a single reconcile of an object: the branches taken, the AWS calls made and why
+// it was requeued. A nil DecisionTrace records nothing, so it can be used whether the object is traced or not.
+type DecisionTrace struct {
+	client     client.Client
+	object     client.Object
+	controller string
+	limit      int
+
+	mu        sync.Mutex
+	steps     []string
+	truncated int
+}
+
+type traceSlotKey struct{}
+
+// traceSlot holds the trace of a reconcile, it's put in the context by the metrics wrapper and filled by StartTrace
+type traceSlot struct {
+	trace *DecisionTrace
+}
+
+// withTraceSlot returns a context StartTrace can record the trace of the reconcile in
+func withTraceSlot(ctx context.Context) (context.Context, *traceSlot) {
+	slot := &traceSlot{}
+	return context.WithValue(ctx, traceSlotKey{}, slot), slot
+}
+
+// TraceFromContext returns the trace of the reconcile, nil when the object isn't traced
+func TraceFromContext(ctx context.Context) *DecisionTrace {
+	if slot, ok := ctx.Value(traceSlotKey{}).(*traceSlot); ok {
+		return slot.trace
+	}
+	return nil
+}
+
+// StartTrace starts the decision trace of the reconcile when the object carries TraceAnnotation. The returned logger
+// records every message logged into the trace on top of logging it, other steps are recorded through
+// TraceFromContext. The trace is written by the metrics wrapper once the reconcile returns.
+func StartTrace(ctx context.Context, c client.Client, controllerName string, obj client.Object, reqLogger logr.Logger) logr.Logger {
+	if obj.GetAnnotations()[TraceAnnotation] != "true" {
+		return reqLogger
+	}
+	slot, ok := ctx.Value(traceSlotKey{}).(*traceSlot)
+	if !ok {
+		return reqLogger
+	}
+	slot.trace = &DecisionTrace{
+		client:     c,
+		object:     obj,
+		controller: controllerName,
+		limit:      traceLimit(obj),
+	}
+	sink := reqLogger.GetSink()
+	if callDepthSink, ok := sink.(logr.CallDepthLogSink); ok {
+		// Account for the frame of traceSink
+		sink = callDepthSink.WithCallDepth(1)
+	}
+	return logr.New(&traceSink{sink: sink, trace: slot.trace})
+}
+
+// traceLimit returns the number of reconciles to trace requested by the object
+func traceLimit(obj client.Object) int {
+	limit, err := strconv.Atoi(obj.GetAnnotations()[TraceReconcilesAnnotation])
+	if err != nil || limit < 1 {
+		return defaultTraceReconciles
+	}
+	if limit > maxTraceReconciles {
+		return maxTraceReconciles
+	}
+	return limit
+}
+
+// Step records a step of the reconcile
+func (t *DecisionTrace) Step(format string, args ...interface{}) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.steps) >= maxTraceSteps {
+		t.truncated++
+		return
+	}
+	t.steps = append(t.steps, fmt.Sprintf("%s %s", time.Now().UTC().Format("15:04:05.000"), fmt.Sprintf(format, args...)))
+}
+
+// AWSCall records an AWS call made during the reconcile
+func (t *DecisionTrace) AWSCall(service, operation string, err error) {
+	if err != nil {
+		t.Step("AWS %s.%s failed: %v", service, operation, err)
+		return
+	}
+	t.Step("AWS %s.%s", service, operation)
+}
+
+// TraceConfigMapName returns the name of the ConfigMap holding the decision traces of the object
+func TraceConfigMapName(obj client.Object) string {
+	return fmt.Sprintf("%s-trace", obj.GetName())
+}
+
+// finish records the outcome of the reconcile and writes the trace into the ConfigMap next to the object, one key per
+// reconcile. Once the requested number of reconciles is traced, TraceAnnotation is removed from the object.
+func (t *DecisionTrace) finish(ctx context.Context, result reconcile.Result, err error) error {
+	switch {
+	case err != nil:
+		t.Step("Reconcile failed, requeued with backoff: %v", err)
+	case result.RequeueAfter > 0:
+		t.Step("Reconcile done, requeued after %s", result.RequeueAfter)
+	case result.Requeue:
+		t.Step("Reconcile done, requeued")
+	default:
+		t.Step("Reconcile done")
+	}
+	t.mu.Lock()
+	steps := t.steps
+	if t.truncated > 0 {
+		steps = append(steps, fmt.Sprintf("... %d more steps not recorded", t.truncated))
+	}
+	t.mu.Unlock()
+
+	configMap := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: t.object.GetNamespace(), Name: TraceConfigMapName(t.object)}
+	getErr := t.client.Get(ctx, key, configMap)
+	if getErr != nil && !k8serr.IsNotFound(getErr) {
+		return getErr
+	}
+	exists := getErr == nil
+	if !exists {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{ManagedLabel: "true"},
+			},
+		}
+		if err := controllerutil.SetOwnerReference(t.object, configMap, t.client.Scheme()); err != nil {
+			return err
+		}
+	}
+
+	count, _ := strconv.Atoi(configMap.Data[traceCountKey])
+	if count >= t.limit {
+		// A new trace was requested after the previous one completed, start over
+		count = 0
+		configMap.Data = nil
+	}
+	count++
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[traceCountKey] = strconv.Itoa(count)
+	configMap.Data[fmt.Sprintf("reconcile-%02d", count)] = fmt.Sprintf("controller: %s\nresourceVersion: %s\n%s\n",
+		t.controller, t.object.GetResourceVersion(), strings.Join(steps, "\n"))
+
+	if exists {
+		err = t.client.Update(ctx, configMap)
+	} else {
+		err = t.client.Create(ctx, configMap)
+	}
+	if err != nil || count < t.limit {
+		return err
+	}
+
+	// Every requested reconcile is traced
+	patch := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, TraceAnnotation)))
+	return client.IgnoreNotFound(t.client.Patch(ctx, t.object, patch))
+}
+
+// traceSink passes the messages logged during a traced reconcile to the trace, on top of the wrapped sink. Messages
+// are traced at every verbosity, the wrapped sink still only logs the ones it's enabled for.
+type traceSink struct {
+	sink  logr.LogSink
+	trace *DecisionTrace
+}
+
+// Init does nothing, the wrapped sink was initialized by its own logger
+func (s *traceSink) Init(logr.RuntimeInfo) {}
+
+func (s *traceSink) Enabled(int) bool {
+	return true
+}
+
+func (s *traceSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	s.trace.Step("%s%s", msg, traceValues(keysAndValues))
+	if s.sink != nil && s.sink.Enabled(level) {
+		s.sink.Info(level, msg, keysAndValues...)
+	}
+}
+
+func (s *traceSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.trace.Step("%s: %v%s", msg, err, traceValues(keysAndValues))
+	if s.sink != nil {
+		s.sink.Error(err, msg, keysAndValues...)
+	}
+}
+
+func (s *traceSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	wrapped := *s
+	if s.sink != nil {
+		wrapped.sink = s.sink.WithValues(keysAndValues...)
+	}
+	return &wrapped
+}
+
+func (s *traceSink) WithName(name string) logr.LogSink {
+	wrapped := *s
+	if s.sink != nil {
+		wrapped.sink = s.sink.WithName(name)
+	}
+	return &wrapped
+}
+
+func (s *traceSink) WithCallDepth(depth int) logr.LogSink {
+	wrapped := *s
+	if sink, ok := s.sink.(logr.CallDepthLogSink); ok {
+		wrapped.sink = sink.WithCallDepth(depth)
+	}
+	return &wrapped
+}
+
+// traceValues formats the key/value pairs of a log message for the trace, sorted by key
+func traceValues(keysAndValues []interface{}) string {
+	pairs := []string{}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", keysAndValues[i], keysAndValues[i+1]))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	sort.Strings(pairs)
+	return " (" + strings.Join(pairs, ", ") + ")"
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
+	"github.com/openshift/aws-account-operator/pkg/testutils"
+)
+
+type tracedReconciler struct {
+	client client.Client
+}
+
+func (t *tracedReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	secret := &corev1.Secret{}
+	if err := t.client.Get(ctx, request.NamespacedName, secret); err != nil {
+		return reconcile.Result{}, err
+	}
+	reqLogger := StartTrace(ctx, t.client, "test", secret, testutils.NewTestLogger().Logger())
+	reqLogger.Info("Account not ready yet", "state", "Creating")
+	reqLogger.V(1).Info("Checked the region initialization")
+	TraceFromContext(ctx).AWSCall("Organizations", "DescribeCreateAccountStatus", nil)
+	return reconcile.Result{RequeueAfter: time.Minute}, nil
+}
+
+var _ = Describe("Decision traces", func() {
+	var (
+		c       client.Client
+		traced  *corev1.Secret
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(func(collector *localmetrics.MetricsCollector) {
+			localmetrics.Collector = collector
+		}, localmetrics.Collector)
+		localmetrics.Collector = localmetrics.NewMetricsCollector(nil)
+
+		traced = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "traced",
+				Namespace:   "aws-account-operator",
+				Annotations: map[string]string{TraceAnnotation: "true", TraceReconcilesAnnotation: "2"},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(traced)}
+	})
+
+	reconcileTraced := func() {
+		_, err := NewReconcilerWithMetrics(&tracedReconciler{client: c}, "test").Reconcile(context.TODO(), request)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getTrace := func() (*corev1.ConfigMap, error) {
+		configMap := &corev1.ConfigMap{}
+		err := c.Get(context.TODO(), client.ObjectKey{Namespace: traced.Namespace, Name: "traced-trace"}, configMap)
+		return configMap, err
+	}
+
+	It("Should record the steps of the requested number of reconciles next to the object", func() {
+		c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(traced).Build()
+		reconcileTraced()
+
+		configMap, err := getTrace()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configMap.Labels).To(HaveKeyWithValue(ManagedLabel, "true"))
+		Expect(configMap.OwnerReferences).To(HaveLen(1))
+		Expect(configMap.OwnerReferences[0].Name).To(Equal("traced"))
+		Expect(configMap.Data).To(HaveKeyWithValue("reconciles", "1"))
+		Expect(configMap.Data["reconcile-01"]).To(HavePrefix("controller: test\n"))
+		Expect(configMap.Data["reconcile-01"]).To(ContainSubstring("Account not ready yet (state=Creating)"))
+		Expect(configMap.Data["reconcile-01"]).To(ContainSubstring("Checked the region initialization"))
+		Expect(configMap.Data["reconcile-01"]).To(ContainSubstring("AWS Organizations.DescribeCreateAccountStatus"))
+		Expect(configMap.Data["reconcile-01"]).To(ContainSubstring("Reconcile done, requeued after 1m0s"))
+
+		current := &corev1.Secret{}
+		Expect(c.Get(context.TODO(), request.NamespacedName, current)).To(Succeed())
+		Expect(current.Annotations).To(HaveKey(TraceAnnotation))
+
+		reconcileTraced()
+		configMap, err = getTrace()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configMap.Data).To(HaveKeyWithValue("reconciles", "2"))
+		Expect(configMap.Data).To(HaveKey("reconcile-02"))
+
+		Expect(c.Get(context.TODO(), request.NamespacedName, current)).To(Succeed())
+		Expect(current.Annotations).ToNot(HaveKey(TraceAnnotation))
+	})
+
+	It("Should start over when a trace is requested again", func() {
+		c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(traced, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "traced-trace", Namespace: traced.Namespace},
+			Data:       map[string]string{"reconciles": "2", "reconcile-01": "old", "reconcile-02": "old"},
+		}).Build()
+		reconcileTraced()
+
+		configMap, err := getTrace()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configMap.Data).To(HaveKeyWithValue("reconciles", "1"))
+		Expect(configMap.Data).ToNot(HaveKey("reconcile-02"))
+	})
+
+	It("Should leave objects without the annotation alone", func() {
+		traced.Annotations = nil
+		c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(traced).Build()
+		reconcileTraced()
+
+		_, err := getTrace()
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should record nothing without a trace", func() {
+		var trace *DecisionTrace
+		Expect(func() { trace.Step("nothing") }).ToNot(Panic())
+		Expect(TraceFromContext(context.TODO())).To(BeNil())
+	})
+})
@@ -5,14 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/openshift/aws-account-operator/test/fixtures"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/smithy-go"
-	"gopkg.in/yaml.v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -20,7 +19,6 @@ import (
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
@@ -49,6 +47,9 @@ const (
 
 	// AwsSecretName is a constant for the name of the Kubernetes secret that holds the AWS Credentials
 	AwsSecretName = "aws-account-operator-credentials" // #nosec G101 -- This is a false positive
+
+	// shortUIDLength is the length of the IDs returned by GenerateShortUID
+	shortUIDLength = 6
 )
 
 // operatorStartTime is (roughly) the time at which the operator came up.
@@ -112,56 +113,48 @@ type AwsPolicy struct {
 	Statement []AwsStatement
 }
 
-// GetServiceQuotasFromAccountPool retrieves and processes the account pool's service quotas from ConfigMap
-func GetServiceQuotasFromAccountPool(reqLogger logr.Logger, accountPoolName string, client client.Client) (awsv1alpha1.RegionalServiceQuotas, error) {
-	reqLogger.Info("Loading Service Quotas")
+// DefaultIAMUserNamePrefix is the prefix of the managed IAM user names of accounts whose pool doesn't set one
+const DefaultIAMUserNamePrefix = "osdManagedAdmin"
 
-	cm, err := GetOperatorConfigMap(client)
-	if err != nil {
-		reqLogger.Error(err, "failed retrieving configmap")
-		return nil, err
-	}
+// iamUserNameMaxLength is the maximum length of an IAM user name
+const iamUserNameMaxLength = 64
 
-	accountpoolString, found := cm.Data["accountpool"]
-	if !found {
-		reqLogger.Error(fixtures.NotFound, "failed getting accountpool data from configmap")
-		return nil, fixtures.NotFound
-	}
+// iamUserNamePattern matches the characters IAM allows in user names
+var iamUserNamePattern = regexp.MustCompile(`^[\w+=,.@-]+$`)
 
-	type Servicequotas map[string]string
-	type AccountPoolConfig struct {
-		IsDefault             bool                     `yaml:"default,omitempty"`
-		RegionedServicequotas map[string]Servicequotas `yaml:"servicequotas,omitempty"`
-	}
+type servicequotas map[string]string
 
-	data := make(map[string]AccountPoolConfig)
-	err = yaml.Unmarshal([]byte(accountpoolString), &data)
+// accountPoolConfig is the configuration of a pool in the accountpool key of the operator ConfigMap
+type accountPoolConfig struct {
+	IsDefault               bool                     `yaml:"default,omitempty"`
+	RegionedServicequotas   map[string]servicequotas `yaml:"servicequotas,omitempty"`
+	IAMUserNamePrefix       string                   `yaml:"iamUserNamePrefix,omitempty"`
+	MaintenanceWindow       *MaintenanceWindow       `yaml:"maintenanceWindow,omitempty"`
+	SupportedRegions        *SupportedRegions        `yaml:"supportedRegions,omitempty"`
+	SkipSupportVerification bool                     `yaml:"skipSupportVerification,omitempty"`
+}
 
-	if err != nil {
-		reqLogger.Error(err, "Failed to unmarshal yaml")
-		return nil, err
+// ValidateIAMUserNamePrefix checks that prefix, followed by a dash and the ID of an account, is a valid IAM user name
+func ValidateIAMUserNamePrefix(prefix string) error {
+	if !iamUserNamePattern.MatchString(prefix) {
+		return fmt.Errorf("IAM user name prefix %q is invalid, IAM user names can only contain alphanumerics and +=,.@_-", prefix)
 	}
-
-	var parsedRegionalServiceQuotas = make(awsv1alpha1.RegionalServiceQuotas)
-
-	if poolData, ok := data[accountPoolName]; !ok {
-		reqLogger.Info("Accountpool not found in configmap. Not setting servicequotas.")
-		return parsedRegionalServiceQuotas, nil
-	} else {
-		// for each service quota in a given region, we'll need to parse and save to use in the account spec.
-		for regionName, serviceQuotas := range poolData.RegionedServicequotas {
-			var parsedServiceQuotas = make(awsv1alpha1.AccountServiceQuota)
-			for quotaCode, quotaValue := range serviceQuotas {
-				qv, _ := strconv.Atoi(quotaValue)
-				parsedServiceQuotas[awsv1alpha1.SupportedServiceQuotas(quotaCode)] = &awsv1alpha1.ServiceQuotaStatus{
-					Value: qv,
-				}
-			}
-			parsedRegionalServiceQuotas[regionName] = parsedServiceQuotas
-		}
+	if maxLength := iamUserNameMaxLength - len("-") - shortUIDLength; len(prefix) > maxLength {
+		return fmt.Errorf("IAM user name prefix %q is longer than %d characters", prefix, maxLength)
 	}
+	return nil
+}
 
-	return parsedRegionalServiceQuotas, nil
+// GetIAMUserNamePrefixFromAccountPool returns the IAM user name prefix of a pool, see OperatorConfig.IAMUserNamePrefix.
+// Reconcilers use their OperatorConfig with the context of the reconcile instead.
+func GetIAMUserNamePrefixFromAccountPool(accountPoolName string, client client.Client) (string, error) {
+	return NewOperatorConfig(client).IAMUserNamePrefix(context.TODO(), accountPoolName)
+}
+
+// GetServiceQuotasFromAccountPool retrieves and processes the account pool's service quotas from ConfigMap, see
+// OperatorConfig.ServiceQuotas
+func GetServiceQuotasFromAccountPool(reqLogger logr.Logger, accountPoolName string, client client.Client) (awsv1alpha1.RegionalServiceQuotas, error) {
+	return NewOperatorConfig(client).ServiceQuotas(context.TODO(), reqLogger, accountPoolName)
 }
 
 // MarshalIAMPolicy converts a role CR into a JSON policy that is acceptable to AWS
@@ -214,8 +207,27 @@ func LogAwsError(logger logr.Logger, errMsg string, customError error, err error
 				AWS Error Message: %s`,
 				errMsg,
 				aerr.ErrorCode(),
-				aerr.ErrorMessage()))
+				aerr.ErrorMessage()),
+			"awsRequestID", AwsRequestID(err))
+	}
+}
+
+// AwsRequestID returns the ID AWS assigned to the request that failed with err, or an empty string when err didn't
+// come from an AWS response. AWS support needs it to look into a failed call.
+func AwsRequestID(err error) string {
+	var respErr interface{ ServiceRequestID() string }
+	if errors.As(err, &respErr) {
+		return respErr.ServiceRequestID()
+	}
+	return ""
+}
+
+// WithAwsRequestID appends the AWS request ID of err to a status condition message
+func WithAwsRequestID(message string, err error) string {
+	if requestID := AwsRequestID(err); requestID != "" {
+		return fmt.Sprintf("%s (AWS request ID: %s)", message, requestID)
 	}
+	return message
 }
 
 // Contains returns true a list of strings includes a specific string
@@ -240,7 +252,7 @@ func Remove(list []string, s string) []string {
 
 // GenerateShortUID Generates a short UID
 func GenerateShortUID() string {
-	return rand.String(6)
+	return rand.String(shortUIDLength)
 }
 
 // GenerateLabel returns a ObjectMeta Labels
@@ -273,14 +285,10 @@ func AccountCRHasIAMUserIDLabel(accountCR *awsv1alpha1.Account) bool {
 	return false
 }
 
-// GetOperatorConfigMap retrieves the default configMap data for the AWS Account Operator from Kubernetes
+// GetOperatorConfigMap retrieves the default configMap data for the AWS Account Operator from Kubernetes. It's meant
+// for callers without a context, reconcilers use their OperatorConfig instead.
 func GetOperatorConfigMap(kubeClient client.Client) (*corev1.ConfigMap, error) {
-	configMap := &corev1.ConfigMap{}
-	err := kubeClient.Get(
-		context.TODO(),
-		types.NamespacedName{Namespace: awsv1alpha1.AccountCrNamespace,
-			Name: awsv1alpha1.DefaultConfigMap}, configMap)
-	return configMap, err
+	return NewOperatorConfig(kubeClient).ConfigMap(context.TODO())
 }
 
 func GetEnvironmentBool(key string, fallback bool) bool {
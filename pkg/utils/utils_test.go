@@ -2,10 +2,15 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -14,9 +19,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	apis "github.com/openshift/aws-account-operator/api"
 	awsv1alpha1 "github.com/openshift/aws-account-operator/api/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/localmetrics"
 	"github.com/openshift/aws-account-operator/pkg/testutils"
 	"k8s.io/client-go/kubernetes/scheme"
 )
@@ -456,4 +463,155 @@ fm-accountpool:
 		})
 	})
 
+	Context("GetIAMUserNamePrefixFromAccountPool", func() {
+		BeforeEach(func() {
+			configMap = &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      awsv1alpha1.DefaultConfigMap,
+					Namespace: awsv1alpha1.AccountCrNamespace,
+				},
+				Data: map[string]string{
+					"accountpool": `hives02ue1:
+  default: true
+  iamUserNamePrefix: stageManagedAdmin
+fm-accountpool:
+  servicequotas: {}
+invalid-accountpool:
+  iamUserNamePrefix: "managed admin"
+`,
+				},
+			}
+		})
+		It("Should return the prefix of the pool", func() {
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{configMap}...).Build()
+			prefix, err := GetIAMUserNamePrefixFromAccountPool("hives02ue1", client)
+			Expect(err).To(BeNil())
+			Expect(prefix).To(Equal("stageManagedAdmin"))
+		})
+		It("Should return the prefix of the default pool for accounts without a pool", func() {
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{configMap}...).Build()
+			prefix, err := GetIAMUserNamePrefixFromAccountPool("", client)
+			Expect(err).To(BeNil())
+			Expect(prefix).To(Equal("stageManagedAdmin"))
+		})
+		It("Should return the default prefix when the pool doesn't set one", func() {
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{configMap}...).Build()
+			prefix, err := GetIAMUserNamePrefixFromAccountPool("fm-accountpool", client)
+			Expect(err).To(BeNil())
+			Expect(prefix).To(Equal(DefaultIAMUserNamePrefix))
+		})
+		It("Should return an Error when the prefix isn't a valid IAM user name", func() {
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects([]runtime.Object{configMap}...).Build()
+			_, err := GetIAMUserNamePrefixFromAccountPool("invalid-accountpool", client)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Context("ValidateIAMUserNamePrefix", func() {
+		It("Should accept the characters IAM allows", func() {
+			Expect(ValidateIAMUserNamePrefix("osd_Managed.Admin+=,@-1")).To(Succeed())
+		})
+		It("Should reject prefixes leaving no room for the account ID", func() {
+			Expect(ValidateIAMUserNamePrefix(strings.Repeat("a", 57))).To(Succeed())
+			Expect(ValidateIAMUserNamePrefix(strings.Repeat("a", 58))).ToNot(Succeed())
+		})
+	})
+
+})
+
+var _ = Describe("Reconcile outcome", func() {
+	DescribeTable("is derived from the result and error of a reconcile",
+		func(result reconcile.Result, err error, expected string) {
+			Expect(reconcileOutcome(result, err)).To(Equal(expected))
+		},
+		Entry("success", reconcile.Result{}, nil, localmetrics.ReconcileOutcomeSuccess),
+		Entry("error", reconcile.Result{Requeue: true}, fmt.Errorf("failed"), localmetrics.ReconcileOutcomeError),
+		Entry("requeue", reconcile.Result{Requeue: true}, nil, localmetrics.ReconcileOutcomeRequeue),
+		Entry("requeue after", reconcile.Result{RequeueAfter: time.Minute}, nil, localmetrics.ReconcileOutcomeRequeueAfter),
+	)
+})
+
+var _ = Describe("AWS request IDs", func() {
+	awsErr := fmt.Errorf("failed creating the account: %w", &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{Err: errors.New("access denied")},
+		RequestID:     "4fd1ea1c-0000-0000-0000-000000000000",
+	})
+
+	It("are extracted from AWS errors", func() {
+		Expect(AwsRequestID(awsErr)).To(Equal("4fd1ea1c-0000-0000-0000-000000000000"))
+		Expect(AwsRequestID(errors.New("not from AWS"))).To(BeEmpty())
+	})
+
+	It("are appended to condition messages", func() {
+		Expect(WithAwsRequestID("Failed to create AWS Account", awsErr)).To(Equal("Failed to create AWS Account (AWS request ID: 4fd1ea1c-0000-0000-0000-000000000000)"))
+		Expect(WithAwsRequestID("Failed to create AWS Account", errors.New("not from AWS"))).To(Equal("Failed to create AWS Account"))
+	})
+})
+
+var _ = Describe("Maintenance windows", func() {
+	// A Saturday
+	saturday := time.Date(2026, time.October, 17, 3, 30, 0, 0, time.UTC)
+
+	DescribeTable("are open for their duration after each start",
+		func(schedule, duration string, now time.Time, expectedOpen bool, expectedNext time.Time) {
+			window := &MaintenanceWindow{Schedule: schedule, Duration: duration}
+			open, next, err := window.Open(now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(open).To(Equal(expectedOpen))
+			Expect(next).To(Equal(expectedNext))
+		},
+		Entry("inside a weekly window", "0 2 * * 6", "4h", saturday, true, time.Time{}),
+		Entry("after a weekly window", "0 2 * * 6", "1h", saturday, false, saturday.AddDate(0, 0, 7).Add(-90*time.Minute)),
+		Entry("before a daily window", "30 4 * * *", "30m", saturday, false, saturday.Add(time.Hour)),
+		Entry("inside a window opened the day before", "0 22 * * 5", "8h", saturday, true, time.Time{}),
+		Entry("on weekdays with ranges and steps", "*/15 1-5 * * 1-5", "10m", saturday, false, saturday.AddDate(0, 0, 2).Add(-150*time.Minute)),
+		Entry("with Sunday as 7", "0 0 * * 7", "1h", saturday, false, saturday.Add(20*time.Hour+30*time.Minute)),
+		Entry("on either restricted day field", "0 3 1 * 0", "1h", saturday, false, saturday.Add(23*time.Hour+30*time.Minute)),
+		Entry("in a leap year", "0 0 29 2 *", "1h", saturday, false, time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+	)
+
+	DescribeTable("are rejected when invalid",
+		func(schedule, duration string) {
+			window := &MaintenanceWindow{Schedule: schedule, Duration: duration}
+			Expect(window.Validate()).NotTo(Succeed())
+		},
+		Entry("missing a field", "0 2 * *", "4h"),
+		Entry("out of range", "0 24 * * *", "4h"),
+		Entry("inverted range", "0 5-1 * * *", "4h"),
+		Entry("invalid step", "*/0 * * * *", "4h"),
+		Entry("never opening", "0 0 31 2 *", "4h"),
+		Entry("invalid duration", "0 2 * * 6", "four hours"),
+		Entry("too long", "0 2 * * 6", "169h"),
+	)
+
+	It("is read from the accountpool configuration", func() {
+		configMap := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: awsv1alpha1.DefaultConfigMap, Namespace: awsv1alpha1.AccountCrNamespace},
+			Data: map[string]string{
+				"accountpool": `hives02ue1:
+  default: true
+  maintenanceWindow:
+    schedule: "0 2 * * 6"
+    duration: 4h
+fm-accountpool: {}
+invalid-accountpool:
+  maintenanceWindow:
+    schedule: "0 2 * *"
+    duration: 4h
+`,
+			},
+		}
+		client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(configMap).Build()
+
+		window, err := GetMaintenanceWindowFromAccountPool("", client)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(window).To(Equal(&MaintenanceWindow{Schedule: "0 2 * * 6", Duration: "4h"}))
+
+		window, err = GetMaintenanceWindowFromAccountPool("fm-accountpool", client)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(window).To(BeNil())
+
+		_, err = GetMaintenanceWindowFromAccountPool("invalid-accountpool", client)
+		Expect(err).To(HaveOccurred())
+	})
 })